@@ -146,6 +146,56 @@ func (acc *DB) Transfer(from, to string, amount int64) (*types.Receipt, error) {
 	return nil, types.ErrNoBalance
 }
 
+// TransferToFrozen 从from 的可用余额转入to 的冻结余额, from 和to 相同时就是冻结自己的资金
+func (acc *DB) TransferToFrozen(from, to string, amount int64) (*types.Receipt, error) {
+	return acc.transferFrozen(from, to, amount, false, true)
+}
+
+// TransferFrozen 从from 的冻结余额转入to 的可用余额, from 和to 相同时就是解冻
+func (acc *DB) TransferFrozen(from, to string, amount int64) (*types.Receipt, error) {
+	return acc.transferFrozen(from, to, amount, true, false)
+}
+
+func addAccountAmount(acc1 *types.Account, amount int64, frozen bool) {
+	if frozen {
+		acc1.Frozen += amount
+	} else {
+		acc1.Balance += amount
+	}
+}
+
+func (acc *DB) transferFrozen(from, to string, amount int64, fromFrozen, toFrozen bool) (*types.Receipt, error) {
+	if !types.CheckAmount(amount) {
+		return nil, types.ErrAmount
+	}
+	accFrom := acc.LoadAccount(from)
+	if (fromFrozen && accFrom.GetFrozen() < amount) || (!fromFrozen && accFrom.GetBalance() < amount) {
+		return nil, types.ErrNoBalance
+	}
+	copyfrom := *accFrom
+	addAccountAmount(accFrom, -amount, fromFrozen)
+	if from == to {
+		addAccountAmount(accFrom, amount, toFrozen)
+		kv := acc.GetKVSet(accFrom)
+		acc.SaveKVSet(kv)
+		receipt := &types.ReceiptAccountTransfer{Prev: &copyfrom, Current: accFrom}
+		return &types.Receipt{
+			Ty:   types.ExecOk,
+			KV:   kv,
+			Logs: []*types.ReceiptLog{{Ty: int32(types.TyLogTransfer), Log: types.Encode(receipt)}},
+		}, nil
+	}
+	accTo := acc.LoadAccount(to)
+	copyto := *accTo
+	addAccountAmount(accTo, amount, toFrozen)
+	fromkv := acc.GetKVSet(accFrom)
+	tokv := acc.GetKVSet(accTo)
+	acc.SaveKVSet(fromkv)
+	acc.SaveKVSet(tokv)
+	return acc.transferReceipt(fromkv, tokv, &types.ReceiptAccountTransfer{Prev: &copyfrom, Current: accFrom},
+		&types.ReceiptAccountTransfer{Prev: &copyto, Current: accTo}), nil
+}
+
 func (acc *DB) depositBalance(execaddr string, amount int64) (*types.Receipt, error) {
 	if !types.CheckAmount(amount) {
 		return nil, types.ErrAmount
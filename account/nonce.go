@@ -0,0 +1,73 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package account
+
+import (
+	"github.com/turingchain2020/turingchain/client"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+//开启ForkTxNonce 之后, 每个地址下一笔交易需要使用的nonce
+var nonceKeyPrefix = "mavl-coins-nonce-"
+
+// CalcNonceKey 地址nonce 在状态数据库中的key
+func CalcNonceKey(addr string) []byte {
+	return []byte(nonceKeyPrefix + addr)
+}
+
+// DecodeNonce 解析状态数据库中保存的nonce, 不存在时为0
+func DecodeNonce(value []byte) (int64, error) {
+	if value == nil {
+		return 0, nil
+	}
+	var nonce types.Int64
+	err := types.Decode(value, &nonce)
+	if err != nil {
+		return 0, err
+	}
+	return nonce.Data, nil
+}
+
+// LoadNonce 从stateDB中载入地址的nonce
+func (acc *DB) LoadNonce(addr string) int64 {
+	value, err := acc.db.Get(CalcNonceKey(addr))
+	if err != nil {
+		return 0
+	}
+	nonce, err := DecodeNonce(value)
+	if err != nil {
+		panic(err) //数据库已经损坏
+	}
+	return nonce
+}
+
+// GetNonceKV 将地址的nonce 转为数据库存储kv
+func (acc *DB) GetNonceKV(addr string, nonce int64) *types.KeyValue {
+	return &types.KeyValue{Key: CalcNonceKey(addr), Value: types.Encode(&types.Int64{Data: nonce})}
+}
+
+// LoadNonces 从最新的状态中载入若干地址的nonce
+func LoadNonces(api client.QueueProtocolAPI, addrs []string) ([]int64, error) {
+	header, err := api.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	get := types.StoreGet{StateHash: header.GetStateHash()}
+	for _, addr := range addrs {
+		get.Keys = append(get.Keys, CalcNonceKey(addr))
+	}
+	values, err := api.StoreGet(&get)
+	if err != nil {
+		return nil, err
+	}
+	nonces := make([]int64, len(addrs))
+	for i, value := range values.Values {
+		nonces[i], err = DecodeNonce(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nonces, nil
+}
@@ -49,6 +49,7 @@ var (
 	RecvChunkNumToHash = []byte("RecvChunkNumToHash:")
 	MaxSerialChunkNum  = []byte("MaxSilChunkNum:")
 	MaxDeletedChunkNum = []byte("MaxDeletedChunkNum:")
+	SnapshotHeight     = []byte("SnapshotHeight")
 	storeLog           = chainlog.New("submodule", "store")
 )
 
@@ -62,7 +63,7 @@ func GetLocalDBKeyList() [][]byte {
 		pushPrefix, lastSeqNumPrefix, tempBlockKey, lastTempBlockKey, LastParaSequence,
 		chainParaTxPrefix, chainBodyPrefix, chainHeaderPrefix, chainReceiptPrefix,
 		BodyHashToChunk, ChunkNumToHash, ChunkHashToNum, RecvChunkNumToHash,
-		MaxSerialChunkNum, MaxDeletedChunkNum,
+		MaxSerialChunkNum, MaxDeletedChunkNum, SnapshotHeight,
 	}
 }
 
@@ -162,6 +163,7 @@ type BlockStore struct {
 	client         queue.Client
 	height         int64
 	lastBlock      *types.Block
+	lastHeader     *types.Header //轻节点没有区块体, 只记录最新的区块头
 	lastheaderlock sync.Mutex
 	saveSequence   bool
 	isParaChain    bool
@@ -199,6 +201,13 @@ func NewBlockStore(chain *BlockChain, db dbm.DB, client queue.Client) *BlockStor
 		if cfg.IsEnable("quickIndex") {
 			blockStore.saveQuickIndexFlag()
 		}
+	} else if chain.cfg.LightMode {
+		header, err := blockStore.GetBlockHeaderByHeight(height)
+		if err != nil {
+			chainlog.Error("init::GetBlockHeaderByHeight::database may be crash")
+			panic(err)
+		}
+		blockStore.lastHeader = header
 	} else {
 		blockdetail, err := blockStore.LoadBlock(height, nil)
 		if err != nil {
@@ -447,7 +456,9 @@ func (bs *BlockStore) LastHeader() *types.Header {
 
 	// 通过lastBlock获取lastheader
 	var blockheader = types.Header{}
-	if bs.lastBlock != nil {
+	if bs.lastHeader != nil {
+		blockheader = *bs.lastHeader
+	} else if bs.lastBlock != nil {
 		blockheader.Version = bs.lastBlock.Version
 		blockheader.ParentHash = bs.lastBlock.ParentHash
 		blockheader.TxHash = bs.lastBlock.TxHash
@@ -455,6 +466,7 @@ func (bs *BlockStore) LastHeader() *types.Header {
 		blockheader.Height = bs.lastBlock.Height
 		blockheader.BlockTime = bs.lastBlock.BlockTime
 		blockheader.Signature = bs.lastBlock.Signature
+		blockheader.ConsensusProof = bs.lastBlock.ConsensusProof
 		blockheader.Difficulty = bs.lastBlock.Difficulty
 
 		blockheader.Hash = bs.lastBlock.Hash(bs.client.GetConfig())
@@ -486,6 +498,14 @@ func (bs *BlockStore) UpdateLastBlock2(block *types.Block) {
 	storeLog.Debug("UpdateLastBlock", "UpdateLastBlock", block.Height, "LastHederhash", common.ToHex(block.Hash(bs.client.GetConfig())))
 }
 
+//UpdateLastHeader 轻节点更新最新的区块头到缓存中
+func (bs *BlockStore) UpdateLastHeader(header *types.Header) {
+	bs.lastheaderlock.Lock()
+	defer bs.lastheaderlock.Unlock()
+	bs.lastHeader = header
+	storeLog.Debug("UpdateLastHeader", "height", header.Height, "hash", common.ToHex(header.Hash))
+}
+
 //LastBlock 获取最新的block信息
 func (bs *BlockStore) LastBlock() *types.Block {
 	bs.lastheaderlock.Lock()
@@ -589,6 +609,41 @@ func (bs *BlockStore) SaveBlock(storeBatch dbm.Batch, blockdetail *types.BlockDe
 	return lastSequence, nil
 }
 
+//SaveHeader 轻节点保存区块头以及高度和hash 的对应关系
+func (bs *BlockStore) SaveHeader(storeBatch dbm.Batch, header *types.Header) error {
+	headerkvs, err := saveHeaderTable(bs.db, header)
+	if err != nil {
+		storeLog.Error("SaveHeader:saveHeaderTable", "height", header.Height, "hash", common.ToHex(header.Hash), "err", err)
+		return err
+	}
+	for _, kv := range headerkvs {
+		storeBatch.Set(kv.GetKey(), kv.GetValue())
+	}
+	heightbytes := types.Encode(&types.Int64{Data: header.Height})
+	storeBatch.Set(blockLastHeight, heightbytes)
+	storeBatch.Set(calcHashToHeightKey(header.Hash), heightbytes)
+	storeBatch.Set(calcHeightToHashKey(header.Height), header.Hash)
+	return nil
+}
+
+//DelHeader 轻节点回退时删除最新的区块头
+func (bs *BlockStore) DelHeader(storeBatch dbm.Batch, header *types.Header) error {
+	headerkvs, err := delHeaderTable(bs.db, header.Height, header.Hash)
+	if err != nil {
+		storeLog.Error("DelHeader:delHeaderTable", "height", header.Height, "hash", common.ToHex(header.Hash), "err", err)
+		return err
+	}
+	for _, kv := range headerkvs {
+		if kv.GetValue() == nil {
+			storeBatch.Delete(kv.GetKey())
+		}
+	}
+	storeBatch.Set(blockLastHeight, types.Encode(&types.Int64{Data: header.Height - 1}))
+	storeBatch.Delete(calcHashToHeightKey(header.Hash))
+	storeBatch.Delete(calcHeightToHashKey(header.Height))
+	return nil
+}
+
 //BlockdetailToBlockBody get block detail
 func (bs *BlockStore) BlockdetailToBlockBody(blockdetail *types.BlockDetail) *types.BlockBody {
 	cfg := bs.client.GetConfig()
@@ -1374,6 +1429,7 @@ func (bs *BlockStore) saveBlockForTable(storeBatch dbm.Batch, blockdetail *types
 	blockheader.Height = blockdetail.Block.Height
 	blockheader.BlockTime = blockdetail.Block.BlockTime
 	blockheader.Signature = blockdetail.Block.Signature
+	blockheader.ConsensusProof = blockdetail.Block.ConsensusProof
 	blockheader.Difficulty = blockdetail.Block.Difficulty
 	blockheader.Hash = hash
 	blockheader.TxCount = int64(len(blockdetail.Block.Txs))
@@ -1443,6 +1499,7 @@ func (bs *BlockStore) loadBlockByIndex(indexName string, prefix []byte, primaryK
 	block.Height = blockheader.Height
 	block.BlockTime = blockheader.BlockTime
 	block.Signature = blockheader.Signature
+	block.ConsensusProof = blockheader.ConsensusProof
 	block.Difficulty = blockheader.Difficulty
 	block.Txs = blockbody.Txs
 	block.MainHeight = blockbody.MainHeight
@@ -1513,6 +1570,7 @@ func (bs *BlockStore) loadBlockByHashOld(hash []byte) (*types.BlockDetail, error
 	block.Height = blockheader.Height
 	block.BlockTime = blockheader.BlockTime
 	block.Signature = blockheader.Signature
+	block.ConsensusProof = blockheader.ConsensusProof
 	block.Difficulty = blockheader.Difficulty
 	block.Txs = blockbody.Txs
 	block.MainHeight = blockbody.MainHeight
@@ -1727,6 +1785,20 @@ func (bs *BlockStore) GetMaxSerialChunkNum() int64 {
 	return chunkNum.Data
 }
 
+// GetSnapshotHeight 获取状态快照的高度, 没有通过快照同步时返回0
+func (bs *BlockStore) GetSnapshotHeight() int64 {
+	value, err := bs.db.Get(SnapshotHeight)
+	if err != nil {
+		return 0
+	}
+	height := &types.Int64{}
+	err = types.Decode(value, height)
+	if err != nil {
+		return 0
+	}
+	return height.Data
+}
+
 //SetMaxSerialChunkNum set max serial chunk num
 func (bs *BlockStore) SetMaxSerialChunkNum(chunkNum int64) error {
 	data := &types.Int64{
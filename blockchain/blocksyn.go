@@ -355,7 +355,8 @@ func (chain *BlockChain) fetchPeerList() error {
 	chain.peerMaxBlklock.Unlock()
 
 	//获取到peerlist之后，需要判断是否已经发起了最优链的检测。如果没有就触发一次最优链的检测
-	if atomic.LoadInt32(&chain.firstcheckbestchain) == 0 {
+	//轻节点同步区块头时自己处理分叉
+	if !chain.cfg.LightMode && atomic.LoadInt32(&chain.firstcheckbestchain) == 0 {
 		synlog.Info("fetchPeerList trigger first CheckBestChain")
 		chain.CheckBestChain(true)
 	}
@@ -210,6 +210,9 @@ func (chain *BlockChain) initConfig(cfg *types.TuringchainConfig) {
 	chain.isRecordBlockSequence = mcfg.IsRecordBlockSequence
 	chain.enablePushSubscribe = mcfg.EnablePushSubscribe
 	chain.isParaChain = mcfg.IsParaChain
+	if mcfg.LightMode && mcfg.IsParaChain {
+		panic("light mode not support para chain")
+	}
 	cfg.S("quickIndex", mcfg.EnableTxQuickIndex)
 	cfg.S("reduceLocaldb", mcfg.EnableReduceLocaldb)
 
@@ -326,11 +329,20 @@ func (chain *BlockChain) InitBlockChain() {
 	cfg := chain.client.GetConfig()
 	cfg.S("dbversion", curdbver)
 	if !chain.cfg.IsParaChain && chain.cfg.RollbackBlock <= 0 {
-		// 定时检测/同步block
-		go chain.SynRoutine()
+		if chain.cfg.LightMode {
+			// 轻节点只同步区块头
+			chain.tickerwg.Add(1)
+			go chain.lightSyncRoutine()
+		} else if chain.needSnapshotSync(curheight) {
+			// 先同步状态快照, 完成之后再同步区块
+			go chain.snapshotSyncRoutine()
+		} else {
+			// 定时检测/同步block
+			go chain.SynRoutine()
 
-		// 定时处理futureblock
-		go chain.UpdateRoutine()
+			// 定时处理futureblock
+			go chain.UpdateRoutine()
+		}
 	}
 
 	if !chain.cfg.DisableShard {
@@ -343,6 +355,13 @@ func (chain *BlockChain) InitBlockChain() {
 }
 
 func (chain *BlockChain) getStateHash() []byte {
+	//轻节点没有区块体, 从最新的区块头获取
+	if chain.cfg.LightMode {
+		if stateHash := chain.blockStore.LastHeader().GetStateHash(); stateHash != nil {
+			return stateHash
+		}
+		return zeroHash[:]
+	}
 	blockhight := chain.GetBlockHeight()
 	blockdetail, err := chain.GetBlock(blockhight)
 	if err != nil {
@@ -496,14 +515,16 @@ func (chain *BlockChain) InitCache(currHeight int64) {
 		return
 	}
 	chain.txHeightCache = newTxHashCache(chain, types.HighAllowPackHeight, types.LowAllowPackHeight)
-	// cache history block if exist
-	if currHeight < 0 {
+	// cache history block if exist, 轻节点没有区块体
+	if currHeight < 0 || chain.cfg.LightMode {
 		return
 	}
 
+	//通过快照同步的节点没有快照高度之前的区块
+	snapshotHeight := chain.blockStore.GetSnapshotHeight()
 	for i := currHeight - chain.cfg.DefCacheSize; i <= currHeight; i++ {
-		if i < 0 {
-			i = 0
+		if i < snapshotHeight {
+			i = snapshotHeight
 		}
 		block, err := chain.GetBlock(i)
 		if err != nil {
@@ -513,8 +534,8 @@ func (chain *BlockChain) InitCache(currHeight int64) {
 	}
 
 	for i := currHeight - types.HighAllowPackHeight - types.LowAllowPackHeight + 1; i <= currHeight; i++ {
-		if i < 0 {
-			i = 0
+		if i < snapshotHeight {
+			i = snapshotHeight
 		}
 		block, err := chain.GetBlock(i)
 		if err != nil {
@@ -545,6 +566,9 @@ func (chain *BlockChain) InitIndexAndBestView() {
 	} else {
 		height = 0
 	}
+	if snapshotHeight := chain.blockStore.GetSnapshotHeight(); height < snapshotHeight {
+		height = snapshotHeight
+	}
 	for ; height <= curheight; height++ {
 		header, err := chain.blockStore.GetBlockHeaderByHeight(height)
 		if header == nil {
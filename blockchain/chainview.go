@@ -87,6 +87,15 @@ func (c *chainView) SetTip(node *blockNode) {
 	c.mtx.Unlock()
 }
 
+// Reset 清空chain view, 以node 作为新的tip, 快照同步时使用
+func (c *chainView) Reset(node *blockNode) {
+	c.mtx.Lock()
+	c.nodes = make(map[int64]*list.Element)
+	c.cacheQueue.Init()
+	c.setTip(node)
+	c.mtx.Unlock()
+}
+
 // 删除tip节点，主要是节点回退时使用
 func (c *chainView) delTip(node *blockNode) {
 	if c.tip() != node {
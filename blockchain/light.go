@@ -0,0 +1,395 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/common/merkle"
+	"github.com/turingchain2020/turingchain/queue"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+/*
+轻节点模式:
+轻节点只从其他节点同步区块头, 每个区块头都要验证高度, 父区块hash, 区块hash, 签名以及共识注册的检查规则,
+没有签名的区块头, 以及共识没有注册检查规则时都无法同步,
+配置了snapshotHeight 和snapshotHash 时从可信的快照区块头开始同步, 否则从创世区块开始同步
+查询交易时从全节点获取交易和merkle 路径, 使用本地区块头中的TxHash 验证, 交易回执不在区块头中, 无法验证
+查询状态时由light store 从全节点获取状态证明, 使用区块头中的StateHash 验证
+轻节点不执行区块, 不处理添加区块的消息, 也不支持平行链
+*/
+
+var (
+	//ErrLightHeader 从其他节点获取的区块头验证失败
+	ErrLightHeader = errors.New("ErrLightHeader")
+	//ErrLightFork 获取的区块头和本地最新的区块头不连续, 本地可能在分叉链上
+	ErrLightFork = errors.New("ErrLightFork")
+	//ErrLightTxProof 从其他节点获取的交易证明验证失败
+	ErrLightTxProof = errors.New("ErrLightTxProof")
+	//ErrLightConsensus 配置的共识没有注册轻节点验证区块头的规则
+	ErrLightConsensus = errors.New("ErrLightConsensus")
+)
+
+const (
+	//每次向其他节点请求的区块头个数
+	lightFetchHeaderNum = 256
+	//每次同步最多回退的区块头个数
+	maxLightRollback = 128
+)
+
+//共识插件通过types.RegisterLightHeaderCheck 注册的区块头检查规则
+var getLightHeaderCheck = types.GetLightHeaderCheck
+
+//lightSyncRoutine 轻节点定时从其他节点同步区块头
+func (chain *BlockChain) lightSyncRoutine() {
+	defer chain.tickerwg.Done()
+	ticker := time.NewTicker(chain.blockSynInterVal * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-chain.quit:
+			return
+		case <-ticker.C:
+			err := chain.lightSync()
+			if err != nil {
+				synlog.Error("lightSync", "height", chain.GetBlockHeight(), "err", err)
+			}
+		}
+	}
+}
+
+//fetchFromPeer 通过p2p 模块从其他节点获取数据
+func (chain *BlockChain) fetchFromPeer(ty int64, req types.Message) (interface{}, error) {
+	msg := chain.client.NewMessage("p2p", ty, req)
+	err := chain.client.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := chain.client.WaitTimeout(msg, 5*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.Err(); err != nil {
+		return nil, err
+	}
+	return resp.GetData(), nil
+}
+
+//fetchLightHeaders 从其他节点获取[start, end]之间的区块头
+func (chain *BlockChain) fetchLightHeaders(start, end int64) ([]*types.Header, error) {
+	data, err := chain.fetchFromPeer(types.EventFetchLightHeaders, &types.ReqBlocks{Start: start, End: end})
+	if err != nil {
+		return nil, err
+	}
+	headers := data.(*types.Headers).GetItems()
+	if len(headers) == 0 {
+		return nil, types.ErrBlockNotFound
+	}
+	return headers, nil
+}
+
+//lightSync 同步到其他节点的最高高度
+func (chain *BlockChain) lightSync() error {
+	err := chain.fetchPeerList()
+	if err != nil {
+		return err
+	}
+	if chain.GetBlockHeight() < 0 {
+		err = chain.lightSyncFirst()
+		if err != nil {
+			return err
+		}
+	}
+	rollback := 0
+	for {
+		select {
+		case <-chain.quit:
+			return types.ErrIsClosed
+		default:
+		}
+		curHeight := chain.GetBlockHeight()
+		peerHeight := chain.GetPeerMaxBlkHeight()
+		if curHeight >= peerHeight {
+			return nil
+		}
+		end := curHeight + lightFetchHeaderNum
+		if end > peerHeight {
+			end = peerHeight
+		}
+		headers, err := chain.fetchLightHeaders(curHeight+1, end)
+		if err != nil {
+			return err
+		}
+		err = chain.connectLightHeaders(headers)
+		if err == ErrLightFork && rollback < maxLightRollback {
+			rollback++
+			err = chain.lightRollback()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+//lightSyncFirst 获取第一个区块头, 配置了快照高度时必须和可信的快照区块hash 一致, 否则必须是创世区块
+func (chain *BlockChain) lightSyncFirst() error {
+	cfg := chain.client.GetConfig()
+	height := chain.cfg.SnapshotHeight
+	headers, err := chain.fetchLightHeaders(height, height)
+	if err != nil {
+		return err
+	}
+	header := headers[0]
+	if header.Height != height || !bytes.Equal(header.CalcHash(cfg), header.Hash) {
+		return ErrLightHeader
+	}
+	if height > 0 {
+		trusted, err := common.FromHex(chain.cfg.SnapshotHash)
+		if err != nil || !bytes.Equal(trusted, header.Hash) {
+			return ErrLightHeader
+		}
+	} else if !bytes.Equal(header.ParentHash, zeroHash[:]) {
+		return ErrLightHeader
+	}
+
+	chain.chainLock.Lock()
+	defer chain.chainLock.Unlock()
+	newbatch := chain.blockStore.NewBatch(true)
+	err = chain.blockStore.SaveHeader(newbatch, header)
+	if err != nil {
+		return err
+	}
+	if height > 0 {
+		newbatch.Set(SnapshotHeight, types.Encode(&types.Int64{Data: height}))
+	}
+	err = newbatch.Write()
+	if err != nil {
+		return err
+	}
+	chainlog.Info("lightSyncFirst", "height", height, "hash", common.ToHex(header.Hash))
+
+	node := newBlockNodeByHeader(false, header, "self", -1)
+	chain.index.AddNode(node)
+	chain.bestChain.Reset(node)
+	chain.updateLightTip(header)
+	return nil
+}
+
+//checkLightHeader 验证区块头和父区块头连续, 区块hash 和签名正确, 并满足共识的检查规则, 共识没有注册检查规则时拒绝
+func (chain *BlockChain) checkLightHeader(parent, header *types.Header) error {
+	cfg := chain.client.GetConfig()
+	if header == nil || header.Height != parent.Height+1 {
+		return ErrLightHeader
+	}
+	if !bytes.Equal(header.ParentHash, parent.Hash) {
+		return ErrLightFork
+	}
+	hash := header.CalcHash(cfg)
+	if !bytes.Equal(hash, header.Hash) {
+		return ErrLightHeader
+	}
+	if header.Signature == nil || !types.CheckSign(hash, "", header.Signature) {
+		return ErrLightHeader
+	}
+	check, ok := getLightHeaderCheck(cfg.GetModuleConfig().Consensus.Name)
+	if !ok {
+		return ErrLightConsensus
+	}
+	return check(cfg, parent, header)
+}
+
+//connectLightHeaders 验证并保存从其他节点获取的区块头
+func (chain *BlockChain) connectLightHeaders(headers []*types.Header) error {
+	chain.chainLock.Lock()
+	defer chain.chainLock.Unlock()
+
+	parent := chain.blockStore.LastHeader()
+	newbatch := chain.blockStore.NewBatch(true)
+	for i, header := range headers {
+		err := chain.checkLightHeader(parent, header)
+		//只有第一个区块头不连续时才是分叉, 否则是其他节点返回的区块头错误
+		if err == ErrLightFork && i > 0 {
+			err = ErrLightHeader
+		}
+		if err != nil {
+			synlog.Error("connectLightHeaders", "height", parent.Height+1, "err", err)
+			return err
+		}
+		err = chain.blockStore.SaveHeader(newbatch, header)
+		if err != nil {
+			return err
+		}
+		parent = header
+	}
+	err := newbatch.Write()
+	if err != nil {
+		return err
+	}
+	for _, header := range headers {
+		node := newBlockNodeByHeader(false, header, "self", -1)
+		node.parent = chain.bestChain.Tip()
+		chain.index.AddNode(node)
+		chain.bestChain.SetTip(node)
+	}
+	chain.updateLightTip(parent)
+	synlog.Debug("connectLightHeaders", "height", parent.Height, "hash", common.ToHex(parent.Hash))
+	return nil
+}
+
+//lightRollback 本地在分叉链上时删除最新的区块头, 不能回退到同步的第一个区块头之前
+func (chain *BlockChain) lightRollback() error {
+	chain.chainLock.Lock()
+	defer chain.chainLock.Unlock()
+
+	header := chain.blockStore.LastHeader()
+	if header.Height <= chain.blockStore.GetSnapshotHeight() {
+		return ErrLightFork
+	}
+	parent, err := chain.blockStore.GetBlockHeaderByHeight(header.Height - 1)
+	if err != nil {
+		return err
+	}
+	newbatch := chain.blockStore.NewBatch(true)
+	err = chain.blockStore.DelHeader(newbatch, header)
+	if err != nil {
+		return err
+	}
+	err = newbatch.Write()
+	if err != nil {
+		return err
+	}
+	if tip := chain.bestChain.Tip(); tip != nil && tip.height == header.Height {
+		chain.bestChain.DelTip(tip)
+		chain.index.DelNode(tip.hash)
+	}
+	chain.updateLightTip(parent)
+	synlog.Info("lightRollback", "height", header.Height, "hash", common.ToHex(header.Hash))
+	return nil
+}
+
+//updateLightTip 更新缓存中的最新高度和区块头
+func (chain *BlockChain) updateLightTip(header *types.Header) {
+	chain.blockStore.UpdateHeight2(header.Height)
+	chain.blockStore.UpdateLastHeader(header)
+	chain.query.updateStateHash(header.StateHash)
+}
+
+//verifyTxProof 使用区块头中的TxHash 验证交易的merkle 路径, ForkRootHash 之后使用多层merkle 树的路径
+func verifyTxProof(cfg *types.TuringchainConfig, header *types.Header, detail *types.TransactionDetail) error {
+	tx := detail.GetTx()
+	if tx == nil || detail.Index < 0 || detail.Index >= header.TxCount {
+		return ErrLightTxProof
+	}
+	var root []byte
+	if !cfg.IsFork(header.Height, "ForkRootHash") {
+		root = merkle.GetMerkleRootFromBranch(detail.Proofs, tx.Hash(), uint32(detail.Index))
+	} else {
+		if len(detail.TxProofs) == 0 {
+			return ErrLightTxProof
+		}
+		root = tx.FullHash()
+		for _, proof := range detail.TxProofs {
+			if proof == nil {
+				return ErrLightTxProof
+			}
+			root = merkle.GetMerkleRootFromBranch(proof.Proofs, root, proof.Index)
+			//子链的根hash
+			if proof.RootHash != nil && !bytes.Equal(proof.RootHash, root) {
+				return ErrLightTxProof
+			}
+		}
+	}
+	if !bytes.Equal(root, header.TxHash) {
+		return ErrLightTxProof
+	}
+	return nil
+}
+
+//lightQueryTx 从全节点获取交易和交易证明, 使用本地的区块头验证
+func (chain *BlockChain) lightQueryTx(txhash []byte) (*types.TransactionDetail, error) {
+	data, err := chain.fetchFromPeer(types.EventFetchTxProof, &types.ReqHash{Hash: txhash})
+	if err != nil {
+		return nil, err
+	}
+	detail := data.(*types.TransactionDetail)
+	if detail.GetTx() == nil || !bytes.Equal(detail.Tx.Hash(), txhash) {
+		return nil, ErrLightTxProof
+	}
+	//交易所在的区块头还没有同步
+	header, err := chain.blockStore.GetBlockHeaderByHeight(detail.Height)
+	if err != nil {
+		return nil, types.ErrTxNotExist
+	}
+	err = verifyTxProof(chain.client.GetConfig(), header, detail)
+	if err != nil {
+		chainlog.Error("lightQueryTx", "hash", common.ToHex(txhash), "height", detail.Height, "err", err)
+		return nil, err
+	}
+	//除了回执之外, 其他信息都从验证过的交易和区块头中获取
+	txresult := &types.TxResult{
+		Height:      header.Height,
+		Index:       int32(detail.Index),
+		Tx:          detail.Tx,
+		Receiptdate: detail.Receipt,
+		Blocktime:   header.BlockTime,
+	}
+	setTxDetailFromTxResult(detail, txresult)
+	return detail, nil
+}
+
+//procLightMsg 轻节点查询交易时需要从全节点获取证明, 不处理添加区块的消息
+func (chain *BlockChain) procLightMsg(msgtype int64, msg *queue.Message, reqnum chan struct{}) bool {
+	if !chain.cfg.LightMode {
+		return false
+	}
+	switch msgtype {
+	case types.EventQueryTx:
+		go chain.processMsg(msg, reqnum, chain.lightQueryTxMsg)
+	case types.EventGetTransactionByHash:
+		go chain.processMsg(msg, reqnum, chain.lightGetTxByHashes)
+	case types.EventSyncBlock, types.EventAddBlockDetail, types.EventBroadcastAddBlock, types.EventAddBlockHeaders,
+		types.EventAddChunkBlock, types.EventAddParaChainBlockDetail, types.EventDelParaChainBlockDetail:
+		go chain.processMsg(msg, reqnum, chain.lightNotSupport)
+	default:
+		return false
+	}
+	return true
+}
+
+func (chain *BlockChain) lightQueryTxMsg(msg *queue.Message) {
+	txhash := (msg.Data).(*types.ReqHash)
+	txDetail, err := chain.lightQueryTx(txhash.Hash)
+	if err != nil {
+		msg.Reply(chain.client.NewMessage("rpc", types.EventTransactionDetail, err))
+		return
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventTransactionDetail, txDetail))
+}
+
+func (chain *BlockChain) lightGetTxByHashes(msg *queue.Message) {
+	txhashs := (msg.Data).(*types.ReqHashes)
+	if int64(len(txhashs.Hashes)) > types.MaxBlockCountPerTime {
+		msg.Reply(chain.client.NewMessage("rpc", types.EventTransactionDetails, types.ErrMaxCountPerTime))
+		return
+	}
+	var txDetails types.TransactionDetails
+	for _, txhash := range txhashs.Hashes {
+		txDetail, err := chain.lightQueryTx(txhash)
+		if err != nil {
+			chainlog.Debug("lightGetTxByHashes", "txhash", common.ToHex(txhash), "err", err)
+			txDetail = &types.TransactionDetail{}
+		}
+		txDetails.Txs = append(txDetails.Txs, txDetail)
+	}
+	msg.Reply(chain.client.NewMessage("rpc", types.EventTransactionDetails, &txDetails))
+}
+
+func (chain *BlockChain) lightNotSupport(msg *queue.Message) {
+	msg.ReplyErr("blockchain", types.ErrNotSupport)
+}
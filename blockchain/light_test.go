@@ -0,0 +1,166 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/common/merkle"
+	"github.com/turingchain2020/turingchain/queue"
+	qmocks "github.com/turingchain2020/turingchain/queue/mocks"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newLightHeader(cfg *types.TuringchainConfig, parent *types.Header, txs []*types.Transaction) *types.Header {
+	header := &types.Header{
+		Height:     parent.Height + 1,
+		ParentHash: parent.Hash,
+		BlockTime:  parent.BlockTime + 1,
+		StateHash:  []byte("statehash"),
+		TxCount:    int64(len(txs)),
+	}
+	header.TxHash = merkle.CalcMerkleRoot(cfg, header.Height, txs)
+	header.Hash = header.CalcHash(cfg)
+	signLightHeader(header)
+	return header
+}
+
+func signLightHeader(header *types.Header) {
+	priv := util.TestPrivkeyList[0]
+	header.Signature = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: priv.Sign(header.Hash).Bytes(),
+	}
+}
+
+func TestLightHeaders(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	chain := InitEnv()
+	cfg := chain.client.GetConfig()
+	chain.cfg.LightMode = true
+	blockStoreDB := dbm.NewDB("blockchain", "leveldb", dir, 100)
+	defer blockStoreDB.Close()
+	chain.blockStore = NewBlockStore(chain, blockStoreDB, chain.client)
+	chain.query = NewQuery(blockStoreDB, chain.client, nil)
+	chain.InitCache(-1)
+	chain.InitIndexAndBestView()
+
+	genesis := newLightHeader(cfg, &types.Header{Height: -1, ParentHash: zeroHash[:], Hash: zeroHash[:]}, nil)
+	h1 := newLightHeader(cfg, genesis, nil)
+	h2 := newLightHeader(cfg, h1, nil)
+
+	client := &qmocks.Client{}
+	client.On("GetConfig").Return(cfg)
+	client.On("NewMessage", mock.Anything, mock.Anything, mock.Anything).Return(&queue.Message{})
+	client.On("Send", mock.Anything, mock.Anything).Return(nil)
+	client.On("WaitTimeout", mock.Anything, mock.Anything).Return(&queue.Message{Data: &types.Headers{Items: []*types.Header{genesis}}}, nil)
+	chain.client = client
+
+	//共识没有注册检查规则时拒绝同步
+	name := cfg.GetModuleConfig().Consensus.Name
+	lightHeaderChecks := make(map[string]types.LightHeaderCheck)
+	defer func(get func(string) (types.LightHeaderCheck, bool)) { getLightHeaderCheck = get }(getLightHeaderCheck)
+	getLightHeaderCheck = func(name string) (types.LightHeaderCheck, bool) {
+		check, ok := lightHeaderChecks[name]
+		return check, ok
+	}
+	require.Nil(t, chain.lightSyncFirst())
+	assert.Equal(t, ErrLightConsensus, chain.connectLightHeaders([]*types.Header{h1}))
+	lightHeaderChecks[name] = func(cfg *types.TuringchainConfig, parent, header *types.Header) error {
+		return nil
+	}
+	assert.Equal(t, int64(0), chain.GetBlockHeight())
+	assert.Equal(t, genesis.Hash, chain.blockStore.LastHeader().Hash)
+
+	require.Nil(t, chain.connectLightHeaders([]*types.Header{h1, h2}))
+	assert.Equal(t, int64(2), chain.GetBlockHeight())
+	assert.Equal(t, h2.Hash, chain.bestChain.Tip().hash)
+	assert.Equal(t, h2.StateHash, chain.query.getStateHash())
+	header, err := chain.blockStore.GetBlockHeaderByHeight(1)
+	require.Nil(t, err)
+	assert.Equal(t, h1.Hash, header.Hash)
+
+	//区块hash 错误, 不连续, 以及批量中间的区块头不连续
+	h3 := newLightHeader(cfg, h2, nil)
+	bad := *h3
+	bad.StateHash = []byte("other")
+	assert.Equal(t, ErrLightHeader, chain.connectLightHeaders([]*types.Header{&bad}))
+	fork := newLightHeader(cfg, h1, nil)
+	fork.Height = 3
+	fork.Hash = fork.CalcHash(cfg)
+	assert.Equal(t, ErrLightFork, chain.connectLightHeaders([]*types.Header{fork}))
+	assert.Equal(t, ErrLightHeader, chain.connectLightHeaders([]*types.Header{h3, fork}))
+	//没有签名或者签名错误
+	unsigned := *h3
+	unsigned.Signature = nil
+	assert.Equal(t, ErrLightHeader, chain.connectLightHeaders([]*types.Header{&unsigned}))
+	badsign := *h3
+	badsign.Signature = h2.Signature
+	assert.Equal(t, ErrLightHeader, chain.connectLightHeaders([]*types.Header{&badsign}))
+	assert.Equal(t, int64(2), chain.GetBlockHeight())
+
+	//共识注册的检查规则
+	lightHeaderChecks[name] = func(cfg *types.TuringchainConfig, parent, header *types.Header) error {
+		return types.ErrBlockNotFound
+	}
+	assert.Equal(t, types.ErrBlockNotFound, chain.connectLightHeaders([]*types.Header{h3}))
+
+	require.Nil(t, chain.lightRollback())
+	assert.Equal(t, int64(1), chain.GetBlockHeight())
+	assert.Equal(t, h1.Hash, chain.blockStore.LastHeader().Hash)
+	assert.Equal(t, h1.Hash, chain.bestChain.Tip().hash)
+	_, err = chain.blockStore.GetBlockHeaderByHeight(2)
+	assert.NotNil(t, err)
+	require.Nil(t, chain.lightRollback())
+	assert.Equal(t, ErrLightFork, chain.lightRollback())
+
+	//重启之后从区块头加载
+	chain.blockStore = NewBlockStore(chain, blockStoreDB, client)
+	assert.Equal(t, genesis.Hash, chain.blockStore.LastHeader().Hash)
+	assert.Equal(t, genesis.StateHash, chain.getStateHash())
+}
+
+func TestVerifyTxProof(t *testing.T) {
+	chain := InitEnv()
+	cfg := chain.client.GetConfig()
+	var txs []*types.Transaction
+	for i := 0; i < 3; i++ {
+		txs = append(txs, &types.Transaction{Execer: []byte("coins"), Payload: []byte("light"), Nonce: int64(i)})
+	}
+	parent := &types.Header{Height: -1, Hash: zeroHash[:]}
+
+	//ForkRootHash 之前使用交易hash 的merkle 路径
+	header := newLightHeader(cfg, parent, txs)
+	assert.False(t, cfg.IsFork(header.Height, "ForkRootHash"))
+	detail := &types.TransactionDetail{Tx: txs[1], Index: 1, Proofs: getTxHashProofs(txs, 1)}
+	assert.Nil(t, verifyTxProof(cfg, header, detail))
+	detail.Index = 2
+	assert.Equal(t, ErrLightTxProof, verifyTxProof(cfg, header, detail))
+	detail.Index = 3
+	assert.Equal(t, ErrLightTxProof, verifyTxProof(cfg, header, detail))
+
+	//ForkRootHash 之后使用交易fullhash 的多层merkle 路径
+	header = newLightHeader(cfg, header, txs)
+	assert.True(t, cfg.IsFork(header.Height, "ForkRootHash"))
+	proof := &types.TxProof{Proofs: getTxFullHashProofs(txs, 2), Index: 2}
+	detail = &types.TransactionDetail{Tx: txs[2], Index: 2, TxProofs: []*types.TxProof{proof}}
+	assert.Nil(t, verifyTxProof(cfg, header, detail))
+	detail.Tx = txs[1]
+	assert.Equal(t, ErrLightTxProof, verifyTxProof(cfg, header, detail))
+	detail.Tx = txs[2]
+	detail.TxProofs = nil
+	assert.Equal(t, ErrLightTxProof, verifyTxProof(cfg, header, detail))
+}
@@ -26,6 +26,9 @@ func (chain *BlockChain) ProcRecvMsg() {
 		if chain.procLocalDB(msgtype, msg, reqnum) {
 			continue
 		}
+		if chain.procLightMsg(msgtype, msg, reqnum) {
+			continue
+		}
 		switch msgtype {
 		case types.EventQueryTx:
 			go chain.processMsg(msg, reqnum, chain.queryTx)
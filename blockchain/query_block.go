@@ -84,6 +84,7 @@ func (chain *BlockChain) ProcGetBlockOverview(ReqHash *types.ReqHash) (*types.Bl
 	header.TxCount = int64(len(block.Block.GetTxs()))
 	header.Difficulty = block.Block.Difficulty
 	header.Signature = block.Block.Signature
+	header.ConsensusProof = block.Block.ConsensusProof
 
 	blockOverview.Head = &header
 
@@ -16,6 +16,10 @@ import (
 
 // Upgrade 升级localDB和storeDB
 func (chain *BlockChain) Upgrade() {
+	//轻节点没有区块体和状态数据, 不需要升级
+	if chain.cfg.LightMode {
+		return
+	}
 	chainlog.Info("chain upgrade start")
 	chain.UpgradeChain()
 	chainlog.Info("storedb upgrade start")
@@ -0,0 +1,210 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/common/difficulty"
+	"github.com/turingchain2020/turingchain/common/merkle"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+/*
+状态快照同步:
+新节点从其他节点下载snapshotHeight 高度的区块和mavl 状态快照, 区块hash 必须和配置的snapshotHash 一致,
+每个快照分片都要验证可以计算出区块头中的StateHash, 全部导入之后把快照区块作为主链的tip, 之后只同步剩余的区块
+快照节点没有快照高度之前的区块, 交易和localdb 索引
+*/
+
+var (
+	//ErrSnapshotBlock 下载的快照区块和配置的不一致
+	ErrSnapshotBlock = errors.New("ErrSnapshotBlock")
+	//ErrSnapshotChunk 下载的快照分片和请求的不一致
+	ErrSnapshotChunk = errors.New("ErrSnapshotChunk")
+)
+
+const (
+	//快照同步失败之后的重试次数, 超过之后从创世区块开始同步
+	maxSnapshotRetry = 30
+	//快照同步失败之后的重试间隔
+	snapshotRetryInterval = 20 * time.Second
+)
+
+//needSnapshotSync 新节点配置了快照高度时需要先同步快照
+//推送订阅和平行链需要完整的sequence, 不支持快照同步
+func (chain *BlockChain) needSnapshotSync(curHeight int64) bool {
+	if chain.cfg.SnapshotHeight <= 0 || chain.isParaChain || chain.isRecordBlockSequence || curHeight > 0 {
+		return false
+	}
+	hash, err := common.FromHex(chain.cfg.SnapshotHash)
+	if err != nil || len(hash) != len(zeroHash) {
+		chainlog.Error("needSnapshotSync", "snapshotHash", chain.cfg.SnapshotHash, "err", types.ErrInvalidParam)
+		return false
+	}
+	return true
+}
+
+//snapshotSyncRoutine 同步快照之后再开始正常的区块同步
+func (chain *BlockChain) snapshotSyncRoutine() {
+	for i := 0; i < maxSnapshotRetry; i++ {
+		err := chain.snapshotSync()
+		if err == nil {
+			break
+		}
+		chainlog.Error("snapshotSync", "height", chain.cfg.SnapshotHeight, "retry", i, "err", err)
+		select {
+		case <-chain.quit:
+			return
+		case <-time.After(snapshotRetryInterval):
+		}
+	}
+	// 定时检测/同步block
+	go chain.SynRoutine()
+
+	// 定时处理futureblock
+	go chain.UpdateRoutine()
+}
+
+//fetchStateSnapshot 通过p2p 模块从其他节点获取快照信息或者快照分片
+func (chain *BlockChain) fetchStateSnapshot(req *types.ReqStateSnapshot) (interface{}, error) {
+	return chain.fetchFromPeer(types.EventFetchStateSnapshot, req)
+}
+
+//sendStore 向store 模块发送快照相关的请求
+func (chain *BlockChain) sendStore(ty int64, data interface{}) error {
+	msg := chain.client.NewMessage("store", ty, data)
+	err := chain.client.Send(msg, true)
+	if err != nil {
+		return err
+	}
+	resp, err := chain.client.Wait(msg)
+	if err != nil {
+		return err
+	}
+	reply := resp.GetData().(*types.Reply)
+	if !reply.IsOk {
+		return errors.New(string(reply.Msg))
+	}
+	return nil
+}
+
+//checkSnapshotBlock 检查快照区块的高度, hash 以及交易的merkle 根
+func (chain *BlockChain) checkSnapshotBlock(detail *types.BlockDetail, blockHash []byte) error {
+	cfg := chain.client.GetConfig()
+	block := detail.GetBlock()
+	if block == nil || block.Height != chain.cfg.SnapshotHeight {
+		return ErrSnapshotBlock
+	}
+	if !bytes.Equal(block.Hash(cfg), blockHash) {
+		return ErrSnapshotBlock
+	}
+	if !bytes.Equal(merkle.CalcMerkleRoot(cfg, block.Height, block.Txs), block.TxHash) {
+		return ErrSnapshotBlock
+	}
+	if len(detail.Receipts) != len(block.Txs) {
+		return ErrSnapshotBlock
+	}
+	return nil
+}
+
+//snapshotSync 下载并验证快照区块和全部快照分片
+func (chain *BlockChain) snapshotSync() error {
+	blockHash, _ := common.FromHex(chain.cfg.SnapshotHash)
+	req := &types.ReqStateSnapshot{
+		Height:    chain.cfg.SnapshotHeight,
+		BlockHash: blockHash,
+		Depth:     -1,
+		Index:     -1,
+	}
+	data, err := chain.fetchStateSnapshot(req)
+	if err != nil {
+		return err
+	}
+	manifest := data.(*types.StateSnapshotManifest)
+	if err := chain.checkSnapshotBlock(manifest.Block, blockHash); err != nil {
+		return err
+	}
+	stateHash := manifest.Block.Block.StateHash
+	chainlog.Info("snapshotSync", "height", req.Height, "stateHash", common.ToHex(stateHash), "depth", manifest.Depth, "total", manifest.Total)
+
+	req.Depth = manifest.Depth
+	for index := int32(0); index < manifest.Total; index++ {
+		select {
+		case <-chain.quit:
+			return types.ErrIsClosed
+		default:
+		}
+		req.Index = index
+		data, err := chain.fetchStateSnapshot(req)
+		if err != nil {
+			return err
+		}
+		chunk := data.(*types.StateSnapshotChunk)
+		if !bytes.Equal(chunk.StateHash, stateHash) || chunk.Depth != manifest.Depth || chunk.Index != index || chunk.Total != manifest.Total {
+			return ErrSnapshotChunk
+		}
+		//store 模块会验证分片可以计算出状态根
+		err = chain.sendStore(types.EventStoreImportSnapshotChunk, chunk)
+		if err != nil {
+			return err
+		}
+		chainlog.Debug("snapshotSync", "index", index, "total", manifest.Total, "nodes", len(chunk.Nodes))
+	}
+	err = chain.sendStore(types.EventStoreCheckSnapshot, &types.ReqHash{Hash: stateHash})
+	if err != nil {
+		return err
+	}
+	return chain.connectSnapshotBlock(manifest.Block)
+}
+
+//connectSnapshotBlock 把快照区块作为主链的tip
+//快照区块不执行, 也不建立交易索引, 快照之前的区块总难度无法计算, 只记录快照区块本身的难度
+func (chain *BlockChain) connectSnapshotBlock(blockdetail *types.BlockDetail) error {
+	chain.chainLock.Lock()
+	defer chain.chainLock.Unlock()
+
+	if chain.GetBlockHeight() > 0 {
+		return types.ErrBlockExist
+	}
+	cfg := chain.client.GetConfig()
+	block := blockdetail.Block
+	hash := block.Hash(cfg)
+
+	newbatch := chain.blockStore.NewBatch(true)
+	_, err := chain.blockStore.SaveBlock(newbatch, blockdetail, -1)
+	if err != nil {
+		return err
+	}
+	err = chain.blockStore.SaveTdByBlockHash(newbatch, hash, difficulty.CalcWork(block.Difficulty))
+	if err != nil {
+		return err
+	}
+	newbatch.Set(SnapshotHeight, types.Encode(&types.Int64{Data: block.Height}))
+	err = newbatch.Write()
+	if err != nil {
+		return err
+	}
+	chainlog.Info("connectSnapshotBlock", "height", block.Height, "hash", common.ToHex(hash))
+
+	chain.blockStore.UpdateHeight2(block.Height)
+	chain.blockStore.UpdateLastBlock2(block)
+	chain.AddCacheBlock(blockdetail)
+
+	node := newBlockNodeByHeader(false, block.GetHeader(cfg), "self", -1)
+	chain.index.AddNode(node)
+	chain.bestChain.Reset(node)
+	chain.query.updateStateHash(block.GetStateHash())
+
+	err = chain.SendAddBlockEvent(blockdetail)
+	if err != nil {
+		chainlog.Debug("connectSnapshotBlock SendAddBlockEvent", "err", err)
+	}
+	//快照同步期间广播过来的区块会放在孤儿池中
+	return chain.orphanPool.ProcessOrphans(hash, chain)
+}
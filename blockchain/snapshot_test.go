@@ -0,0 +1,78 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common"
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/common/merkle"
+	"github.com/turingchain2020/turingchain/queue"
+	qmocks "github.com/turingchain2020/turingchain/queue/mocks"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectSnapshotBlock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	chain := InitEnv()
+	cfg := chain.client.GetConfig()
+	//记录sequence 时需要从创世区块开始同步
+	chain.isRecordBlockSequence = false
+	blockStoreDB := dbm.NewDB("blockchain", "leveldb", dir, 100)
+	defer blockStoreDB.Close()
+	chain.blockStore = NewBlockStore(chain, blockStoreDB, chain.client)
+	chain.query = NewQuery(blockStoreDB, chain.client, nil)
+	chain.InitCache(-1)
+	chain.InitIndexAndBestView()
+	assert.Equal(t, int64(0), chain.blockStore.GetSnapshotHeight())
+
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("snapshot"), Nonce: 1}
+	block := &types.Block{Height: 100, StateHash: []byte("statehash"), Txs: []*types.Transaction{tx}}
+	block.TxHash = merkle.CalcMerkleRoot(cfg, block.Height, block.Txs)
+	detail := &types.BlockDetail{Block: block, Receipts: []*types.ReceiptData{{Ty: types.ExecOk}}}
+
+	chain.cfg.SnapshotHeight = 100
+	chain.cfg.SnapshotHash = common.ToHex(block.Hash(cfg))
+	assert.True(t, chain.needSnapshotSync(-1))
+	assert.False(t, chain.needSnapshotSync(1))
+	chain.isRecordBlockSequence = true
+	assert.False(t, chain.needSnapshotSync(-1))
+	chain.isRecordBlockSequence = false
+	chain.cfg.SnapshotHash = "0x1234"
+	assert.False(t, chain.needSnapshotSync(-1))
+
+	assert.Nil(t, chain.checkSnapshotBlock(detail, block.Hash(cfg)))
+	assert.Equal(t, ErrSnapshotBlock, chain.checkSnapshotBlock(detail, []byte("hash")))
+	detail.Receipts = nil
+	assert.Equal(t, ErrSnapshotBlock, chain.checkSnapshotBlock(detail, block.Hash(cfg)))
+	detail.Receipts = []*types.ReceiptData{{Ty: types.ExecOk}}
+
+	client := &qmocks.Client{}
+	client.On("GetConfig").Return(cfg)
+	client.On("NewMessage", mock.Anything, mock.Anything, mock.Anything).Return(&queue.Message{})
+	client.On("Send", mock.Anything, mock.Anything).Return(nil)
+	chain.client = client
+	require.Nil(t, chain.connectSnapshotBlock(detail))
+	assert.Equal(t, int64(100), chain.GetBlockHeight())
+	assert.Equal(t, int64(100), chain.bestChain.Height())
+	assert.Equal(t, block.Hash(cfg), chain.bestChain.Tip().hash)
+	assert.Equal(t, block.StateHash, chain.query.getStateHash())
+	assert.Equal(t, int64(100), chain.blockStore.GetSnapshotHeight())
+	assert.Equal(t, types.ErrBlockExist, chain.connectSnapshotBlock(detail))
+
+	//重启之后从快照高度开始加载区块
+	chain.InitCache(chain.GetBlockHeight())
+	chain.InitIndexAndBestView()
+	assert.Equal(t, block.Hash(cfg), chain.bestChain.Tip().hash)
+}
@@ -96,6 +96,10 @@ func (mock *mockClient) CloseQueue() (*types.Reply, error) {
 	return &types.Reply{IsOk: true, Msg: []byte("Ok")}, nil
 }
 
+func (mock *mockClient) GetQueueStats(topic string) (*types.QueueStats, error) {
+	return mock.c.GetQueueStats(topic)
+}
+
 func (mock *mockClient) NewMessage(topic string, ty int64, data interface{}) *queue.Message {
 	return mock.c.NewMessage(topic, ty, data)
 }
@@ -564,6 +564,29 @@ func (_m *QueueProtocolAPI) GetPushSeqLastNum(param *types.ReqString) (*types.In
 	return r0, r1
 }
 
+// GetQueueStats provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) GetQueueStats(param *types.ReqString) (*types.QueueStats, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.QueueStats
+	if rf, ok := ret.Get(0).(func(*types.ReqString) *types.QueueStats); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueueStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqString) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSequenceByHash provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) GetSequenceByHash(param *types.ReqHash) (*types.Int64, error) {
 	ret := _m.Called(param)
@@ -1110,6 +1133,20 @@ func (_m *QueueProtocolAPI) SendTx(param *types.Transaction) (*types.Reply, erro
 	return r0, r1
 }
 
+// StoreCheckState provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) StoreCheckState(param *types.StoreCheckState) error {
+	ret := _m.Called(param)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.StoreCheckState) error); ok {
+		r0 = rf(param)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StoreCommit provides a mock function with given fields: param
 func (_m *QueueProtocolAPI) StoreCommit(param *types.ReqHash) (*types.ReplyHash, error) {
 	ret := _m.Called(param)
@@ -1179,6 +1216,29 @@ func (_m *QueueProtocolAPI) StoreGet(_a0 *types.StoreGet) (*types.StoreReplyValu
 	return r0, r1
 }
 
+// StoreGetStateProof provides a mock function with given fields: param
+func (_m *QueueProtocolAPI) StoreGetStateProof(param *types.ReqStateProof) (*types.StateProof, error) {
+	ret := _m.Called(param)
+
+	var r0 *types.StateProof
+	if rf, ok := ret.Get(0).(func(*types.ReqStateProof) *types.StateProof); ok {
+		r0 = rf(param)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.ReqStateProof) error); ok {
+		r1 = rf(param)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreGetTotalCoins provides a mock function with given fields: _a0
 func (_m *QueueProtocolAPI) StoreGetTotalCoins(_a0 *types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error) {
 	ret := _m.Called(_a0)
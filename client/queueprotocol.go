@@ -6,6 +6,7 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/turingchain2020/turingchain/common/version"
 	"github.com/turingchain2020/turingchain/queue"
+	"github.com/turingchain2020/turingchain/trace"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -43,6 +45,8 @@ type QueueProtocol struct {
 	// 消息队列
 	client queue.Client
 	option QueueProtocolOption
+	// 发送消息时使用的调用链上下文
+	trace trace.SpanContext
 }
 
 // New New QueueProtocolAPI interface
@@ -61,9 +65,21 @@ func New(client queue.Client, option *QueueProtocolOption) (QueueProtocolAPI, er
 	return q, nil
 }
 
+// WithTrace 返回在调用链sc 上发送消息的api, 不支持跟踪的实现返回api 本身
+func WithTrace(api QueueProtocolAPI, sc trace.SpanContext) QueueProtocolAPI {
+	q, ok := api.(*QueueProtocol)
+	if !ok || !sc.IsValid() {
+		return api
+	}
+	traced := *q
+	traced.trace = sc
+	return &traced
+}
+
 func (q *QueueProtocol) send(topic string, ty int64, data interface{}) (*queue.Message, error) {
 	client := q.client
 	msg := client.NewMessage(topic, ty, data)
+	msg.SetTrace(q.trace)
 	err := client.SendTimeout(msg, true, q.option.SendTimeout)
 	if err != nil {
 		return &queue.Message{}, err
@@ -80,6 +96,7 @@ func (q *QueueProtocol) send(topic string, ty int64, data interface{}) (*queue.M
 func (q *QueueProtocol) notify(topic string, ty int64, data interface{}) (*queue.Message, error) {
 	client := q.client
 	msg := client.NewMessage(topic, ty, data)
+	msg.SetTrace(q.trace)
 	err := client.SendTimeout(msg, false, q.option.SendTimeout)
 	if err != nil {
 		return &queue.Message{}, err
@@ -769,6 +786,56 @@ func (q *QueueProtocol) StoreList(param *types.StoreList) (*types.StoreListReply
 	return nil, types.ErrTypeAsset
 }
 
+// StoreCheckState check the state exists and is not pruned
+func (q *QueueProtocol) StoreCheckState(param *types.StoreCheckState) error {
+	if param == nil {
+		err := types.ErrInvalidParam
+		log.Error("StoreCheckState", "Error", err)
+		return err
+	}
+	msg, err := q.send(storeKey, types.EventStoreCheckState, param)
+	if err != nil {
+		log.Debug("StoreCheckState", "Error", err.Error())
+		return err
+	}
+	reply, ok := msg.GetData().(*types.Reply)
+	if !ok {
+		return types.ErrTypeAsset
+	}
+	if !reply.IsOk {
+		//store 没有处理该消息
+		if string(reply.Msg) == types.ErrActionNotSupport.Error() {
+			return types.ErrActionNotSupport
+		}
+		return errors.New(string(reply.Msg))
+	}
+	return nil
+}
+
+// StoreGetStateProof get values and multi proof of keys from statedb
+func (q *QueueProtocol) StoreGetStateProof(param *types.ReqStateProof) (*types.StateProof, error) {
+	if param == nil || len(param.Keys) == 0 {
+		err := types.ErrInvalidParam
+		log.Error("StoreGetStateProof", "Error", err)
+		return nil, err
+	}
+	msg, err := q.send(storeKey, types.EventStoreGetStateProof, param)
+	if err != nil {
+		log.Error("StoreGetStateProof", "Error", err.Error())
+		return nil, err
+	}
+	if reply, ok := msg.GetData().(*types.StateProof); ok {
+		return reply, nil
+	}
+	//store 不支持时返回types.Reply
+	if reply, ok := msg.GetData().(*types.Reply); ok {
+		return nil, errors.New(string(reply.Msg))
+	}
+	err = types.ErrTypeAsset
+	log.Error("StoreGetStateProof", "Error", err.Error())
+	return nil, err
+}
+
 // StoreGetTotalCoins get total coins from statedb
 func (q *QueueProtocol) StoreGetTotalCoins(param *types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error) {
 	if param == nil {
@@ -794,6 +861,14 @@ func (q *QueueProtocol) CloseQueue() (*types.Reply, error) {
 	return q.client.CloseQueue()
 }
 
+// GetQueueStats 获取消息队列的统计
+func (q *QueueProtocol) GetQueueStats(param *types.ReqString) (*types.QueueStats, error) {
+	if param == nil {
+		param = &types.ReqString{}
+	}
+	return q.client.GetQueueStats(param.Data)
+}
+
 // GetLastBlockSequence 获取最新的block执行序列号
 func (q *QueueProtocol) GetLastBlockSequence() (*types.Int64, error) {
 	msg, err := q.send(blockchainKey, types.EventGetLastBlockSequence, &types.ReqNil{})
@@ -112,11 +112,17 @@ type QueueProtocolAPI interface {
 	StoreDel(param *types.StoreDel) (*types.ReplyHash, error)
 	StoreGetTotalCoins(*types.IterateRangeByStateHash) (*types.ReplyGetTotalCoins, error)
 	StoreList(param *types.StoreList) (*types.StoreListReply, error)
+	// 检查状态是否存在或者已经被裁剪, store 不支持检查时返回ErrActionNotSupport
+	StoreCheckState(param *types.StoreCheckState) error
+	// 获取多个key 的状态证明
+	StoreGetStateProof(param *types.ReqStateProof) (*types.StateProof, error)
 	// --------------- store interfaces end
 
 	// +++++++++++++++ other interfaces begin
 	// close turingchain
 	CloseQueue() (*types.Reply, error)
+	// get queue stats of topic, all topics when empty
+	GetQueueStats(param *types.ReqString) (*types.QueueStats, error)
 	// --------------- other interfaces end
 	// types.EventAddBlockSeqCB
 	AddPushSubscribe(param *types.PushSubscribeReq) (*types.ReplySubscribePush, error)
@@ -87,6 +87,9 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	for j, kv := range receipt.GetBlockBegin().GetKV() {
+		fmt.Println("block begin KV:", j, kv)
+	}
 	for i, r := range receipt.GetReceipts() {
 		println("=======================")
 		println("tx index ", i)
@@ -0,0 +1,120 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/turingchain2020/turingchain/common"
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	mavl "github.com/turingchain2020/turingchain/system/store/mavl/db"
+	"github.com/spf13/cobra"
+)
+
+//StateCmd 离线导出和导入mavl 状态, 节点需要先停止
+func StateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state",
+		Short: "Export or import mavl state offline",
+	}
+	cmd.AddCommand(
+		exportStateCmd(),
+		importStateCmd(),
+	)
+	return cmd
+}
+
+func addStateDBFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("store", "s", "datadir/mavltree", "store db path")
+	cmd.Flags().StringP("localdb", "l", "", "blockchain db path, export/import localdb data if set")
+	cmd.Flags().StringP("driver", "d", "leveldb", "db driver")
+	cmd.Flags().Int32P("cache", "", 128, "db cache size")
+}
+
+func exportStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "export",
+		Short:        "Export mavl state of state hash to file",
+		RunE:         exportState,
+		SilenceUsage: true,
+	}
+	addStateDBFlags(cmd)
+	cmd.Flags().StringP("statehash", "", "", "state hash to export")
+	cmd.MarkFlagRequired("statehash")
+	cmd.Flags().StringP("out", "o", "state.dat", "output file")
+	return cmd
+}
+
+func exportState(cmd *cobra.Command, args []string) error {
+	store, _ := cmd.Flags().GetString("store")
+	localPath, _ := cmd.Flags().GetString("localdb")
+	driver, _ := cmd.Flags().GetString("driver")
+	cache, _ := cmd.Flags().GetInt32("cache")
+	stateHash, _ := cmd.Flags().GetString("statehash")
+	out, _ := cmd.Flags().GetString("out")
+
+	hash, err := common.FromHex(stateHash)
+	if err != nil || len(hash) == 0 {
+		return fmt.Errorf("invalid state hash %s", stateHash)
+	}
+	db := dbm.NewDB("store", driver, store, cache)
+	defer db.Close()
+	var localdb dbm.DB
+	if localPath != "" {
+		localdb = dbm.NewDB("blockchain", driver, localPath, cache)
+		defer localdb.Close()
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	leaves, err := mavl.ExportState(f, db, hash, localdb, nil)
+	if err != nil {
+		return fmt.Errorf("export state failed: %v", err)
+	}
+	fmt.Println("export state", stateHash, "leaves", leaves, "to", out)
+	return nil
+}
+
+func importStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "import",
+		Short:        "Import mavl state from file and verify state hash",
+		RunE:         importState,
+		SilenceUsage: true,
+	}
+	addStateDBFlags(cmd)
+	cmd.Flags().StringP("in", "i", "state.dat", "input file")
+	return cmd
+}
+
+func importState(cmd *cobra.Command, args []string) error {
+	store, _ := cmd.Flags().GetString("store")
+	localPath, _ := cmd.Flags().GetString("localdb")
+	driver, _ := cmd.Flags().GetString("driver")
+	cache, _ := cmd.Flags().GetInt32("cache")
+	in, _ := cmd.Flags().GetString("in")
+
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	db := dbm.NewDB("store", driver, store, cache)
+	defer db.Close()
+	var localdb dbm.DB
+	if localPath != "" {
+		localdb = dbm.NewDB("blockchain", driver, localPath, cache)
+		defer localdb.Close()
+	}
+	root, leaves, err := mavl.ImportState(f, db, localdb, nil)
+	if err != nil {
+		return fmt.Errorf("import state failed: %v", err)
+	}
+	fmt.Println("import state", common.ToHex(root), "leaves", leaves, "from", in)
+	return nil
+}
@@ -226,8 +226,11 @@ ForkChainParamV2= -1
 ForkBase58AddressCheck=1800000
 ForkTicketFundAddrV1=-1
 ForkRootHash=1
+ForkTxNonce=-1
 [fork.sub.coins]
 Enable=0
+ForkTransferMulti=-1
+ForkTimeLock=-1
 [fork.sub.ticket]
 Enable=0
 ForkTicketId = 1200000
@@ -239,6 +242,9 @@ Enable=0
 [fork.sub.manage]
 Enable=0
 ForkManageExec=100000
+ForkManageProposal=-1
+[fork.sub.multisig]
+Enable=-1
 [fork.sub.token]
 Enable=0
 ForkTokenBlackList= 0
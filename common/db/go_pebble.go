@@ -0,0 +1,535 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+	log "github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/types"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+var plog = log.New("module", "db.pebble")
+
+func init() {
+	dbCreator := func(name string, dir string, cache int) (DB, error) {
+		return NewPebbleDB(name, dir, cache)
+	}
+	registerDBCreator(pebbleBackendStr, dbCreator, false)
+}
+
+//PebbleDB db
+type PebbleDB struct {
+	BaseDB
+	db *pebble.DB
+
+	compTimeMeter    metrics.Meter // Meter for measuring the total time spent in database compaction
+	compReadMeter    metrics.Meter // Meter for measuring the data read during compaction
+	compWriteMeter   metrics.Meter // Meter for measuring the data written during compaction
+	writeDelayNMeter metrics.Meter // Meter for measuring the write delay number due to database compaction
+	writeDelayMeter  metrics.Meter // Meter for measuring the write delay duration due to database compaction
+	diskSizeGauge    metrics.Gauge // Gauge for tracking the size of all the levels in the database
+	diskWriteMeter   metrics.Meter // Meter for measuring the effective amount of data written
+	readAmpGauge     metrics.Gauge // Gauge for tracking the read amplification
+	compDebtGauge    metrics.Gauge // Gauge for tracking the estimated compaction debt
+
+	//compaction 的总时间, 写入因为compaction 停顿的次数和时间, 由EventListener 更新
+	compactTime        int64
+	writeStallCount    int64
+	writeStallDuration int64
+	writeStallStart    int64
+
+	quitChan chan chan error // Quit channel to stop the metrics collection before closing the database
+}
+
+//NewPebbleDB new
+func NewPebbleDB(name string, dir string, cache int) (*PebbleDB, error) {
+	dbPath := path.Join(dir, name+".db")
+	if cache == 0 {
+		cache = 64
+	}
+	handles := cache
+	if handles < 16 {
+		handles = 16
+	}
+	if cache < 4 {
+		cache = 4
+	}
+	database := &PebbleDB{
+		quitChan: make(chan chan error),
+	}
+	c := pebble.NewCache(int64(cache / 2 * 1024 * 1024))
+	defer c.Unref()
+	opts := &pebble.Options{
+		Cache:        c,
+		MaxOpenFiles: handles,
+		// Two of these are used internally
+		MemTableSize:                cache / 4 * 1024 * 1024,
+		MemTableStopWritesThreshold: 2,
+		Levels:                      make([]pebble.LevelOptions, 7),
+		EventListener: pebble.EventListener{
+			CompactionEnd:   database.onCompactionEnd,
+			WriteStallBegin: database.onWriteStallBegin,
+			WriteStallEnd:   database.onWriteStallEnd,
+		},
+	}
+	for i := range opts.Levels {
+		opts.Levels[i].FilterPolicy = bloom.FilterPolicy(10)
+		opts.Levels[i].FilterType = pebble.TableFilter
+		// 每一层的文件大小翻倍
+		opts.Levels[i].TargetFileSize = int64(2*1024*1024) << uint(i)
+	}
+	db, err := pebble.Open(dbPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	database.db = db
+
+	namespace := "pebble/"
+	database.compTimeMeter = metrics.NewRegisteredMeter(namespace+"compact/time", nil)
+	database.compReadMeter = metrics.NewRegisteredMeter(namespace+"compact/input", nil)
+	database.compWriteMeter = metrics.NewRegisteredMeter(namespace+"compact/output", nil)
+	database.diskSizeGauge = metrics.NewRegisteredGauge(namespace+"disk/size", nil)
+	database.diskWriteMeter = metrics.NewRegisteredMeter(namespace+"disk/write", nil)
+	database.writeDelayMeter = metrics.NewRegisteredMeter(namespace+"compact/writedelay/duration", nil)
+	database.writeDelayNMeter = metrics.NewRegisteredMeter(namespace+"compact/writedelay/counter", nil)
+	database.readAmpGauge = metrics.NewRegisteredGauge(namespace+"read/amplification", nil)
+	database.compDebtGauge = metrics.NewRegisteredGauge(namespace+"compact/debt", nil)
+
+	// Start up the metrics gathering and return
+	go database.meter(metricsGatheringInterval)
+
+	return database, nil
+}
+
+func (db *PebbleDB) onCompactionEnd(info pebble.CompactionInfo) {
+	atomic.AddInt64(&db.compactTime, int64(info.Duration))
+}
+
+func (db *PebbleDB) onWriteStallBegin(info pebble.WriteStallBeginInfo) {
+	atomic.AddInt64(&db.writeStallCount, 1)
+	atomic.StoreInt64(&db.writeStallStart, time.Now().UnixNano())
+	plog.Warn("Database compacting, write stall", "reason", info.Reason)
+}
+
+func (db *PebbleDB) onWriteStallEnd() {
+	start := atomic.SwapInt64(&db.writeStallStart, 0)
+	if start > 0 {
+		atomic.AddInt64(&db.writeStallDuration, time.Now().UnixNano()-start)
+	}
+}
+
+//Get get
+func (db *PebbleDB) Get(key []byte) ([]byte, error) {
+	res, closer, err := db.db.Get(key)
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return nil, ErrNotFoundInDb
+		}
+		plog.Error("Get", "error", err)
+		return nil, err
+	}
+	//返回的数据在closer 关闭之后失效
+	value := cloneByte(res)
+	closer.Close()
+	return value, nil
+}
+
+//Set set
+func (db *PebbleDB) Set(key []byte, value []byte) error {
+	err := db.db.Set(key, value, pebble.NoSync)
+	if err != nil {
+		plog.Error("Set", "error", err)
+		return err
+	}
+	return nil
+}
+
+//SetSync 同步
+func (db *PebbleDB) SetSync(key []byte, value []byte) error {
+	err := db.db.Set(key, value, pebble.Sync)
+	if err != nil {
+		plog.Error("SetSync", "error", err)
+		return err
+	}
+	return nil
+}
+
+//Delete 删除
+func (db *PebbleDB) Delete(key []byte) error {
+	err := db.db.Delete(key, pebble.NoSync)
+	if err != nil {
+		plog.Error("Delete", "error", err)
+		return err
+	}
+	return nil
+}
+
+//DeleteSync 删除同步
+func (db *PebbleDB) DeleteSync(key []byte) error {
+	err := db.db.Delete(key, pebble.Sync)
+	if err != nil {
+		plog.Error("DeleteSync", "error", err)
+		return err
+	}
+	return nil
+}
+
+//DB db
+func (db *PebbleDB) DB() *pebble.DB {
+	return db.db
+}
+
+//Close 关闭
+func (db *PebbleDB) Close() {
+	if db.quitChan != nil {
+		errc := make(chan error)
+		db.quitChan <- errc
+		if err := <-errc; err != nil {
+			plog.Error("Metrics collection failed", "err", err)
+		}
+		db.quitChan = nil
+	}
+
+	err := db.db.Close()
+	if err != nil {
+		plog.Error("Close", "error", err)
+	}
+}
+
+//Print 打印
+func (db *PebbleDB) Print() {
+	plog.Info("Print", "stats", db.db.Metrics().String())
+
+	it := db.db.NewIter(nil)
+	defer it.Close()
+	for it.First(); it.Valid(); it.Next() {
+		plog.Info("Print", "key", string(it.Key()), "value", string(it.Value()))
+	}
+}
+
+//Stats ...
+func (db *PebbleDB) Stats() map[string]string {
+	m := db.db.Metrics()
+	total := m.Total()
+	stats := map[string]string{
+		"pebble.stats":              m.String(),
+		"pebble.disk-usage":         strconv.FormatUint(diskSpaceUsage(m), 10),
+		"pebble.read-amp":           strconv.Itoa(m.ReadAmp()),
+		"pebble.compactions":        strconv.FormatInt(m.Compact.Count, 10),
+		"pebble.compaction-debt":    strconv.FormatUint(m.Compact.EstimatedDebt, 10),
+		"pebble.compaction-running": strconv.FormatInt(m.Compact.InProgressBytes, 10),
+		"pebble.flushes":            strconv.FormatInt(m.Flush.Count, 10),
+		"pebble.memtable-size":      strconv.FormatUint(m.MemTable.Size, 10),
+		"pebble.write-amp":          fmt.Sprintf("%.2f", total.WriteAmp()),
+		"pebble.writedelay": fmt.Sprintf("DelayN:%d Delay:%s", atomic.LoadInt64(&db.writeStallCount),
+			time.Duration(atomic.LoadInt64(&db.writeStallDuration))),
+		"pebble.blockcache": fmt.Sprintf("Size:%d Hits:%d Misses:%d", m.BlockCache.Size, m.BlockCache.Hits, m.BlockCache.Misses),
+	}
+	for i, level := range m.Levels {
+		stats["pebble.num-files-at-level"+strconv.Itoa(i)] = strconv.FormatInt(level.NumFiles, 10)
+	}
+	return stats
+}
+
+//diskSpaceUsage sst 文件和wal 文件占用的空间
+func diskSpaceUsage(m *pebble.Metrics) uint64 {
+	total := m.Total()
+	return uint64(total.Size) + m.WAL.Size + m.Table.ZombieSize
+}
+
+func pebbleIterOptions(start, end []byte) *pebble.IterOptions {
+	return &pebble.IterOptions{LowerBound: start, UpperBound: end}
+}
+
+//Iterator 迭代器
+func (db *PebbleDB) Iterator(start []byte, end []byte, reverse bool) Iterator {
+	if end == nil {
+		end = bytesPrefix(start)
+	}
+	if bytes.Equal(end, types.EmptyValue) {
+		end = nil
+	}
+	it := db.db.NewIter(pebbleIterOptions(start, end))
+	return &pebbleIt{Iterator: it, itBase: itBase{start, end, reverse}}
+}
+
+//BeginTx 使用indexed batch 实现事务, 事务中可以读到未提交的数据
+func (db *PebbleDB) BeginTx() (TxKV, error) {
+	return &pebbleTx{batch: db.db.NewIndexedBatch()}, nil
+}
+
+// CompactRange start 和limit 为nil 时压缩整个数据库
+func (db *PebbleDB) CompactRange(start, limit []byte) error {
+	if start == nil || limit == nil {
+		it := db.db.NewIter(nil)
+		if !it.First() {
+			return it.Close()
+		}
+		if start == nil {
+			start = cloneByte(it.Key())
+		}
+		if limit == nil {
+			it.Last()
+			//pebble 不压缩limit 本身, 最后一个key 需要包含在范围内
+			limit = append(cloneByte(it.Key()), 0)
+		}
+		if err := it.Close(); err != nil {
+			return err
+		}
+	}
+	if bytes.Compare(start, limit) >= 0 {
+		return nil
+	}
+	return db.db.Compact(start, limit)
+}
+
+// meter periodically retrieves internal pebble metrics and reports them to
+// the metrics subsystem.
+func (db *PebbleDB) meter(refresh time.Duration) {
+	var (
+		errc            chan error
+		compTime        time.Duration
+		compRead        uint64
+		compWrite       uint64
+		walWrite        uint64
+		delaystats      [2]int64
+		lastWritePaused time.Time
+	)
+	for errc == nil {
+		m := db.db.Metrics()
+		total := m.Total()
+		var read uint64
+		for _, level := range m.Levels {
+			read += level.BytesRead
+		}
+		write := total.BytesCompacted + total.BytesFlushed
+		duration := time.Duration(atomic.LoadInt64(&db.compactTime))
+		db.diskSizeGauge.Update(int64(diskSpaceUsage(m)))
+		db.compTimeMeter.Mark(int64(duration - compTime))
+		db.compReadMeter.Mark(int64(read - compRead))
+		db.compWriteMeter.Mark(int64(write - compWrite))
+		db.diskWriteMeter.Mark(int64(m.WAL.BytesWritten - walWrite))
+		db.readAmpGauge.Update(int64(m.ReadAmp()))
+		db.compDebtGauge.Update(int64(m.Compact.EstimatedDebt))
+		compTime, compRead, compWrite, walWrite = duration, read, write, m.WAL.BytesWritten
+
+		delayN, delay := atomic.LoadInt64(&db.writeStallCount), atomic.LoadInt64(&db.writeStallDuration)
+		db.writeDelayNMeter.Mark(delayN - delaystats[0])
+		db.writeDelayMeter.Mark(delay - delaystats[1])
+		// If a warning that db is performing compaction has been displayed, any subsequent
+		// warnings will be withheld for one minute not to overwhelm the user.
+		if atomic.LoadInt64(&db.writeStallStart) > 0 && delayN-delaystats[0] == 0 &&
+			time.Now().After(lastWritePaused.Add(degradationWarnInterval)) {
+			plog.Warn("Database compacting, degraded performance", "debt", m.Compact.EstimatedDebt)
+			lastWritePaused = time.Now()
+		}
+		delaystats[0], delaystats[1] = delayN, delay
+
+		// Sleep a bit, then repeat the stats collection
+		select {
+		case errc = <-db.quitChan:
+			// Quit requesting, stop hammering the database
+		case <-time.After(refresh):
+			// Timeout, gather a new set of stats
+		}
+	}
+	errc <- nil
+}
+
+type pebbleIt struct {
+	*pebble.Iterator
+	itBase
+}
+
+//Close 关闭
+func (dbit *pebbleIt) Close() {
+	if err := dbit.Iterator.Close(); err != nil {
+		plog.Error("Iterator Close", "error", err)
+	}
+}
+
+//Next next
+func (dbit *pebbleIt) Next() bool {
+	if dbit.reverse {
+		return dbit.Iterator.Prev() && dbit.Valid()
+	}
+	return dbit.Iterator.Next() && dbit.Valid()
+}
+
+//Rewind ...
+func (dbit *pebbleIt) Rewind() bool {
+	if dbit.reverse {
+		return dbit.Iterator.Last() && dbit.Valid()
+	}
+	return dbit.Iterator.First() && dbit.Valid()
+}
+
+//Seek 定位到第一个大于等于key 的位置
+func (dbit *pebbleIt) Seek(key []byte) bool {
+	return dbit.Iterator.SeekGE(key)
+}
+
+func (dbit *pebbleIt) ValueCopy() []byte {
+	return cloneByte(dbit.Iterator.Value())
+}
+
+func (dbit *pebbleIt) Valid() bool {
+	return dbit.Iterator.Valid() && dbit.checkKey(dbit.Key())
+}
+
+func (dbit *pebbleIt) Error() error {
+	return dbit.Iterator.Error()
+}
+
+type pebbleBatch struct {
+	db    *PebbleDB
+	batch *pebble.Batch
+	wop   *pebble.WriteOptions
+	//pebble 的batch 写入之后不能再次写入, 继续使用时复制一份
+	applied bool
+	size    int
+	len     int
+}
+
+//NewBatch new
+func (db *PebbleDB) NewBatch(sync bool) Batch {
+	wop := pebble.NoSync
+	if sync {
+		wop = pebble.Sync
+	}
+	return &pebbleBatch{db: db, batch: db.db.NewBatch(), wop: wop}
+}
+
+func (mBatch *pebbleBatch) reuse() {
+	if !mBatch.applied {
+		return
+	}
+	batch := mBatch.db.db.NewBatch()
+	if err := batch.SetRepr(cloneByte(mBatch.batch.Repr())); err != nil {
+		panic(err)
+	}
+	mBatch.batch.Close()
+	mBatch.batch = batch
+	mBatch.applied = false
+}
+
+func (mBatch *pebbleBatch) Set(key, value []byte) {
+	mBatch.reuse()
+	if err := mBatch.batch.Set(key, value, nil); err != nil {
+		plog.Error("batch Set", "error", err)
+	}
+	mBatch.size += len(key)
+	mBatch.size += len(value)
+	mBatch.len += len(value)
+}
+
+func (mBatch *pebbleBatch) Delete(key []byte) {
+	mBatch.reuse()
+	if err := mBatch.batch.Delete(key, nil); err != nil {
+		plog.Error("batch Delete", "error", err)
+	}
+	mBatch.size += len(key)
+	mBatch.len++
+}
+
+func (mBatch *pebbleBatch) Write() error {
+	mBatch.reuse()
+	err := mBatch.db.db.Apply(mBatch.batch, mBatch.wop)
+	if err != nil {
+		plog.Error("Write", "error", err)
+		return err
+	}
+	mBatch.applied = true
+	return nil
+}
+
+func (mBatch *pebbleBatch) ValueSize() int {
+	return mBatch.size
+}
+
+//ValueLen  batch数量
+func (mBatch *pebbleBatch) ValueLen() int {
+	return mBatch.len
+}
+
+func (mBatch *pebbleBatch) Reset() {
+	mBatch.batch.Reset()
+	mBatch.applied = false
+	mBatch.len = 0
+	mBatch.size = 0
+}
+
+func (mBatch *pebbleBatch) UpdateWriteSync(sync bool) {
+	if sync {
+		mBatch.wop = pebble.Sync
+	} else {
+		mBatch.wop = pebble.NoSync
+	}
+}
+
+type pebbleTx struct {
+	batch *pebble.Batch
+}
+
+func (db *pebbleTx) Commit() error {
+	return db.batch.Commit(pebble.NoSync)
+}
+
+func (db *pebbleTx) Rollback() {
+	if err := db.batch.Close(); err != nil {
+		plog.Error("tx Rollback", "error", err)
+	}
+}
+
+//Get get in transaction
+func (db *pebbleTx) Get(key []byte) ([]byte, error) {
+	res, closer, err := db.batch.Get(key)
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return nil, ErrNotFoundInDb
+		}
+		plog.Error("tx Get", "error", err)
+		return nil, err
+	}
+	value := cloneByte(res)
+	closer.Close()
+	return value, nil
+}
+
+//Set set in transaction
+func (db *pebbleTx) Set(key []byte, value []byte) error {
+	err := db.batch.Set(key, value, nil)
+	if err != nil {
+		plog.Error("tx Set", "error", err)
+		return err
+	}
+	return nil
+}
+
+//Iterator 迭代器 in transaction
+func (db *pebbleTx) Iterator(start []byte, end []byte, reverse bool) Iterator {
+	if end == nil {
+		end = bytesPrefix(start)
+	}
+	if bytes.Equal(end, types.EmptyValue) {
+		end = nil
+	}
+	it := db.batch.NewIter(pebbleIterOptions(start, end))
+	return &pebbleIt{Iterator: it, itBase: itBase{start, end, reverse}}
+}
+
+//Begin call panic when Begin not rewrite
+func (db *pebbleTx) Begin() {
+	panic("Begin not impl")
+}
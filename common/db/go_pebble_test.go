@@ -0,0 +1,143 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPebbleDB(t *testing.T) (*PebbleDB, func()) {
+	dir, err := ioutil.TempDir("", "pebble")
+	require.NoError(t, err)
+	t.Log(dir)
+	pdb, err := NewPebbleDB("pebble", dir, 128)
+	require.NoError(t, err)
+	return pdb, func() {
+		pdb.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// pebble迭代器测试
+func TestPebbleDBIterator(t *testing.T) {
+	pdb, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBIterator(t, pdb)
+}
+
+func TestPebbleDBIteratorAll(t *testing.T) {
+	pdb, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBIteratorAllKey(t, pdb)
+}
+
+func TestPebbleDBIteratorReserverExample(t *testing.T) {
+	pdb, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBIteratorReserverExample(t, pdb)
+}
+
+func TestPebbleDBIteratorDel(t *testing.T) {
+	pdb, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBIteratorDel(t, pdb)
+}
+
+func TestPebbleDBBatch(t *testing.T) {
+	pdb, closer := newTestPebbleDB(t)
+	defer closer()
+	testBatch(t, pdb)
+}
+
+func TestPebbleDBTransaction(t *testing.T) {
+	pdb, closer := newTestPebbleDB(t)
+	defer closer()
+	testTransaction(t, pdb)
+}
+
+// pebble边界测试
+func TestPebbleDBBoundary(t *testing.T) {
+	pdb, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBBoundary(t, pdb)
+}
+
+// pebble返回值测试
+func TestPebbleDBResult(t *testing.T) {
+	pdb, closer := newTestPebbleDB(t)
+	defer closer()
+	testDBIteratorResult(t, pdb)
+}
+
+func TestPebbleDBBatchReuse(t *testing.T) {
+	pdb, closer := newTestPebbleDB(t)
+	defer closer()
+	batch := pdb.NewBatch(true)
+	batch.Set([]byte("k1"), []byte("v1"))
+	require.Nil(t, batch.Write())
+	//写入之后继续使用, 和leveldb 一样包含之前的数据
+	batch.Delete([]byte("k1"))
+	batch.Set([]byte("k2"), []byte("v2"))
+	require.Nil(t, batch.Write())
+	_, err := pdb.Get([]byte("k1"))
+	assert.Equal(t, types.ErrNotFound, err)
+	v, err := pdb.Get([]byte("k2"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("v2"), v)
+
+	batch.Reset()
+	assert.Equal(t, 0, batch.ValueLen())
+	batch.Set([]byte("k3"), []byte("v3"))
+	require.Nil(t, batch.Write())
+	v, err = pdb.Get([]byte("k3"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("v3"), v)
+}
+
+func TestPebbleDBCompactAndStats(t *testing.T) {
+	pdb, closer := newTestPebbleDB(t)
+	defer closer()
+	//空数据库
+	require.Nil(t, pdb.CompactRange(nil, nil))
+	for i := 0; i < 1000; i++ {
+		require.Nil(t, pdb.Set([]byte(fmt.Sprintf("key%04d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Nil(t, pdb.CompactRange(nil, nil))
+	require.Nil(t, pdb.CompactRange([]byte("key0100"), []byte("key0200")))
+
+	stats := pdb.Stats()
+	assert.NotEmpty(t, stats["pebble.stats"])
+	assert.NotEqual(t, "0", stats["pebble.disk-usage"])
+	assert.NotEqual(t, "0", stats["pebble.compactions"])
+	assert.Contains(t, stats["pebble.writedelay"], "DelayN:0")
+	files := 0
+	for i := 0; i < 7; i++ {
+		n, err := strconv.Atoi(stats[fmt.Sprintf("pebble.num-files-at-level%d", i)])
+		require.Nil(t, err)
+		files += n
+	}
+	assert.True(t, files > 0)
+
+	v, err := pdb.Get([]byte("key0999"))
+	require.Nil(t, err)
+	assert.Equal(t, "value999", string(v))
+}
+
+func TestNewPebbleDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pebble")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := NewDB("test", pebbleBackendStr, dir, 16)
+	require.Nil(t, db.Set([]byte("k"), []byte("v")))
+	db.Close()
+}
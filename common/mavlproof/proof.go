@@ -0,0 +1,316 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mavlproof 验证mavl 树的多key 证明, 只依赖标准库, 可以在轻节点和手机钱包中单独使用
+package mavlproof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+/*
+多key 证明是从状态树中裁剪出来的部分树, 节点按照后序遍历的顺序保存:
+NodeHash  没有展开的子树, 只保存子树的hash
+NodeLeaf  叶子节点, 保存key 和value
+NodeInner inner 节点, 保存高度和大小, hash 由两个子节点计算
+证明中的叶子节点按照key 的顺序排列, key 存在时可以直接找到对应的叶子节点,
+key 不存在时需要证明key 前后相邻的两个叶子节点之间没有其他的子树
+*/
+
+//证明中节点的类型, 和types.MAVLMultiProofNode 的ty 字段一致
+const (
+	NodeHash  = 1
+	NodeLeaf  = 2
+	NodeInner = 3
+)
+
+const hashLen = sha256.Size
+
+var (
+	// ErrInvalidProof 证明的格式错误
+	ErrInvalidProof = errors.New("ErrInvalidProof")
+	// ErrProofRoot 证明计算出的状态根和期望的不一致
+	ErrProofRoot = errors.New("ErrProofRoot")
+	// ErrProofKey 证明中没有包含key 存在或者不存在的信息
+	ErrProofKey = errors.New("ErrProofKey")
+)
+
+// Node 证明中的节点
+type Node struct {
+	Ty     int32
+	Hash   []byte
+	Key    []byte
+	Value  []byte
+	Height int32
+	Size   int32
+}
+
+// MultiProof 多key 证明, 节点按照后序遍历的顺序排列
+type MultiProof struct {
+	Nodes []*Node
+}
+
+// KeyValue 证明的结果, Exists 为false 时表示key 在状态中不存在
+type KeyValue struct {
+	Key    []byte
+	Value  []byte
+	Exists bool
+}
+
+//element 按照key 的顺序展开之后的叶子节点或者没有展开的子树
+type element struct {
+	leaf  bool
+	key   []byte
+	value []byte
+}
+
+//root 计算证明对应的状态根, 同时返回按照顺序展开的叶子节点和子树
+func (proof *MultiProof) root() ([]byte, []element, error) {
+	var stack [][]byte
+	var elems []element
+	for _, node := range proof.Nodes {
+		if node == nil {
+			return nil, nil, ErrInvalidProof
+		}
+		switch node.Ty {
+		case NodeHash:
+			if len(node.Hash) < hashLen {
+				return nil, nil, ErrInvalidProof
+			}
+			stack = append(stack, node.Hash[len(node.Hash)-hashLen:])
+			elems = append(elems, element{})
+		case NodeLeaf:
+			stack = append(stack, LeafHash(node.Key, node.Value))
+			elems = append(elems, element{leaf: true, key: node.Key, value: node.Value})
+		case NodeInner:
+			if len(stack) < 2 || node.Height <= 0 {
+				return nil, nil, ErrInvalidProof
+			}
+			left, right := stack[len(stack)-2], stack[len(stack)-1]
+			stack = stack[:len(stack)-2]
+			stack = append(stack, InnerHash(left, right, node.Height, node.Size))
+		default:
+			return nil, nil, ErrInvalidProof
+		}
+	}
+	if len(stack) != 1 {
+		return nil, nil, ErrInvalidProof
+	}
+	//叶子节点必须严格递增
+	var last []byte
+	for _, e := range elems {
+		if !e.leaf {
+			continue
+		}
+		if last != nil && bytes.Compare(last, e.key) >= 0 {
+			return nil, nil, ErrInvalidProof
+		}
+		last = e.key
+	}
+	return stack[0], elems, nil
+}
+
+// Verify 验证证明可以计算出状态根root, 并返回每个key 的值以及是否存在
+// 空的状态树没有节点, root 为nil 或者全0
+func (proof *MultiProof) Verify(root []byte, keys [][]byte) ([]*KeyValue, error) {
+	if len(proof.Nodes) == 0 {
+		if !isEmptyRoot(root) {
+			return nil, ErrProofRoot
+		}
+		kvs := make([]*KeyValue, len(keys))
+		for i, key := range keys {
+			kvs[i] = &KeyValue{Key: key}
+		}
+		return kvs, nil
+	}
+	hash, elems, err := proof.root()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(hash, root) {
+		return nil, ErrProofRoot
+	}
+	kvs := make([]*KeyValue, len(keys))
+	for i, key := range keys {
+		kv, err := lookup(elems, key)
+		if err != nil {
+			return nil, err
+		}
+		kvs[i] = kv
+	}
+	return kvs, nil
+}
+
+//lookup 在展开的节点中查找key, 不存在时前后相邻的必须都是叶子节点
+func lookup(elems []element, key []byte) (*KeyValue, error) {
+	//第一个key 大于等于key 的叶子节点
+	pos := len(elems)
+	for i, e := range elems {
+		if e.leaf && bytes.Compare(e.key, key) >= 0 {
+			pos = i
+			break
+		}
+	}
+	if pos < len(elems) && bytes.Equal(elems[pos].key, key) {
+		return &KeyValue{Key: key, Value: elems[pos].value, Exists: true}, nil
+	}
+	//pos 是叶子节点或者在最后, 之前的元素必须是叶子节点, 否则key 可能在没有展开的子树中
+	if pos > 0 && !elems[pos-1].leaf {
+		return nil, ErrProofKey
+	}
+	return &KeyValue{Key: key}, nil
+}
+
+func isEmptyRoot(root []byte) bool {
+	for _, b := range root {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyProof 解析并验证proto 编码的证明
+func VerifyProof(root []byte, keys [][]byte, data []byte) ([]*KeyValue, error) {
+	proof, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return proof.Verify(root, keys)
+}
+
+// Decode 解析proto 编码的types.MAVLMultiProof
+func Decode(data []byte) (*MultiProof, error) {
+	proof := &MultiProof{}
+	err := walkFields(data, func(field, wire int, v uint64, b []byte) error {
+		if field != 1 || wire != 2 {
+			return nil
+		}
+		node := &Node{}
+		err := walkFields(b, func(field, wire int, v uint64, b []byte) error {
+			switch field {
+			case 1:
+				node.Ty = int32(v)
+			case 2:
+				node.Hash = b
+			case 3:
+				node.Key = b
+			case 4:
+				node.Value = b
+			case 5:
+				node.Height = int32(v)
+			case 6:
+				node.Size = int32(v)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		proof.Nodes = append(proof.Nodes, node)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+//walkFields 按照proto 的编码规则遍历data 中的字段
+func walkFields(data []byte, fn func(field, wire int, v uint64, b []byte) error) error {
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			return ErrInvalidProof
+		}
+		data = data[n:]
+		field, wire := int(tag>>3), int(tag&7)
+		var v uint64
+		var b []byte
+		switch wire {
+		case 0:
+			v, n = binary.Uvarint(data)
+			if n <= 0 {
+				return ErrInvalidProof
+			}
+			data = data[n:]
+		case 1, 5:
+			size := 8
+			if wire == 5 {
+				size = 4
+			}
+			if len(data) < size {
+				return ErrInvalidProof
+			}
+			data = data[size:]
+		case 2:
+			l, n := binary.Uvarint(data)
+			if n <= 0 || l > uint64(len(data)-n) {
+				return ErrInvalidProof
+			}
+			b = data[n : n+int(l)]
+			data = data[n+int(l):]
+		default:
+			return ErrInvalidProof
+		}
+		if err := fn(field, wire, v, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+//appendBytes 和appendVarint 按照proto3 的编码规则写入字段, 零值不写入
+func appendBytes(buf []byte, field int, data []byte) []byte {
+	if len(data) == 0 {
+		return buf
+	}
+	buf = appendUvarint(buf, uint64(field<<3|2))
+	buf = appendUvarint(buf, uint64(len(data)))
+	return append(buf, data...)
+}
+
+func appendVarint(buf []byte, field int, v int32) []byte {
+	if v == 0 {
+		return buf
+	}
+	buf = appendUvarint(buf, uint64(field<<3))
+	return appendUvarint(buf, uint64(int64(v)))
+}
+
+// LeafHash 计算叶子节点的hash, 和types.LeafNode.Hash 相同
+func LeafHash(key, value []byte) []byte {
+	var buf []byte
+	buf = appendBytes(buf, 1, key)
+	buf = appendBytes(buf, 2, value)
+	buf = appendVarint(buf, 4, 1)
+	sum := sha256.Sum256(buf)
+	return sum[:]
+}
+
+// InnerHash 计算inner 节点的hash, 和types.InnerNode.Hash 相同, 开启前缀时子节点hash 只取最后32字节
+func InnerHash(left, right []byte, height, size int32) []byte {
+	if len(left) > hashLen {
+		left = left[len(left)-hashLen:]
+	}
+	if len(right) > hashLen {
+		right = right[len(right)-hashLen:]
+	}
+	var buf []byte
+	buf = appendBytes(buf, 1, left)
+	buf = appendBytes(buf, 2, right)
+	buf = appendVarint(buf, 3, height)
+	buf = appendVarint(buf, 4, size)
+	sum := sha256.Sum256(buf)
+	return sum[:]
+}
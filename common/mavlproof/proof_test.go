@@ -0,0 +1,85 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavlproof
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeNode(node *Node) []byte {
+	var buf []byte
+	buf = appendVarint(buf, 1, node.Ty)
+	buf = appendBytes(buf, 2, node.Hash)
+	buf = appendBytes(buf, 3, node.Key)
+	buf = appendBytes(buf, 4, node.Value)
+	buf = appendVarint(buf, 5, node.Height)
+	buf = appendVarint(buf, 6, node.Size)
+	return buf
+}
+
+func encodeProof(proof *MultiProof) []byte {
+	var buf []byte
+	for _, node := range proof.Nodes {
+		buf = appendBytes(buf, 1, encodeNode(node))
+	}
+	return buf
+}
+
+func TestVerifyProof(t *testing.T) {
+	//树的结构: ((a, c), (e, g))
+	hashA, hashC := LeafHash([]byte("a"), []byte("1")), LeafHash([]byte("c"), []byte("2"))
+	hashE, hashG := LeafHash([]byte("e"), []byte("3")), LeafHash([]byte("g"), []byte("4"))
+	left := InnerHash(hashA, hashC, 1, 2)
+	right := InnerHash(hashE, hashG, 1, 2)
+	root := InnerHash(left, right, 2, 4)
+
+	//展开a, c 和e, 可以证明a, b, c, d 的结果
+	proof := &MultiProof{Nodes: []*Node{
+		{Ty: NodeLeaf, Key: []byte("a"), Value: []byte("1")},
+		{Ty: NodeLeaf, Key: []byte("c"), Value: []byte("2")},
+		{Ty: NodeInner, Height: 1, Size: 2},
+		{Ty: NodeLeaf, Key: []byte("e"), Value: []byte("3")},
+		{Ty: NodeHash, Hash: hashG},
+		{Ty: NodeInner, Height: 1, Size: 2},
+		{Ty: NodeInner, Height: 2, Size: 4},
+	}}
+	data := encodeProof(proof)
+	decoded, err := Decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, proof, decoded)
+
+	kvs, err := VerifyProof(root, [][]byte{[]byte("0"), []byte("a"), []byte("b"), []byte("d"), []byte("e")}, data)
+	require.NoError(t, err)
+	assert.False(t, kvs[0].Exists)
+	assert.True(t, kvs[1].Exists)
+	assert.Equal(t, []byte("1"), kvs[1].Value)
+	assert.False(t, kvs[2].Exists)
+	assert.False(t, kvs[3].Exists)
+	assert.Equal(t, []byte("3"), kvs[4].Value)
+
+	//f 和h 可能在没有展开的子树中
+	_, err = VerifyProof(root, [][]byte{[]byte("f")}, data)
+	assert.Equal(t, ErrProofKey, err)
+	_, err = VerifyProof(root, [][]byte{[]byte("h")}, data)
+	assert.Equal(t, ErrProofKey, err)
+	_, err = VerifyProof(left, [][]byte{[]byte("a")}, data)
+	assert.Equal(t, ErrProofRoot, err)
+	_, err = VerifyProof(root, nil, data[:len(data)-1])
+	assert.Equal(t, ErrInvalidProof, err)
+
+	proof.Nodes = proof.Nodes[:len(proof.Nodes)-1]
+	_, err = proof.Verify(root, nil)
+	assert.Equal(t, ErrInvalidProof, err)
+
+	//空树
+	kvs, err = VerifyProof(make([]byte, 32), [][]byte{[]byte("a")}, nil)
+	require.NoError(t, err)
+	assert.False(t, kvs[0].Exists)
+	_, err = VerifyProof(root, [][]byte{[]byte("a")}, nil)
+	assert.Equal(t, ErrProofRoot, err)
+}
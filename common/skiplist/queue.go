@@ -187,3 +187,20 @@ func (cache *Queue) Walk(count int, cb func(value Scorer) bool) {
 		return true
 	})
 }
+
+// WalkReverse 从最后一个开始反向遍历整个队列
+func (cache *Queue) WalkReverse(count int, cb func(value Scorer) bool) {
+	i := 0
+	for e := cache.txList.tail; e != nil; e = e.Prev() {
+		l := e.Value.Value.(*list.List)
+		for x := l.Back(); x != nil; x = x.Prev() {
+			if !cb(x.Value.(Scorer)) {
+				return
+			}
+			i++
+			if i == count {
+				return
+			}
+		}
+	}
+}
@@ -120,6 +120,25 @@ func TestQueueWalk(t *testing.T) {
 	assert.Equal(t, data2[1], "111")
 }
 
+func TestQueueWalkReverse(t *testing.T) {
+	q := NewQueue(10)
+	q.Push(sc1)
+	q.Push(sc2)
+	var data []string
+	q.WalkReverse(0, func(value Scorer) bool {
+		data = append(data, string(value.Hash()))
+		return true
+	})
+	assert.Equal(t, []string{"111", "222"}, data)
+
+	data = nil
+	q.WalkReverse(1, func(value Scorer) bool {
+		data = append(data, string(value.Hash()))
+		return true
+	})
+	assert.Equal(t, []string{"111"}, data)
+}
+
 type scoreint struct {
 	data int64
 }
@@ -6,6 +6,7 @@ package executor
 
 import (
 	"bytes"
+	"time"
 
 	"github.com/turingchain2020/turingchain/executor/authority"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/turingchain2020/turingchain/common"
 	"github.com/turingchain2020/turingchain/common/address"
 	dbm "github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/metrics"
 	drivers "github.com/turingchain2020/turingchain/system/dapp"
 	"github.com/turingchain2020/turingchain/types"
 	"github.com/golang/protobuf/proto"
@@ -212,6 +214,10 @@ func (e *executor) execCheckTx(tx *types.Transaction, index int) error {
 	} else {
 		exec = e.loadDriver(tx, index)
 	}
+	//nonce 小于地址当前nonce 的交易已经不能执行, 大于的交易在mempool 中等待
+	if e.cfg.IsFork(e.height, "ForkTxNonce") && tx.Nonce < e.coinsAccount.LoadNonce(tx.From()) {
+		return types.ErrTxNonceTooLow
+	}
 	//手续费检查
 	if !exec.IsFree() && e.cfg.GetMinTxFeeRate() > 0 {
 		from := tx.From()
@@ -452,6 +458,14 @@ func (e *executor) execFee(tx *types.Transaction, index int) (*types.Receipt, er
 			return nil, err
 		}
 	}
+	//开启ForkTxNonce 之后, 支付手续费的地址nonce 必须和交易的nonce 相等
+	nonceFork := e.cfg.IsFork(e.height, "ForkTxNonce")
+	if nonceFork {
+		err := e.checkNonce(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
 	var err error
 	//公链不允许手续费为0
 	if !e.cfg.IsPara() && e.cfg.GetMinTxFeeRate() > 0 && !ex.IsFree() {
@@ -460,9 +474,58 @@ func (e *executor) execFee(tx *types.Transaction, index int) (*types.Receipt, er
 			return nil, err
 		}
 	}
+	if nonceFork {
+		feelog.KV = append(feelog.KV, e.incNonce(tx))
+	}
 	return feelog, nil
 }
 
+//execBlockBegin 调用注册的BlockBeginner, 执行器在这个高度还没有开启时跳过, 没有状态修改时返回nil
+func (e *executor) execBlockBegin() (*types.Receipt, error) {
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	for _, name := range drivers.GetBlockBeginners() {
+		driver, err := drivers.LoadDriverWithClient(e.api, name, e.height)
+		if err != nil {
+			continue
+		}
+		beginner, ok := driver.(drivers.BlockBeginner)
+		if !ok {
+			continue
+		}
+		e.setEnv(driver)
+		r, err := beginner.BlockBegin()
+		if err != nil {
+			elog.Error("execBlockBegin", "exec", name, "height", e.height, "err", err)
+			return nil, err
+		}
+		if r != nil {
+			receipt.KV = append(receipt.KV, r.KV...)
+			receipt.Logs = append(receipt.Logs, r.Logs...)
+		}
+	}
+	if len(receipt.KV) == 0 && len(receipt.Logs) == 0 {
+		return nil, nil
+	}
+	return receipt, nil
+}
+
+func (e *executor) checkNonce(tx *types.Transaction) error {
+	from := tx.From()
+	nonce := e.coinsAccount.LoadNonce(from)
+	if tx.Nonce != nonce {
+		elog.Error("checkNonce", "from", from, "nonce", nonce, "tx.nonce", tx.Nonce)
+		return types.ErrTxNonce
+	}
+	return nil
+}
+
+//incNonce 交易执行之后地址的nonce 加1
+func (e *executor) incNonce(tx *types.Transaction) *types.KeyValue {
+	kv := e.coinsAccount.GetNonceKV(tx.From(), tx.Nonce+1)
+	e.coinsAccount.SaveKVSet([]*types.KeyValue{kv})
+	return kv
+}
+
 func copyReceipt(feelog *types.Receipt) *types.Receipt {
 	receipt := types.Receipt{}
 	receipt = *feelog
@@ -473,11 +536,24 @@ func copyReceipt(feelog *types.Receipt) *types.Receipt {
 	return &receipt
 }
 
+//metricsExecName 监控使用的执行器名称, 没有注册的执行器统一记为other, 防止label 无限增长
+func metricsExecName(execer []byte) string {
+	name := string(types.GetRealExecName(execer))
+	if !drivers.IsDriverRegisted(name) {
+		return "other"
+	}
+	return name
+}
+
 func (e *executor) execTxOne(feelog *types.Receipt, tx *types.Transaction, index int) (*types.Receipt, error) {
 	//只有到pack级别的，才会增加index
 	e.startTx()
+	start := time.Now()
 	receipt, err := e.Exec(tx, index)
+	execName := metricsExecName(tx.Execer)
+	metrics.Timer("executor_tx_exec", "executor", execName).UpdateSince(start)
 	if err != nil {
+		metrics.Counter("executor_tx_failed", "executor", execName).Inc(1)
 		elog.Error("exec tx error = ", "err", err, "exec", string(tx.Execer), "action", tx.ActionName())
 		//add error log
 		errlog := &types.ReceiptLog{Ty: types.TyLogErr, Log: []byte(err.Error())}
@@ -97,3 +97,12 @@ func (app *notAllowApp) Allow(tx *types.Transaction, index int) error {
 	}
 	return types.ErrActionNotSupport
 }
+
+func TestMetricsExecName(t *testing.T) {
+	exec, _ := initEnv(types.GetDefaultCfgstring())
+	execInit(exec.client.GetConfig())
+	assert.Equal(t, "coins", metricsExecName([]byte("coins")))
+	assert.Equal(t, "coins", metricsExecName([]byte("user.p.test.coins")))
+	assert.Equal(t, "other", metricsExecName([]byte("user.randomname")))
+	assert.Equal(t, "other", metricsExecName([]byte("unknownexec")))
+}
@@ -7,17 +7,21 @@ package executor
 
 //store package store the world - state data
 import (
+	"bytes"
 	"fmt"
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/turingchain2020/turingchain/executor/authority"
 
 	"github.com/turingchain2020/turingchain/client/api"
+	"github.com/turingchain2020/turingchain/common"
 	dbm "github.com/turingchain2020/turingchain/common/db"
 	clog "github.com/turingchain2020/turingchain/common/log"
 	log "github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/metrics"
 	"github.com/turingchain2020/turingchain/pluginmgr"
 	"github.com/turingchain2020/turingchain/rpc/grpcclient"
 	drivers "github.com/turingchain2020/turingchain/system/dapp"
@@ -50,6 +54,9 @@ type Executor struct {
 	pluginEnable   map[string]bool
 	alias          map[string]string
 	noneDriverPool *sync.Pool
+	//交易并行执行
+	parallel        bool
+	parallelWorkers int
 }
 
 func execInit(cfg *typ.TuringchainConfig) {
@@ -72,6 +79,9 @@ func New(cfg *typ.TuringchainConfig) *Executor {
 	exec.pluginEnable["addrindex"] = !mcfg.DisableAddrIndex
 	exec.pluginEnable["txindex"] = true
 	exec.pluginEnable["fee"] = true
+	exec.pluginEnable["logindex"] = mcfg.EnableLogIndex
+	exec.parallel = mcfg.EnableParallel
+	exec.parallelWorkers = defaultParallelWorkers(mcfg.ParallelWorkers)
 	exec.noneDriverPool = &sync.Pool{
 		New: func() interface{} {
 			none, err := drivers.LoadDriver("none", 0)
@@ -204,6 +214,31 @@ func (exec *Executor) upgradePlugin(plugin string) (*types.LocalDBSet, error) {
 	return kvset, nil
 }
 
+//getQueryHeader 查询历史状态时使用指定高度的区块头, 只指定状态hash 时仍然使用最新的区块头
+//指定的状态不存在或者已经被裁剪时返回错误
+func (exec *Executor) getQueryHeader(header *types.Header, data *types.ChainExecutor) (*types.Header, error) {
+	if data.Height < 0 || (data.Height > 0 && data.StateHash != nil) {
+		return nil, types.ErrInvalidParam
+	}
+	if data.Height > 0 {
+		headers, err := exec.qclient.GetHeaders(&types.ReqBlocks{Start: data.Height, End: data.Height})
+		if err != nil {
+			return nil, err
+		}
+		if len(headers.GetItems()) == 0 {
+			return nil, types.ErrBlockNotFound
+		}
+		header = headers.Items[0]
+		data.StateHash = header.StateHash
+	}
+	err := exec.qclient.StoreCheckState(&types.StoreCheckState{StateHash: data.StateHash, Height: data.Height})
+	if err != nil && err != types.ErrActionNotSupport {
+		elog.Error("getQueryHeader", "height", data.Height, "stateHash", common.ToHex(data.StateHash), "err", err)
+		return nil, err
+	}
+	return header, nil
+}
+
 func (exec *Executor) procExecQuery(msg *queue.Message) {
 	//panic 处理
 	defer func() {
@@ -219,6 +254,14 @@ func (exec *Executor) procExecQuery(msg *queue.Message) {
 		return
 	}
 	data := msg.GetData().(*types.ChainExecutor)
+	//查询最新状态时不需要检查
+	if data.Height != 0 || (data.StateHash != nil && !bytes.Equal(data.StateHash, header.StateHash)) {
+		header, err = exec.getQueryHeader(header, data)
+		if err != nil {
+			msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, err))
+			return
+		}
+	}
 	driver, err := drivers.LoadDriverWithClient(exec.qclient, data.Driver, header.GetHeight())
 	if err != nil {
 		msg.Reply(exec.client.NewMessage("", types.EventBlockChainQuery, err))
@@ -315,6 +358,8 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 		}
 	}()
 	datas := msg.GetData().(*types.ExecTxList)
+	defer metrics.Timer("executor_block_exec").UpdateSince(time.Now())
+	metrics.Counter("executor_tx_total").Inc(int64(len(datas.Txs)))
 	ctx := &executorCtx{
 		stateHash:  datas.StateHash,
 		height:     datas.Height,
@@ -331,6 +376,21 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 	}
 	execute := newExecutor(ctx, exec, localdb, datas.Txs, nil)
 	execute.enableMVCC(nil)
+	var pstate *parallelState
+	if exec.isParallelEnable(execute, datas.Txs) {
+		pstate = exec.speculateTxs(ctx, datas.Txs)
+	}
+	//执行交易之前处理执行器在区块开始时的状态修改, 每个区块只处理一次, 收据单独返回
+	var begin *types.Receipt
+	if datas.Height > 0 {
+		var err error
+		begin, err = execute.execBlockBegin()
+		if err != nil {
+			msg.Reply(exec.client.NewMessage("", types.EventReceipts, err))
+			return
+		}
+		pstate.markWritten(begin)
+	}
 	var receipts []*types.Receipt
 	index := 0
 	for i := 0; i < len(datas.Txs); i++ {
@@ -341,7 +401,7 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 			continue
 		}
 		if tx.GroupCount == 0 {
-			receipt, err := execute.execTx(exec, tx, index)
+			receipt, err := pstate.execTx(execute, exec, tx, i, index)
 			if api.IsAPIEnvError(err) {
 				msg.Reply(exec.client.NewMessage("", types.EventReceipts, err))
 				return
@@ -380,11 +440,12 @@ func (exec *Executor) procExecTxList(msg *queue.Message) {
 			}
 			continue
 		}
+		pstate.markWritten(receiptlist...)
 		receipts = append(receipts, receiptlist...)
 		index += int(tx.GroupCount)
 	}
 	msg.Reply(exec.client.NewMessage("", types.EventReceipts,
-		&types.Receipts{Receipts: receipts}))
+		&types.Receipts{Receipts: receipts, BlockBegin: begin}))
 }
 
 func (exec *Executor) procExecAddBlock(msg *queue.Message) {
@@ -5,6 +5,7 @@
 package executor_test
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
@@ -13,11 +14,13 @@ import (
 
 	"sync"
 
+	"github.com/turingchain2020/turingchain/account"
 	"github.com/turingchain2020/turingchain/common"
 	"github.com/turingchain2020/turingchain/common/address"
 	"github.com/turingchain2020/turingchain/common/merkle"
 	_ "github.com/turingchain2020/turingchain/system"
 	drivers "github.com/turingchain2020/turingchain/system/dapp"
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
 	"github.com/turingchain2020/turingchain/types"
 	"github.com/turingchain2020/turingchain/util"
 	"github.com/turingchain2020/turingchain/util/testnode"
@@ -150,6 +153,167 @@ func TestTxGroup(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestExecTxNonce(t *testing.T) {
+	mock33 := newMockNode()
+	defer mock33.Close()
+	cfg := mock33.GetClient().GetConfig()
+	forks, err := cfg.GetForks()
+	assert.Nil(t, err)
+	forks["ForkTxNonce"] = 0
+	defer func() { forks["ForkTxNonce"] = types.MaxHeight }()
+	genkey := mock33.GetGenesisKey()
+	genaddr := mock33.GetGenesisAddress()
+	mock33.WaitHeight(0)
+	block := mock33.GetBlock(0)
+	addr, _ := util.Genaddress()
+	createTx := func(nonce int64) *types.Transaction {
+		tx := util.CreateCoinsTx(cfg, genkey, addr, types.Coin)
+		tx.Nonce = nonce
+		tx.Sign(types.SECP256K1, genkey)
+		return tx
+	}
+	//nonce 从0 开始连续递增
+	txs := []*types.Transaction{createTx(0), createTx(1)}
+	block, err = util.ExecAndCheckBlock(mock33.GetClient(), block, txs, []int{types.ExecOk, types.ExecOk})
+	assert.Nil(t, err)
+	values, err := mock33.GetAPI().StoreGet(&types.StoreGet{StateHash: block.StateHash, Keys: [][]byte{account.CalcNonceKey(genaddr)}})
+	assert.Nil(t, err)
+	nonce, err := account.DecodeNonce(values.Values[0])
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), nonce)
+
+	//已经使用的nonce 和不连续的nonce 都不能执行
+	txs = []*types.Transaction{createTx(1), createTx(3), createTx(2)}
+	block, err = util.ExecAndCheckBlock(mock33.GetClient(), block, txs, []int{0, 0, types.ExecOk})
+	assert.Nil(t, err)
+
+	//mempool 替换之后原交易可能还在其他节点, 原交易和替换交易同时打包时只有一笔可以执行
+	orig := createTx(3)
+	replaced := createTx(3)
+	replaced.Fee = orig.Fee * 2
+	replaced.Sign(types.SECP256K1, genkey)
+	block, err = util.ExecAndCheckBlock(mock33.GetClient(), block, []*types.Transaction{replaced, orig}, []int{types.ExecOk, 0})
+	assert.Nil(t, err)
+	//替换交易打包之后, 原交易在后面的区块中也不能执行
+	_, err = util.ExecAndCheckBlock(mock33.GetClient(), block, []*types.Transaction{orig}, []int{0})
+	assert.Nil(t, err)
+}
+
+func TestExecTransferMulti(t *testing.T) {
+	mock33 := testnode.New("--free--", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	cfg := mock33.GetClient().GetConfig()
+	addr1, _ := util.Genaddress()
+	addr2, _ := util.Genaddress()
+	createTx := func(transfers []*types.AssetsTransfer) *types.Transaction {
+		multi := &cty.AssetsTransferMulti{Transfers: transfers}
+		action := &cty.CoinsAction{Value: &cty.CoinsAction_TransferMulti{TransferMulti: multi}, Ty: cty.CoinsActionTransferMulti}
+		tx := &types.Transaction{Execer: []byte("coins"), Payload: types.Encode(action), To: transfers[0].To}
+		tx, err := types.FormatTx(cfg, "coins", tx)
+		assert.Nil(t, err)
+		tx.Sign(types.SECP256K1, mock33.GetGenesisKey())
+		return tx
+	}
+	tx := createTx([]*types.AssetsTransfer{
+		{To: addr1, Amount: types.Coin},
+		{To: addr2, Amount: 2 * types.Coin, Note: []byte("airdrop")},
+	})
+	detail, err := mock33.WaitTx(mock33.SendTx(tx))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	//每个收款地址都有付款和收款两条转账日志
+	var transfers int
+	for _, log := range detail.Receipt.Logs {
+		if log.Ty == types.TyLogTransfer {
+			transfers++
+		}
+	}
+	assert.Equal(t, 4, transfers)
+	block := mock33.GetLastBlock()
+	assert.Equal(t, types.Coin, mock33.GetAccount(block.StateHash, addr1).Balance)
+	assert.Equal(t, 2*types.Coin, mock33.GetAccount(block.StateHash, addr2).Balance)
+	//tx.To 之外的收款地址也可以查询到交易
+	txinfos, err := mock33.GetAPI().GetTransactionByAddr(&types.ReqAddr{Addr: addr2, Count: 10, Height: -1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(txinfos.TxInfos))
+	assert.Equal(t, tx.Hash(), txinfos.TxInfos[0].Hash)
+
+	//余额不足时整个交易失败
+	addr3, _ := util.Genaddress()
+	tx = createTx([]*types.AssetsTransfer{{To: addr3, Amount: types.Coin}, {To: addr2, Amount: 100000000 * types.Coin}})
+	_, err = util.ExecAndCheckBlock(mock33.GetClient(), block, []*types.Transaction{tx}, []int{types.ExecPack})
+	assert.Nil(t, err)
+}
+
+func TestExecTimeLock(t *testing.T) {
+	mock33 := testnode.New("--free--", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	cfg := mock33.GetClient().GetConfig()
+	genkey := mock33.GetGenesisKey()
+	genaddr := mock33.GetGenesisAddress()
+	addr1, _ := util.Genaddress()
+	send := func(action *cty.CoinsAction, to string) (*types.Transaction, int32) {
+		tx, err := types.FormatTx(cfg, "coins", &types.Transaction{Payload: types.Encode(action), To: to})
+		assert.Nil(t, err)
+		tx.Sign(types.SECP256K1, genkey)
+		detail, err := mock33.WaitTx(mock33.SendTx(tx))
+		assert.Nil(t, err)
+		return tx, detail.Receipt.Ty
+	}
+	claim := func(id string) int32 {
+		action := &cty.CoinsAction{Value: &cty.CoinsAction_TimeLockClaim{TimeLockClaim: &cty.AssetsTimeLockClaim{LockID: id}}, Ty: cty.CoinsActionTimeLockClaim}
+		_, ty := send(action, addr1)
+		return ty
+	}
+	list := func(addr string) []*cty.TimeLockInfo {
+		msg, err := mock33.GetAPI().Query("coins", "GetTimeLocksByAddr", &cty.ReqTimeLocks{Addr: addr})
+		assert.Nil(t, err)
+		return msg.(*cty.ReplyTimeLocks).Locks
+	}
+
+	//第一阶段两个区块之后解锁, 第二阶段不会解锁
+	height := mock33.GetLastBlock().Height
+	lock := &cty.AssetsTimeLock{
+		Stages: []*cty.TimeLockStage{
+			{Amount: types.Coin, UnlockHeight: height + 3},
+			{Amount: 2 * types.Coin, UnlockHeight: height + 1000},
+		},
+		Beneficiary: addr1,
+		Cancelable:  true,
+	}
+	tx, ty := send(&cty.CoinsAction{Value: &cty.CoinsAction_TimeLock{TimeLock: lock}, Ty: cty.CoinsActionTimeLock}, addr1)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	id := hex.EncodeToString(tx.Hash())
+	block := mock33.GetLastBlock()
+	acc := mock33.GetAccount(block.StateHash, addr1)
+	assert.Equal(t, int64(0), acc.Balance)
+	assert.Equal(t, 3*types.Coin, acc.Frozen)
+	assert.Equal(t, 1, len(list(addr1)))
+	assert.Equal(t, 1, len(list(genaddr)))
+
+	assert.Equal(t, int32(types.ExecPack), claim(id))
+	assert.Equal(t, int32(types.ExecOk), claim(id))
+	acc = mock33.GetAccount(mock33.GetLastBlock().StateHash, addr1)
+	assert.Equal(t, types.Coin, acc.Balance)
+	assert.Equal(t, 2*types.Coin, acc.Frozen)
+
+	//撤销之后未解锁的部分退回创建者, 索引被删除
+	genBalance := mock33.GetAccount(mock33.GetLastBlock().StateHash, genaddr).Balance
+	cancel := &cty.CoinsAction{Value: &cty.CoinsAction_TimeLockCancel{TimeLockCancel: &cty.AssetsTimeLockCancel{LockID: id}}, Ty: cty.CoinsActionTimeLockCancel}
+	_, ty = send(cancel, addr1)
+	assert.Equal(t, int32(types.ExecOk), ty)
+	block = mock33.GetLastBlock()
+	assert.Equal(t, int64(0), mock33.GetAccount(block.StateHash, addr1).Frozen)
+	assert.Equal(t, genBalance+2*types.Coin-cfg.GetMinTxFeeRate(), mock33.GetAccount(block.StateHash, genaddr).Balance)
+	assert.Equal(t, 0, len(list(addr1)))
+	msg, err := mock33.GetAPI().Query("coins", "GetTimeLock", &types.ReqString{Data: id})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(cty.TimeLockStatusCanceled), msg.(*cty.TimeLockInfo).Status)
+	assert.Equal(t, int32(types.ExecPack), claim(id))
+}
+
 func TestExecAllow(t *testing.T) {
 	mock33 := newMockNode()
 	defer mock33.Close()
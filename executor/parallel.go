@@ -0,0 +1,175 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/turingchain2020/turingchain/client/api"
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+/*
+交易并行执行规则:
+1. 区块内所有非交易组的交易, 基于父区块的状态, 在独立的executor中并发预执行, 并记录读取的key
+2. 按区块顺序依次提交预执行结果, 提交时如果该交易读取的key已经被前面的交易修改,
+   或者预执行时使用的index和实际的index不一致, 则在主executor中按顺序重新执行
+3. 交易组, 以及需要同时执行ExecLocal的执行器的交易, 不做预执行, 始终按顺序执行
+
+执行的结果只依赖于读取到的数据, 所以提交的receipt和顺序执行完全一致
+*/
+
+//specResult 单笔交易的预执行结果
+type specResult struct {
+	receipt *types.Receipt
+	err     error
+	index   int
+	reads   map[string]bool
+}
+
+//预执行结果是否可以直接使用
+func (spec *specResult) isValid(index int, written map[string]bool) bool {
+	if spec.index != index {
+		return false
+	}
+	for key := range spec.reads {
+		if written[key] {
+			return false
+		}
+	}
+	return true
+}
+
+//parallelState 一个区块并行执行的状态, 为nil 时表示顺序执行
+type parallelState struct {
+	specs   []*specResult
+	written map[string]bool
+}
+
+func (p *parallelState) markWritten(receipts ...*types.Receipt) {
+	if p == nil {
+		return
+	}
+	for _, receipt := range receipts {
+		if receipt == nil {
+			continue
+		}
+		for _, kv := range receipt.KV {
+			p.written[string(kv.Key)] = true
+		}
+	}
+}
+
+//execTx 提交第i笔交易的预执行结果, 存在冲突时按顺序重新执行
+func (p *parallelState) execTx(e *executor, exec *Executor, tx *types.Transaction, i, index int) (*types.Receipt, error) {
+	if p == nil {
+		return e.execTx(exec, tx, index)
+	}
+	spec := p.specs[i]
+	if spec == nil || !spec.isValid(index, p.written) {
+		receipt, err := e.execTx(exec, tx, index)
+		p.markWritten(receipt)
+		return receipt, err
+	}
+	if spec.err != nil {
+		return nil, spec.err
+	}
+	for _, kv := range spec.receipt.KV {
+		if err := e.stateDB.Set(kv.Key, kv.Value); err != nil {
+			panic(err)
+		}
+	}
+	p.markWritten(spec.receipt)
+	return spec.receipt, nil
+}
+
+func (exec *Executor) isParallelEnable(e *executor, txs []*types.Transaction) bool {
+	if !exec.parallel || len(txs) < 2 {
+		return false
+	}
+	//创世区块和平行链的执行失败处理方式不同, 不做并行
+	if e.height == 0 || e.cfg.IsPara() {
+		return false
+	}
+	//需要保证:
+	//1. 交易执行失败时可以回滚
+	//2. statedb 中的数据和receipt中的KV一致
+	//3. 执行阶段不能读写localdb
+	return e.cfg.IsFork(e.height, "ForkExecRollback") &&
+		e.cfg.IsFork(e.height, "ForkStateDBSet") &&
+		e.cfg.IsFork(e.height, "ForkLocalDBAccess")
+}
+
+//speculateTxs 并发预执行区块中的交易
+func (exec *Executor) speculateTxs(ctx *executorCtx, txs []*types.Transaction) *parallelState {
+	p := &parallelState{
+		specs:   make([]*specResult, len(txs)),
+		written: make(map[string]bool),
+	}
+	workers := exec.parallelWorkers
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	jobs := make(chan int, len(txs))
+	for i, tx := range txs {
+		if tx.GroupCount == 0 {
+			jobs <- i
+		}
+	}
+	close(jobs)
+	var wg sync.WaitGroup
+	for n := 0; n < workers; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var localdb dbm.KVDB
+			if !exec.disableLocal {
+				localdb = NewLocalDB(exec.client, true)
+				defer localdb.(*LocalDB).Close()
+			}
+			for i := range jobs {
+				p.specs[i] = exec.speculateTx(ctx, localdb, txs, i)
+			}
+		}()
+	}
+	wg.Wait()
+	return p
+}
+
+//speculateTx 在父区块的状态上预执行第i笔交易, 假设前面的交易都执行成功, 那么index = i
+func (exec *Executor) speculateTx(ctx *executorCtx, localdb dbm.KVDB, txs []*types.Transaction, i int) (spec *specResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			elog.Error("speculateTx panic", "index", i, "err", r)
+			spec = nil
+		}
+	}()
+	e := newExecutor(ctx, exec, localdb, txs, nil)
+	e.enableMVCC(nil)
+	e.stateDB.(*StateDB).TrackReads()
+	tx := txs[i]
+	if e.isExecLocalSameTime(tx, i) {
+		return nil
+	}
+	receipt, err := e.execTx(exec, tx, i)
+	if api.IsAPIEnvError(err) {
+		return nil
+	}
+	return &specResult{
+		receipt: receipt,
+		err:     err,
+		index:   i,
+		reads:   e.stateDB.(*StateDB).GetReadKeys(),
+	}
+}
+
+func defaultParallelWorkers(workers int32) int {
+	if workers <= 0 {
+		return runtime.NumCPU()
+	}
+	return int(workers)
+}
@@ -0,0 +1,72 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common/crypto"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/turingchain2020/turingchain/util/testnode"
+	"github.com/stretchr/testify/assert"
+)
+
+func execBlocksWithConfig(t *testing.T, parallel bool, blocks [][]*types.Transaction) []*types.BlockDetail {
+	cfg := testnode.GetDefaultConfig()
+	cfg.GetModuleConfig().Consensus.Minerstart = false
+	cfg.GetModuleConfig().Exec.EnableParallel = parallel
+	cfg.GetModuleConfig().Exec.ParallelWorkers = 4
+	mock33 := testnode.NewWithConfig(cfg, nil)
+	defer mock33.Close()
+	mock33.WaitHeight(0)
+	block := mock33.GetBlock(0)
+	var details []*types.BlockDetail
+	for _, txs := range blocks {
+		newblock := util.CreateNewBlock(cfg, block, txs)
+		detail, _, err := util.ExecBlock(mock33.GetClient(), block.StateHash, newblock, false, true, false)
+		assert.Nil(t, err)
+		details = append(details, detail)
+		block = detail.Block
+	}
+	return details
+}
+
+func TestParallelExecSameAsSequential(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	priv := util.TestPrivkeyList[1]
+
+	var privs []crypto.PrivKey
+	var addrs []string
+	var txs1 []*types.Transaction
+	for i := 0; i < 8; i++ {
+		addr, p := util.Genaddress()
+		privs = append(privs, p)
+		addrs = append(addrs, addr)
+		txs1 = append(txs1, util.CreateCoinsTx(cfg, priv, addr, 10*types.Coin))
+	}
+	var txs2 []*types.Transaction
+	for i := 0; i < 8; i++ {
+		to, _ := util.Genaddress()
+		//互不冲突的转账
+		txs2 = append(txs2, util.CreateCoinsTx(cfg, privs[i], to, types.Coin))
+	}
+	//同一个账户的连续转账, 后一笔依赖前一笔的结果
+	txs2 = append(txs2, util.CreateCoinsTx(cfg, privs[0], addrs[1], 8*types.Coin))
+	txs2 = append(txs2, util.CreateCoinsTx(cfg, privs[1], addrs[2], 17*types.Coin))
+	//余额不足
+	txs2 = append(txs2, util.CreateCoinsTx(cfg, privs[3], addrs[4], 100*types.Coin))
+
+	seq := execBlocksWithConfig(t, false, [][]*types.Transaction{txs1, txs2})
+	par := execBlocksWithConfig(t, true, [][]*types.Transaction{txs1, txs2})
+	assert.Equal(t, len(seq), len(par))
+	for i := range seq {
+		assert.Equal(t, seq[i].Block.StateHash, par[i].Block.StateHash)
+		assert.Equal(t, len(seq[i].Receipts), len(par[i].Receipts))
+		for j := range seq[i].Receipts {
+			assert.Equal(t, types.Encode(seq[i].Receipts[j]), types.Encode(par[i].Receipts[j]))
+		}
+	}
+}
@@ -42,15 +42,10 @@ func (p *addrindexPlugin) ExecLocal(executor *executor, data *types.BlockDetail)
 			}
 		}
 		if len(txindex.to) != 0 {
-			tokey1 := types.CalcTxAddrDirHashKey(txindex.to, drivers.TxIndexTo, txindex.heightstr)
-			tokey2 := types.CalcTxAddrHashKey(txindex.to, txindex.heightstr)
-			set.KV = append(set.KV, &types.KeyValue{Key: tokey1, Value: txinfobyte})
-			set.KV = append(set.KV, &types.KeyValue{Key: tokey2, Value: txinfobyte})
-			types.AssertConfig(executor.api)
-			kv, err := updateAddrTxsCount(executor.api.GetConfig(), executor.localDB, txindex.to, 1, true)
-			if err == nil && kv != nil {
-				set.KV = append(set.KV, kv)
-			}
+			set.KV = append(set.KV, getToAddrIndexKV(executor, txindex.to, txindex.heightstr, txinfobyte)...)
+		}
+		for _, to := range txindex.otherTos {
+			set.KV = append(set.KV, getToAddrIndexKV(executor, to, txindex.heightstr, txinfobyte)...)
 		}
 	}
 	return set.KV, nil
@@ -75,19 +70,28 @@ func (p *addrindexPlugin) ExecDelLocal(executor *executor, data *types.BlockDeta
 			}
 		}
 		if len(txindex.to) != 0 {
-			tokey1 := types.CalcTxAddrDirHashKey(txindex.to, drivers.TxIndexTo, txindex.heightstr)
-			tokey2 := types.CalcTxAddrHashKey(txindex.to, txindex.heightstr)
-			set.KV = append(set.KV, &types.KeyValue{Key: tokey1, Value: nil})
-			set.KV = append(set.KV, &types.KeyValue{Key: tokey2, Value: nil})
-			kv, err := updateAddrTxsCount(executor.api.GetConfig(), executor.localDB, txindex.to, 1, false)
-			if err == nil && kv != nil {
-				set.KV = append(set.KV, kv)
-			}
+			set.KV = append(set.KV, getToAddrIndexKV(executor, txindex.to, txindex.heightstr, nil)...)
+		}
+		for _, to := range txindex.otherTos {
+			set.KV = append(set.KV, getToAddrIndexKV(executor, to, txindex.heightstr, nil)...)
 		}
 	}
 	return set.KV, nil
 }
 
+//getToAddrIndexKV 收款地址的交易索引, txinfobyte 为nil 时删除索引
+func getToAddrIndexKV(executor *executor, to, heightstr string, txinfobyte []byte) []*types.KeyValue {
+	tokey1 := types.CalcTxAddrDirHashKey(to, drivers.TxIndexTo, heightstr)
+	tokey2 := types.CalcTxAddrHashKey(to, heightstr)
+	kvs := []*types.KeyValue{{Key: tokey1, Value: txinfobyte}, {Key: tokey2, Value: txinfobyte}}
+	types.AssertConfig(executor.api)
+	kv, err := updateAddrTxsCount(executor.api.GetConfig(), executor.localDB, to, 1, txinfobyte != nil)
+	if err == nil && kv != nil {
+		kvs = append(kvs, kv)
+	}
+	return kvs
+}
+
 func getAddrTxsCountKV(addr string, count int64) *types.KeyValue {
 	counts := &types.Int64{Data: count}
 	countbytes := types.Encode(counts)
@@ -0,0 +1,124 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/turingchain2020/turingchain/common/address"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+func init() {
+	RegisterPlugin("logindex", &logindexPlugin{})
+}
+
+//logindexPlugin 为交易回执中的日志建立索引, 可以按照执行器, 日志类型和地址查询日志
+type logindexPlugin struct {
+	pluginBase
+}
+
+func (p *logindexPlugin) CheckEnable(executor *executor, enable bool) (kvs []*types.KeyValue, ok bool, err error) {
+	kvs, ok, err = p.checkFlag(executor, types.FlagLogIndex, enable)
+	if err == types.ErrDBFlag {
+		panic("logindex config is enable, it must be synchronized from 0 height ")
+	}
+	return kvs, ok, err
+}
+
+func (p *logindexPlugin) ExecLocal(executor *executor, data *types.BlockDetail) ([]*types.KeyValue, error) {
+	return getLogIndexKVs(executor, data), nil
+}
+
+func (p *logindexPlugin) ExecDelLocal(executor *executor, data *types.BlockDetail) ([]*types.KeyValue, error) {
+	kvs := getLogIndexKVs(executor, data)
+	for _, kv := range kvs {
+		kv.Value = nil
+	}
+	return kvs, nil
+}
+
+func getLogIndexKVs(executor *executor, data *types.BlockDetail) (kvs []*types.KeyValue) {
+	for i, tx := range data.Block.Txs {
+		execer := string(tx.Execer)
+		for j, l := range data.Receipts[i].GetLogs() {
+			logindex := types.LogIndexStr(executor.height, int32(i), int32(j))
+			item := &types.LogItem{
+				Height:    executor.height,
+				TxIndex:   int32(i),
+				LogIndex:  int32(j),
+				TxHash:    tx.Hash(),
+				Execer:    execer,
+				Ty:        l.Ty,
+				Log:       l.Log,
+				BlockTime: executor.blocktime,
+				Addrs:     getLogAddrs(tx.Execer, l),
+			}
+			//索引中只保存日志内容的key, 日志内容只保存一份
+			key := types.CalcLogKey(logindex)
+			kvs = append(kvs, &types.KeyValue{Key: key, Value: types.Encode(item)})
+			kvs = append(kvs, &types.KeyValue{Key: types.CalcLogExecKey(execer, logindex), Value: key})
+			kvs = append(kvs, &types.KeyValue{Key: types.CalcLogTypeKey(execer, l.Ty, logindex), Value: key})
+			for _, addr := range item.Addrs {
+				kvs = append(kvs, &types.KeyValue{Key: types.CalcLogAddrKey(addr, logindex), Value: key})
+			}
+		}
+	}
+	return kvs
+}
+
+//getLogAddrs 解析日志, 获取日志中所有名称以Addr 结尾的字段中的合法地址
+func getLogAddrs(execer []byte, l *types.ReceiptLog) []string {
+	msg, err := types.DecodeLog(execer, int64(l.Ty), l.Log)
+	if err != nil {
+		return nil
+	}
+	if _, ok := msg.(types.Message); !ok {
+		return nil
+	}
+	addrs := make(map[string]bool)
+	collectAddrs(reflect.ValueOf(msg), "", addrs, 0)
+	list := make([]string, 0, len(addrs))
+	for addr := range addrs {
+		list = append(list, addr)
+	}
+	sort.Strings(list)
+	return list
+}
+
+//collectAddrs 递归遍历日志结构, 嵌套层数有限制
+func collectAddrs(v reflect.Value, name string, addrs map[string]bool, depth int) {
+	if depth > 8 {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			collectAddrs(v.Elem(), name, addrs, depth+1)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" || strings.HasPrefix(field.Name, "XXX_") {
+				continue
+			}
+			collectAddrs(v.Field(i), field.Name, addrs, depth+1)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			collectAddrs(v.Index(i), strings.TrimSuffix(name, "s"), addrs, depth+1)
+		}
+	case reflect.String:
+		if strings.HasSuffix(strings.ToLower(name), "addr") && address.CheckAddress(v.String()) == nil {
+			addrs[v.String()] = true
+		}
+	}
+}
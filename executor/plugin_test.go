@@ -72,3 +72,56 @@ func TestPluginBase(t *testing.T) {
 	_, _, err = base.checkFlag(executor, k, true)
 	assert.NoError(t, err)
 }
+
+func TestPluginLogIndex(t *testing.T) {
+	exec, _ := initEnv(types.GetDefaultCfgstring())
+	cfg := exec.client.GetConfig()
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	ctx := &executorCtx{
+		height:     0,
+		blocktime:  time.Now().Unix(),
+		difficulty: 1,
+	}
+	addr, priv := util.Genaddress()
+	tx := util.CreateCoinsTx(cfg, priv, addr, types.Coin)
+	transfer := &types.ReceiptAccountTransfer{
+		Prev:    &types.Account{Addr: addr, Balance: 0},
+		Current: &types.Account{Addr: addr, Balance: types.Coin},
+	}
+	detail := &types.BlockDetail{
+		Block: &types.Block{Txs: []*types.Transaction{tx}},
+		Receipts: []*types.ReceiptData{{Ty: types.ExecOk, Logs: []*types.ReceiptLog{
+			{Ty: types.TyLogTransfer, Log: types.Encode(transfer)},
+		}}},
+	}
+	executor := newExecutor(ctx, exec, kvdb, detail.Block.Txs, nil)
+	plugin := &logindexPlugin{}
+	kvs, ok, err := plugin.CheckEnable(executor, true)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(kvs))
+
+	kvs, err = plugin.ExecLocal(executor, detail)
+	assert.NoError(t, err)
+	//日志内容, 执行器索引, 类型索引, 地址索引
+	assert.Equal(t, 4, len(kvs))
+	logindex := types.LogIndexStr(0, 0, 0)
+	key := types.CalcLogKey(logindex)
+	var item types.LogItem
+	assert.Equal(t, key, kvs[0].Key)
+	assert.NoError(t, types.Decode(kvs[0].Value, &item))
+	assert.Equal(t, tx.Hash(), item.TxHash)
+	assert.Equal(t, []string{addr}, item.Addrs)
+	assert.Equal(t, types.CalcLogExecKey("coins", logindex), kvs[1].Key)
+	assert.Equal(t, types.CalcLogTypeKey("coins", types.TyLogTransfer, logindex), kvs[2].Key)
+	assert.Equal(t, types.CalcLogAddrKey(addr, logindex), kvs[3].Key)
+	assert.Equal(t, key, kvs[3].Value)
+
+	kvs, err = plugin.ExecDelLocal(executor, detail)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(kvs))
+	for _, kv := range kvs {
+		assert.Nil(t, kv.Value)
+	}
+}
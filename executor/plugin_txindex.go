@@ -69,8 +69,10 @@ func getTx(executor *executor, tx *types.Transaction, receipt *types.ReceiptData
 }
 
 type txIndex struct {
-	from      string
-	to        string
+	from string
+	to   string
+	//交易转账给多个地址时, 除了to 之外的其他收款地址
+	otherTos  []string
 	heightstr string
 	index     *types.ReplyTxInfo
 }
@@ -98,5 +100,14 @@ func getTxIndex(executor *executor, tx *types.Transaction, receipt *types.Receip
 
 	txIndexInfo.from = address.PubKeyToAddress(tx.GetSignature().GetPubkey()).String()
 	txIndexInfo.to = tx.GetRealToAddr()
+	if getter, ok := ety.(types.ToAddrsGetter); ok {
+		dup := map[string]bool{txIndexInfo.to: true}
+		for _, addr := range getter.GetToAddrs(tx) {
+			if !dup[addr] {
+				dup[addr] = true
+				txIndexInfo.otherTos = append(txIndexInfo.otherTos, addr)
+			}
+		}
+	}
 	return &txIndexInfo
 }
@@ -24,6 +24,8 @@ type StateDB struct {
 	height    int64
 	local     *db.SimpleMVCC
 	opt       *StateDBOption
+	//并行执行时记录读取过的key, 用于冲突检测
+	reads map[string]bool
 }
 
 // StateDBOption state db option enable mvcc
@@ -114,6 +116,9 @@ func (s *StateDB) Get(key []byte) ([]byte, error) {
 
 func (s *StateDB) get(key []byte) ([]byte, error) {
 	skey := types.Bytes2Str(key)
+	if s.reads != nil {
+		s.reads[string(key)] = true
+	}
 	if s.intx && s.txcache != nil {
 		if value, ok := s.txcache[skey]; ok {
 			return value, nil
@@ -139,7 +144,8 @@ func (s *StateDB) get(key []byte) ([]byte, error) {
 	}
 	resp, err := s.client.Wait(msg)
 	if err != nil {
-		panic(err) //no happen for ever
+		//轻节点的store 获取状态失败时返回错误
+		return nil, err
 	}
 	defer s.client.FreeMessage(msg, resp)
 	if nil == resp.GetData().(*types.StoreReplyValue).Values {
@@ -169,6 +175,16 @@ func debugAccount(prefix string, key []byte, value []byte) {
 	*/
 }
 
+// TrackReads 开启读取记录, 之后所有Get的key都会被记录
+func (s *StateDB) TrackReads() {
+	s.reads = make(map[string]bool)
+}
+
+// GetReadKeys get state db read keys
+func (s *StateDB) GetReadKeys() map[string]bool {
+	return s.reads
+}
+
 // StartTx reset state db keys
 func (s *StateDB) StartTx() {
 	s.keys = nil
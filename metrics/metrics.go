@@ -1,10 +1,13 @@
 package metrics
 
 import (
+	"net/http"
+	"strings"
 	"time"
 
 	turingchainlog "github.com/turingchain2020/turingchain/common/log/log15"
 	"github.com/turingchain2020/turingchain/metrics/influxdb"
+	"github.com/turingchain2020/turingchain/metrics/prometheus"
 	"github.com/turingchain2020/turingchain/types"
 	go_metrics "github.com/rcrowley/go-metrics"
 )
@@ -19,6 +22,13 @@ type influxDBPara struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+type prometheusPara struct {
+	// 提供给prometheus 拉取数据的监听地址
+	ListenAddr string `json:"listenAddr,omitempty"`
+	Path       string `json:"path,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
 var (
 	log = turingchainlog.New("module", "turingchain metrics")
 )
@@ -53,8 +63,68 @@ func StartMetrics(cfg *types.TuringchainConfig) {
 			influxdbcfg.Username,
 			influxdbcfg.Password,
 			"")
+	case "prometheus":
+		var promcfg prometheusPara
+		if subcfg, ok := cfg.GetSubConfig().Metrics[metrics.DataEmitMode]; ok {
+			types.MustDecode(subcfg, &promcfg)
+		}
+		if promcfg.ListenAddr == "" {
+			promcfg.ListenAddr = "localhost:9101"
+		}
+		if promcfg.Path == "" {
+			promcfg.Path = "/metrics"
+		}
+		log.Info("StartMetrics with prometheus", "listenAddr", promcfg.ListenAddr, "path", promcfg.Path,
+			"namespace", promcfg.Namespace)
+		mux := http.NewServeMux()
+		mux.Handle(promcfg.Path, prometheus.Handler(go_metrics.DefaultRegistry, promcfg.Namespace))
+		go func() {
+			err := http.ListenAndServe(promcfg.ListenAddr, mux)
+			log.Error("StartMetrics prometheus server stopped", "err", err)
+		}()
 	default:
 		log.Error("startMetrics", "The dataEmitMode set is not supported now ", metrics.DataEmitMode)
 		return
 	}
 }
+
+var labelReplacer = strings.NewReplacer(",", "_", "=", "_", "{", "_", "}", "_")
+
+//Name 生成带标签的metrics 名称, labels 为key, value 交替出现
+//例如 Name("executor_tx_exec", "executor", "coins") 生成 executor_tx_exec{executor=coins},
+//prometheus 导出时转换为 executor_tx_exec{executor="coins"}
+func Name(name string, labels ...string) string {
+	if len(labels) < 2 {
+		return name
+	}
+	var pairs []string
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, labelReplacer.Replace(labels[i])+"="+labelReplacer.Replace(labels[i+1]))
+	}
+	return name + "{" + strings.Join(pairs, ",") + "}"
+}
+
+//Timer 获取或者注册计时器
+func Timer(name string, labels ...string) go_metrics.Timer {
+	return go_metrics.GetOrRegisterTimer(Name(name, labels...), nil)
+}
+
+//Counter 获取或者注册计数器
+func Counter(name string, labels ...string) go_metrics.Counter {
+	return go_metrics.GetOrRegisterCounter(Name(name, labels...), nil)
+}
+
+//Gauge 获取或者注册gauge
+func Gauge(name string, labels ...string) go_metrics.Gauge {
+	return go_metrics.GetOrRegisterGauge(Name(name, labels...), nil)
+}
+
+//GaugeFloat64 获取或者注册浮点数gauge
+func GaugeFloat64(name string, labels ...string) go_metrics.GaugeFloat64 {
+	return go_metrics.GetOrRegisterGaugeFloat64(Name(name, labels...), nil)
+}
+
+//Meter 获取或者注册meter
+func Meter(name string, labels ...string) go_metrics.Meter {
+	return go_metrics.GetOrRegisterMeter(Name(name, labels...), nil)
+}
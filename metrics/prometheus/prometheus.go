@@ -0,0 +1,156 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package prometheus 把go-metrics 中注册的数据按照prometheus 文本格式导出, 提供给prometheus 拉取
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	go_metrics "github.com/rcrowley/go-metrics"
+)
+
+var quantiles = []float64{0.5, 0.9, 0.99}
+
+//summary 计时器和直方图的共同接口
+type summary interface {
+	Count() int64
+	Sum() int64
+	Percentiles([]float64) []float64
+}
+
+type family struct {
+	typ     string
+	samples []string
+}
+
+type collector struct {
+	namespace string
+	families  map[string]*family
+}
+
+//splitName 拆分metrics.Name 生成的名称, name{k=v,k2=v2} 转换为name 和prometheus 格式的标签
+func splitName(name string) (string, []string) {
+	i := strings.IndexByte(name, '{')
+	if i < 0 || !strings.HasSuffix(name, "}") {
+		return name, nil
+	}
+	var labels []string
+	for _, pair := range strings.Split(name[i+1:len(name)-1], ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		labels = append(labels, sanitize(kv[0])+"="+strconv.Quote(kv[1]))
+	}
+	return name[:i], labels
+}
+
+//sanitize prometheus 的名称只能包含字母, 数字, 下划线和冒号
+func sanitize(name string) string {
+	b := []byte(name)
+	for i, c := range b {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == ':') {
+			b[i] = '_'
+		}
+	}
+	if len(b) > 0 && b[0] >= '0' && b[0] <= '9' {
+		return "_" + string(b)
+	}
+	return string(b)
+}
+
+func formatLabels(labels []string, extra ...string) string {
+	all := append(append([]string{}, labels...), extra...)
+	if len(all) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(all, ",") + "}"
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func (c *collector) add(name, typ string, labels []string, suffix string, value float64, extra ...string) {
+	f, ok := c.families[name]
+	if !ok {
+		f = &family{typ: typ}
+		c.families[name] = f
+	}
+	f.samples = append(f.samples, name+suffix+formatLabels(labels, extra...)+" "+formatFloat(value))
+}
+
+func (c *collector) addSummary(name string, labels []string, s summary, scale float64) {
+	ps := s.Percentiles(quantiles)
+	for i, q := range quantiles {
+		c.add(name, "summary", labels, "", ps[i]/scale, "quantile="+strconv.Quote(formatFloat(q)))
+	}
+	c.add(name, "summary", labels, "_sum", float64(s.Sum())/scale)
+	c.add(name, "summary", labels, "_count", float64(s.Count()))
+}
+
+func (c *collector) collect(name string, i interface{}) {
+	base, labels := splitName(name)
+	base = sanitize(base)
+	if c.namespace != "" {
+		base = sanitize(c.namespace) + "_" + base
+	}
+	switch m := i.(type) {
+	case go_metrics.Counter:
+		c.add(base, "counter", labels, "", float64(m.Count()))
+	case go_metrics.Gauge:
+		c.add(base, "gauge", labels, "", float64(m.Value()))
+	case go_metrics.GaugeFloat64:
+		c.add(base, "gauge", labels, "", m.Value())
+	case go_metrics.Meter:
+		s := m.Snapshot()
+		c.add(base+"_total", "counter", labels, "", float64(s.Count()))
+		c.add(base+"_rate1m", "gauge", labels, "", s.Rate1())
+	case go_metrics.Timer:
+		//go-metrics 的计时器单位为纳秒, 转换为秒
+		c.addSummary(base+"_seconds", labels, m.Snapshot(), 1e9)
+	case go_metrics.Histogram:
+		c.addSummary(base, labels, m.Snapshot(), 1)
+	}
+}
+
+//WriteMetrics 按照prometheus 文本格式输出registry 中的所有数据
+func WriteMetrics(w io.Writer, r go_metrics.Registry, namespace string) error {
+	c := &collector{namespace: namespace, families: make(map[string]*family)}
+	r.Each(c.collect)
+	var names []string
+	for name := range c.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	for _, name := range names {
+		f := c.families[name]
+		fmt.Fprintf(&buf, "# TYPE %s %s\n", name, f.typ)
+		for _, sample := range f.samples {
+			buf.WriteString(sample)
+			buf.WriteByte('\n')
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+//Handler prometheus 拉取数据的http 接口
+func Handler(r go_metrics.Registry, namespace string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		err := WriteMetrics(w, r, namespace)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prometheus
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	go_metrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	r := go_metrics.NewRegistry()
+	go_metrics.GetOrRegisterCounter("tx_total", r).Inc(3)
+	go_metrics.GetOrRegisterGauge("mempool.size", r).Update(10)
+	go_metrics.GetOrRegisterCounter("tx_rejected{reason=ErrTxFee}", r).Inc(1)
+	go_metrics.GetOrRegisterCounter("tx_rejected{reason=ErrTxExpire}", r).Inc(2)
+	go_metrics.GetOrRegisterTimer("exec{executor=coins}", r).Update(2 * time.Second)
+
+	var buf bytes.Buffer
+	require.Nil(t, WriteMetrics(&buf, r, "tc"))
+	out := buf.String()
+	assert.Contains(t, out, "# TYPE tc_tx_total counter\ntc_tx_total 3\n")
+	assert.Contains(t, out, "# TYPE tc_mempool_size gauge\ntc_mempool_size 10\n")
+	assert.Contains(t, out, `tc_tx_rejected{reason="ErrTxFee"} 1`)
+	assert.Contains(t, out, `tc_tx_rejected{reason="ErrTxExpire"} 2`)
+	assert.Contains(t, out, "# TYPE tc_exec_seconds summary\n")
+	assert.Contains(t, out, `tc_exec_seconds{executor="coins",quantile="0.5"} 2`)
+	assert.Contains(t, out, `tc_exec_seconds_sum{executor="coins"} 2`)
+	assert.Contains(t, out, `tc_exec_seconds_count{executor="coins"} 1`)
+
+	w := httptest.NewRecorder()
+	Handler(r, "").ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, 200, w.Code)
+	assert.Contains(t, w.Body.String(), "tx_total 3\n")
+}
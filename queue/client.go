@@ -12,6 +12,7 @@ import (
 
 	"unsafe"
 
+	"github.com/turingchain2020/turingchain/trace"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -38,6 +39,7 @@ type Client interface {
 	Sub(topic string) //订阅消息
 	Close()
 	CloseQueue() (*types.Reply, error)
+	GetQueueStats(topic string) (*types.QueueStats, error)
 	NewMessage(topic string, ty int64, data interface{}) (msg *Message)
 	FreeMessage(msg ...*Message) //回收msg， 需要注意回收时上下文不再引用
 	GetConfig() *types.TuringchainConfig
@@ -97,11 +99,34 @@ func (client *client) SendTimeout(msg *Message, waitReply bool, timeout time.Dur
 	if client.isClose() {
 		return ErrIsQueueClosed
 	}
+	//放入队列之后接收方可能已经在处理消息, 发送方不能再修改msg
+	msg.sendTime = time.Now()
+	span := startSpan(msg)
 	if !waitReply {
 		//msg.chReply = nil
-		return client.q.sendLowTimeout(msg, timeout)
+		err = client.q.sendLowTimeout(msg, timeout)
+		span.Finish(err)
+		return err
 	}
-	return client.q.send(msg, timeout)
+	err = client.q.send(msg, timeout)
+	if err != nil {
+		span.Finish(err)
+	}
+	return err
+}
+
+//startSpan 消息带有跟踪上下文时记录发送的span, 接收方看到的上下文是这个span.
+//需要在消息放入队列之前调用, 等待应答的消息在WaitTimeout 中结束span
+func startSpan(msg *Message) *trace.Span {
+	span := trace.StartChild(msg.trace, "queue.send "+msg.Topic)
+	msg.span = span
+	if span == nil {
+		return nil
+	}
+	span.SetAttr("event", types.GetEventName(int(msg.Ty)))
+	span.SetAttr("id", msg.ID)
+	msg.trace = span.Context()
+	return span
 }
 
 //系统设计出两种优先级别的消息发送
@@ -116,6 +141,8 @@ func (client *client) NewMessage(topic string, ty int64, data interface{}) (msg
 	msg.Ty = ty
 	msg.Data = data
 	msg.Topic = topic
+	msg.trace = trace.SpanContext{}
+	msg.span = nil
 	return
 }
 
@@ -152,12 +179,20 @@ func (client *client) WaitTimeout(msg *Message, timeout time.Duration) (*Message
 		defer timer.Stop()
 		t = timer.C
 	}
+	span := msg.span
+	topic, ty, sendTime := msg.Topic, msg.Ty, msg.sendTime
 	select {
 	case msg = <-msg.chReply:
+		span.Finish(msg.Err())
+		if stats := client.q.getStats(topic); stats != nil && !sendTime.IsZero() {
+			stats.reply(ty, time.Since(sendTime))
+		}
 		return msg, msg.Err()
 	case <-client.done:
+		span.Finish(ErrIsQueueClosed)
 		return &Message{}, ErrIsQueueClosed
 	case <-t:
+		span.Finish(ErrQueueTimeout)
 		return &Message{}, ErrQueueTimeout
 	}
 }
@@ -201,6 +236,14 @@ func (client *client) Close() {
 	}
 	topic := client.getTopic()
 	client.q.closeTopic(topic)
+	client.unsub()
+}
+
+//unsub 停止接收消息, 但是不关闭topic, 之后可以由其他client 重新订阅
+func (client *client) unsub() {
+	if atomic.LoadPointer(&client.topic) != nil {
+		client.q.unsubscribe(client.getTopic())
+	}
 	close(client.done)
 	atomic.StoreInt32(&client.isCloseing, 1)
 	client.wg.Wait()
@@ -211,6 +254,11 @@ func (client *client) Close() {
 	}
 }
 
+// GetQueueStats 获取消息队列的统计, topic 为空时返回所有topic
+func (client *client) GetQueueStats(topic string) (*types.QueueStats, error) {
+	return client.q.queueStats(topic)
+}
+
 // CloseQueue 关闭消息队列
 func (client *client) CloseQueue() (*types.Reply, error) {
 	//	client.q.Close()
@@ -238,13 +286,33 @@ func (client *client) isEnd(data *Message, ok bool) bool {
 
 // Sub 订阅消息类型
 func (client *client) Sub(topic string) {
+	//本地订阅只有client 关闭时返回错误
+	client.sub(topic, false)
+}
+
+//sub 订阅消息类型, 远程模块订阅时topic 不能已经有订阅者
+func (client *client) sub(topic string, remote bool) error {
 	//正在关闭或者已经关闭
 	if client.isInClose() || client.isClose() {
-		return
+		return ErrIsQueueClosed
+	}
+	sub, err := client.q.subscribe(topic, remote)
+	if err != nil {
+		return err
 	}
 	client.wg.Add(1)
 	client.setTopic(topic)
-	sub := client.q.chanSub(topic)
+	if sub.stats != nil {
+		sub.stats.setRecv(client.recv)
+	}
+	recv := func(data *Message) {
+		//交给模块之后消息可能被修改, 提前保存
+		ty, sendTime := data.Ty, data.sendTime
+		client.Recv() <- data
+		if sub.stats != nil {
+			sub.stats.deliver(ty, sendTime, sub.depth())
+		}
+	}
 	go func() {
 		defer func() {
 			client.wg.Done()
@@ -256,7 +324,7 @@ func (client *client) Sub(topic string) {
 					qlog.Info("unsub1", "topic", topic)
 					return
 				}
-				client.Recv() <- data
+				recv(data)
 			default:
 				select {
 				case data, ok := <-sub.high:
@@ -264,13 +332,13 @@ func (client *client) Sub(topic string) {
 						qlog.Info("unsub2", "topic", topic)
 						return
 					}
-					client.Recv() <- data
+					recv(data)
 				case data, ok := <-sub.low:
 					if client.isEnd(data, ok) {
 						qlog.Info("unsub3", "topic", topic)
 						return
 					}
-					client.Recv() <- data
+					recv(data)
 				case <-client.done:
 					qlog.Error("unsub4", "topic", topic)
 					return
@@ -278,4 +346,5 @@ func (client *client) Sub(topic string) {
 			}
 		}
 	}()
+	return nil
 }
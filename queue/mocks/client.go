@@ -71,6 +71,29 @@ func (_m *Client) GetConfig() *types.TuringchainConfig {
 	return r0
 }
 
+// GetQueueStats provides a mock function with given fields: topic
+func (_m *Client) GetQueueStats(topic string) (*types.QueueStats, error) {
+	ret := _m.Called(topic)
+
+	var r0 *types.QueueStats
+	if rf, ok := ret.Get(0).(func(string) *types.QueueStats); ok {
+		r0 = rf(topic)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueueStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(topic)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMessage provides a mock function with given fields: topic, ty, data
 func (_m *Client) NewMessage(topic string, ty int64, data interface{}) *queue.Message {
 	ret := _m.Called(topic, ty, data)
@@ -10,11 +10,14 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/turingchain2020/turingchain/trace"
 	"github.com/turingchain2020/turingchain/types"
 
 	log "github.com/turingchain2020/turingchain/common/log/log15"
@@ -39,6 +42,7 @@ var (
 	ErrIsQueueClosed    = errors.New("ErrIsQueueClosed")
 	ErrQueueTimeout     = errors.New("ErrQueueTimeout")
 	ErrQueueChannelFull = errors.New("ErrQueueChannelFull")
+	ErrTopicSubscribed  = errors.New("ErrTopicSubscribed")
 )
 
 // DisableLog disable log
@@ -50,6 +54,11 @@ type chanSub struct {
 	high    chan *Message
 	low     chan *Message
 	isClose int32
+	stats   *topicStats
+}
+
+func (sub *chanSub) depth() int64 {
+	return int64(len(sub.high) + len(sub.low))
 }
 
 // Queue only one obj in project
@@ -74,16 +83,27 @@ type queue struct {
 	name      string
 	cfg       *types.TuringchainConfig
 	msgPool   *sync.Pool
+	// 队列满时的处理策略
+	policy      string
+	topicPolicy map[string]string
+	slowTime    int64
+	// topic 的订阅者数量, 远程模块订阅过的topic
+	subscribers  map[string]int
+	remoteTopics map[string]bool
 }
 
 // New new queue struct
 func New(name string) Queue {
 	q := &queue{
-		chanSubs:  make(map[string]*chanSub),
-		name:      name,
+		chanSubs:     make(map[string]*chanSub),
+		subscribers:  make(map[string]int),
+		remoteTopics: make(map[string]bool),
+		name:         name,
 		done:      make(chan struct{}, 1),
 		interrupt: make(chan struct{}, 1),
 		callback:  make(chan *Message, 1024),
+		policy:    PolicyBlock,
+		slowTime:  int64(defaultSlowConsumerTime),
 	}
 	q.msgPool = &sync.Pool{
 		New: func() interface{} {
@@ -105,9 +125,31 @@ func New(name string) Queue {
 			}
 		}
 	}()
+	go q.monitor()
 	return q
 }
 
+//monitor 定时检查慢消费者
+func (q *queue) monitor() {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-q.done:
+			return
+		case now := <-ticker.C:
+			slowTime := time.Duration(atomic.LoadInt64(&q.slowTime))
+			q.mu.Lock()
+			for _, sub := range q.chanSubs {
+				if sub.stats != nil {
+					sub.stats.checkSlow(sub.depth(), now, slowTime)
+				}
+			}
+			q.mu.Unlock()
+		}
+	}
+}
+
 // GetConfig return the queue TuringchainConfig
 func (q *queue) GetConfig() *types.TuringchainConfig {
 	return q.cfg
@@ -122,6 +164,52 @@ func (q *queue) SetConfig(cfg *types.TuringchainConfig) {
 		panic("do not reset queue config")
 	}
 	q.cfg = cfg
+	q.setQueueConfig(cfg.GetModuleConfig().Queue)
+}
+
+//setQueueConfig 设置队列满时的处理策略和慢消费者的检查时间
+func (q *queue) setQueueConfig(cfg *types.Queue) {
+	if cfg == nil {
+		return
+	}
+	policy := PolicyBlock
+	if cfg.Policy != "" {
+		policy = cfg.Policy
+	}
+	if !checkPolicy(policy) {
+		panic("queue policy " + policy + " is not supported")
+	}
+	topicPolicy := make(map[string]string)
+	for _, item := range cfg.TopicPolicy {
+		kv := strings.SplitN(item, ":", 2)
+		if len(kv) != 2 || !checkPolicy(kv[1]) {
+			panic("queue topic policy " + item + " is invalid")
+		}
+		topicPolicy[kv[0]] = kv[1]
+	}
+	if cfg.SlowConsumerTime > 0 {
+		atomic.StoreInt64(&q.slowTime, int64(time.Duration(cfg.SlowConsumerTime)*time.Millisecond))
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.policy = policy
+	q.topicPolicy = topicPolicy
+	for topic, sub := range q.chanSubs {
+		if sub.stats != nil {
+			sub.stats.setPolicy(q.policyOf(topic))
+		}
+	}
+}
+
+//policyOf topic 的处理策略, 远程模块订阅的topic 没有单独配置时不阻塞发送方
+func (q *queue) policyOf(topic string) string {
+	if policy, ok := q.topicPolicy[topic]; ok {
+		return policy
+	}
+	if q.remoteTopics[topic] {
+		return remotePolicy
+	}
+	return q.policy
 }
 
 // Name return the queue name
@@ -164,7 +252,7 @@ func (q *queue) Close() {
 		if ch.isClose == 0 {
 			ch.high <- &Message{}
 			ch.low <- &Message{}
-			q.chanSubs[topic] = &chanSub{isClose: 1}
+			q.chanSubs[topic] = &chanSub{isClose: 1, stats: ch.stats}
 		}
 	}
 	q.mu.Unlock()
@@ -177,17 +265,47 @@ func (q *queue) Close() {
 func (q *queue) chanSub(topic string) *chanSub {
 	q.mu.Lock()
 	defer q.mu.Unlock()
+	return q.getChanSub(topic)
+}
+
+func (q *queue) getChanSub(topic string) *chanSub {
 	_, ok := q.chanSubs[topic]
 	if !ok {
 		q.chanSubs[topic] = &chanSub{
 			high:    make(chan *Message, defaultChanBuffer),
 			low:     make(chan *Message, defaultLowChanBuffer),
 			isClose: 0,
+			stats:   newTopicStats(topic, q.policyOf(topic)),
 		}
 	}
 	return q.chanSubs[topic]
 }
 
+//subscribe 记录topic 的订阅者, 远程模块不能订阅本地已经有订阅者的topic
+func (q *queue) subscribe(topic string, remote bool) (*chanSub, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if remote && q.subscribers[topic] > 0 {
+		return nil, ErrTopicSubscribed
+	}
+	q.subscribers[topic]++
+	if remote && !q.remoteTopics[topic] {
+		q.remoteTopics[topic] = true
+		if sub, ok := q.chanSubs[topic]; ok && sub.stats != nil {
+			sub.stats.setPolicy(q.policyOf(topic))
+		}
+	}
+	return q.getChanSub(topic), nil
+}
+
+func (q *queue) unsubscribe(topic string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.subscribers[topic] > 0 {
+		q.subscribers[topic]--
+	}
+}
+
 func (q *queue) closeTopic(topic string) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
@@ -199,7 +317,37 @@ func (q *queue) closeTopic(topic string) {
 		sub.high <- &Message{}
 		sub.low <- &Message{}
 	}
-	q.chanSubs[topic] = &chanSub{isClose: 1}
+	q.chanSubs[topic] = &chanSub{isClose: 1, stats: sub.stats}
+}
+
+//getStats 获取topic 的统计, topic 不存在时返回nil
+func (q *queue) getStats(topic string) *topicStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	sub, ok := q.chanSubs[topic]
+	if !ok {
+		return nil
+	}
+	return sub.stats
+}
+
+//queueStats 获取队列的统计, topic 为空时返回所有topic
+func (q *queue) queueStats(topic string) (*types.QueueStats, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := time.Now()
+	stats := &types.QueueStats{}
+	for name, sub := range q.chanSubs {
+		if sub.stats == nil || (topic != "" && topic != name) {
+			continue
+		}
+		stats.Topics = append(stats.Topics, sub.stats.snapshot(int64(len(sub.high)), int64(len(sub.low)), now))
+	}
+	if topic != "" && len(stats.Topics) == 0 {
+		return nil, types.ErrNotFound
+	}
+	sort.Slice(stats.Topics, func(i, j int) bool { return stats.Topics[i].Topic < stats.Topics[j].Topic })
+	return stats, nil
 }
 
 func (q *queue) send(msg *Message, timeout time.Duration) (err error) {
@@ -210,6 +358,17 @@ func (q *queue) send(msg *Message, timeout time.Duration) (err error) {
 	if sub.isClose == 1 {
 		return types.ErrChannelClosed
 	}
+	if sub.stats.getPolicy() == PolicyError {
+		timeout = 0
+	}
+	sub.stats.enqueue(msg)
+	defer func() {
+		if err != nil {
+			sub.stats.cancel(msg, false)
+			return
+		}
+		sub.stats.updateDepth(sub.depth())
+	}()
 	if timeout == -1 {
 		sub.high <- msg
 		return nil
@@ -248,16 +407,25 @@ func (q *queue) sendAsyn(msg *Message) error {
 	if sub.isClose == 1 {
 		return types.ErrChannelClosed
 	}
+	sub.stats.enqueue(msg)
 	select {
 	case sub.low <- msg:
+		sub.stats.updateDepth(sub.depth())
 		return nil
 	default:
+		if isDroppable(sub.stats.getPolicy(), msg.Ty) {
+			//可以丢弃的低优先级消息直接丢弃, 不影响发送方
+			sub.stats.cancel(msg, true)
+			qlog.Error("send asyn drop", "msg", msg)
+			return nil
+		}
+		sub.stats.cancel(msg, false)
 		qlog.Error("send asyn err", "msg", msg, "err", ErrQueueChannelFull)
 		return ErrQueueChannelFull
 	}
 }
 
-func (q *queue) sendLowTimeout(msg *Message, timeout time.Duration) error {
+func (q *queue) sendLowTimeout(msg *Message, timeout time.Duration) (err error) {
 	if q.isClosed() {
 		return types.ErrChannelClosed
 	}
@@ -265,13 +433,22 @@ func (q *queue) sendLowTimeout(msg *Message, timeout time.Duration) error {
 	if sub.isClose == 1 {
 		return types.ErrChannelClosed
 	}
+	policy := sub.stats.getPolicy()
+	if timeout == 0 || policy == PolicyError || isDroppable(policy, msg.Ty) {
+		return q.sendAsyn(msg)
+	}
+	sub.stats.enqueue(msg)
+	defer func() {
+		if err != nil {
+			sub.stats.cancel(msg, false)
+			return
+		}
+		sub.stats.updateDepth(sub.depth())
+	}()
 	if timeout == -1 {
 		sub.low <- msg
 		return nil
 	}
-	if timeout == 0 {
-		return q.sendAsyn(msg)
-	}
 	t := time.NewTimer(timeout)
 	defer t.Stop()
 	select {
@@ -296,6 +473,9 @@ type Message struct {
 	Data     interface{}
 	chReply  chan *Message
 	callback func(msg *Message)
+	sendTime time.Time
+	trace    trace.SpanContext
+	span     *trace.Span
 }
 
 // NewMessage new message
@@ -320,6 +500,16 @@ func NewMessageCallback(id int64, topic string, ty int64, data interface{}, call
 	return msg
 }
 
+// SetTrace 设置消息的跟踪上下文, 发送时在这个调用链上记录span
+func (msg *Message) SetTrace(sc trace.SpanContext) {
+	msg.trace = sc
+}
+
+// Trace 消息的跟踪上下文, 处理消息时新建的消息可以继承
+func (msg *Message) Trace() trace.SpanContext {
+	return msg.trace
+}
+
 // GetData get message data
 func (msg *Message) GetData() interface{} {
 	if _, ok := msg.Data.(error); ok {
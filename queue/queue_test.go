@@ -5,12 +5,19 @@
 package queue
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/turingchain2020/turingchain/trace"
 	"github.com/turingchain2020/turingchain/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -58,6 +65,65 @@ func TestTimeout(t *testing.T) {
 	}
 }
 
+func TestMessageTrace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "queuetrace")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "trace.json")
+	require.Nil(t, trace.Start(&types.Trace{Enable: true, Exporter: "file", File: file}))
+
+	q := New("channel")
+	//mempool 收到的消息继续在调用链上发送给执行器
+	go func() {
+		client := q.Client()
+		client.Sub("mempool")
+		for msg := range client.Recv() {
+			check := client.NewMessage("execs", types.EventCheckTx, nil)
+			check.SetTrace(msg.Trace())
+			assert.Nil(t, client.Send(check, false))
+			msg.Reply(client.NewMessage("mempool", types.EventReply, &types.Reply{IsOk: true}))
+		}
+	}()
+	go func() {
+		client := q.Client()
+		client.Sub("execs")
+		for range client.Recv() {
+		}
+	}()
+	root := trace.StartSpan(trace.SpanContext{}, "rpc")
+	client := q.Client()
+	msg := client.NewMessage("mempool", types.EventTx, nil)
+	msg.SetTrace(root.Context())
+	require.Nil(t, client.Send(msg, true))
+	_, err = client.Wait(msg)
+	require.Nil(t, err)
+	root.End()
+	//没有跟踪上下文的消息不记录
+	require.Nil(t, client.Send(client.NewMessage("execs", types.EventCheckTx, nil), false))
+	q.Close()
+	trace.Stop()
+
+	f, err := os.Open(file)
+	require.Nil(t, err)
+	defer f.Close()
+	spans := make(map[string]*trace.SpanData)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var span trace.SpanData
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &span))
+		spans[span.Name] = &span
+	}
+	require.Equal(t, 3, len(spans))
+	send := spans["queue.send mempool"]
+	require.NotNil(t, send)
+	assert.Equal(t, root.Context().TraceID.String(), send.TraceID)
+	assert.Equal(t, root.Context().SpanID.String(), send.ParentID)
+	check := spans["queue.send execs"]
+	require.NotNil(t, check)
+	assert.Equal(t, send.TraceID, check.TraceID)
+	assert.Equal(t, send.SpanID, check.ParentID)
+}
+
 func TestClient_WaitTimeout(t *testing.T) {
 	q := New("channel")
 	client := q.Client()
@@ -0,0 +1,551 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/turingchain2020/turingchain/trace"
+	"github.com/turingchain2020/turingchain/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+//远程模块通过grpc 双向流接入节点的消息队列:
+//1. 远程模块订阅topic 之后, 节点把这个topic 的消息转发给远程模块, 远程模块处理之后返回应答
+//2. 远程模块发送的消息由节点放入本地队列, 需要应答时把应答转发给远程模块
+//3. 连接断开之后远程模块自动重连并重新订阅, 断开期间的消息在节点的队列中积压,
+//   队列满时直接返回错误, 模块重连之后收到EventRemoteConnected, 需要自己补上错过的数据
+//4. 连接需要声明运行的模块, 只能是queue.remote.modules 中配置的模块, 只能订阅模块对应的topic,
+//   只能发送模块对应的消息, 其他消息返回ErrRemoteNotAllowed
+
+//远程模块接入的错误
+var (
+	ErrRemoteDisconnected = errors.New("ErrRemoteDisconnected")
+	ErrRemoteDataType     = errors.New("ErrRemoteDataType")
+	ErrRemoteNotAllowed   = errors.New("ErrRemoteNotAllowed")
+)
+
+//帧类型
+const (
+	frameReady = iota + 1
+	frameSub
+	frameMsg
+	frameReply
+	frameStats
+)
+
+//远程模块认证和声明模块使用的metadata
+const (
+	remoteTokenKey   = "queue-token"
+	remoteModulesKey = "queue-module"
+)
+
+//每个连接同时在节点上处理的消息数, 超过时直接返回ErrQueueChannelFull
+var maxRemoteCalls = 1024
+
+//remoteModuleTopics 可以在其他进程中运行的模块, 以及模块可以订阅的topic
+var remoteModuleTopics = map[string][]string{
+	"wallet": {"wallet"},
+	"rpc":    nil,
+}
+
+//remoteQueryEvents 远程模块都可以发送的查询和提交交易的消息
+var remoteQueryEvents = map[string][]int64{
+	"blockchain": {types.EventGetAddrOverview, types.EventGetBlockByHashes, types.EventGetBlockBySeq, types.EventGetBlockHash,
+		types.EventGetBlockHeight, types.EventGetBlockOverview, types.EventGetBlockSequences, types.EventGetBlocks,
+		types.EventGetHeaders, types.EventGetHeightByTitle, types.EventGetLastBlockMainSequence, types.EventGetLastBlockSequence,
+		types.EventGetLastHeader, types.EventGetMainSeqByHash, types.EventGetParaTxByTitle, types.EventGetParaTxByTitleAndHeight,
+		types.EventGetSeqByHash, types.EventGetTransactionByAddr, types.EventGetTransactionByHash, types.EventIsNtpClockSync,
+		types.EventIsSync, types.EventQueryTx, types.EventLocalGet, types.EventLocalList},
+	"mempool":   {types.EventTx, types.EventGetMempool, types.EventGetLastMempool, types.EventGetProperFee},
+	"execs":     {types.EventBlockChainQuery},
+	"store":     {types.EventStoreGet, types.EventStoreList, types.EventStoreGetStateProof, types.EventStoreGetTotalCoins},
+	"consensus": {types.EventConsensusQuery},
+	"p2p":       {types.EventGetNetInfo, types.EventNetProtocols, types.EventPeerInfo},
+}
+
+var (
+	remoteEventMu sync.RWMutex
+	//remoteModuleEvents 模块除了remoteQueryEvents 之外可以发送的消息, topic -> 消息类型
+	remoteModuleEvents = map[string]map[string][]int64{
+		"rpc": {
+			"wallet":     {types.EventWalletExecutor},
+			"blockchain": {types.EventSubscribePush, types.EventListPushes, types.EventGetPushLastNum},
+		},
+	}
+)
+
+var (
+	remoteErrMu sync.RWMutex
+	//应答中常见的错误, 解码之后保持同一个对象, 调用方可以直接比较
+	remoteErrors = make(map[string]error)
+)
+
+func init() {
+	RegisterRemoteError(ErrIsQueueClosed, ErrQueueTimeout, ErrQueueChannelFull, ErrRemoteDisconnected, ErrRemoteDataType, ErrRemoteNotAllowed,
+		types.ErrNotFound, types.ErrChannelClosed, types.ErrInvalidParam, types.ErrNotSupport, types.ErrActionNotSupport,
+		types.ErrEmpty, types.ErrTimeout, types.ErrTxExist, types.ErrTxDup, types.ErrMemFull, types.ErrNoBalance,
+		types.ErrHeightNotExist, types.ErrTxNotExist, types.ErrBlockNotFound, types.ErrWalletIsLocked, types.ErrSaveSeedFirst)
+}
+
+//RegisterRemoteError 注册通过远程连接传递的错误, 插件可以注册自己的错误
+func RegisterRemoteError(errs ...error) {
+	remoteErrMu.Lock()
+	defer remoteErrMu.Unlock()
+	for _, err := range errs {
+		remoteErrors[err.Error()] = err
+	}
+}
+
+//RegisterRemoteEvent 允许远程模块发送topic 的消息, 插件在init 中注册自己需要的消息
+func RegisterRemoteEvent(module, topic string, events ...int64) {
+	remoteEventMu.Lock()
+	defer remoteEventMu.Unlock()
+	if remoteModuleEvents[module] == nil {
+		remoteModuleEvents[module] = make(map[string][]int64)
+	}
+	remoteModuleEvents[module][topic] = append(remoteModuleEvents[module][topic], events...)
+}
+
+func remoteError(s string) error {
+	remoteErrMu.RLock()
+	defer remoteErrMu.RUnlock()
+	if err, ok := remoteErrors[s]; ok {
+		return err
+	}
+	return errors.New(s)
+}
+
+//encodeFrame 编码消息, 消息数据只能是protobuf 消息或者错误
+func encodeFrame(kind int32, msg *Message) (*types.QueueFrame, error) {
+	frame := &types.QueueFrame{Kind: kind, Id: msg.ID, Topic: msg.Topic, Ty: msg.Ty}
+	if msg.trace.IsValid() {
+		frame.TraceParent = msg.trace.TraceParent()
+	}
+	switch data := msg.Data.(type) {
+	case nil:
+	case error:
+		frame.Err = data.Error()
+	case proto.Message:
+		if reflect.ValueOf(data).IsNil() {
+			break
+		}
+		frame.DataType = proto.MessageName(data)
+		frame.Data = types.Encode(data)
+	default:
+		return nil, ErrRemoteDataType
+	}
+	return frame, nil
+}
+
+//decodeFrame 解码消息, 返回的消息可以应答
+func decodeFrame(frame *types.QueueFrame) (*Message, error) {
+	var data interface{}
+	switch {
+	case frame.Err != "":
+		data = remoteError(frame.Err)
+	case frame.DataType != "":
+		t := proto.MessageType(frame.DataType)
+		if t == nil || t.Kind() != reflect.Ptr {
+			return nil, ErrRemoteDataType
+		}
+		pb := reflect.New(t.Elem()).Interface().(proto.Message)
+		if err := types.Decode(frame.Data, pb); err != nil {
+			return nil, err
+		}
+		data = pb
+	}
+	msg := NewMessage(frame.Id, frame.Topic, frame.Ty, data)
+	msg.trace, _ = trace.ParseTraceParent(frame.TraceParent)
+	return msg, nil
+}
+
+func replyFrame(id int64, reply *Message) *types.QueueFrame {
+	reply.ID = id
+	frame, err := encodeFrame(frameReply, reply)
+	if err != nil {
+		frame = &types.QueueFrame{Kind: frameReply, Id: id, Ty: reply.Ty, Err: err.Error()}
+	}
+	return frame
+}
+
+//RemoteServer 节点侧的远程模块接入服务
+type RemoteServer struct {
+	q        Queue
+	cfg      *types.QueueRemote
+	server   *grpc.Server
+	listener net.Listener
+}
+
+//NewRemoteServer 新建远程模块接入服务
+func NewRemoteServer(q Queue, cfg *types.QueueRemote) (*RemoteServer, error) {
+	var opts []grpc.ServerOption
+	if cfg.EnableTLS {
+		creds, err := credentials.NewServerTLSFromFile(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             10 * time.Second,
+		PermitWithoutStream: true,
+	}))
+	s := &RemoteServer{q: q, cfg: cfg, server: grpc.NewServer(opts...)}
+	types.RegisterQueueRemoteServer(s.server, s)
+	return s, nil
+}
+
+//Start 开始监听远程模块的连接
+func (s *RemoteServer) Start() error {
+	listener, err := net.Listen("tcp", s.cfg.ListenAddr)
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+	qlog.Info("remote queue server start", "addr", listener.Addr(), "tls", s.cfg.EnableTLS)
+	go func() {
+		if err := s.server.Serve(listener); err != nil {
+			qlog.Error("remote queue server", "err", err)
+		}
+	}()
+	return nil
+}
+
+//Addr 监听的地址
+func (s *RemoteServer) Addr() string {
+	if s.listener == nil {
+		return ""
+	}
+	return s.listener.Addr().String()
+}
+
+//Close 关闭服务, 断开所有远程模块
+func (s *RemoteServer) Close() {
+	s.server.Stop()
+}
+
+func (s *RemoteServer) auth(ctx context.Context) error {
+	if s.cfg.Token == "" {
+		//没有配置token 时只允许本机连接
+		if p, ok := peer.FromContext(ctx); ok {
+			if addr, ok := p.Addr.(*net.TCPAddr); ok && addr.IP.IsLoopback() {
+				return nil
+			}
+		}
+		return status.Error(codes.Unauthenticated, "remote module is not authorized")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(remoteTokenKey)
+	if len(tokens) == 0 || subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(s.cfg.Token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid remote queue token")
+	}
+	return nil
+}
+
+//remoteACL 一个连接可以订阅的topic 和可以发送的消息
+type remoteACL struct {
+	topics map[string]bool
+	events map[string]map[int64]bool
+}
+
+func (acl *remoteACL) addEvents(topic string, events []int64) {
+	if acl.events[topic] == nil {
+		acl.events[topic] = make(map[int64]bool)
+	}
+	for _, ty := range events {
+		acl.events[topic][ty] = true
+	}
+}
+
+func (acl *remoteACL) canSend(topic string, ty int64) bool {
+	return acl.events[topic][ty]
+}
+
+//allowModules 连接声明的模块都需要在queue.remote.modules 中配置, 返回这些模块可以订阅的topic 和可以发送的消息
+func (s *RemoteServer) allowModules(ctx context.Context) (*remoteACL, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	names := md.Get(remoteModulesKey)
+	if len(names) == 0 {
+		return nil, status.Error(codes.PermissionDenied, "remote module is not declared")
+	}
+	acl := &remoteACL{topics: make(map[string]bool), events: make(map[string]map[int64]bool)}
+	for topic, events := range remoteQueryEvents {
+		acl.addEvents(topic, events)
+	}
+	remoteEventMu.RLock()
+	defer remoteEventMu.RUnlock()
+	for _, name := range names {
+		topics, ok := remoteModuleTopics[name]
+		if !ok || !isRemoteModule(s.cfg, name) {
+			return nil, status.Error(codes.PermissionDenied, "module "+name+" is not in queue.remote.modules")
+		}
+		for _, topic := range topics {
+			acl.topics[topic] = true
+		}
+		for topic, events := range remoteModuleEvents[name] {
+			acl.addEvents(topic, events)
+		}
+	}
+	return acl, nil
+}
+
+func isRemoteModule(cfg *types.QueueRemote, name string) bool {
+	for _, m := range cfg.Modules {
+		if strings.TrimSpace(m) == name {
+			return true
+		}
+	}
+	return false
+}
+
+//Connect 一个远程模块的连接
+func (s *RemoteServer) Connect(stream types.QueueRemote_ConnectServer) error {
+	if err := s.auth(stream.Context()); err != nil {
+		qlog.Error("remote module connect", "err", err)
+		return err
+	}
+	acl, err := s.allowModules(stream.Context())
+	if err != nil {
+		qlog.Error("remote module connect", "err", err)
+		return err
+	}
+	sess := newRemoteSession(s.q, stream, acl)
+	defer sess.close()
+	if err := sess.send(&types.QueueFrame{Kind: frameReady}); err != nil {
+		return err
+	}
+	for {
+		frame, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		//订阅失败时断开连接, 远程模块重连之后重新订阅
+		if err := sess.handle(frame); err != nil {
+			qlog.Error("remote module sub", "topic", frame.Topic, "err", err)
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+}
+
+//remoteSession 节点侧一个远程连接的状态
+type remoteSession struct {
+	q       Queue
+	stream  types.QueueRemote_ConnectServer
+	acl     *remoteACL
+	calls   chan struct{}
+	sendMu  sync.Mutex
+	client  *client
+	mu      sync.Mutex
+	subs    map[string]*client
+	pending map[int64]*Message
+	seq     int64
+	wg      sync.WaitGroup
+}
+
+func newRemoteSession(q Queue, stream types.QueueRemote_ConnectServer, acl *remoteACL) *remoteSession {
+	return &remoteSession{
+		q:       q,
+		stream:  stream,
+		acl:     acl,
+		calls:   make(chan struct{}, maxRemoteCalls),
+		client:  q.Client().(*client),
+		subs:    make(map[string]*client),
+		pending: make(map[int64]*Message),
+	}
+}
+
+func (s *remoteSession) send(frame *types.QueueFrame) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.stream.Send(frame)
+}
+
+func (s *remoteSession) handle(frame *types.QueueFrame) error {
+	switch frame.Kind {
+	case frameSub:
+		return s.sub(frame.Topic)
+	case frameMsg:
+		if !s.acl.canSend(frame.Topic, frame.Ty) {
+			qlog.Error("remote send not allowed", "topic", frame.Topic, "ty", types.GetEventName(int(frame.Ty)))
+			s.reject(frame, ErrRemoteNotAllowed)
+			return nil
+		}
+		//不阻塞读取, 应答需要继续处理
+		select {
+		case s.calls <- struct{}{}:
+			go func() {
+				defer func() { <-s.calls }()
+				s.call(frame)
+			}()
+		default:
+			qlog.Error("remote send too many calls", "topic", frame.Topic, "ty", frame.Ty)
+			s.reject(frame, ErrQueueChannelFull)
+		}
+	case frameReply:
+		s.reply(frame)
+	case frameStats:
+		stats, err := s.client.GetQueueStats(frame.Topic)
+		var reply *Message
+		if err != nil {
+			reply = NewMessage(0, "", types.EventReply, err)
+		} else {
+			reply = NewMessage(0, "", types.EventReply, stats)
+		}
+		if err := s.send(replyFrame(frame.Id, reply)); err != nil {
+			qlog.Error("remote stats reply", "err", err)
+		}
+	default:
+		qlog.Error("remote frame kind not support", "kind", frame.Kind)
+	}
+	return nil
+}
+
+//sub 远程模块订阅topic, 转发这个topic 的消息
+func (s *remoteSession) sub(topic string) error {
+	if !s.acl.topics[topic] {
+		return ErrRemoteNotAllowed
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.subs[topic]; ok {
+		return nil
+	}
+	c := s.q.Client().(*client)
+	if err := c.sub(topic, true); err != nil {
+		return err
+	}
+	s.subs[topic] = c
+	qlog.Info("remote module sub", "topic", topic)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for msg := range c.Recv() {
+			s.forward(msg)
+		}
+	}()
+	return nil
+}
+
+func (s *remoteSession) forward(msg *Message) {
+	frame, err := encodeFrame(frameMsg, msg)
+	if err != nil {
+		qlog.Error("remote forward", "msg", msg, "err", err)
+		msg.Reply(NewMessage(0, msg.Topic, msg.Ty, err))
+		return
+	}
+	//发送方的消息id 不一定唯一, 使用连接内的序号
+	frame.Id = atomic.AddInt64(&s.seq, 1)
+	s.mu.Lock()
+	s.pending[frame.Id] = msg
+	s.mu.Unlock()
+	if err := s.send(frame); err != nil {
+		if m := s.takePending(frame.Id); m != nil {
+			m.Reply(NewMessage(0, msg.Topic, msg.Ty, ErrRemoteDisconnected))
+		}
+	}
+}
+
+func (s *remoteSession) takePending(id int64) *Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	msg, ok := s.pending[id]
+	if !ok {
+		return nil
+	}
+	delete(s.pending, id)
+	return msg
+}
+
+//reply 远程模块处理完消息之后的应答
+func (s *remoteSession) reply(frame *types.QueueFrame) {
+	msg := s.takePending(frame.Id)
+	if msg == nil {
+		qlog.Debug("remote reply without pending message", "id", frame.Id)
+		return
+	}
+	reply, err := decodeFrame(frame)
+	if err != nil {
+		reply = NewMessage(0, "", frame.Ty, err)
+	}
+	msg.Reply(reply)
+}
+
+//reject 不处理远程模块发送的消息, 需要应答时返回错误
+func (s *remoteSession) reject(frame *types.QueueFrame, err error) {
+	if !frame.WaitReply {
+		return
+	}
+	if err := s.send(replyFrame(frame.Id, NewMessage(0, "", types.EventReply, err))); err != nil {
+		qlog.Error("remote reject", "topic", frame.Topic, "err", err)
+	}
+}
+
+//call 远程模块发送的消息放入本地队列
+func (s *remoteSession) call(frame *types.QueueFrame) {
+	msg, err := decodeFrame(frame)
+	if err == nil {
+		msg.ID = atomic.AddInt64(&gid, 1)
+		err = s.client.SendTimeout(msg, frame.WaitReply, -1)
+	}
+	if !frame.WaitReply {
+		if err != nil {
+			qlog.Error("remote send", "topic", frame.Topic, "ty", frame.Ty, "err", err)
+		}
+		return
+	}
+	var reply *Message
+	if err == nil {
+		reply, err = s.client.Wait(msg)
+	}
+	if err != nil {
+		if err == ErrIsQueueClosed {
+			return
+		}
+		reply = NewMessage(0, "", types.EventReply, err)
+	}
+	if err := s.send(replyFrame(frame.Id, reply)); err != nil {
+		qlog.Error("remote reply", "topic", frame.Topic, "err", err)
+	}
+}
+
+//close 连接断开, 停止转发, 没有应答的消息返回错误
+func (s *remoteSession) close() {
+	s.mu.Lock()
+	subs := s.subs
+	s.subs = make(map[string]*client)
+	s.mu.Unlock()
+	for topic, c := range subs {
+		qlog.Info("remote module unsub", "topic", topic)
+		c.unsub()
+	}
+	s.client.unsub()
+	s.wg.Wait()
+	s.mu.Lock()
+	for id, msg := range s.pending {
+		msg.Reply(NewMessage(0, msg.Topic, msg.Ty, ErrRemoteDisconnected))
+		delete(s.pending, id)
+	}
+	s.mu.Unlock()
+}
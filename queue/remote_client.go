@@ -0,0 +1,539 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/turingchain2020/turingchain/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	minReconnectInterval = time.Second
+	maxReconnectInterval = 30 * time.Second
+	remoteStatsTimeout   = 10 * time.Second
+)
+
+//远程模块接收通道的长度, 和低优先级队列一样
+var remoteRecvBuffer = defaultLowChanBuffer
+
+//remoteQueue 在其他进程中运行的模块使用的消息队列, 所有消息通过节点的消息队列转发
+type remoteQueue struct {
+	cfg       *types.TuringchainConfig
+	conf      *types.QueueRemote
+	modules   []string
+	conn      *grpc.ClientConn
+	interrupt chan struct{}
+	done      chan struct{}
+	isClose   int32
+	mu        sync.Mutex
+	clients   []*remoteClient
+}
+
+// NewRemote 新建连接节点消息队列的远程队列, modules 为这个进程中运行的模块
+func NewRemote(conf *types.QueueRemote, modules []string) (Queue, error) {
+	kp := keepalive.ClientParameters{
+		Time:                20 * time.Second,
+		Timeout:             20 * time.Second,
+		PermitWithoutStream: true,
+	}
+	opts := []grpc.DialOption{grpc.WithKeepaliveParams(kp)}
+	if conf.EnableTLS {
+		creds, err := credentials.NewClientTLSFromFile(conf.CertFile, "")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, err := grpc.Dial(conf.NodeAddr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteQueue{
+		conf:      conf,
+		modules:   modules,
+		conn:      conn,
+		interrupt: make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}, nil
+}
+
+// Name return the queue name
+func (q *remoteQueue) Name() string {
+	return "remote"
+}
+
+// SetConfig set the queue TuringchainConfig
+func (q *remoteQueue) SetConfig(cfg *types.TuringchainConfig) {
+	q.cfg = cfg
+}
+
+// GetConfig return the queue TuringchainConfig
+func (q *remoteQueue) GetConfig() *types.TuringchainConfig {
+	return q.cfg
+}
+
+// Start 等待进程退出
+func (q *remoteQueue) Start() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	select {
+	case <-q.done:
+		qlog.Info("closing remote queue done")
+	case <-q.interrupt:
+		qlog.Info("closing remote queue")
+	case s := <-c:
+		qlog.Info("Got signal:", s)
+	}
+}
+
+// Close 关闭所有client 和连接
+func (q *remoteQueue) Close() {
+	if !atomic.CompareAndSwapInt32(&q.isClose, 0, 1) {
+		return
+	}
+	q.mu.Lock()
+	clients := q.clients
+	q.clients = nil
+	q.mu.Unlock()
+	for _, c := range clients {
+		c.Close()
+	}
+	close(q.done)
+	if err := q.conn.Close(); err != nil {
+		qlog.Error("close remote queue", "err", err)
+	}
+	qlog.Info("remote queue closed")
+}
+
+// Client new client
+func (q *remoteQueue) Client() Client {
+	c := newRemoteClient(q)
+	q.mu.Lock()
+	q.clients = append(q.clients, c)
+	q.mu.Unlock()
+	return c
+}
+
+//remoteClient 每个client 使用一个双向流, 断开之后自动重连
+type remoteClient struct {
+	q         *remoteQueue
+	recv      chan *Message
+	done      chan struct{}
+	ctx       context.Context
+	cancel    context.CancelFunc
+	startOnce sync.Once
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+	sendMu    sync.Mutex
+	mu        sync.Mutex
+	topic     string
+	stream    types.QueueRemote_ConnectClient
+	ready     chan struct{}
+	pending   map[int64]*Message
+}
+
+func newRemoteClient(q *remoteQueue) *remoteClient {
+	c := &remoteClient{
+		q: q,
+		//接收通道满时新的消息直接返回错误, 不影响读取应答
+		recv:    make(chan *Message, remoteRecvBuffer),
+		done:    make(chan struct{}),
+		ready:   make(chan struct{}),
+		pending: make(map[int64]*Message),
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	return c
+}
+
+//start 第一次使用时连接节点
+func (c *remoteClient) start() {
+	c.startOnce.Do(func() {
+		c.wg.Add(1)
+		go c.run()
+	})
+}
+
+func (c *remoteClient) run() {
+	defer c.wg.Done()
+	interval := minReconnectInterval
+	for {
+		stream, err := c.connect()
+		if err == nil {
+			start := time.Now()
+			if !c.notifyConnected() {
+				c.disconnect()
+				return
+			}
+			err = c.recvLoop(stream)
+			c.disconnect()
+			//连接保持一段时间之后才重置重连间隔, 订阅被节点拒绝时不会频繁重连
+			if time.Since(start) > maxReconnectInterval {
+				interval = minReconnectInterval
+			}
+		}
+		select {
+		case <-c.done:
+			return
+		default:
+		}
+		qlog.Error("remote queue disconnected", "node", c.q.conf.NodeAddr, "err", err, "retry", interval)
+		t := time.NewTimer(interval)
+		select {
+		case <-c.done:
+			t.Stop()
+			return
+		case <-t.C:
+		}
+		interval *= 2
+		if interval > maxReconnectInterval {
+			interval = maxReconnectInterval
+		}
+	}
+}
+
+//connect 连接节点, 收到节点的确认之后重新订阅
+func (c *remoteClient) connect() (types.QueueRemote_ConnectClient, error) {
+	ctx := c.ctx
+	if c.q.conf.Token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, remoteTokenKey, c.q.conf.Token)
+	}
+	for _, m := range c.q.modules {
+		ctx = metadata.AppendToOutgoingContext(ctx, remoteModulesKey, m)
+	}
+	stream, err := types.NewQueueRemoteClient(c.q.conn).Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	frame, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if frame.Kind != frameReady {
+		return nil, errors.New("remote queue not ready")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.topic != "" {
+		if err := stream.Send(&types.QueueFrame{Kind: frameSub, Topic: c.topic}); err != nil {
+			return nil, err
+		}
+	}
+	c.stream = stream
+	close(c.ready)
+	qlog.Info("remote queue connected", "node", c.q.conf.NodeAddr, "topic", c.topic)
+	return stream, nil
+}
+
+//notifyConnected 订阅topic 的模块在连接之后先收到EventRemoteConnected,
+//断开期间节点上的消息可能被丢弃, 模块需要从节点补上错过的数据
+func (c *remoteClient) notifyConnected() bool {
+	c.mu.Lock()
+	topic := c.topic
+	c.mu.Unlock()
+	if topic == "" {
+		return true
+	}
+	select {
+	case c.recv <- NewMessage(0, topic, types.EventRemoteConnected, nil):
+		return true
+	case <-c.done:
+		return false
+	}
+}
+
+//disconnect 连接断开, 等待应答的消息返回错误
+func (c *remoteClient) disconnect() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stream = nil
+	c.ready = make(chan struct{})
+	for id, msg := range c.pending {
+		msg.Reply(NewMessage(0, msg.Topic, msg.Ty, ErrRemoteDisconnected))
+		delete(c.pending, id)
+	}
+}
+
+func (c *remoteClient) recvLoop(stream types.QueueRemote_ConnectClient) error {
+	for {
+		frame, err := stream.Recv()
+		if err == io.EOF {
+			return ErrRemoteDisconnected
+		}
+		if err != nil {
+			return err
+		}
+		switch frame.Kind {
+		case frameMsg:
+			msg, err := decodeFrame(frame)
+			if err != nil {
+				qlog.Error("remote recv", "topic", frame.Topic, "ty", frame.Ty, "err", err)
+				c.send(stream, replyFrame(frame.Id, NewMessage(0, "", frame.Ty, err)))
+				continue
+			}
+			//模块处理不过来时不能阻塞, 否则读不到节点转发的应答
+			select {
+			case c.recv <- msg:
+				go c.waitReply(stream, msg)
+			default:
+				qlog.Error("remote recv full", "topic", frame.Topic, "ty", frame.Ty)
+				c.send(stream, replyFrame(frame.Id, NewMessage(0, "", frame.Ty, ErrQueueChannelFull)))
+			}
+		case frameReply:
+			msg := c.takePending(frame.Id)
+			if msg == nil {
+				continue
+			}
+			reply, err := decodeFrame(frame)
+			if err != nil {
+				reply = NewMessage(0, "", frame.Ty, err)
+			}
+			msg.Reply(reply)
+		}
+	}
+}
+
+//waitReply 模块处理完消息之后把应答发送给节点
+func (c *remoteClient) waitReply(stream types.QueueRemote_ConnectClient, msg *Message) {
+	select {
+	case reply := <-msg.chReply:
+		c.send(stream, replyFrame(msg.ID, reply))
+	case <-stream.Context().Done():
+	}
+}
+
+func (c *remoteClient) send(stream types.QueueRemote_ConnectClient, frame *types.QueueFrame) {
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+	if err := stream.Send(frame); err != nil {
+		qlog.Error("remote send", "topic", frame.Topic, "kind", frame.Kind, "err", err)
+	}
+}
+
+func (c *remoteClient) takePending(id int64) *Message {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	msg, ok := c.pending[id]
+	if !ok {
+		return nil
+	}
+	delete(c.pending, id)
+	return msg
+}
+
+//waitStream 等待连接节点, timeout 为-1 时一直等待
+func (c *remoteClient) waitStream(timeout time.Duration) (types.QueueRemote_ConnectClient, error) {
+	c.start()
+	var t <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		t = timer.C
+	}
+	for {
+		c.mu.Lock()
+		stream, ready := c.stream, c.ready
+		c.mu.Unlock()
+		if stream != nil {
+			return stream, nil
+		}
+		if timeout == 0 {
+			return nil, ErrRemoteDisconnected
+		}
+		select {
+		case <-ready:
+		case <-c.done:
+			return nil, ErrIsQueueClosed
+		case <-t:
+			return nil, ErrQueueTimeout
+		}
+	}
+}
+
+//sendFrame 发送消息, 需要应答时记录等待应答的消息
+func (c *remoteClient) sendFrame(kind int32, msg *Message, waitReply bool, timeout time.Duration) error {
+	if c.isClose() {
+		return ErrIsQueueClosed
+	}
+	frame, err := encodeFrame(kind, msg)
+	if err != nil {
+		return err
+	}
+	frame.WaitReply = waitReply
+	stream, err := c.waitStream(timeout)
+	if err != nil {
+		return err
+	}
+	if waitReply {
+		c.mu.Lock()
+		c.pending[msg.ID] = msg
+		c.mu.Unlock()
+	}
+	c.sendMu.Lock()
+	err = stream.Send(frame)
+	c.sendMu.Unlock()
+	if err != nil && waitReply {
+		c.takePending(msg.ID)
+	}
+	return err
+}
+
+// Send 发送消息, 没有连接上节点时一直等待
+func (c *remoteClient) Send(msg *Message, waitReply bool) error {
+	return c.SendTimeout(msg, waitReply, -1)
+}
+
+// SendTimeout 超时发送, timeout 为等待连接节点的时间
+func (c *remoteClient) SendTimeout(msg *Message, waitReply bool, timeout time.Duration) error {
+	msg.sendTime = time.Now()
+	span := startSpan(msg)
+	err := c.sendFrame(frameMsg, msg, waitReply, timeout)
+	if err != nil || !waitReply {
+		span.Finish(err)
+	}
+	return err
+}
+
+// WaitTimeout 等待节点转发的应答
+func (c *remoteClient) WaitTimeout(msg *Message, timeout time.Duration) (*Message, error) {
+	if msg.chReply == nil {
+		return &Message{}, errors.New("empty wait channel")
+	}
+	var t <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		t = timer.C
+	}
+	span := msg.span
+	select {
+	case reply := <-msg.chReply:
+		span.Finish(reply.Err())
+		return reply, reply.Err()
+	case <-c.done:
+		span.Finish(ErrIsQueueClosed)
+		return &Message{}, ErrIsQueueClosed
+	case <-t:
+		c.takePending(msg.ID)
+		span.Finish(ErrQueueTimeout)
+		return &Message{}, ErrQueueTimeout
+	}
+}
+
+// Wait 等待应答
+func (c *remoteClient) Wait(msg *Message) (*Message, error) {
+	msg, err := c.WaitTimeout(msg, -1)
+	if err == ErrQueueTimeout {
+		panic(err)
+	}
+	return msg, err
+}
+
+// Recv 获取接受消息通道
+func (c *remoteClient) Recv() chan *Message {
+	return c.recv
+}
+
+// Reply 应答消息
+func (c *remoteClient) Reply(msg *Message) {
+	if msg.chReply != nil {
+		msg.Reply(msg)
+	}
+}
+
+// Sub 订阅节点上的topic, 重连之后自动重新订阅
+func (c *remoteClient) Sub(topic string) {
+	if c.isClose() {
+		return
+	}
+	c.mu.Lock()
+	c.topic = topic
+	stream := c.stream
+	c.mu.Unlock()
+	if stream != nil {
+		c.send(stream, &types.QueueFrame{Kind: frameSub, Topic: topic})
+	}
+	c.start()
+}
+
+func (c *remoteClient) isClose() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close 断开连接, 接收通道中没有处理的消息返回错误
+func (c *remoteClient) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.cancel()
+		c.wg.Wait()
+		close(c.recv)
+		for msg := range c.recv {
+			msg.Reply(NewMessage(0, msg.Topic, msg.Ty, types.ErrChannelClosed))
+		}
+	})
+}
+
+// CloseQueue 退出远程模块所在的进程, 不影响节点
+func (c *remoteClient) CloseQueue() (*types.Reply, error) {
+	select {
+	case c.q.interrupt <- struct{}{}:
+	default:
+	}
+	return &types.Reply{IsOk: true}, nil
+}
+
+// GetQueueStats 获取节点消息队列的统计
+func (c *remoteClient) GetQueueStats(topic string) (*types.QueueStats, error) {
+	msg := c.NewMessage(topic, types.EventReply, nil)
+	if err := c.sendFrame(frameStats, msg, true, remoteStatsTimeout); err != nil {
+		return nil, err
+	}
+	reply, err := c.WaitTimeout(msg, remoteStatsTimeout)
+	if err != nil {
+		return nil, err
+	}
+	stats, ok := reply.GetData().(*types.QueueStats)
+	if !ok {
+		return nil, types.ErrTypeAsset
+	}
+	return stats, nil
+}
+
+// NewMessage 新建消息
+func (c *remoteClient) NewMessage(topic string, ty int64, data interface{}) *Message {
+	return NewMessage(atomic.AddInt64(&gid, 1), topic, ty, data)
+}
+
+// FreeMessage 远程client 的消息不使用内存池
+func (c *remoteClient) FreeMessage(msgs ...*Message) {}
+
+// GetConfig return the queue TuringchainConfig
+func (c *remoteClient) GetConfig() *types.TuringchainConfig {
+	types.AssertConfig(c.q)
+	cfg := c.q.GetConfig()
+	if cfg == nil {
+		panic("TuringchainConfig is nil")
+	}
+	return cfg
+}
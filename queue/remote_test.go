@@ -0,0 +1,298 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startRemoteServer(t *testing.T, q Queue, addr, token string, modules ...string) *RemoteServer {
+	if len(modules) == 0 {
+		modules = []string{"wallet", "rpc"}
+	}
+	server, err := NewRemoteServer(q, &types.QueueRemote{ListenAddr: addr, Token: token, Modules: modules})
+	require.Nil(t, err)
+	require.Nil(t, server.Start())
+	return server
+}
+
+func newRemoteQueue(t *testing.T, addr, token string, modules ...string) Queue {
+	if len(modules) == 0 {
+		modules = []string{"wallet"}
+	}
+	rq, err := NewRemote(&types.QueueRemote{NodeAddr: addr, Token: token}, modules)
+	require.Nil(t, err)
+	return rq
+}
+
+//remoteWallet 远程进程中的wallet 模块, 返回连接上节点的次数
+func remoteWallet(client Client) *int32 {
+	var connected int32
+	client.Sub("wallet")
+	go func() {
+		for msg := range client.Recv() {
+			switch msg.Ty {
+			case types.EventRemoteConnected:
+				atomic.AddInt32(&connected, 1)
+			case types.EventWalletAccountList:
+				//处理消息时访问节点上的模块
+				req := client.NewMessage("blockchain", types.EventGetBlockHeight, nil)
+				if err := client.Send(req, true); err != nil {
+					msg.Reply(client.NewMessage("", types.EventReply, err))
+					continue
+				}
+				resp, err := client.Wait(req)
+				if err != nil {
+					msg.Reply(client.NewMessage("", types.EventReply, err))
+					continue
+				}
+				msg.Reply(client.NewMessage("", types.EventReply, &types.WalletAccounts{
+					Wallets: []*types.WalletAccount{{Label: resp.GetData().(*types.ReplyBlockHeight).String()}},
+				}))
+			default:
+				msg.Reply(client.NewMessage("", types.EventReply, types.ErrWalletIsLocked))
+			}
+		}
+	}()
+	return &connected
+}
+
+func TestRemoteQueue(t *testing.T) {
+	q := New("channel")
+	defer q.Close()
+	go func() {
+		client := q.Client()
+		client.Sub("blockchain")
+		for msg := range client.Recv() {
+			msg.Reply(client.NewMessage("", types.EventReplyBlockHeight, &types.ReplyBlockHeight{Height: 100}))
+		}
+	}()
+	server := startRemoteServer(t, q, "127.0.0.1:0", "secret")
+	defer server.Close()
+
+	rq := newRemoteQueue(t, server.Addr(), "secret")
+	defer rq.Close()
+	remoteWallet(rq.Client())
+
+	//节点上的模块访问远程的wallet
+	client := q.Client()
+	require.Eventually(t, func() bool {
+		stats, err := client.GetQueueStats("")
+		return err == nil && len(stats.Topics) == 2
+	}, 5*time.Second, 20*time.Millisecond)
+	msg := client.NewMessage("wallet", types.EventWalletAccountList, nil)
+	require.Nil(t, client.Send(msg, true))
+	resp, err := client.WaitTimeout(msg, 5*time.Second)
+	require.Nil(t, err)
+	accounts := resp.GetData().(*types.WalletAccounts)
+	assert.Equal(t, "height:100 ", accounts.Wallets[0].Label)
+	//远程模块订阅的topic 默认不阻塞发送方
+	stats, err := client.GetQueueStats("wallet")
+	require.Nil(t, err)
+	assert.Equal(t, PolicyError, stats.Topics[0].Policy)
+
+	//错误在远程传递之后是同一个对象
+	msg = client.NewMessage("wallet", types.EventWalletExecutor, nil)
+	require.Nil(t, client.Send(msg, true))
+	_, err = client.WaitTimeout(msg, 5*time.Second)
+	assert.Equal(t, types.ErrWalletIsLocked, err)
+
+	//远程访问节点的统计
+	rclient := rq.Client()
+	stats, err = rclient.GetQueueStats("blockchain")
+	require.Nil(t, err)
+	assert.Equal(t, "blockchain", stats.Topics[0].Topic)
+	assert.Equal(t, int64(1), stats.Topics[0].Sent)
+
+	//不是protobuf 的数据不能发送
+	assert.Equal(t, ErrRemoteDataType, rclient.Send(rclient.NewMessage("blockchain", types.EventGetBlockHeight, 1), false))
+}
+
+func TestRemoteAuth(t *testing.T) {
+	q := New("channel")
+	defer q.Close()
+	server := startRemoteServer(t, q, "127.0.0.1:0", "secret")
+	defer server.Close()
+
+	rq := newRemoteQueue(t, server.Addr(), "wrong")
+	defer rq.Close()
+	client := rq.Client()
+	err := client.SendTimeout(client.NewMessage("blockchain", types.EventGetBlockHeight, nil), false, time.Second)
+	assert.Equal(t, ErrQueueTimeout, err)
+	_, err = q.Client().GetQueueStats("blockchain")
+	assert.Equal(t, types.ErrNotFound, err)
+
+	//没有配置token 时只允许本机连接
+	local := startRemoteServer(t, q, "127.0.0.1:0", "")
+	defer local.Close()
+	rq2 := newRemoteQueue(t, local.Addr(), "")
+	defer rq2.Close()
+	client = rq2.Client()
+	assert.Nil(t, client.SendTimeout(client.NewMessage("blockchain", types.EventGetBlockHeight, nil), false, 5*time.Second))
+}
+
+func TestRemoteReconnect(t *testing.T) {
+	q := New("channel")
+	defer q.Close()
+	server := startRemoteServer(t, q, "127.0.0.1:0", "")
+	addr := server.Addr()
+
+	rq := newRemoteQueue(t, addr, "")
+	defer rq.Close()
+	connected := remoteWallet(rq.Client())
+	client := q.Client()
+	call := func() error {
+		msg := client.NewMessage("wallet", types.EventWalletExecutor, nil)
+		if err := client.Send(msg, true); err != nil {
+			return err
+		}
+		_, err := client.WaitTimeout(msg, 5*time.Second)
+		return err
+	}
+	require.Eventually(t, func() bool { return call() == types.ErrWalletIsLocked }, 5*time.Second, 20*time.Millisecond)
+
+	//远程等待应答时断开
+	rclient := rq.Client()
+	msg := rclient.NewMessage("blockchain", types.EventGetBlockHeight, nil)
+	require.Nil(t, rclient.Send(msg, true))
+	server.Close()
+	_, err := rclient.WaitTimeout(msg, 5*time.Second)
+	assert.Equal(t, ErrRemoteDisconnected, err)
+
+	//断开期间的消息在节点积压, 重连之后继续处理
+	msg = client.NewMessage("wallet", types.EventWalletExecutor, nil)
+	require.Nil(t, client.Send(msg, true))
+	server = startRemoteServer(t, q, addr, "")
+	defer server.Close()
+	_, err = client.WaitTimeout(msg, 10*time.Second)
+	assert.Equal(t, types.ErrWalletIsLocked, err)
+	//每次连接上节点都会通知模块
+	assert.Equal(t, int32(2), atomic.LoadInt32(connected))
+}
+
+func TestRemoteAllow(t *testing.T) {
+	q := New("channel")
+	defer q.Close()
+	server := startRemoteServer(t, q, "127.0.0.1:0", "", "rpc")
+	defer server.Close()
+
+	//没有配置的模块不能连接
+	rq := newRemoteQueue(t, server.Addr(), "", "wallet")
+	defer rq.Close()
+	client := rq.Client()
+	err := client.SendTimeout(client.NewMessage("blockchain", types.EventGetBlockHeight, nil), false, time.Second)
+	assert.Equal(t, ErrQueueTimeout, err)
+
+	//rpc 不能订阅
+	rq2 := newRemoteQueue(t, server.Addr(), "", "rpc")
+	defer rq2.Close()
+	client = rq2.Client()
+	client.Sub("wallet")
+	assert.Nil(t, client.SendTimeout(client.NewMessage("blockchain", types.EventGetBlockHeight, nil), false, 5*time.Second))
+	time.Sleep(100 * time.Millisecond)
+	_, err = q.Client().GetQueueStats("wallet")
+	assert.Equal(t, types.ErrNotFound, err)
+}
+
+func TestRemoteDupSub(t *testing.T) {
+	q := New("channel")
+	defer q.Close()
+	local := q.Client()
+	local.Sub("wallet")
+	server := startRemoteServer(t, q, "127.0.0.1:0", "")
+	defer server.Close()
+
+	//本地已经有订阅者时远程模块不能订阅
+	rq := newRemoteQueue(t, server.Addr(), "")
+	defer rq.Close()
+	remoteWallet(rq.Client())
+	time.Sleep(200 * time.Millisecond)
+	client := q.Client()
+	msg := client.NewMessage("wallet", types.EventWalletExecutor, nil)
+	require.Nil(t, client.Send(msg, true))
+	select {
+	case recv := <-local.Recv():
+		assert.Equal(t, msg.ID, recv.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("local sub not receive message")
+	}
+	stats, err := client.GetQueueStats("wallet")
+	require.Nil(t, err)
+	assert.Equal(t, PolicyBlock, stats.Topics[0].Policy)
+
+	_, err = q.(*queue).subscribe("wallet", true)
+	assert.Equal(t, ErrTopicSubscribed, err)
+	//本地订阅者关闭之后可以订阅
+	local.Close()
+	_, err = q.(*queue).subscribe("wallet", true)
+	assert.Nil(t, err)
+}
+
+func TestRemoteNotAllowed(t *testing.T) {
+	q := New("channel")
+	defer q.Close()
+	server := startRemoteServer(t, q, "127.0.0.1:0", "")
+	defer server.Close()
+
+	rq := newRemoteQueue(t, server.Addr(), "")
+	defer rq.Close()
+	client := rq.Client()
+	//远程模块不能发送修改节点状态的消息
+	msg := client.NewMessage("store", types.EventStoreCommit, &types.ReqHash{})
+	require.Nil(t, client.Send(msg, true))
+	_, err := client.WaitTimeout(msg, 5*time.Second)
+	assert.Equal(t, ErrRemoteNotAllowed, err)
+	//只有rpc 可以调用钱包
+	msg = client.NewMessage("wallet", types.EventWalletExecutor, nil)
+	require.Nil(t, client.Send(msg, true))
+	_, err = client.WaitTimeout(msg, 5*time.Second)
+	assert.Equal(t, ErrRemoteNotAllowed, err)
+	_, err = q.Client().GetQueueStats("store")
+	assert.Equal(t, types.ErrNotFound, err)
+}
+
+func TestRemoteRecvFull(t *testing.T) {
+	remoteRecvBuffer = 1
+	defer func() { remoteRecvBuffer = defaultLowChanBuffer }()
+	q := New("channel")
+	defer q.Close()
+	go func() {
+		client := q.Client()
+		client.Sub("blockchain")
+		for msg := range client.Recv() {
+			msg.Reply(client.NewMessage("", types.EventReplyBlockHeight, &types.ReplyBlockHeight{Height: 100}))
+		}
+	}()
+	server := startRemoteServer(t, q, "127.0.0.1:0", "")
+	defer server.Close()
+
+	//远程模块不处理消息, 接收通道被连接通知占满
+	rq := newRemoteQueue(t, server.Addr(), "")
+	defer rq.Close()
+	rclient := rq.Client()
+	rclient.Sub("wallet")
+	client := q.Client()
+	require.Eventually(t, func() bool {
+		_, err := client.GetQueueStats("wallet")
+		return err == nil
+	}, 5*time.Second, 20*time.Millisecond)
+	msg := client.NewMessage("wallet", types.EventWalletExecutor, nil)
+	require.Nil(t, client.Send(msg, true))
+	_, err := client.WaitTimeout(msg, 5*time.Second)
+	assert.Equal(t, ErrQueueChannelFull, err)
+
+	//接收通道满时仍然可以收到应答
+	msg = rclient.NewMessage("blockchain", types.EventGetBlockHeight, nil)
+	require.Nil(t, rclient.Send(msg, true))
+	resp, err := rclient.WaitTimeout(msg, 5*time.Second)
+	require.Nil(t, err)
+	assert.Equal(t, int64(100), resp.GetData().(*types.ReplyBlockHeight).Height)
+}
@@ -0,0 +1,301 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turingchain2020/turingchain/metrics"
+	"github.com/turingchain2020/turingchain/types"
+	go_metrics "github.com/rcrowley/go-metrics"
+)
+
+//队列满时的处理策略
+const (
+	// PolicyBlock 阻塞等待队列空闲
+	PolicyBlock = "block"
+	// PolicyDrop 丢弃droppableEvents 中的低优先级消息, 其他消息阻塞等待
+	PolicyDrop = "drop"
+	// PolicyError 立即返回ErrQueueChannelFull
+	PolicyError = "error"
+	// 远程模块订阅的topic 默认的处理策略, 连接断开时发送方不会阻塞
+	remotePolicy = PolicyError
+)
+
+const (
+	defaultSlowConsumerTime = 10 * time.Second
+	// 保存最近交给模块的消息类型, 需要大于client 接收通道的长度
+	recentSize = 16
+)
+
+//PolicyDrop 时队列满可以丢弃的低优先级消息, 只有丢弃之后可以重新获取的广播消息,
+//区块的增加和删除等消息丢弃之后模块状态会不一致, 不能丢弃
+var droppableEvents = map[int64]bool{
+	types.EventTxBroadcast:    true,
+	types.EventBlockBroadcast: true,
+}
+
+func isDroppable(policy string, ty int64) bool {
+	return policy == PolicyDrop && droppableEvents[ty]
+}
+
+func checkPolicy(policy string) bool {
+	return policy == PolicyBlock || policy == PolicyDrop || policy == PolicyError
+}
+
+//eventStats 一种消息的统计
+type eventStats struct {
+	count      int64
+	pending    int64
+	waitTotal  time.Duration
+	waitMax    time.Duration
+	replyCount int64
+	replyTotal time.Duration
+	replyMax   time.Duration
+}
+
+//topicStats 一个topic 的统计
+type topicStats struct {
+	mu          sync.Mutex
+	topic       string
+	policy      string
+	created     time.Time
+	highWater   int64
+	sent        int64
+	dropped     int64
+	rejected    int64
+	recent      [recentSize]int64
+	delivered   int64
+	recv        chan *Message
+	lastDeliver time.Time
+	slow        bool
+	events      map[int64]*eventStats
+
+	latency   go_metrics.Timer
+	backlog   go_metrics.Gauge
+	highGauge go_metrics.Gauge
+	dropCount go_metrics.Counter
+	rejCount  go_metrics.Counter
+	slowCount go_metrics.Counter
+}
+
+func newTopicStats(topic, policy string) *topicStats {
+	return &topicStats{
+		topic:     topic,
+		policy:    policy,
+		created:   time.Now(),
+		events:    make(map[int64]*eventStats),
+		latency:   metrics.Timer("queue_topic_latency", "topic", topic),
+		backlog:   metrics.Gauge("queue_topic_backlog", "topic", topic),
+		highGauge: metrics.Gauge("queue_topic_high_water", "topic", topic),
+		dropCount: metrics.Counter("queue_topic_dropped", "topic", topic),
+		rejCount:  metrics.Counter("queue_topic_rejected", "topic", topic),
+		slowCount: metrics.Counter("queue_slow_consumer", "topic", topic),
+	}
+}
+
+func (s *topicStats) event(ty int64) *eventStats {
+	e, ok := s.events[ty]
+	if !ok {
+		e = &eventStats{}
+		s.events[ty] = e
+	}
+	return e
+}
+
+func (s *topicStats) setRecv(recv chan *Message) {
+	s.mu.Lock()
+	s.recv = recv
+	s.mu.Unlock()
+}
+
+//current 模块正在处理的消息类型, 交给模块的消息还有一部分在接收通道中等待
+func (s *topicStats) current() (int64, bool) {
+	n := s.delivered - 1
+	if s.recv != nil {
+		n -= int64(len(s.recv))
+	}
+	if n < 0 || n < s.delivered-recentSize {
+		return 0, false
+	}
+	return s.recent[n%recentSize], true
+}
+
+func (s *topicStats) getPolicy() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.policy
+}
+
+func (s *topicStats) setPolicy(policy string) {
+	s.mu.Lock()
+	s.policy = policy
+	s.mu.Unlock()
+}
+
+//enqueue 消息放入队列之前统计, 放入失败时调用cancel
+func (s *topicStats) enqueue(msg *Message) {
+	s.mu.Lock()
+	s.sent++
+	e := s.event(msg.Ty)
+	e.count++
+	e.pending++
+	s.mu.Unlock()
+}
+
+func (s *topicStats) cancel(msg *Message, dropped bool) {
+	s.mu.Lock()
+	s.sent--
+	e := s.event(msg.Ty)
+	e.count--
+	e.pending--
+	if dropped {
+		s.dropped++
+	} else {
+		s.rejected++
+	}
+	s.mu.Unlock()
+	if dropped {
+		s.dropCount.Inc(1)
+	} else {
+		s.rejCount.Inc(1)
+	}
+}
+
+func (s *topicStats) updateDepth(depth int64) {
+	s.mu.Lock()
+	if depth > s.highWater {
+		s.highWater = depth
+		s.highGauge.Update(depth)
+	}
+	s.mu.Unlock()
+	s.backlog.Update(depth)
+}
+
+//deliver 消息交给模块处理
+func (s *topicStats) deliver(ty int64, sendTime time.Time, depth int64) {
+	now := time.Now()
+	var wait time.Duration
+	if !sendTime.IsZero() {
+		wait = now.Sub(sendTime)
+		s.latency.Update(wait)
+	}
+	s.backlog.Update(depth)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.event(ty)
+	e.pending--
+	e.waitTotal += wait
+	if wait > e.waitMax {
+		e.waitMax = wait
+	}
+	s.recent[s.delivered%recentSize] = ty
+	s.delivered++
+	s.lastDeliver = now
+	if s.slow {
+		s.slow = false
+		qlog.Info("consumer recovered", "topic", s.topic, "depth", depth)
+	}
+}
+
+//reply 需要应答的消息收到应答
+func (s *topicStats) reply(ty int64, d time.Duration) {
+	s.mu.Lock()
+	e := s.event(ty)
+	e.replyCount++
+	e.replyTotal += d
+	if d > e.replyMax {
+		e.replyMax = d
+	}
+	s.mu.Unlock()
+}
+
+//pendingEvents 等待处理最多的几种消息
+func (s *topicStats) pendingEvents(n int) string {
+	var tys []int64
+	for ty, e := range s.events {
+		if e.pending > 0 {
+			tys = append(tys, ty)
+		}
+	}
+	sort.Slice(tys, func(i, j int) bool { return s.events[tys[i]].pending > s.events[tys[j]].pending })
+	if len(tys) > n {
+		tys = tys[:n]
+	}
+	var names []string
+	for _, ty := range tys {
+		names = append(names, types.GetEventName(int(ty))+":"+strconv.FormatInt(s.events[ty].pending, 10))
+	}
+	return strings.Join(names, ",")
+}
+
+//checkSlow 有消息积压并且长时间没有交给模块处理时报告慢消费者
+func (s *topicStats) checkSlow(depth int64, now time.Time, slowTime time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	last := s.lastDeliver
+	if last.IsZero() {
+		last = s.created
+	}
+	idle := now.Sub(last)
+	if depth == 0 || idle < slowTime || s.slow {
+		return
+	}
+	s.slow = true
+	s.slowCount.Inc(1)
+	var current string
+	if ty, ok := s.current(); ok {
+		current = types.GetEventName(int(ty))
+	}
+	qlog.Error("slow consumer", "topic", s.topic, "depth", depth, "idle", idle,
+		"current", current, "pending", s.pendingEvents(3))
+}
+
+func avgMicro(total time.Duration, count int64) int64 {
+	if count == 0 {
+		return 0
+	}
+	return int64(total/time.Microsecond) / count
+}
+
+func (s *topicStats) snapshot(highDepth, lowDepth int64, now time.Time) *types.QueueTopicStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := &types.QueueTopicStats{
+		Topic:         s.topic,
+		Policy:        s.policy,
+		HighDepth:     highDepth,
+		LowDepth:      lowDepth,
+		HighWaterMark: s.highWater,
+		Sent:          s.sent,
+		Dropped:       s.dropped,
+		Rejected:      s.rejected,
+		Slow:          s.slow,
+	}
+	if !s.lastDeliver.IsZero() {
+		stats.Idle = int64(now.Sub(s.lastDeliver) / time.Millisecond)
+	}
+	if ty, ok := s.current(); ok {
+		stats.Current = types.GetEventName(int(ty))
+	}
+	for ty, e := range s.events {
+		stats.Events = append(stats.Events, &types.QueueEventStats{
+			Event:      types.GetEventName(int(ty)),
+			Count:      e.count,
+			Pending:    e.pending,
+			AvgWait:    avgMicro(e.waitTotal, e.count-e.pending),
+			MaxWait:    int64(e.waitMax / time.Microsecond),
+			ReplyCount: e.replyCount,
+			AvgReply:   avgMicro(e.replyTotal, e.replyCount),
+			MaxReply:   int64(e.replyMax / time.Microsecond),
+		})
+	}
+	sort.Slice(stats.Events, func(i, j int) bool { return stats.Events[i].Event < stats.Events[j].Event })
+	return stats
+}
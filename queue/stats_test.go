@@ -0,0 +1,150 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueStats(t *testing.T) {
+	q := New("channel")
+	defer q.Close()
+	go func() {
+		client := q.Client()
+		client.Sub("mempool")
+		for msg := range client.Recv() {
+			msg.Reply(client.NewMessage("", types.EventReply, &types.Reply{IsOk: true}))
+		}
+	}()
+	client := q.Client()
+	for i := 0; i < 3; i++ {
+		msg := client.NewMessage("mempool", types.EventTx, nil)
+		require.Nil(t, client.Send(msg, true))
+		_, err := client.Wait(msg)
+		require.Nil(t, err)
+	}
+	require.Nil(t, client.Send(client.NewMessage("mempool", types.EventGetMempoolSize, nil), false))
+
+	stats, err := client.GetQueueStats("mempool")
+	require.Nil(t, err)
+	require.Equal(t, 1, len(stats.Topics))
+	topic := stats.Topics[0]
+	assert.Equal(t, "mempool", topic.Topic)
+	assert.Equal(t, PolicyBlock, topic.Policy)
+	assert.Equal(t, int64(4), topic.Sent)
+	assert.True(t, topic.HighWaterMark >= 1)
+	events := make(map[string]*types.QueueEventStats)
+	for _, e := range topic.Events {
+		events[e.Event] = e
+	}
+	require.NotNil(t, events["EventTx"])
+	assert.Equal(t, int64(3), events["EventTx"].Count)
+	assert.Equal(t, int64(0), events["EventTx"].Pending)
+	assert.Equal(t, int64(3), events["EventTx"].ReplyCount)
+	assert.True(t, events["EventTx"].MaxReply >= events["EventTx"].AvgReply)
+	assert.Equal(t, int64(1), events["EventGetMempoolSize"].Count)
+
+	_, err = client.GetQueueStats("wallet")
+	assert.Equal(t, types.ErrNotFound, err)
+	all, err := client.GetQueueStats("")
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(all.Topics))
+}
+
+func TestQueuePolicy(t *testing.T) {
+	q := New("channel").(*queue)
+	q.setQueueConfig(&types.Queue{Policy: PolicyError, TopicPolicy: []string{"p2p:drop"}})
+	client := q.Client()
+	//没有订阅者, 队列满之后按照策略处理
+	for i := 0; i < defaultChanBuffer; i++ {
+		require.Nil(t, client.Send(client.NewMessage("execs", types.EventCheckTx, nil), true))
+	}
+	err := client.Send(client.NewMessage("execs", types.EventCheckTx, nil), true)
+	assert.Equal(t, ErrQueueChannelFull, err)
+
+	for i := 0; i < defaultLowChanBuffer; i++ {
+		require.Nil(t, client.Send(client.NewMessage("p2p", types.EventTxBroadcast, nil), false))
+	}
+	assert.Nil(t, client.Send(client.NewMessage("p2p", types.EventTxBroadcast, nil), false))
+
+	stats, err := client.GetQueueStats("")
+	require.Nil(t, err)
+	require.Equal(t, 2, len(stats.Topics))
+	execs, p2p := stats.Topics[0], stats.Topics[1]
+	assert.Equal(t, PolicyError, execs.Policy)
+	assert.Equal(t, int64(defaultChanBuffer), execs.HighDepth)
+	assert.Equal(t, int64(defaultChanBuffer), execs.Sent)
+	assert.Equal(t, int64(1), execs.Rejected)
+	assert.Equal(t, PolicyDrop, p2p.Policy)
+	assert.Equal(t, int64(defaultLowChanBuffer), p2p.LowDepth)
+	assert.Equal(t, int64(defaultLowChanBuffer), p2p.HighWaterMark)
+	assert.Equal(t, int64(1), p2p.Dropped)
+	//区块的增加和删除消息不能丢弃, 阻塞等待
+	err = client.SendTimeout(client.NewMessage("p2p", types.EventAddBlock, nil), false, 10*time.Millisecond)
+	assert.Equal(t, ErrQueueTimeout, err)
+	err = client.SendTimeout(client.NewMessage("p2p", types.EventDelBlock, nil), false, 0)
+	assert.Equal(t, ErrQueueChannelFull, err)
+	stats, err = client.GetQueueStats("p2p")
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), stats.Topics[0].Dropped)
+
+	assert.Panics(t, func() { q.setQueueConfig(&types.Queue{Policy: "wait"}) })
+	assert.Panics(t, func() { q.setQueueConfig(&types.Queue{TopicPolicy: []string{"p2p"}}) })
+
+	//队列满时关闭会阻塞, 先清空
+	for _, topic := range []string{"execs", "p2p"} {
+		sub := q.chanSub(topic)
+		for len(sub.high) > 0 {
+			<-sub.high
+		}
+		for len(sub.low) > 0 {
+			<-sub.low
+		}
+	}
+	q.Close()
+}
+
+func TestSlowConsumer(t *testing.T) {
+	q := New("channel").(*queue)
+	defer q.Close()
+	q.setQueueConfig(&types.Queue{SlowConsumerTime: 100})
+	client := q.Client()
+	block := make(chan struct{})
+	go func() {
+		client := q.Client()
+		client.Sub("execs")
+		for msg := range client.Recv() {
+			if msg.Ty == types.EventBlockChainQuery {
+				<-block
+			}
+		}
+	}()
+	require.Nil(t, client.Send(client.NewMessage("execs", types.EventBlockChainQuery, nil), false))
+	//第一个消息阻塞模块之后, 后面的消息在队列中积压
+	for i := 0; i < 10; i++ {
+		require.Nil(t, client.Send(client.NewMessage("execs", types.EventCheckTx, nil), false))
+	}
+	stats := q.getStats("execs")
+	require.Eventually(t, func() bool {
+		s, err := client.GetQueueStats("execs")
+		return err == nil && s.Topics[0].Slow
+	}, 3*time.Second, 50*time.Millisecond)
+	s, _ := client.GetQueueStats("execs")
+	assert.Equal(t, "EventBlockChainQuery", s.Topics[0].Current)
+	stats.mu.Lock()
+	assert.Contains(t, stats.pendingEvents(3), "EventCheckTx:")
+	stats.mu.Unlock()
+
+	close(block)
+	require.Eventually(t, func() bool {
+		s, err := client.GetQueueStats("execs")
+		return err == nil && !s.Topics[0].Slow && s.Topics[0].LowDepth == 0
+	}, 3*time.Second, 50*time.Millisecond)
+}
@@ -312,8 +312,60 @@ func (c *channelClient) GetAddrOverview(parm *types.ReqAddr) (*types.AddrOvervie
 	return reply, nil
 }
 
+//getStateHash 根据高度或者状态hash 获取查询使用的状态hash, 都没有指定时返回nil, 使用最新的状态
+//height 为0 表示没有指定高度, 创世区块的状态需要通过状态hash 查询
+func (c *channelClient) getStateHash(stateHash []byte, height int64) ([]byte, error) {
+	if height < 0 || (height > 0 && len(stateHash) > 0) {
+		return nil, types.ErrInvalidParam
+	}
+	if height > 0 {
+		headers, err := c.GetHeaders(&types.ReqBlocks{Start: height, End: height})
+		if err != nil {
+			log.Error("getStateHash", "height", height, "err", err)
+			return nil, err
+		}
+		if len(headers.GetItems()) == 0 {
+			return nil, types.ErrBlockNotFound
+		}
+		stateHash = headers.Items[0].StateHash
+	}
+	if len(stateHash) == 0 {
+		return nil, nil
+	}
+	err := c.StoreCheckState(&types.StoreCheckState{StateHash: stateHash, Height: height})
+	//store 不支持检查时按照原来的方式查询
+	if err != nil && err != types.ErrActionNotSupport {
+		log.Error("getStateHash", "height", height, "stateHash", common.ToHex(stateHash), "err", err)
+		return nil, err
+	}
+	return stateHash, nil
+}
+
+//getStateHashHex 和getStateHash 相同, 状态hash 使用16进制字符串
+func (c *channelClient) getStateHashHex(stateHash string, height int64) (string, error) {
+	var hash []byte
+	if stateHash != "" {
+		var err error
+		hash, err = common.FromHex(stateHash)
+		if err != nil {
+			return "", err
+		}
+	}
+	hash, err := c.getStateHash(hash, height)
+	if err != nil || hash == nil {
+		return "", err
+	}
+	return common.ToHex(hash), nil
+}
+
 // GetBalance get balance
 func (c *channelClient) GetBalance(in *types.ReqBalance) ([]*types.Account, error) {
+	stateHash, err := c.getStateHashHex(in.StateHash, in.Height)
+	if err != nil {
+		return nil, err
+	}
+	in.StateHash = stateHash
+	in.Height = 0
 	// in.AssetExec & in.AssetSymbol 新增参数，
 	// 不填时兼容原来的调用
 	if in.AssetExec == "" || in.AssetSymbol == "" {
@@ -341,6 +393,11 @@ func (c *channelClient) GetAllExecBalance(in *types.ReqAllExecBalance) (*types.A
 			return nil, types.ErrInvalidAddress
 		}
 	}
+	//每个执行器使用相同的状态查询
+	stateHash, err := c.getStateHashHex(in.StateHash, in.Height)
+	if err != nil {
+		return nil, err
+	}
 	var addrs []string
 	addrs = append(addrs, addr)
 	allBalance := &types.AllExecBalance{Addr: addr}
@@ -349,7 +406,7 @@ func (c *channelClient) GetAllExecBalance(in *types.ReqAllExecBalance) (*types.A
 		params := &types.ReqBalance{
 			Addresses:   addrs,
 			Execer:      execer,
-			StateHash:   in.StateHash,
+			StateHash:   stateHash,
 			AssetExec:   in.AssetExec,
 			AssetSymbol: in.AssetSymbol,
 		}
@@ -370,8 +427,33 @@ func (c *channelClient) GetAllExecBalance(in *types.ReqAllExecBalance) (*types.A
 	return allBalance, nil
 }
 
+// GetStateProof 获取多个key 的值和状态证明, 客户端可以用common/mavlproof 根据区块头中的状态根验证
+func (c *channelClient) GetStateProof(in *types.ReqStateProof) (*types.StateProof, error) {
+	if len(in.GetKeys()) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	stateHash, err := c.getStateHash(in.StateHash, in.Height)
+	if err != nil {
+		return nil, err
+	}
+	if stateHash == nil {
+		header, err := c.GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		stateHash = header.StateHash
+	}
+	return c.StoreGetStateProof(&types.ReqStateProof{StateHash: stateHash, Keys: in.Keys})
+}
+
 // GetTotalCoins get total of coins
 func (c *channelClient) GetTotalCoins(in *types.ReqGetTotalCoins) (*types.ReplyGetTotalCoins, error) {
+	stateHash, err := c.getStateHash(in.StateHash, in.Height)
+	if err != nil {
+		return nil, err
+	}
+	in.StateHash = stateHash
+	in.Height = 0
 	//获取地址账户的余额通过account模块
 	resp, err := c.accountdb.GetTotalCoins(c.QueueProtocolAPI, in)
 	if err != nil {
@@ -407,6 +489,12 @@ func (c *channelClient) GetTimeStatus() (*types.TimeStatus, error) {
 
 // GetExecBalance get balance with exec by channelclient
 func (c *channelClient) GetExecBalance(in *types.ReqGetExecBalance) (*types.ReplyGetExecBalance, error) {
+	stateHash, err := c.getStateHash(in.StateHash, in.Height)
+	if err != nil {
+		return nil, err
+	}
+	in.StateHash = stateHash
+	in.Height = 0
 	//通过account模块获取地址账户在合约中的余额
 	resp, err := c.accountdb.GetExecBalance(c.QueueProtocolAPI, in)
 	if err != nil {
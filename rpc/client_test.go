@@ -11,6 +11,7 @@ import (
 
 	"github.com/turingchain2020/turingchain/account"
 	"github.com/turingchain2020/turingchain/client/mocks"
+	"github.com/turingchain2020/turingchain/common"
 	"github.com/turingchain2020/turingchain/common/address"
 	slog "github.com/turingchain2020/turingchain/common/log"
 	"github.com/turingchain2020/turingchain/pluginmgr"
@@ -319,6 +320,51 @@ func TestChannelClient_GetBalance(t *testing.T) {
 	testChannelClientGetBalanceOther(t)
 }
 
+func TestChannelClient_GetBalanceHistory(t *testing.T) {
+	cfg := types.NewTuringchainConfig(types.GetDefaultCfgstring())
+	api := &mocks.QueueProtocolAPI{}
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := &channelClient{
+		QueueProtocolAPI: api,
+		accountdb:        new(account.DB),
+	}
+	header := &types.Header{Height: 10, StateHash: []byte("statehash10")}
+	api.On("GetHeaders", &types.ReqBlocks{Start: 10, End: 10}).Return(&types.Headers{Items: []*types.Header{header}}, nil)
+	api.On("GetHeaders", &types.ReqBlocks{Start: 100, End: 100}).Return(nil, types.ErrStartHeight)
+	api.On("GetHeaders", &types.ReqBlocks{Start: 1, End: 1}).Return(&types.Headers{Items: []*types.Header{{Height: 1, StateHash: []byte("statehash1")}}}, nil)
+	api.On("StoreCheckState", &types.StoreCheckState{StateHash: header.StateHash, Height: 10}).Return(nil)
+	api.On("StoreCheckState", &types.StoreCheckState{StateHash: []byte("statehash1"), Height: 1}).Return(types.ErrStatePruned)
+	api.On("StoreCheckState", &types.StoreCheckState{StateHash: []byte("unknown")}).Return(types.ErrStateNotFound)
+
+	var acc = &types.Account{Addr: "1Jn2qu84Z1SUUosWjySggBS9pKWdAP3tZt", Balance: 100}
+	api.On("StoreGet", &types.StoreGet{StateHash: header.StateHash, Keys: [][]byte{new(account.DB).AccountKey(acc.Addr)}}).Return(&types.StoreReplyValue{Values: [][]byte{types.Encode(acc)}}, nil)
+	in := &types.ReqBalance{Addresses: []string{acc.Addr}, Execer: "coins", Height: 10}
+	data, err := client.GetBalance(in)
+	assert.Nil(t, err)
+	assert.Equal(t, acc.Balance, data[0].Balance)
+
+	_, err = client.GetBalance(&types.ReqBalance{Addresses: []string{acc.Addr}, Execer: "coins", Height: 1})
+	assert.Equal(t, types.ErrStatePruned, err)
+	_, err = client.GetBalance(&types.ReqBalance{Addresses: []string{acc.Addr}, Execer: "coins", Height: 100})
+	assert.Equal(t, types.ErrStartHeight, err)
+	_, err = client.GetBalance(&types.ReqBalance{Addresses: []string{acc.Addr}, Execer: "coins", StateHash: common.ToHex([]byte("unknown"))})
+	assert.Equal(t, types.ErrStateNotFound, err)
+	_, err = client.GetBalance(&types.ReqBalance{Addresses: []string{acc.Addr}, Execer: "coins", StateHash: common.ToHex(header.StateHash), Height: 10})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = client.GetAllExecBalance(&types.ReqAllExecBalance{Addr: acc.Addr, Height: 1})
+	assert.Equal(t, types.ErrStatePruned, err)
+	_, err = client.GetExecBalance(&types.ReqGetExecBalance{Height: 1})
+	assert.Equal(t, types.ErrStatePruned, err)
+	_, err = client.GetTotalCoins(&types.ReqGetTotalCoins{Height: 1})
+	assert.Equal(t, types.ErrStatePruned, err)
+
+	//store 不支持检查时直接查询
+	api.On("StoreCheckState", &types.StoreCheckState{StateHash: []byte("statehash2")}).Return(types.ErrActionNotSupport)
+	hash, err := client.getStateHash([]byte("statehash2"), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("statehash2"), hash)
+}
+
 func TestChannelClient_GetTotalCoins(t *testing.T) {
 	cfg := types.NewTuringchainConfig(types.GetDefaultCfgstring())
 	client := new(channelClient)
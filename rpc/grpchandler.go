@@ -10,13 +10,14 @@ import (
 	"strings"
 
 	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/trace"
 	pb "github.com/turingchain2020/turingchain/types"
 	"golang.org/x/net/context"
 )
 
 // SendTransactionSync send transaction by network and query
 func (g *Grpc) SendTransactionSync(ctx context.Context, in *pb.Transaction) (*pb.Reply, error) {
-	reply, err := g.cli.SendTx(in)
+	reply, err := g.cli.withTrace(trace.FromContext(ctx).Context()).SendTx(in)
 	if err != nil {
 		return reply, err
 	}
@@ -36,7 +37,7 @@ func (g *Grpc) SendTransactionSync(ctx context.Context, in *pb.Transaction) (*pb
 
 // SendTransaction send transaction by network
 func (g *Grpc) SendTransaction(ctx context.Context, in *pb.Transaction) (*pb.Reply, error) {
-	return g.cli.SendTx(in)
+	return g.cli.withTrace(trace.FromContext(ctx).Context()).SendTx(in)
 }
 
 // CreateNoBalanceTxs create multiple transaction with no balance
@@ -467,6 +468,11 @@ func (g *Grpc) CloseQueue(ctx context.Context, in *pb.ReqNil) (*pb.Reply, error)
 	return &pb.Reply{IsOk: true}, nil
 }
 
+// GetQueueStats get queue stats
+func (g *Grpc) GetQueueStats(ctx context.Context, in *pb.ReqString) (*pb.QueueStats, error) {
+	return g.cli.GetQueueStats(in)
+}
+
 // GetLastBlockSequence get last block sequence
 func (g *Grpc) GetLastBlockSequence(ctx context.Context, in *pb.ReqNil) (*pb.Int64, error) {
 	return g.cli.GetLastBlockSequence()
@@ -548,3 +554,18 @@ func (g *Grpc) GetServerTime(ctx context.Context, in *pb.ReqNil) (*pb.ServerTime
 	}
 	return serverTime, nil
 }
+
+// Subscribe 订阅区块, 交易回执以及mempool 中的交易, 客户端断开后结束推送
+func (g *Grpc) Subscribe(in *pb.ReqSubscribe, stream pb.Turingchain_SubscribeServer) error {
+	return runSubscribe(g.cli.QueueProtocolAPI, in, stream.Send, stream.Context().Done())
+}
+
+// GetStateProof 查询多个key 的值和状态证明
+func (g *Grpc) GetStateProof(ctx context.Context, in *pb.ReqStateProof) (*pb.StateProof, error) {
+	return g.cli.GetStateProof(in)
+}
+
+// GetLogs 按照高度范围, 执行器, 日志类型和地址查询交易回执中的日志
+func (g *Grpc) GetLogs(ctx context.Context, in *pb.ReqGetLogs) (*pb.ReplyLogs, error) {
+	return g.cli.GetLogs(in)
+}
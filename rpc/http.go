@@ -16,6 +16,8 @@ import (
 	"net/rpc/jsonrpc"
 	"strings"
 
+	"github.com/turingchain2020/turingchain/trace"
+	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -73,6 +75,10 @@ func (j *JSONRPCServer) Listen() (int, error) {
 			writeError(w, r, 0, fmt.Sprintf(`Unauthozied`))
 			return
 		}
+		if r.URL.Path == wsPath && websocket.IsWebSocketUpgrade(r) {
+			j.serveWebsocket(w, r, ip)
+			return
+		}
 		if r.URL.Path == "/" {
 			data, err := ioutil.ReadAll(r.Body)
 			if err != nil {
@@ -100,14 +106,25 @@ func (j *JSONRPCServer) Listen() (int, error) {
 					return
 				}
 			}
-			serverCodec := jsonrpc.NewServerCodec(&HTTPConn{in: ioutil.NopCloser(bytes.NewReader(data)), out: w, r: r})
+			parent, _ := trace.ParseTraceParent(r.Header.Get(traceParentHeader))
+			span := trace.StartSpan(parent, "jsonrpc "+client.Method)
+			span.SetAttr("remote", ip)
+			defer span.End()
+			serverCodec := &traceCodec{
+				ServerCodec: jsonrpc.NewServerCodec(&HTTPConn{in: ioutil.NopCloser(bytes.NewReader(data)), out: w, r: r}),
+				sc:          span.Context(),
+			}
 			w.Header().Set("Content-type", "application/json")
 			if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
 				w.Header().Set("Content-Encoding", "gzip")
 			}
+			if span != nil {
+				w.Header().Set(traceParentHeader, span.Context().TraceParent())
+			}
 			w.WriteHeader(200)
 			err = j.s.ServeRequest(serverCodec)
 			if err != nil {
+				span.SetError(err)
 				log.Debug("Error while serving JSON request: %v", err)
 				return
 			}
@@ -120,7 +120,7 @@ func (c *Turingchain) SendTransaction(in rpctypes.RawParm, result *interface{})
 	if cfg.IsPara() {
 		reply, err = c.mainGrpcCli.SendTransaction(context.Background(), &parm)
 	} else {
-		reply, err = c.cli.SendTx(&parm)
+		reply, err = c.cli.withTrace(in.Trace()).SendTx(&parm)
 	}
 
 	if err == nil {
@@ -426,7 +426,7 @@ func (c *Turingchain) GetAccounts(in *types.ReqAccountList, result *interface{})
 	accountsList := reply.(*types.WalletAccounts)
 	var accounts rpctypes.WalletAccounts
 	for _, wallet := range accountsList.Wallets {
-		accounts.Wallets = append(accounts.Wallets, &rpctypes.WalletAccount{Label: wallet.GetLabel(),
+		accounts.Wallets = append(accounts.Wallets, &rpctypes.WalletAccount{Label: wallet.GetLabel(), WatchOnly: wallet.GetWatchOnly(),
 			Acc: &rpctypes.Account{Currency: wallet.GetAcc().GetCurrency(), Balance: wallet.GetAcc().GetBalance(),
 				Frozen: wallet.GetAcc().GetFrozen(), Addr: wallet.GetAcc().GetAddr()}})
 	}
@@ -895,7 +895,19 @@ func (c *Turingchain) Query(in rpctypes.Query4Jrpc, result *interface{}) error {
 		log.Error("EventQuery1", "err", err.Error(), "funcName", in.FuncName)
 		return err
 	}
-	resp, err := c.cli.Query(cfg.ExecName(in.Execer), in.FuncName, decodePayload)
+	query := &types.ChainExecutor{
+		Driver:   cfg.ExecName(in.Execer),
+		FuncName: in.FuncName,
+		Param:    types.Encode(decodePayload),
+		Height:   in.Height,
+	}
+	if in.StateHash != "" {
+		query.StateHash, err = common.FromHex(in.StateHash)
+		if err != nil {
+			return err
+		}
+	}
+	resp, err := c.cli.QueryChain(query)
 	if err != nil {
 		log.Error("EventQuery2", "err", err.Error())
 		return err
@@ -1118,6 +1130,86 @@ func (c *Turingchain) GetServerTime(in *types.ReqNil, result *interface{}) error
 	return nil
 }
 
+// GetStateProof 查询多个key 的值和状态证明
+func (c *Turingchain) GetStateProof(in *rpctypes.ReqStateProof, result *interface{}) error {
+	req := &types.ReqStateProof{Height: in.Height}
+	var err error
+	if in.StateHash != "" {
+		req.StateHash, err = common.FromHex(in.StateHash)
+		if err != nil {
+			return err
+		}
+	}
+	for _, key := range in.Keys {
+		k, err := common.FromHex(key)
+		if err != nil {
+			return err
+		}
+		req.Keys = append(req.Keys, k)
+	}
+	reply, err := c.cli.GetStateProof(req)
+	if err != nil {
+		return err
+	}
+	proof := &rpctypes.StateProof{StateHash: common.ToHex(reply.StateHash), Proof: common.ToHex(reply.Proof)}
+	for i, key := range reply.Keys {
+		proof.Keys = append(proof.Keys, common.ToHex(key))
+		proof.Values = append(proof.Values, common.ToHex(reply.Values[i]))
+	}
+	*result = proof
+	return nil
+}
+
+// GetLogs 按照高度范围, 执行器, 日志类型和地址查询交易回执中的日志
+func (c *Turingchain) GetLogs(in *types.ReqGetLogs, result *interface{}) error {
+	reply, err := c.cli.GetLogs(in)
+	if err != nil {
+		return err
+	}
+	*result = convertLogItems(reply.Logs)
+	return nil
+}
+
+// NewLogFilter 创建日志过滤器, 返回过滤器的id
+func (c *Turingchain) NewLogFilter(in *types.ReqGetLogs, result *interface{}) error {
+	id, err := c.filters.newFilter(&c.cli, in)
+	if err != nil {
+		return err
+	}
+	*result = id
+	return nil
+}
+
+// GetFilterChanges 获取过滤器上一次查询之后新产生的日志
+func (c *Turingchain) GetFilterChanges(in *types.ReqString, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	logs, err := c.filters.changes(&c.cli, in.Data)
+	if err != nil {
+		return err
+	}
+	*result = convertLogItems(logs)
+	return nil
+}
+
+// UninstallFilter 删除日志过滤器
+func (c *Turingchain) UninstallFilter(in *types.ReqString, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	*result = c.filters.uninstall(in.Data)
+	return nil
+}
+
+func convertLogItems(items []*types.LogItem) []*rpctypes.LogItem {
+	logs := make([]*rpctypes.LogItem, len(items))
+	for i, item := range items {
+		logs[i] = rpctypes.DecodeLogItem(item)
+	}
+	return logs
+}
+
 // CloseQueue close queue
 func (c *Turingchain) CloseQueue(in *types.ReqNil, result *interface{}) error {
 	go func() {
@@ -1132,6 +1224,16 @@ func (c *Turingchain) CloseQueue(in *types.ReqNil, result *interface{}) error {
 	return nil
 }
 
+// GetQueueStats get queue stats
+func (c *Turingchain) GetQueueStats(in *types.ReqString, result *interface{}) error {
+	reply, err := c.cli.GetQueueStats(in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
 // GetLastBlockSequence get sequence last block
 func (c *Turingchain) GetLastBlockSequence(in *types.ReqNil, result *interface{}) error {
 	resp, err := c.cli.GetLastBlockSequence()
@@ -1499,6 +1601,7 @@ func (c *Turingchain) QueryChain(in rpctypes.ChainExecutor, result *interface{})
 	qin.Driver = in.Driver
 	qin.FuncName = in.FuncName
 	qin.Param = types.Encode(msg)
+	qin.Height = in.Height
 	if in.StateHash != "" {
 		qin.StateHash = common.HexToHash(in.StateHash).Bytes()
 	}
@@ -1547,6 +1547,32 @@ func TestTuringchain_GetTotalCoins(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestTuringchain_GetStateProof(t *testing.T) {
+	cfg := types.NewTuringchainConfig(types.GetDefaultCfgstring())
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg)
+	client := newTestTuringchain(api)
+	var testResult interface{}
+	err := client.GetStateProof(&rpctypes.ReqStateProof{}, &testResult)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	key := []byte("mavl-coins-trc-addr")
+	api.On("GetLastHeader").Return(&types.Header{StateHash: []byte("statehash")}, nil)
+	req := &types.ReqStateProof{StateHash: []byte("statehash"), Keys: [][]byte{key}}
+	reply := &types.StateProof{StateHash: req.StateHash, Keys: req.Keys, Values: [][]byte{[]byte("value")}, Proof: []byte("proof")}
+	api.On("StoreGetStateProof", req).Return(reply, nil)
+	err = client.GetStateProof(&rpctypes.ReqStateProof{Keys: []string{common.ToHex(key)}}, &testResult)
+	assert.Nil(t, err)
+	proof := testResult.(*rpctypes.StateProof)
+	assert.Equal(t, common.ToHex(reply.StateHash), proof.StateHash)
+	assert.Equal(t, common.ToHex([]byte("value")), proof.Values[0])
+	assert.Equal(t, common.ToHex(reply.Proof), proof.Proof)
+
+	api.On("StoreCheckState", &types.StoreCheckState{StateHash: []byte("pruned")}).Return(types.ErrStatePruned)
+	err = client.GetStateProof(&rpctypes.ReqStateProof{StateHash: common.ToHex([]byte("pruned")), Keys: []string{common.ToHex(key)}}, &testResult)
+	assert.Equal(t, types.ErrStatePruned, err)
+}
+
 func TestTuringchain_GetFatalFailure(t *testing.T) {
 	cfg := types.NewTuringchainConfig(types.GetDefaultCfgstring())
 	api := new(mocks.QueueProtocolAPI)
@@ -0,0 +1,338 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/turingchain2020/turingchain/common/address"
+	"github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+const (
+	//单次查询返回的日志条数上限
+	maxLogCount = 1000
+	//同时存在的日志过滤器个数上限
+	maxLogFilter = 1024
+	//过滤器超过这个时间没有被查询, 会被删除
+	logFilterTimeout = 5 * time.Minute
+)
+
+var logFilterID uint64
+
+//checkLogIndex 检查节点是否开启了日志索引
+func (c *channelClient) checkLogIndex() error {
+	reply, err := c.LocalGet(&types.LocalDBGet{Keys: [][]byte{types.FlagLogIndex}})
+	if err != nil {
+		return err
+	}
+	if len(reply.Values) == 0 || len(reply.Values[0]) == 0 {
+		return types.ErrNotSupport
+	}
+	return nil
+}
+
+//checkGetLogs 检查查询参数
+func checkGetLogs(req *types.ReqGetLogs) error {
+	if req == nil || req.Count < 0 {
+		return types.ErrInvalidParam
+	}
+	for _, addr := range req.Addrs {
+		if err := address.CheckAddress(addr); err != nil {
+			return types.ErrInvalidAddress
+		}
+	}
+	return nil
+}
+
+//GetLogs 查询指定高度范围内满足条件的日志, 最多返回count 条
+func (c *channelClient) GetLogs(req *types.ReqGetLogs) (*types.ReplyLogs, error) {
+	if err := checkGetLogs(req); err != nil {
+		return nil, err
+	}
+	if req.FromHeight < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	if err := c.checkLogIndex(); err != nil {
+		return nil, err
+	}
+	//toHeight 小于0 时查询到最新的区块
+	to := req.ToHeight
+	if to < 0 {
+		header, err := c.GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		to = header.Height
+	}
+	if to < req.FromHeight {
+		return &types.ReplyLogs{}, nil
+	}
+	logs, _, err := c.queryLogs(req, types.LogHeightStr(req.FromHeight), types.LogHeightStr(to+1))
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplyLogs{Logs: logs}, nil
+}
+
+//queryLogs 查询位置在(start, end) 之间满足条件的日志, 返回日志和下一次查询的起点
+//日志查询完时返回的下一次查询的起点为end
+func (c *channelClient) queryLogs(req *types.ReqGetLogs, start, end string) ([]*types.LogItem, string, error) {
+	count := req.Count
+	if count == 0 || count > maxLogCount {
+		count = maxLogCount
+	}
+	var logs []*types.LogItem
+	for {
+		indexes, err := c.listLogIndexes(req, start, end, count)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(indexes) == 0 {
+			return logs, end, nil
+		}
+		keys := make([][]byte, len(indexes))
+		for i, index := range indexes {
+			keys[i] = types.CalcLogKey(index)
+		}
+		reply, err := c.LocalGet(&types.LocalDBGet{Keys: keys})
+		if err != nil {
+			return nil, "", err
+		}
+		for i, value := range reply.Values {
+			start = indexes[i]
+			if len(value) == 0 {
+				continue
+			}
+			var item types.LogItem
+			if err := types.Decode(value, &item); err != nil {
+				return nil, "", err
+			}
+			if !matchLog(req, &item) {
+				continue
+			}
+			logs = append(logs, &item)
+			if int32(len(logs)) == count {
+				return logs, start, nil
+			}
+		}
+		if int32(len(indexes)) < count {
+			return logs, end, nil
+		}
+	}
+}
+
+//logIndexPrefixes 根据查询条件选择遍历的索引, 地址的区分度最高, 优先使用
+func logIndexPrefixes(req *types.ReqGetLogs) (prefixes [][]byte) {
+	switch {
+	case len(req.Addrs) > 0:
+		for _, addr := range req.Addrs {
+			prefixes = append(prefixes, types.CalcLogAddrKey(addr, ""))
+		}
+	case req.Execer != "" && len(req.LogTypes) > 0:
+		for _, ty := range req.LogTypes {
+			prefixes = append(prefixes, types.CalcLogTypeKey(req.Execer, ty, ""))
+		}
+	case req.Execer != "":
+		prefixes = append(prefixes, types.CalcLogExecKey(req.Execer, ""))
+	default:
+		prefixes = append(prefixes, types.CalcLogKey(""))
+	}
+	return prefixes
+}
+
+//listLogIndexes 合并多个索引的查询结果, 按照日志的先后顺序最多返回count 个日志位置
+//每个索引最多取count 个, 合并排序后的前count 个一定是完整的
+func (c *channelClient) listLogIndexes(req *types.ReqGetLogs, start, end string, count int32) ([]string, error) {
+	var indexes []string
+	for _, prefix := range logIndexPrefixes(req) {
+		list, err := c.listLogIndex(prefix, start, end, count)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, list...)
+	}
+	sort.Strings(indexes)
+	result := indexes[:0]
+	for i, index := range indexes {
+		if i > 0 && index == indexes[i-1] {
+			continue
+		}
+		result = append(result, index)
+		if int32(len(result)) == count {
+			break
+		}
+	}
+	return result, nil
+}
+
+//listLogIndex 按顺序获取一个索引中位置在(start, end) 之间的日志位置
+func (c *channelClient) listLogIndex(prefix []byte, start, end string, count int32) ([]string, error) {
+	//升序遍历时不包含key 本身, 并且key 不存在时会跳过第一个key
+	//所以先找到不大于start 的最后一个key, 从这个key 开始遍历
+	floor, err := c.LocalList(&types.LocalDBList{Prefix: prefix, Key: append(append([]byte{}, prefix...), start...), Count: 1, Direction: db.ListSeek})
+	if err != nil {
+		return nil, err
+	}
+	param := &types.LocalDBList{Prefix: prefix, Count: count, Direction: db.ListASC | db.ListKeyOnly}
+	if len(floor.Values) == 2 {
+		param.Key = floor.Values[0]
+	}
+	reply, err := c.LocalList(param)
+	if err != nil {
+		return nil, err
+	}
+	var list []string
+	for _, key := range reply.Values {
+		index := string(key[len(prefix):])
+		if index <= start {
+			continue
+		}
+		if index >= end {
+			break
+		}
+		list = append(list, index)
+	}
+	return list, nil
+}
+
+//matchLog 检查日志是否满足全部查询条件
+func matchLog(req *types.ReqGetLogs, item *types.LogItem) bool {
+	if req.Execer != "" && req.Execer != item.Execer {
+		return false
+	}
+	if len(req.LogTypes) > 0 {
+		found := false
+		for _, ty := range req.LogTypes {
+			if ty == item.Ty {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(req.Addrs) > 0 {
+		for _, addr := range req.Addrs {
+			for _, itemAddr := range item.Addrs {
+				if addr == itemAddr {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return true
+}
+
+//logFilter 保存过滤条件和已经返回的日志位置
+type logFilter struct {
+	mu         sync.Mutex
+	req        *types.ReqGetLogs
+	cursor     string
+	lastAccess time.Time
+}
+
+//logFilters 服务端保存的日志过滤器, 通过GetFilterChanges 获取上一次查询之后新产生的日志
+type logFilters struct {
+	mu      sync.Mutex
+	filters map[string]*logFilter
+}
+
+func newLogFilters() *logFilters {
+	return &logFilters{filters: make(map[string]*logFilter)}
+}
+
+//removeExpired 删除长时间没有查询的过滤器, 调用时需要持有锁
+func (f *logFilters) removeExpired(now time.Time) {
+	for id, filter := range f.filters {
+		if now.Sub(filter.lastAccess) > logFilterTimeout {
+			delete(f.filters, id)
+		}
+	}
+}
+
+//newFilter 创建过滤器, fromHeight 小于0 时从下一个区块开始, toHeight 小于0 时一直查询到最新的区块
+func (f *logFilters) newFilter(cli *channelClient, req *types.ReqGetLogs) (string, error) {
+	if err := checkGetLogs(req); err != nil {
+		return "", err
+	}
+	if err := cli.checkLogIndex(); err != nil {
+		return "", err
+	}
+	from := req.FromHeight
+	if from < 0 {
+		header, err := cli.GetLastHeader()
+		if err != nil {
+			return "", err
+		}
+		from = header.Height + 1
+	}
+	now := time.Now()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removeExpired(now)
+	if len(f.filters) >= maxLogFilter {
+		return "", types.ErrTooManyLogFilter
+	}
+	id := fmt.Sprintf("0x%x", atomic.AddUint64(&logFilterID, 1))
+	f.filters[id] = &logFilter{req: req, cursor: types.LogHeightStr(from), lastAccess: now}
+	return id, nil
+}
+
+func (f *logFilters) get(id string) (*logFilter, error) {
+	now := time.Now()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removeExpired(now)
+	filter, ok := f.filters[id]
+	if !ok {
+		return nil, types.ErrLogFilterNotFound
+	}
+	filter.lastAccess = now
+	return filter, nil
+}
+
+//changes 返回上一次查询之后新产生的日志, 每次最多返回count 条
+func (f *logFilters) changes(cli *channelClient, id string) ([]*types.LogItem, error) {
+	filter, err := f.get(id)
+	if err != nil {
+		return nil, err
+	}
+	filter.mu.Lock()
+	defer filter.mu.Unlock()
+	header, err := cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	to := header.Height
+	if filter.req.ToHeight >= 0 && filter.req.ToHeight < to {
+		to = filter.req.ToHeight
+	}
+	end := types.LogHeightStr(to + 1)
+	if filter.cursor >= end {
+		return nil, nil
+	}
+	logs, next, err := cli.queryLogs(filter.req, filter.cursor, end)
+	if err != nil {
+		return nil, err
+	}
+	filter.cursor = next
+	return logs, nil
+}
+
+func (f *logFilters) uninstall(id string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.filters[id]
+	delete(f.filters, id)
+	return ok
+}
@@ -0,0 +1,88 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc_test
+
+import (
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common"
+	rpctypes "github.com/turingchain2020/turingchain/rpc/types"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/turingchain2020/turingchain/util/testnode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLogs(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	cfg.GetModuleConfig().Exec.EnableLogIndex = true
+	mocker := testnode.NewWithConfig(cfg, nil)
+	defer mocker.Close()
+	mocker.Listen()
+	jsonc := mocker.GetJSONC()
+	addr, _ := util.Genaddress()
+
+	tx1 := util.CreateCoinsTx(cfg, mocker.GetGenesisKey(), addr, types.Coin)
+	_, err := mocker.GetAPI().SendTx(tx1)
+	assert.Nil(t, err)
+	mocker.WaitHeight(1)
+
+	var logs []*rpctypes.LogItem
+	req := &types.ReqGetLogs{FromHeight: 1, ToHeight: -1, Execer: "coins", LogTypes: []int32{types.TyLogTransfer}}
+	assert.Nil(t, jsonc.Call("Turingchain.GetLogs", req, &logs))
+	assert.Equal(t, 2, len(logs))
+	for _, l := range logs {
+		assert.Equal(t, common.ToHex(tx1.Hash()), l.TxHash)
+		assert.Equal(t, "LogTransfer", l.TyName)
+	}
+
+	//只有一条转账日志包含新地址
+	req = &types.ReqGetLogs{ToHeight: -1, Addrs: []string{addr}}
+	assert.Nil(t, jsonc.Call("Turingchain.GetLogs", req, &logs))
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, int64(1), logs[0].Height)
+	assert.Equal(t, int32(types.TyLogTransfer), logs[0].Ty)
+
+	req = &types.ReqGetLogs{ToHeight: -1, Execer: "coins", Count: 1}
+	assert.Nil(t, jsonc.Call("Turingchain.GetLogs", req, &logs))
+	assert.Equal(t, 1, len(logs))
+
+	var id string
+	req = &types.ReqGetLogs{FromHeight: -1, ToHeight: -1, Addrs: []string{addr}}
+	assert.Nil(t, jsonc.Call("Turingchain.NewLogFilter", req, &id))
+	assert.Nil(t, jsonc.Call("Turingchain.GetFilterChanges", &types.ReqString{Data: id}, &logs))
+	assert.Equal(t, 0, len(logs))
+
+	tx2 := util.CreateCoinsTx(cfg, mocker.GetGenesisKey(), addr, types.Coin)
+	_, err = mocker.GetAPI().SendTx(tx2)
+	assert.Nil(t, err)
+	mocker.WaitHeight(2)
+	assert.Nil(t, jsonc.Call("Turingchain.GetFilterChanges", &types.ReqString{Data: id}, &logs))
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, common.ToHex(tx2.Hash()), logs[0].TxHash)
+	//已经返回的日志不会重复返回
+	assert.Nil(t, jsonc.Call("Turingchain.GetFilterChanges", &types.ReqString{Data: id}, &logs))
+	assert.Equal(t, 0, len(logs))
+
+	//toHeight 为0 时只查询创世区块, 小于0 时查询到最新的区块
+	params := map[string]interface{}{"addrs": []string{addr}}
+	assert.Nil(t, jsonc.Call("Turingchain.GetLogs", params, &logs))
+	assert.Equal(t, 0, len(logs))
+	params = map[string]interface{}{"toHeight": -1, "addrs": []string{addr}}
+	assert.Nil(t, jsonc.Call("Turingchain.GetLogs", params, &logs))
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, int64(2), logs[1].Height)
+	var id2 string
+	params = map[string]interface{}{"addrs": []string{addr}}
+	assert.Nil(t, jsonc.Call("Turingchain.NewLogFilter", params, &id2))
+	assert.Nil(t, jsonc.Call("Turingchain.GetFilterChanges", &types.ReqString{Data: id2}, &logs))
+	assert.Equal(t, 0, len(logs))
+
+	var ok bool
+	assert.Nil(t, jsonc.Call("Turingchain.UninstallFilter", &types.ReqString{Data: id}, &ok))
+	assert.True(t, ok)
+	err = jsonc.Call("Turingchain.GetFilterChanges", &types.ReqString{Data: id}, &logs)
+	assert.Equal(t, types.ErrLogFilterNotFound.Error(), err.Error())
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/turingchain2020/turingchain/pluginmgr"
 	"github.com/turingchain2020/turingchain/queue"
 	"github.com/turingchain2020/turingchain/rpc/grpcclient"
+	"github.com/turingchain2020/turingchain/trace"
 	_ "github.com/turingchain2020/turingchain/rpc/grpcclient" // register grpc multiple resolver
 	"github.com/turingchain2020/turingchain/types"
 	"golang.org/x/net/context"
@@ -41,6 +42,8 @@ type Turingchain struct {
 	cli channelClient
 	//for communicate with main chain in parallel chain
 	mainGrpcCli types.TuringchainClient
+	//服务端保存的日志过滤器
+	filters *logFilters
 }
 
 // Grpc a channelClient
@@ -183,10 +186,20 @@ func NewGRpcServer(c queue.Client, api client.QueueProtocolAPI) *Grpcserver {
 		if err := auth(ctx, info); err != nil {
 			return nil, err
 		}
+		span := trace.StartSpan(grpcTraceParent(ctx), "grpc "+info.FullMethod)
 		// Continue processing the request
-		return handler(ctx, req)
+		resp, err = handler(trace.NewContext(ctx, span), req)
+		span.Finish(err)
+		return resp, err
 	}
 	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	streamInterceptor := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := auth(ss.Context(), &grpc.UnaryServerInfo{FullMethod: info.FullMethod}); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+	opts = append(opts, grpc.StreamInterceptor(streamInterceptor))
 	if rpcCfg.EnableTLS {
 		creds, err := credentials.NewServerTLSFromFile(rpcCfg.CertFile, rpcCfg.KeyFile)
 		if err != nil {
@@ -210,7 +223,7 @@ func NewGRpcServer(c queue.Client, api client.QueueProtocolAPI) *Grpcserver {
 
 // NewJSONRPCServer new json rpcserver object
 func NewJSONRPCServer(c queue.Client, api client.QueueProtocolAPI) *JSONRPCServer {
-	j := &JSONRPCServer{jrpc: &Turingchain{}}
+	j := &JSONRPCServer{jrpc: &Turingchain{filters: newLogFilters()}}
 	j.jrpc.cli.Init(c, api)
 	if c.GetConfig().IsPara() {
 		grpcCli, err := grpcclient.NewMainChainClient(c.GetConfig(), "")
@@ -326,6 +339,9 @@ func (r *RPC) JRPC() *rpc.Server {
 	return r.japi.s
 }
 
+// Wait for ready, 作为queue.Module 加载
+func (r *RPC) Wait() {}
+
 // Close rpc close
 func (r *RPC) Close() {
 	if r.gapi != nil {
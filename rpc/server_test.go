@@ -60,6 +60,23 @@ func TestCheckBasicAuth(t *testing.T) {
 
 }
 
+func TestCheckWsOrigin(t *testing.T) {
+	rpcCfg = new(types.RPC)
+	r := &http.Request{Host: "localhost:9671", Header: make(http.Header)}
+	//非浏览器客户端
+	assert.True(t, checkWsOrigin(r))
+	r.Header.Set("Origin", "http://localhost:9671")
+	assert.True(t, checkWsOrigin(r))
+	r.Header.Set("Origin", "https://wallet.example.com")
+	assert.False(t, checkWsOrigin(r))
+	rpcCfg.WsOriginWhitelist = []string{"https://wallet.example.com/"}
+	assert.True(t, checkWsOrigin(r))
+	r.Header.Set("Origin", "http://wallet.example.com")
+	assert.False(t, checkWsOrigin(r))
+	rpcCfg.WsOriginWhitelist = []string{"*"}
+	assert.True(t, checkWsOrigin(r))
+}
+
 func TestJSONClient_Call(t *testing.T) {
 	rpcCfg = new(types.RPC)
 	rpcCfg.GrpcBindAddr = "127.0.0.1:8101"
@@ -276,3 +293,22 @@ func TestCheckFuncList(t *testing.T) {
 	assert.True(t, checkGrpcFuncBlacklist(funcName))
 
 }
+
+func TestWsCheckFuncList(t *testing.T) {
+	defer func(white, black map[string]bool) {
+		jrpcFuncWhitelist, jrpcFuncBlacklist = white, black
+	}(jrpcFuncWhitelist, jrpcFuncBlacklist)
+	jrpcFuncWhitelist = map[string]bool{"subscribe": true}
+	jrpcFuncBlacklist = map[string]bool{"subscribe": true}
+	req := &wsRequest{Method: "subscribe"}
+	_, err := newWsConn(nil, nil, false).handle(req)
+	assert.Equal(t, "The subscribe method is not authorized!", err.Error())
+	_, err = newWsConn(nil, nil, true).handle(req)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	delete(jrpcFuncBlacklist, "subscribe")
+	_, err = newWsConn(nil, nil, false).handle(req)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = newWsConn(nil, nil, false).handle(&wsRequest{Method: "unsubscribe"})
+	assert.Equal(t, "The unsubscribe method is not authorized!", err.Error())
+}
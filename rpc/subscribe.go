@@ -0,0 +1,180 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/turingchain2020/turingchain/client"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+// 订阅推送的类型, 前四种与blockchain push 的类型保持一致
+const (
+	subscribeBlock = int32(iota)
+	subscribeBlockHeader
+	subscribeTxReceipt
+	subscribeTxResult
+	subscribePendingTx
+)
+
+const (
+	//没有新的数据时, 每隔多久查询一次
+	subscribePollInterval = 500 * time.Millisecond
+	//同时存在的订阅个数上限, websocket 和grpc 共用
+	maxSubscriber = 1024
+)
+
+var subscriberCount int32
+
+//checkSubscribe 检查订阅参数, 订阅交易回执时必须指定合约
+func checkSubscribe(req *types.ReqSubscribe) error {
+	if req == nil || req.Type < subscribeBlock || req.Type > subscribePendingTx {
+		return types.ErrInvalidParam
+	}
+	if req.Type == subscribeTxReceipt && len(req.Contract) == 0 {
+		return types.ErrInvalidParam
+	}
+	return nil
+}
+
+//runSubscribe 按照sequence 的顺序推送数据, 直到send 失败或者done 被关闭
+func runSubscribe(api client.QueueProtocolAPI, req *types.ReqSubscribe, send func(*types.SubscribeEvent) error, done <-chan struct{}) error {
+	if err := checkSubscribe(req); err != nil {
+		return err
+	}
+	if atomic.AddInt32(&subscriberCount, 1) > maxSubscriber {
+		atomic.AddInt32(&subscriberCount, -1)
+		return types.ErrTooManySubscriber
+	}
+	defer atomic.AddInt32(&subscriberCount, -1)
+	if req.Type == subscribePendingTx {
+		return runSubscribePendingTx(api, send, done)
+	}
+
+	next := req.StartSequence
+	if next < 0 {
+		last, err := api.GetLastBlockSequence()
+		if err != nil {
+			return err
+		}
+		next = last.Data + 1
+	}
+	ticker := time.NewTicker(subscribePollInterval)
+	defer ticker.Stop()
+	for {
+		last, err := api.GetLastBlockSequence()
+		if err != nil {
+			return err
+		}
+		for ; next <= last.Data; next++ {
+			select {
+			case <-done:
+				return nil
+			default:
+			}
+			event, err := loadSubscribeEvent(api, req, next)
+			if err != nil {
+				return err
+			}
+			if event == nil {
+				continue
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-done:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+//loadSubscribeEvent 获取sequence 对应的推送数据, 没有需要推送的交易时返回nil
+func loadSubscribeEvent(api client.QueueProtocolAPI, req *types.ReqSubscribe, seq int64) (*types.SubscribeEvent, error) {
+	blockSeq, err := api.GetBlockBySeq(&types.Int64{Data: seq})
+	if err != nil {
+		return nil, err
+	}
+	cfg := api.GetConfig()
+	block := blockSeq.Detail.Block
+	event := &types.SubscribeEvent{Type: req.Type, SeqNum: seq}
+	switch req.Type {
+	case subscribeBlock:
+		event.Value = &types.SubscribeEvent_Block{Block: blockSeq}
+	case subscribeBlockHeader:
+		header := &types.HeaderSeq{Num: seq, Seq: blockSeq.Seq, Header: block.GetHeader(cfg)}
+		event.Value = &types.SubscribeEvent_Header{Header: header}
+	case subscribeTxReceipt:
+		receipts := &types.TxReceipts4SubscribePerBlk{}
+		for i, tx := range block.Txs {
+			if req.Contract[string(tx.Execer)] {
+				receipts.Tx = append(receipts.Tx, tx)
+				receipts.ReceiptData = append(receipts.ReceiptData, blockSeq.Detail.Receipts[i])
+			}
+		}
+		if len(receipts.Tx) == 0 {
+			return nil, nil
+		}
+		receipts.Height = block.Height
+		receipts.BlockHash = block.Hash(cfg)
+		receipts.ParentHash = block.ParentHash
+		receipts.AddDelType = int32(blockSeq.Seq.Type)
+		receipts.SeqNum = seq
+		event.Value = &types.SubscribeEvent_TxReceipt{TxReceipt: receipts}
+	case subscribeTxResult:
+		results := &types.TxResultPerBlock{
+			Items:      make([]*types.TxHashWithReceiptType, len(blockSeq.Detail.Receipts)),
+			Height:     block.Height,
+			BlockHash:  block.Hash(cfg),
+			ParentHash: block.ParentHash,
+			AddDelType: int32(blockSeq.Seq.Type),
+			SeqNum:     seq,
+		}
+		for i := range results.Items {
+			results.Items[i] = &types.TxHashWithReceiptType{
+				Hash: block.Txs[i].Hash(),
+				Ty:   blockSeq.Detail.Receipts[i].Ty,
+			}
+		}
+		event.Value = &types.SubscribeEvent_TxResult{TxResult: results}
+	}
+	return event, nil
+}
+
+//runSubscribePendingTx 定时查询mempool, 推送新进入mempool 的交易
+func runSubscribePendingTx(api client.QueueProtocolAPI, send func(*types.SubscribeEvent) error, done <-chan struct{}) error {
+	var seen map[string]bool
+	ticker := time.NewTicker(subscribePollInterval)
+	defer ticker.Stop()
+	for {
+		reply, err := api.GetMempool(&types.ReqGetMempool{IsAll: true})
+		if err != nil {
+			return err
+		}
+		//只记录当前mempool 中的交易, 已经打包的交易不会再出现
+		current := make(map[string]bool, len(reply.Txs))
+		for _, tx := range reply.Txs {
+			hash := string(tx.Hash())
+			current[hash] = true
+			if seen[hash] {
+				continue
+			}
+			event := &types.SubscribeEvent{Type: subscribePendingTx, Value: &types.SubscribeEvent_Tx{Tx: tx}}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+		seen = current
+		select {
+		case <-done:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
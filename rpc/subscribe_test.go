@@ -0,0 +1,164 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/turingchain2020/turingchain/util/testnode"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type wsMessage struct {
+	ID     uint64          `json:"id"`
+	Method string          `json:"method"`
+	Result json.RawMessage `json:"result"`
+	Error  interface{}     `json:"error"`
+	Params struct {
+		Subscription string          `json:"subscription"`
+		Result       json.RawMessage `json:"result"`
+		Error        string          `json:"error"`
+	} `json:"params"`
+}
+
+func readWsEvent(t *testing.T, conn *websocket.Conn) (string, *types.SubscribeEvent) {
+	var msg wsMessage
+	assert.Nil(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+	assert.Nil(t, conn.ReadJSON(&msg))
+	assert.Equal(t, "subscription", msg.Method)
+	assert.Equal(t, "", msg.Params.Error)
+	var event types.SubscribeEvent
+	assert.Nil(t, types.JSONToPB(msg.Params.Result, &event))
+	return msg.Params.Subscription, &event
+}
+
+func TestWebsocketSubscribe(t *testing.T) {
+	mocker := testnode.New("--free--", nil)
+	defer mocker.Close()
+	mocker.Listen()
+	cfg := mocker.GetClient().GetConfig()
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+mocker.GetCfg().RPC.JrpcBindAddr+"/ws", nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	//参数错误
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"id": 1, "method": "subscribe", "params": []interface{}{map[string]interface{}{"type": 2}}}))
+	var reply wsMessage
+	assert.Nil(t, conn.ReadJSON(&reply))
+	assert.Equal(t, uint64(1), reply.ID)
+	assert.Equal(t, types.ErrInvalidParam.Error(), reply.Error)
+
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"id": 2, "method": "subscribe", "params": []interface{}{map[string]interface{}{"type": 0, "startSequence": 0}}}))
+	assert.Nil(t, conn.ReadJSON(&reply))
+	assert.Equal(t, uint64(2), reply.ID)
+	assert.Nil(t, reply.Error)
+	var blockSub string
+	assert.Nil(t, json.Unmarshal(reply.Result, &blockSub))
+
+	id, event := readWsEvent(t, conn)
+	assert.Equal(t, blockSub, id)
+	assert.Equal(t, int64(0), event.SeqNum)
+	assert.Equal(t, int64(0), event.GetBlock().Detail.Block.Height)
+
+	tx := util.CreateCoinsTx(cfg, mocker.GetGenesisKey(), mocker.GetHotAddress(), types.Coin)
+	mocker.GetAPI().SendTx(tx)
+	mocker.WaitHeight(1)
+	id, event = readWsEvent(t, conn)
+	assert.Equal(t, blockSub, id)
+	assert.Equal(t, int64(1), event.SeqNum)
+	assert.Equal(t, tx.Hash(), event.GetBlock().Detail.Block.Txs[0].Hash())
+
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"id": 3, "method": "unsubscribe", "params": []interface{}{blockSub}}))
+	assert.Nil(t, conn.ReadJSON(&reply))
+	assert.Equal(t, uint64(3), reply.ID)
+	assert.Equal(t, "true", string(reply.Result))
+}
+
+func TestWebsocketOrigin(t *testing.T) {
+	mocker := testnode.New("--free--", nil)
+	defer mocker.Close()
+	mocker.Listen()
+	addr := mocker.GetCfg().RPC.JrpcBindAddr
+	//其他网页发起的跨域连接
+	_, resp, err := websocket.DefaultDialer.Dial("ws://"+addr+"/ws", http.Header{"Origin": []string{"http://evil.example.com"}})
+	assert.Equal(t, websocket.ErrBadHandshake, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	//同源
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+addr+"/ws", http.Header{"Origin": []string{"http://" + addr}})
+	assert.Nil(t, err)
+	conn.Close()
+}
+
+func TestGrpcSubscribe(t *testing.T) {
+	mocker := testnode.New("--free--", nil)
+	defer mocker.Close()
+	mocker.Listen()
+	cfg := mocker.GetClient().GetConfig()
+	conn, err := grpc.Dial(mocker.GetCfg().RPC.GrpcBindAddr, grpc.WithInsecure())
+	assert.Nil(t, err)
+	defer conn.Close()
+	client := types.NewTuringchainClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx1 := util.CreateCoinsTx(cfg, mocker.GetGenesisKey(), mocker.GetHotAddress(), types.Coin)
+	mocker.GetAPI().SendTx(tx1)
+	mocker.WaitHeight(1)
+
+	tx2 := util.CreateCoinsTx(cfg, mocker.GetGenesisKey(), mocker.GetHotAddress(), types.Coin)
+	mocker.GetAPI().SendTx(tx2)
+	mocker.WaitHeight(2)
+
+	//从指定的sequence 开始推送, 模拟断线后重新订阅
+	receipts, err := client.Subscribe(ctx, &types.ReqSubscribe{Type: 2, StartSequence: 1, Contract: map[string]bool{"coins": true}})
+	assert.Nil(t, err)
+	for i, tx := range []*types.Transaction{tx1, tx2} {
+		event, err := receipts.Recv()
+		assert.Nil(t, err)
+		assert.Equal(t, int64(i+1), event.SeqNum)
+		assert.Equal(t, tx.Hash(), event.GetTxReceipt().Tx[0].Hash())
+		assert.Equal(t, int32(types.ExecOk), event.GetTxReceipt().ReceiptData[0].Ty)
+	}
+}
+
+func TestGrpcSubscribePendingTx(t *testing.T) {
+	cfg := testnode.GetDefaultConfig()
+	//不打包区块, 交易一直留在mempool 中
+	cfg.GetModuleConfig().Consensus.Minerstart = false
+	mocker := testnode.NewWithConfig(cfg, nil)
+	defer mocker.Close()
+	mocker.Listen()
+	conn, err := grpc.Dial(mocker.GetCfg().RPC.GrpcBindAddr, grpc.WithInsecure())
+	assert.Nil(t, err)
+	defer conn.Close()
+	client := types.NewTuringchainClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tx1 := util.CreateCoinsTx(cfg, mocker.GetGenesisKey(), mocker.GetHotAddress(), types.Coin)
+	_, err = mocker.GetAPI().SendTx(tx1)
+	assert.Nil(t, err)
+	pending, err := client.Subscribe(ctx, &types.ReqSubscribe{Type: 4})
+	assert.Nil(t, err)
+	event, err := pending.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, tx1.Hash(), event.GetTx().Hash())
+
+	//已经推送过的交易不再推送
+	tx2 := util.CreateCoinsTx(cfg, mocker.GetGenesisKey(), mocker.GetHotAddress(), 2*types.Coin)
+	_, err = mocker.GetAPI().SendTx(tx2)
+	assert.Nil(t, err)
+	event, err = pending.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, tx2.Hash(), event.GetTx().Hash())
+}
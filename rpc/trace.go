@@ -0,0 +1,53 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"net/rpc"
+
+	"github.com/turingchain2020/turingchain/client"
+	"github.com/turingchain2020/turingchain/trace"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+// 请求中携带W3C 跟踪上下文的header
+const traceParentHeader = "traceparent"
+
+//traceCodec 把请求的跟踪上下文设置到支持跟踪的参数中
+type traceCodec struct {
+	rpc.ServerCodec
+	sc trace.SpanContext
+}
+
+func (c *traceCodec) ReadRequestBody(x interface{}) error {
+	err := c.ServerCodec.ReadRequestBody(x)
+	if param, ok := x.(interface{ SetTrace(trace.SpanContext) }); ok {
+		param.SetTrace(c.sc)
+	}
+	return err
+}
+
+//grpcTraceParent 从grpc metadata 中获取跟踪上下文
+func grpcTraceParent(ctx context.Context) trace.SpanContext {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return trace.SpanContext{}
+	}
+	values := md.Get(traceParentHeader)
+	if len(values) == 0 {
+		return trace.SpanContext{}
+	}
+	sc, _ := trace.ParseTraceParent(values[0])
+	return sc
+}
+
+//withTrace 在调用链sc 上访问其他模块
+func (c *channelClient) withTrace(sc trace.SpanContext) *channelClient {
+	if !sc.IsValid() {
+		return c
+	}
+	return &channelClient{QueueProtocolAPI: client.WithTrace(c.QueueProtocolAPI, sc), accountdb: c.accountdb}
+}
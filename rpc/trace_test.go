@@ -0,0 +1,44 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"testing"
+
+	"github.com/turingchain2020/turingchain/client/mocks"
+	rpctypes "github.com/turingchain2020/turingchain/rpc/types"
+	"github.com/turingchain2020/turingchain/trace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTraceCodec(t *testing.T) {
+	sc, err := trace.ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.Nil(t, err)
+	data := `{"id":1,"method":"Turingchain.SendTransaction","params":[{"data":"0x00"}]}`
+	conn := &HTTPConn{in: ioutil.NopCloser(bytes.NewBufferString(data)), out: ioutil.Discard}
+	codec := &traceCodec{ServerCodec: jsonrpc.NewServerCodec(conn), sc: sc}
+	var req rpc.Request
+	require.Nil(t, codec.ReadRequestHeader(&req))
+	var param rpctypes.RawParm
+	require.Nil(t, codec.ReadRequestBody(&param))
+	assert.Equal(t, "0x00", param.Data)
+	assert.Equal(t, sc, param.Trace())
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(traceParentHeader, sc.TraceParent()))
+	assert.Equal(t, sc, grpcTraceParent(ctx))
+	assert.False(t, grpcTraceParent(context.Background()).IsValid())
+
+	api := new(mocks.QueueProtocolAPI)
+	cli := &channelClient{QueueProtocolAPI: api}
+	assert.Equal(t, cli, cli.withTrace(trace.SpanContext{}))
+	assert.Equal(t, api, cli.withTrace(sc).QueueProtocolAPI)
+}
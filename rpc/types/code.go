@@ -50,6 +50,28 @@ func DecodeLog(execer []byte, rlog *ReceiptData) (*ReceiptDataResult, error) {
 	return rd, nil
 }
 
+// DecodeLogItem decode log item
+func DecodeLogItem(item *types.LogItem) *LogItem {
+	out := &LogItem{
+		Height:    item.Height,
+		TxIndex:   item.TxIndex,
+		LogIndex:  item.LogIndex,
+		TxHash:    common.ToHex(item.TxHash),
+		Execer:    item.Execer,
+		Ty:        item.Ty,
+		TyName:    "unkownType",
+		RawLog:    common.ToHex(item.Log),
+		BlockTime: item.BlockTime,
+		Addrs:     item.Addrs,
+	}
+	logType := types.LoadLog([]byte(item.Execer), int64(item.Ty))
+	if logType != nil {
+		out.Log, _ = logType.JSON(item.Log)
+		out.TyName = logType.Name()
+	}
+	return out
+}
+
 // ConvertWalletTxDetailToJSON conver the wallet tx detail to json
 func ConvertWalletTxDetailToJSON(in *types.WalletTxDetails, out *WalletTxDetails) error {
 	if in == nil || out == nil {
@@ -7,6 +7,8 @@ package types
 
 import (
 	"encoding/json"
+
+	"github.com/turingchain2020/turingchain/trace"
 )
 
 // TransParm transport parameter
@@ -29,6 +31,18 @@ type SignedTx struct {
 type RawParm struct {
 	Token string `json:"token"`
 	Data  string `json:"data"`
+	// 请求的调用链上下文, 由rpc 服务端设置
+	trace trace.SpanContext
+}
+
+// SetTrace set trace context of request
+func (p *RawParm) SetTrace(sc trace.SpanContext) {
+	p.trace = sc
+}
+
+// Trace trace context of request
+func (p RawParm) Trace() trace.SpanContext {
+	return p.trace
 }
 
 // QueryParm Query parameter
@@ -112,6 +126,21 @@ type ReceiptLogResult struct {
 	RawLog string          `json:"rawLog"`
 }
 
+// LogItem log with its position in chain
+type LogItem struct {
+	Height    int64           `json:"height"`
+	TxIndex   int32           `json:"txIndex"`
+	LogIndex  int32           `json:"logIndex"`
+	TxHash    string          `json:"txHash"`
+	Execer    string          `json:"execer"`
+	Ty        int32           `json:"ty"`
+	TyName    string          `json:"tyName"`
+	Log       json.RawMessage `json:"log"`
+	RawLog    string          `json:"rawLog"`
+	BlockTime int64           `json:"blockTime"`
+	Addrs     []string        `json:"addrs"`
+}
+
 // Block block information
 type Block struct {
 	Version    int64          `json:"version"`
@@ -232,8 +261,9 @@ type WalletAccounts struct {
 
 // WalletAccount  wallet account
 type WalletAccount struct {
-	Acc   *Account `json:"acc"`
-	Label string   `json:"label"`
+	Acc       *Account `json:"acc"`
+	Label     string   `json:"label"`
+	WatchOnly bool     `json:"watchOnly,omitempty"`
 }
 
 // Account account information
@@ -298,11 +328,29 @@ type BlockOverview struct {
 	TxHashes []string `json:"txHashes"`
 }
 
+// ReqStateProof 状态证明的请求, key 使用16进制字符串
+type ReqStateProof struct {
+	StateHash string   `json:"stateHash,omitempty"`
+	Height    int64    `json:"height,omitempty"`
+	Keys      []string `json:"keys"`
+}
+
+// StateProof 状态证明, values 和keys 一一对应, key 不存在时value 为空
+type StateProof struct {
+	StateHash string   `json:"stateHash"`
+	Keys      []string `json:"keys"`
+	Values    []string `json:"values"`
+	Proof     string   `json:"proof"`
+}
+
 // Query4Jrpc query jrpc
 type Query4Jrpc struct {
 	Execer   string          `json:"execer"`
 	FuncName string          `json:"funcName"`
 	Payload  json.RawMessage `json:"payload"`
+	//可选, 查询指定状态或者高度, 不能同时指定
+	StateHash string `json:"stateHash,omitempty"`
+	Height    int64  `json:"height,omitempty"`
 }
 
 // ChainExecutor chain executor
@@ -311,6 +359,7 @@ type ChainExecutor struct {
 	FuncName  string          `json:"funcName"`
 	StateHash string          `json:"stateHash"`
 	Payload   json.RawMessage `json:"payload"`
+	Height    int64           `json:"height,omitempty"`
 }
 
 // WalletStatus wallet status
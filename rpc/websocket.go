@@ -0,0 +1,207 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/turingchain2020/turingchain/client"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/gorilla/websocket"
+)
+
+/*
+websocket 订阅接口, 连接地址为 ws://host:port/ws
+订阅: {"id":1,"method":"subscribe","params":[{"type":2,"startSequence":-1,"contract":{"coins":true}}]}
+返回: {"id":1,"result":"0x1","error":null}
+推送: {"method":"subscription","params":{"subscription":"0x1","result":{...}}}
+取消: {"id":2,"method":"unsubscribe","params":["0x1"]}
+推送出错时会发送 {"method":"subscription","params":{"subscription":"0x1","error":"..."}}, 该订阅结束
+非本机的连接, subscribe 和unsubscribe 方法和jsonrpc 一样受jrpcFuncWhitelist 和jrpcFuncBlacklist 限制
+*/
+
+const wsPath = "/ws"
+
+var (
+	wsUpgrader = websocket.Upgrader{
+		CheckOrigin: checkWsOrigin,
+	}
+	wsSubscribeID uint64
+)
+
+type wsRequest struct {
+	ID     uint64            `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type wsNotification struct {
+	Method string         `json:"method"`
+	Params *wsSubResponse `json:"params"`
+}
+
+type wsSubResponse struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result,omitempty"`
+	Error        string          `json:"error,omitempty"`
+}
+
+//wsConn 一个websocket 连接, 可以同时存在多个订阅
+type wsConn struct {
+	api  client.QueueProtocolAPI
+	conn *websocket.Conn
+	//websocket 不支持并发写
+	writeLock sync.Mutex
+	mu        sync.Mutex
+	subs      map[string]chan struct{}
+	wg        sync.WaitGroup
+	//本机连接不检查方法的黑白名单, 和jsonrpc 一致
+	loopback bool
+}
+
+func newWsConn(api client.QueueProtocolAPI, conn *websocket.Conn, loopback bool) *wsConn {
+	return &wsConn{api: api, conn: conn, subs: make(map[string]chan struct{}), loopback: loopback}
+}
+
+//checkWsOrigin 浏览器中的网页可以跨域连接本机的节点, 只允许同源和白名单中的来源, 非浏览器的客户端没有Origin
+func checkWsOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	for _, allow := range rpcCfg.WsOriginWhitelist {
+		if allow == "*" || strings.EqualFold(strings.TrimSuffix(allow, "/"), origin) {
+			return true
+		}
+	}
+	log.Error("serveWebsocket origin not allowed", "origin", origin)
+	return false
+}
+
+func (j *JSONRPCServer) serveWebsocket(w http.ResponseWriter, r *http.Request, ip string) {
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Error("serveWebsocket upgrade", "err", err)
+		return
+	}
+	ws := newWsConn(j.jrpc.cli.QueueProtocolAPI, conn, net.ParseIP(ip).IsLoopback())
+	ws.run()
+}
+
+//run 处理请求直到连接断开, 断开后结束所有订阅
+func (ws *wsConn) run() {
+	defer func() {
+		ws.mu.Lock()
+		for id, done := range ws.subs {
+			close(done)
+			delete(ws.subs, id)
+		}
+		ws.mu.Unlock()
+		ws.wg.Wait()
+		ws.conn.Close()
+	}()
+	for {
+		_, data, err := ws.conn.ReadMessage()
+		if err != nil {
+			log.Debug("wsConn read", "err", err)
+			return
+		}
+		var req wsRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			ws.writeJSON(&serverResponse{Error: fmt.Sprintf(`invalid json request err:%s`, err.Error())})
+			continue
+		}
+		result, err := ws.handle(&req)
+		resp := &serverResponse{ID: req.ID, Result: result}
+		if err != nil {
+			resp.Error = err.Error()
+		}
+		ws.writeJSON(resp)
+	}
+}
+
+func (ws *wsConn) handle(req *wsRequest) (interface{}, error) {
+	if !ws.loopback && (checkJrpcFuncBlacklist(req.Method) || !checkJrpcFuncWhitelist(req.Method)) {
+		return nil, fmt.Errorf(`The %s method is not authorized!`, req.Method)
+	}
+	if len(req.Params) != 1 {
+		return nil, types.ErrInvalidParam
+	}
+	switch req.Method {
+	case "subscribe":
+		var sub types.ReqSubscribe
+		if err := types.JSONToPB(req.Params[0], &sub); err != nil {
+			return nil, err
+		}
+		if err := checkSubscribe(&sub); err != nil {
+			return nil, err
+		}
+		return ws.subscribe(&sub), nil
+	case "unsubscribe":
+		var id string
+		if err := json.Unmarshal(req.Params[0], &id); err != nil {
+			return nil, err
+		}
+		return ws.unsubscribe(id), nil
+	}
+	return nil, fmt.Errorf("the method %s does not exist", req.Method)
+}
+
+func (ws *wsConn) subscribe(req *types.ReqSubscribe) string {
+	id := fmt.Sprintf("0x%x", atomic.AddUint64(&wsSubscribeID, 1))
+	done := make(chan struct{})
+	ws.mu.Lock()
+	ws.subs[id] = done
+	ws.mu.Unlock()
+	ws.wg.Add(1)
+	go func() {
+		defer ws.wg.Done()
+		send := func(event *types.SubscribeEvent) error {
+			data, err := types.PBToJSON(event)
+			if err != nil {
+				return err
+			}
+			return ws.writeJSON(&wsNotification{Method: "subscription", Params: &wsSubResponse{Subscription: id, Result: data}})
+		}
+		err := runSubscribe(ws.api, req, send, done)
+		if err != nil {
+			log.Error("wsConn subscribe", "id", id, "err", err)
+			_ = ws.writeJSON(&wsNotification{Method: "subscription", Params: &wsSubResponse{Subscription: id, Error: err.Error()}})
+		}
+		ws.unsubscribe(id)
+	}()
+	return id
+}
+
+func (ws *wsConn) unsubscribe(id string) bool {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	done, ok := ws.subs[id]
+	if ok {
+		close(done)
+		delete(ws.subs, id)
+	}
+	return ok
+}
+
+func (ws *wsConn) writeJSON(v interface{}) error {
+	ws.writeLock.Lock()
+	defer ws.writeLock.Unlock()
+	return ws.conn.WriteJSON(v)
+}
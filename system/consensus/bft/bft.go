@@ -0,0 +1,334 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bft 多验证节点的拜占庭容错共识, 采用 propose/prevote/precommit 三阶段投票,
+// 区块一旦提交即为最终确定, 不存在分叉
+package bft
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/common/crypto"
+	log "github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/queue"
+	drivers "github.com/turingchain2020/turingchain/system/consensus"
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+var blog = log.New("module", "bft")
+
+var (
+	errNotValidator     = errors.New("ErrNotValidator")
+	errInvalidSignature = errors.New("ErrInvalidSignature")
+	errInvalidProposer  = errors.New("ErrInvalidProposer")
+	errInvalidCommit    = errors.New("ErrInvalidCommit")
+)
+
+const msgChanSize = 4096
+
+//Client bft共识客户端
+type Client struct {
+	*drivers.BaseClient
+	subcfg     *subConfig
+	privKey    crypto.PrivKey
+	pubKey     []byte
+	validators *validatorSet
+	transport  transport
+	msgC       chan *types.BftMessage
+	blockC     chan *types.Block
+	timeoutC   chan timeoutInfo
+	quit       chan struct{}
+	rs         *roundState
+	//缓存下一个高度的消息, 进入新的高度时处理
+	future     []*types.BftMessage
+}
+
+func init() {
+	drivers.Reg("bft", New)
+	drivers.QueryData.Register("bft", &Client{})
+	types.RegisterLightHeaderCheck("bft", checkLightHeader)
+}
+
+type subConfig struct {
+	Genesis          string `json:"genesis"`
+	GenesisBlockTime int64  `json:"genesisBlockTime"`
+	//本节点的验证私钥, 非验证节点留空
+	ValidatorKey string `json:"validatorKey"`
+	//所有验证节点的公钥, 顺序决定了提议者的轮换顺序
+	Validators         []string `json:"validators"`
+	TimeoutProposeMs   int64    `json:"timeoutProposeMs"`
+	TimeoutPrevoteMs   int64    `json:"timeoutPrevoteMs"`
+	TimeoutPrecommitMs int64    `json:"timeoutPrecommitMs"`
+	//每增加一轮, 超时时间增加的值
+	TimeoutDeltaMs  int64 `json:"timeoutDeltaMs"`
+	TimeoutCommitMs int64 `json:"timeoutCommitMs"`
+	WaitTxMs        int64 `json:"waitTxMs"`
+	//没有交易时, 每隔多少秒打包一个空区块, 0 表示不打包空区块
+	EmptyBlockInterval int64 `json:"emptyBlockInterval"`
+}
+
+//New 创建bft共识
+func New(cfg *types.Consensus, sub []byte) queue.Module {
+	c := drivers.NewBaseClient(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.Genesis == "" {
+		subcfg.Genesis = cfg.Genesis
+	}
+	if subcfg.GenesisBlockTime == 0 {
+		subcfg.GenesisBlockTime = cfg.GenesisBlockTime
+	}
+	setDefaultTimeout(&subcfg)
+	validators, err := newValidatorSet(subcfg.Validators)
+	if err != nil {
+		panic("bft: validators config error, " + err.Error())
+	}
+	client := &Client{
+		BaseClient: c,
+		subcfg:     &subcfg,
+		validators: validators,
+		msgC:       make(chan *types.BftMessage, msgChanSize),
+		blockC:     make(chan *types.Block, 16),
+		timeoutC:   make(chan timeoutInfo, 16),
+		quit:       make(chan struct{}),
+	}
+	if subcfg.ValidatorKey != "" {
+		client.privKey, err = loadPrivKey(subcfg.ValidatorKey)
+		if err != nil {
+			panic("bft: validatorKey config error, " + err.Error())
+		}
+		client.pubKey = client.privKey.PubKey().Bytes()
+		if !validators.has(client.pubKey) {
+			panic("bft: validatorKey is not in validators")
+		}
+	}
+	client.transport = newTransport(client)
+	c.SetChild(client)
+	return client
+}
+
+func setDefaultTimeout(subcfg *subConfig) {
+	if subcfg.TimeoutProposeMs == 0 {
+		subcfg.TimeoutProposeMs = 3000
+	}
+	if subcfg.TimeoutPrevoteMs == 0 {
+		subcfg.TimeoutPrevoteMs = 1000
+	}
+	if subcfg.TimeoutPrecommitMs == 0 {
+		subcfg.TimeoutPrecommitMs = 1000
+	}
+	if subcfg.TimeoutDeltaMs == 0 {
+		subcfg.TimeoutDeltaMs = 500
+	}
+	if subcfg.TimeoutCommitMs == 0 {
+		subcfg.TimeoutCommitMs = 1000
+	}
+	if subcfg.WaitTxMs == 0 {
+		subcfg.WaitTxMs = 100
+	}
+}
+
+func loadPrivKey(key string) (crypto.PrivKey, error) {
+	data, err := common.FromHex(key)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	if err != nil {
+		return nil, err
+	}
+	return cr.PrivKeyFromBytes(data)
+}
+
+//Close close
+func (client *Client) Close() {
+	select {
+	case <-client.quit:
+	default:
+		close(client.quit)
+	}
+	blog.Info("consensus bft closed")
+}
+
+//GetGenesisBlockTime 获取创世区块时间
+func (client *Client) GetGenesisBlockTime() int64 {
+	return client.subcfg.GenesisBlockTime
+}
+
+//CreateGenesisTx 创建创世交易
+func (client *Client) CreateGenesisTx() (ret []*types.Transaction) {
+	var tx types.Transaction
+	tx.Execer = []byte("coins")
+	tx.To = client.subcfg.Genesis
+	//gen payload
+	g := &cty.CoinsAction_Genesis{}
+	g.Genesis = &types.AssetsGenesis{}
+	g.Genesis.Amount = 1e8 * types.Coin
+	tx.Payload = types.Encode(&cty.CoinsAction{Value: g, Ty: cty.CoinsActionGenesis})
+	ret = append(ret, &tx)
+	return
+}
+
+//ProcEvent 处理p2p订阅的共识消息
+func (client *Client) ProcEvent(msg *queue.Message) bool {
+	if msg.Ty != types.EventReceiveSubData {
+		return false
+	}
+	data, ok := msg.GetData().(*types.TopicData)
+	if !ok {
+		return true
+	}
+	client.recvData(data.GetData())
+	return true
+}
+
+//recvData 收到其他节点的共识消息, 不能阻塞调用方
+func (client *Client) recvData(data []byte) {
+	var msg types.BftMessage
+	if err := types.Decode(data, &msg); err != nil {
+		blog.Error("recvData decode", "err", err)
+		return
+	}
+	//自己发出的消息已经在本地处理
+	if client.pubKey != nil && string(msg.GetSignature().GetPubkey()) == string(client.pubKey) {
+		return
+	}
+	select {
+	case client.msgC <- &msg:
+	default:
+		blog.Error("recvData msg channel is full")
+	}
+}
+
+//AddBlock 区块写入blockchain后通知共识进入新的高度
+func (client *Client) AddBlock(b *types.Block) error {
+	select {
+	case client.blockC <- b:
+	default:
+	}
+	return nil
+}
+
+//CheckBlock 检查区块由验证节点签名, 并且区块中的提交证明有超过2/3 的验证节点投了precommit
+func (client *Client) CheckBlock(parent *types.Block, current *types.BlockDetail) error {
+	block := current.Block
+	hash := client.blockHash(block)
+	if err := client.checkBlockSign(block, hash); err != nil {
+		return err
+	}
+	commit, err := decodeCommit(block.GetConsensusProof())
+	if err != nil {
+		return err
+	}
+	return client.validators.verifyCommit(commit, block.Height, hash)
+}
+
+//checkBlockSign 区块需要由验证节点签名, 签名不参与区块hash 的计算, 锁定的区块在后面的轮次中重新提议时保留原来的签名
+func (client *Client) checkBlockSign(block *types.Block, hash []byte) error {
+	sig := block.GetSignature()
+	if sig == nil {
+		return types.ErrSign
+	}
+	if !client.validators.has(sig.GetPubkey()) {
+		return errNotValidator
+	}
+	if !types.CheckSign(hash, "", sig) {
+		return types.ErrSign
+	}
+	return nil
+}
+
+func decodeCommit(proof []byte) (*types.BftCommit, error) {
+	if len(proof) == 0 {
+		return nil, errInvalidCommit
+	}
+	var commit types.BftCommit
+	if err := types.Decode(proof, &commit); err != nil {
+		return nil, errInvalidCommit
+	}
+	return &commit, nil
+}
+
+//checkLightHeader 轻节点验证区块头, 签名的节点需要是验证节点, 并且带有超过2/3 验证节点的提交证明
+func checkLightHeader(cfg *types.TuringchainConfig, parent, header *types.Header) error {
+	var subcfg subConfig
+	if sub := cfg.GetSubConfig().Consensus["bft"]; sub != nil {
+		if err := json.Unmarshal(sub, &subcfg); err != nil {
+			return err
+		}
+	}
+	validators, err := newValidatorSet(subcfg.Validators)
+	if err != nil {
+		return err
+	}
+	if !validators.has(header.GetSignature().GetPubkey()) {
+		return errNotValidator
+	}
+	commit, err := decodeCommit(header.GetConsensusProof())
+	if err != nil {
+		return err
+	}
+	return validators.verifyCommit(commit, header.Height, header.Hash)
+}
+
+//CmpBestBlock 提交的区块都是最终确定的, 不需要分叉选择
+func (client *Client) CmpBestBlock(newBlock *types.Block, cmpBlock *types.Block) bool {
+	return false
+}
+
+//CreateBlock 运行共识状态机, 非验证节点只同步和检查区块
+func (client *Client) CreateBlock() {
+	if client.privKey == nil {
+		blog.Info("bft: node is not a validator")
+		return
+	}
+	err := client.transport.start()
+	if err != nil {
+		blog.Error("bft: start transport", "err", err)
+		return
+	}
+	waitTx := time.Duration(client.subcfg.WaitTxMs) * time.Millisecond
+	for !client.IsMining() || !client.IsCaughtUp() {
+		select {
+		case <-client.quit:
+			return
+		case <-time.After(waitTx):
+		}
+	}
+	client.enterNewHeight(client.GetCurrentHeight() + 1)
+	ticker := time.NewTicker(waitTx)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-client.quit:
+			return
+		case msg := <-client.msgC:
+			client.handleMessage(msg)
+		case ti := <-client.timeoutC:
+			client.handleTimeout(ti)
+		case b := <-client.blockC:
+			client.handleNewBlock(b)
+		case <-ticker.C:
+			client.checkWaitTxs()
+		}
+	}
+}
+
+//Query_GetCommit 查询区块的提交证明, 提交证明和区块一起保存
+func (client *Client) Query_GetCommit(req *types.ReqInt) (types.Message, error) {
+	if req.GetHeight() <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	block, err := client.RequestBlock(req.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	return decodeCommit(block.GetConsensusProof())
+}
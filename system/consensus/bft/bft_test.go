@@ -0,0 +1,289 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bft
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/turingchain2020/turingchain/util/testnode"
+	"github.com/stretchr/testify/assert"
+
+	//加载系统内置store, 不要依赖plugin
+	_ "github.com/turingchain2020/turingchain/system/dapp/init"
+	_ "github.com/turingchain2020/turingchain/system/mempool/init"
+	_ "github.com/turingchain2020/turingchain/system/store/init"
+)
+
+//memHub 在同一个进程内转发共识消息
+type memHub struct {
+	mu      sync.RWMutex
+	clients []*Client
+	down    map[*Client]bool
+	//返回true 时丢弃从from 节点发往to 节点的消息
+	drop func(from, to int, msg *types.BftMessage) bool
+}
+
+type memTransport struct {
+	hub    *memHub
+	client *Client
+}
+
+func (t *memTransport) start() error {
+	return nil
+}
+
+func (t *memTransport) broadcast(msg *types.BftMessage) error {
+	data := types.Encode(msg)
+	t.hub.mu.RLock()
+	defer t.hub.mu.RUnlock()
+	if t.hub.down[t.client] {
+		return nil
+	}
+	from := t.hub.index(t.client)
+	for to, c := range t.hub.clients {
+		if c == t.client || t.hub.down[c] {
+			continue
+		}
+		if t.hub.drop != nil && t.hub.drop(from, to, msg) {
+			continue
+		}
+		c.recvData(data)
+	}
+	return nil
+}
+
+func (hub *memHub) index(client *Client) int {
+	for i, c := range hub.clients {
+		if c == client {
+			return i
+		}
+	}
+	return -1
+}
+
+func (hub *memHub) setDrop(drop func(from, to int, msg *types.BftMessage) bool) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	hub.drop = drop
+}
+
+func (hub *memHub) stop(client *Client) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	hub.down[client] = true
+}
+
+func newTestNodes(t *testing.T, n int) ([]*testnode.TuringchainMock, *memHub) {
+	hub := &memHub{down: make(map[*Client]bool)}
+	newTransport = func(client *Client) transport {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		hub.clients = append(hub.clients, client)
+		return &memTransport{hub: hub, client: client}
+	}
+	var pubkeys []string
+	for i := 0; i < n; i++ {
+		pubkeys = append(pubkeys, common.ToHex(util.TestPrivkeyList[i].PubKey().Bytes()))
+	}
+	var nodes []*testnode.TuringchainMock
+	for i := 0; i < n; i++ {
+		cfg := testnode.GetDefaultConfig()
+		cfg.GetModuleConfig().Consensus.Name = "bft"
+		sub := &subConfig{
+			ValidatorKey:       common.ToHex(util.TestPrivkeyList[i].Bytes()),
+			Validators:         pubkeys,
+			TimeoutProposeMs:   1000,
+			TimeoutPrevoteMs:   300,
+			TimeoutPrecommitMs: 300,
+			TimeoutDeltaMs:     100,
+			TimeoutCommitMs:    50,
+			WaitTxMs:           20,
+		}
+		data, err := json.Marshal(sub)
+		assert.Nil(t, err)
+		cfg.GetSubConfig().Consensus["bft"] = data
+		nodes = append(nodes, testnode.NewWithConfig(cfg, nil))
+	}
+	return nodes, hub
+}
+
+func sendTxs(nodes []*testnode.TuringchainMock, count int) {
+	cfg := nodes[0].GetClient().GetConfig()
+	txs := util.GenNoneTxs(cfg, nodes[0].GetGenesisKey(), int64(count))
+	for _, node := range nodes {
+		for _, tx := range txs {
+			node.GetAPI().SendTx(tx)
+		}
+	}
+}
+
+func waitHeight(t *testing.T, node *testnode.TuringchainMock, height int64) {
+	deadline := time.Now().Add(30 * time.Second)
+	for node.GetLastBlock().Height < height {
+		if time.Now().After(deadline) {
+			t.Fatalf("wait height %d timeout, current %d", height, node.GetLastBlock().Height)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestBftConsensus(t *testing.T) {
+	nodes, hub := newTestNodes(t, 4)
+	defer func() {
+		for _, node := range nodes {
+			node.Close()
+		}
+	}()
+	cfg := nodes[0].GetClient().GetConfig()
+	for h := int64(1); h <= 3; h++ {
+		sendTxs(nodes, 5)
+		for _, node := range nodes {
+			waitHeight(t, node, h)
+		}
+	}
+	for h := int64(1); h <= 3; h++ {
+		hash := nodes[0].GetBlock(h).Hash(cfg)
+		for _, node := range nodes[1:] {
+			assert.Equal(t, hash, node.GetBlock(h).Hash(cfg))
+		}
+	}
+	commit, err := hub.clients[0].Query_GetCommit(&types.ReqInt{Height: 2})
+	assert.Nil(t, err)
+	assert.True(t, len(commit.(*types.BftCommit).Precommits) >= hub.clients[0].validators.quorum())
+
+	//轻节点验证区块头的签名节点和提交证明
+	block := nodes[0].GetBlock(2)
+	header := block.GetHeader(cfg)
+	header.Signature = block.Signature
+	parent := nodes[0].GetBlock(1).GetHeader(cfg)
+	assert.Nil(t, checkLightHeader(cfg, parent, header))
+	noproof := *header
+	noproof.ConsensusProof = nil
+	assert.Equal(t, errInvalidCommit, checkLightHeader(cfg, parent, &noproof))
+	other := *header
+	other.ConsensusProof = nodes[0].GetBlock(1).ConsensusProof
+	assert.Equal(t, errInvalidCommit, checkLightHeader(cfg, parent, &other))
+	priv := util.TestPrivkeyList[4]
+	other.Signature = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: priv.Sign(header.Hash).Bytes()}
+	assert.Equal(t, errNotValidator, checkLightHeader(cfg, parent, &other))
+
+	//停止一个节点, 剩下的节点仍然可以达成共识
+	hub.stop(hub.clients[3])
+	for h := int64(4); h <= 7; h++ {
+		sendTxs(nodes[:3], 5)
+		for _, node := range nodes[:3] {
+			waitHeight(t, node, h)
+		}
+	}
+	hash := nodes[0].GetBlock(7).Hash(cfg)
+	assert.Equal(t, hash, nodes[1].GetBlock(7).Hash(cfg))
+	assert.Equal(t, hash, nodes[2].GetBlock(7).Hash(cfg))
+}
+
+//第0轮只有部分节点锁定了区块, 第1轮的提议者重新提议锁定的区块, 没有锁定的节点也要接受该提议
+func TestBftLockedBlock(t *testing.T) {
+	nodes, hub := newTestNodes(t, 4)
+	defer func() {
+		for _, node := range nodes {
+			node.Close()
+		}
+	}()
+	//高度1 第0轮的提议者是节点1, 第1轮的提议者是节点2
+	hub.setDrop(func(from, to int, msg *types.BftMessage) bool {
+		if msgHeight(msg) != 1 {
+			return false
+		}
+		if p := msg.GetProposal(); p != nil {
+			//节点3 没有收到第0轮的提议, 投nil
+			return p.Round == 0 && to == 3
+		}
+		//节点0 收不到节点1 的prevote, 没有锁定区块, 第0轮只有节点1和节点2 锁定, 不能提交
+		vote := msg.GetVote()
+		return vote.Round == 0 && vote.Type == voteTypePrevote && from == 1 && to == 0
+	})
+	sendTxs(nodes, 5)
+	for _, node := range nodes {
+		waitHeight(t, node, 1)
+	}
+	cfg := nodes[0].GetClient().GetConfig()
+	hash := nodes[0].GetBlock(1).Hash(cfg)
+	for _, node := range nodes[1:] {
+		assert.Equal(t, hash, node.GetBlock(1).Hash(cfg))
+	}
+	commit, err := hub.clients[3].Query_GetCommit(&types.ReqInt{Height: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), commit.(*types.BftCommit).Round)
+	//第1轮提交的是节点1 在第0轮提议的区块
+	assert.Equal(t, util.TestPrivkeyList[1].PubKey().Bytes(), nodes[3].GetBlock(1).Signature.Pubkey)
+}
+
+func TestVerifyCommit(t *testing.T) {
+	var keys []string
+	for i := 0; i < 4; i++ {
+		keys = append(keys, common.ToHex(util.TestPrivkeyList[i].PubKey().Bytes()))
+	}
+	vs, err := newValidatorSet(keys)
+	assert.Nil(t, err)
+	hash := common.Sha256([]byte("block"))
+	precommit := func(i int, round int32, hash []byte) *types.BftMessage {
+		vote := &types.BftVote{Type: voteTypePrecommit, Height: 10, Round: round, BlockHash: hash}
+		priv := util.TestPrivkeyList[i]
+		return &types.BftMessage{
+			Value: &types.BftMessage_Vote{Vote: vote},
+			Signature: &types.Signature{
+				Ty:        types.SECP256K1,
+				Pubkey:    priv.PubKey().Bytes(),
+				Signature: priv.Sign(types.Encode(vote)).Bytes(),
+			},
+		}
+	}
+	commit := &types.BftCommit{Height: 10, Round: 1, BlockHash: hash}
+	commit.Precommits = []*types.BftMessage{precommit(0, 1, hash), precommit(1, 1, hash), precommit(2, 1, hash)}
+	assert.Nil(t, vs.verifyCommit(commit, 10, hash))
+	assert.Equal(t, errInvalidCommit, vs.verifyCommit(commit, 11, hash))
+	assert.Equal(t, errInvalidCommit, vs.verifyCommit(commit, 10, common.Sha256(hash)))
+
+	//一个验证节点签名的冲突区块不能通过检查
+	other := common.Sha256(hash)
+	forged := &types.BftCommit{Height: 10, Round: 1, BlockHash: other, Precommits: []*types.BftMessage{precommit(0, 1, other)}}
+	assert.Equal(t, errInvalidCommit, vs.verifyCommit(forged, 10, other))
+	//重复的投票只计算一次
+	forged.Precommits = append(forged.Precommits, precommit(0, 1, other), precommit(0, 1, other))
+	assert.Equal(t, errInvalidCommit, vs.verifyCommit(forged, 10, other))
+	//不同轮次的投票
+	commit.Precommits[2] = precommit(2, 0, hash)
+	assert.Equal(t, errInvalidCommit, vs.verifyCommit(commit, 10, hash))
+	//非验证节点
+	commit.Precommits[2] = precommit(4, 1, hash)
+	assert.Equal(t, errNotValidator, vs.verifyCommit(commit, 10, hash))
+	//签名错误
+	commit.Precommits[2] = precommit(2, 1, hash)
+	commit.Precommits[2].Signature.Signature = commit.Precommits[1].Signature.Signature
+	assert.Equal(t, errInvalidSignature, vs.verifyCommit(commit, 10, hash))
+	//没有提交证明
+	_, err = decodeCommit(nil)
+	assert.Equal(t, errInvalidCommit, err)
+}
+
+func TestValidatorSet(t *testing.T) {
+	var keys []string
+	for i := 0; i < 4; i++ {
+		keys = append(keys, common.ToHex(util.TestPrivkeyList[i].PubKey().Bytes()))
+	}
+	vs, err := newValidatorSet(keys)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, vs.quorum())
+	assert.Equal(t, 1, vs.faulty())
+	assert.Equal(t, vs.pubkeys[1], vs.proposer(1, 0))
+	assert.Equal(t, vs.pubkeys[0], vs.proposer(2, 2))
+	_, err = newValidatorSet(append(keys, keys[0]))
+	assert.NotNil(t, err)
+}
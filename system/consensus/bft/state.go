@@ -0,0 +1,489 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bft
+
+import (
+	"time"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/common/merkle"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/golang/protobuf/proto"
+)
+
+/*
+共识状态机, 只在CreateBlock 的协程中运行:
+1. 进入新的高度后等待交易, 本地mempool 有交易或者收到其他节点的消息时进入第0轮
+2. propose: 提议者打包并预执行区块, 签名后广播
+3. prevote: 收到合法的提议后投prevote, 如果已经锁定了区块, 只对锁定的区块投票, 超时投nil
+4. precommit: 收到超过2/3 对同一区块的prevote 后锁定该区块并投precommit, 超过2/3 投nil 时解锁并投nil
+5. commit: 收到超过2/3 对同一区块的precommit 后提交区块, 否则超时后进入下一轮.
+   precommit 作为提交证明和区块一起保存和同步, 同步区块的节点通过提交证明检查区块
+任意轮次中, 超过1/3 的节点进入了更高的轮次, 本节点跟随进入该轮次
+*/
+
+const (
+	stepNewHeight = iota
+	stepPropose
+	stepPrevote
+	stepPrecommit
+	stepCommit
+)
+
+const (
+	voteTypePrevote   = 1
+	voteTypePrecommit = 2
+)
+
+type timeoutInfo struct {
+	height int64
+	round  int32
+	step   int
+}
+
+type voteKey struct {
+	ty    int32
+	round int32
+}
+
+//roundState 当前高度的共识状态
+type roundState struct {
+	height    int64
+	round     int32
+	step      int
+	startTime time.Time
+	//每一轮验证通过的提议区块
+	proposals map[int32]*types.Block
+	blocks    map[string]*types.Block
+	votes     map[voteKey]*voteSet
+	//锁定的区块, 在后面的轮次中只能对该区块投票
+	lockedRound int32
+	lockedBlock *types.Block
+	scheduled   map[timeoutInfo]bool
+}
+
+func newRoundState(height int64) *roundState {
+	return &roundState{
+		height:      height,
+		step:        stepNewHeight,
+		startTime:   types.Now(),
+		proposals:   make(map[int32]*types.Block),
+		blocks:      make(map[string]*types.Block),
+		votes:       make(map[voteKey]*voteSet),
+		lockedRound: -1,
+		scheduled:   make(map[timeoutInfo]bool),
+	}
+}
+
+func (rs *roundState) getVotes(ty, round int32) *voteSet {
+	key := voteKey{ty: ty, round: round}
+	votes, ok := rs.votes[key]
+	if !ok {
+		votes = newVoteSet()
+		rs.votes[key] = votes
+	}
+	return votes
+}
+
+//countRound 在某一轮次投过票的节点数
+func (rs *roundState) countRound(round int32) int {
+	voters := make(map[string]bool)
+	for key, votes := range rs.votes {
+		if key.round != round {
+			continue
+		}
+		for pub := range votes.votes {
+			voters[pub] = true
+		}
+	}
+	return len(voters)
+}
+
+func (client *Client) blockHash(block *types.Block) []byte {
+	return block.Hash(client.GetAPI().GetConfig())
+}
+
+func (client *Client) enterNewHeight(height int64) {
+	client.rs = newRoundState(height)
+	blog.Debug("enterNewHeight", "height", height)
+	future := client.future
+	client.future = nil
+	for _, msg := range future {
+		client.handleMessage(msg)
+	}
+	client.checkWaitTxs()
+}
+
+//checkWaitTxs 有交易或者到了打包空区块的时间, 开始第0轮
+func (client *Client) checkWaitTxs() {
+	rs := client.rs
+	if rs == nil || rs.step != stepNewHeight {
+		return
+	}
+	interval := client.subcfg.EmptyBlockInterval
+	lastBlock := client.GetCurrentBlock()
+	if interval > 0 && types.Now().Unix()-lastBlock.BlockTime >= interval {
+		client.enterNewRound(rs.height, 0)
+		return
+	}
+	if len(client.RequestTx(1, nil)) > 0 {
+		client.enterNewRound(rs.height, 0)
+	}
+}
+
+func (client *Client) enterNewRound(height int64, round int32) {
+	rs := client.rs
+	if rs.height != height || (rs.step != stepNewHeight && round <= rs.round) {
+		return
+	}
+	blog.Debug("enterNewRound", "height", height, "round", round)
+	rs.round = round
+	rs.step = stepPropose
+	client.scheduleTimeout(client.subcfg.TimeoutProposeMs, height, round, stepPropose)
+	if string(client.validators.proposer(height, round)) == string(client.pubKey) {
+		client.propose()
+	}
+	//可能在进入该轮次之前已经收到提议和投票
+	if _, ok := rs.proposals[round]; ok && rs.step == stepPropose {
+		client.doPrevote()
+	}
+	client.checkVotes(round)
+}
+
+func (client *Client) propose() {
+	rs := client.rs
+	block := rs.lockedBlock
+	if block == nil {
+		block = client.createProposalBlock()
+	}
+	if block == nil {
+		return
+	}
+	proposal := &types.BftProposal{Height: rs.height, Round: rs.round, Block: block}
+	msg := &types.BftMessage{Value: &types.BftMessage_Proposal{Proposal: proposal}}
+	client.signAndBroadcast(msg, types.Encode(proposal))
+}
+
+//createProposalBlock 从mempool 中获取交易, 预执行后签名
+func (client *Client) createProposalBlock() *types.Block {
+	cfg := client.GetAPI().GetConfig()
+	lastBlock := client.GetCurrentBlock()
+	maxTxNum := int(cfg.GetP(lastBlock.Height + 1).MaxTxNumber)
+	txs := client.RequestTx(maxTxNum, nil)
+	txs = client.CheckTxDup(txs)
+
+	var newblock types.Block
+	newblock.ParentHash = lastBlock.Hash(cfg)
+	newblock.Height = lastBlock.Height + 1
+	client.AddTxsToBlock(&newblock, txs)
+	newblock.Difficulty = cfg.GetP(0).PowLimitBits
+	//需要首先对交易进行排序然后再计算TxHash
+	if cfg.IsFork(newblock.GetHeight(), "ForkRootHash") {
+		newblock.Txs = types.TransactionSort(newblock.Txs)
+	}
+	newblock.TxHash = merkle.CalcMerkleRoot(cfg, newblock.Height, newblock.Txs)
+	newblock.BlockTime = types.Now().Unix()
+	if lastBlock.BlockTime >= newblock.BlockTime {
+		newblock.BlockTime = lastBlock.BlockTime + 1
+	}
+	detail, _, err := util.PreExecBlock(client.GetQueueClient(), lastBlock.StateHash, &newblock, false, false, false)
+	if err != nil {
+		blog.Error("createProposalBlock PreExecBlock", "height", newblock.Height, "err", err)
+		return nil
+	}
+	block := detail.Block
+	block.Signature = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    client.pubKey,
+		Signature: client.privKey.Sign(block.Hash(cfg)).Bytes(),
+	}
+	return block
+}
+
+func (client *Client) signAndBroadcast(msg *types.BftMessage, data []byte) {
+	msg.Signature = &types.Signature{
+		Ty:        types.SECP256K1,
+		Pubkey:    client.pubKey,
+		Signature: client.privKey.Sign(data).Bytes(),
+	}
+	err := client.transport.broadcast(msg)
+	if err != nil {
+		blog.Error("broadcast", "err", err)
+	}
+	client.handleMessage(msg)
+}
+
+func (client *Client) verifyMessage(msg *types.BftMessage) ([]byte, error) {
+	sig := msg.GetSignature()
+	if !client.validators.has(sig.GetPubkey()) {
+		return nil, errNotValidator
+	}
+	var data []byte
+	if p := msg.GetProposal(); p != nil {
+		data = types.Encode(p)
+	} else if v := msg.GetVote(); v != nil {
+		data = types.Encode(v)
+	} else {
+		return nil, types.ErrInvalidParam
+	}
+	if !types.CheckSign(data, "", sig) {
+		return nil, errInvalidSignature
+	}
+	return sig.GetPubkey(), nil
+}
+
+func msgHeight(msg *types.BftMessage) int64 {
+	if p := msg.GetProposal(); p != nil {
+		return p.Height
+	}
+	return msg.GetVote().GetHeight()
+}
+
+func (client *Client) handleMessage(msg *types.BftMessage) {
+	rs := client.rs
+	height := msgHeight(msg)
+	if height < rs.height || (height == rs.height && rs.step == stepCommit) {
+		return
+	}
+	if height > rs.height {
+		if height == rs.height+1 && len(client.future) < msgChanSize {
+			client.future = append(client.future, msg)
+		}
+		return
+	}
+	signer, err := client.verifyMessage(msg)
+	if err != nil {
+		blog.Error("handleMessage verify", "height", height, "err", err)
+		return
+	}
+	//收到当前高度的消息, 说明其他节点已经开始了共识
+	if rs.step == stepNewHeight {
+		client.enterNewRound(rs.height, 0)
+	}
+	if p := msg.GetProposal(); p != nil {
+		client.handleProposal(signer, p)
+		return
+	}
+	client.handleVote(msg)
+}
+
+func (client *Client) handleProposal(signer []byte, p *types.BftProposal) {
+	rs := client.rs
+	if string(client.validators.proposer(p.Height, p.Round)) != string(signer) {
+		blog.Error("handleProposal", "err", errInvalidProposer, "height", p.Height, "round", p.Round)
+		return
+	}
+	if _, ok := rs.proposals[p.Round]; ok {
+		return
+	}
+	block := p.GetBlock()
+	if err := client.checkProposalBlock(signer, block); err != nil {
+		blog.Error("handleProposal check block", "height", p.Height, "round", p.Round, "err", err)
+		rs.proposals[p.Round] = nil
+	} else {
+		rs.proposals[p.Round] = block
+		rs.blocks[string(client.blockHash(block))] = block
+	}
+	if p.Round == rs.round && rs.step == stepPropose {
+		client.doPrevote()
+	}
+	//提议可能晚于precommit 到达
+	client.checkVotes(p.Round)
+}
+
+//checkProposalBlock 检查提议的区块, 并重新执行比对状态.
+//提议由BftMessage 的签名认证, 锁定的区块在后面的轮次中由新的提议者重新提议, 区块的签名可以来自之前的提议者
+func (client *Client) checkProposalBlock(signer []byte, block *types.Block) error {
+	cfg := client.GetAPI().GetConfig()
+	lastBlock := client.GetCurrentBlock()
+	if block == nil || block.Height != lastBlock.Height+1 {
+		return types.ErrBlockHeight
+	}
+	if string(block.ParentHash) != string(lastBlock.Hash(cfg)) {
+		return types.ErrParentHash
+	}
+	hash := client.blockHash(block)
+	//自己打包的区块和之前轮次验证过的区块已经执行过
+	if _, ok := client.rs.blocks[string(hash)]; ok || string(signer) == string(client.pubKey) {
+		return nil
+	}
+	if err := client.checkBlockSign(block, hash); err != nil {
+		return err
+	}
+	if lastBlock.BlockTime > block.BlockTime {
+		return types.ErrBlockTime
+	}
+	if block.Size() > types.MaxBlockSize {
+		return types.ErrBlockSize
+	}
+	if int64(len(block.Txs)) > cfg.GetP(block.Height).MaxTxNumber {
+		return types.ErrManyTx
+	}
+	//提交证明在提交时才生成, 这里不能调用CheckBlock
+	execBlock := proto.Clone(block).(*types.Block)
+	_, _, err := util.PreExecBlock(client.GetQueueClient(), lastBlock.StateHash, execBlock, true, false, false)
+	return err
+}
+
+func (client *Client) doPrevote() {
+	rs := client.rs
+	var hash []byte
+	if rs.lockedBlock != nil {
+		hash = client.blockHash(rs.lockedBlock)
+	} else if block := rs.proposals[rs.round]; block != nil {
+		hash = client.blockHash(block)
+	}
+	rs.step = stepPrevote
+	client.sendVote(voteTypePrevote, hash)
+}
+
+func (client *Client) doPrecommit(hash []byte) {
+	rs := client.rs
+	rs.step = stepPrecommit
+	if hash != nil {
+		rs.lockedRound = rs.round
+		rs.lockedBlock = rs.blocks[string(hash)]
+	} else if nilHash, ok := rs.getVotes(voteTypePrevote, rs.round).majority(client.validators.quorum()); ok && nilHash == nil {
+		//超过2/3 的节点投了nil, 解除锁定
+		rs.lockedRound = -1
+		rs.lockedBlock = nil
+	}
+	client.sendVote(voteTypePrecommit, hash)
+}
+
+func (client *Client) sendVote(ty int32, hash []byte) {
+	rs := client.rs
+	vote := &types.BftVote{Type: ty, Height: rs.height, Round: rs.round, BlockHash: hash}
+	msg := &types.BftMessage{Value: &types.BftMessage_Vote{Vote: vote}}
+	client.signAndBroadcast(msg, types.Encode(vote))
+}
+
+func (client *Client) handleVote(msg *types.BftMessage) {
+	rs := client.rs
+	vote := msg.GetVote()
+	if vote.Type != voteTypePrevote && vote.Type != voteTypePrecommit {
+		return
+	}
+	if !rs.getVotes(vote.Type, vote.Round).add(msg) {
+		return
+	}
+	//超过1/3 的节点已经进入更高的轮次
+	if vote.Round > rs.round && rs.countRound(vote.Round) > client.validators.faulty() {
+		client.enterNewRound(rs.height, vote.Round)
+		return
+	}
+	client.checkVotes(vote.Round)
+}
+
+func (client *Client) checkVotes(round int32) {
+	rs := client.rs
+	if rs.step == stepCommit {
+		return
+	}
+	quorum := client.validators.quorum()
+	precommits := rs.getVotes(voteTypePrecommit, round)
+	hash, ok := precommits.majority(quorum)
+	if ok && hash != nil {
+		if block := rs.blocks[string(hash)]; block != nil {
+			client.commit(round, hash, block)
+		}
+		return
+	}
+	if round != rs.round {
+		return
+	}
+	prevotes := rs.getVotes(voteTypePrevote, round)
+	if rs.step == stepPrevote {
+		if hash, ok := prevotes.majority(quorum); ok {
+			//没有收到该区块时只能投nil
+			if hash != nil && rs.blocks[string(hash)] == nil {
+				hash = nil
+			}
+			client.doPrecommit(hash)
+		} else if prevotes.total() >= quorum {
+			client.scheduleTimeout(client.subcfg.TimeoutPrevoteMs, rs.height, round, stepPrevote)
+		}
+	}
+	if rs.step == stepPrecommit {
+		if ok {
+			//超过2/3 投了nil, 直接进入下一轮
+			client.enterNewRound(rs.height, round+1)
+		} else if precommits.total() >= quorum {
+			client.scheduleTimeout(client.subcfg.TimeoutPrecommitMs, rs.height, round, stepPrecommit)
+		}
+	}
+}
+
+func (client *Client) commit(round int32, hash []byte, block *types.Block) {
+	rs := client.rs
+	rs.step = stepCommit
+	blog.Info("bft commit", "height", rs.height, "round", round, "hash", common.ToHex(hash), "txs", len(block.Txs))
+	if client.GetCurrentHeight() < rs.height {
+		//提交证明和区块一起保存和同步, 其他节点通过提交证明检查区块
+		commit := &types.BftCommit{
+			Height:     rs.height,
+			Round:      round,
+			BlockHash:  hash,
+			Precommits: rs.getVotes(voteTypePrecommit, round).list(hash),
+		}
+		newblock := proto.Clone(block).(*types.Block)
+		newblock.ConsensusProof = types.Encode(commit)
+		lastBlock := client.GetCurrentBlock()
+		err := client.WriteBlock(lastBlock.StateHash, newblock)
+		if err != nil {
+			blog.Error("bft commit WriteBlock", "height", rs.height, "err", err)
+		}
+	}
+	client.scheduleTimeout(client.subcfg.TimeoutCommitMs, rs.height+1, 0, stepNewHeight)
+}
+
+//handleNewBlock 从其他节点同步到了当前高度的区块
+func (client *Client) handleNewBlock(block *types.Block) {
+	rs := client.rs
+	if block.Height < rs.height || (block.Height == rs.height && rs.step == stepCommit) {
+		return
+	}
+	rs.step = stepCommit
+	client.scheduleTimeout(client.subcfg.TimeoutCommitMs, block.Height+1, 0, stepNewHeight)
+}
+
+func (client *Client) scheduleTimeout(ms int64, height int64, round int32, step int) {
+	ti := timeoutInfo{height: height, round: round, step: step}
+	if client.rs.scheduled[ti] {
+		return
+	}
+	client.rs.scheduled[ti] = true
+	if step != stepNewHeight {
+		ms += int64(round) * client.subcfg.TimeoutDeltaMs
+	}
+	time.AfterFunc(time.Duration(ms)*time.Millisecond, func() {
+		select {
+		case client.timeoutC <- ti:
+		case <-client.quit:
+		}
+	})
+}
+
+func (client *Client) handleTimeout(ti timeoutInfo) {
+	rs := client.rs
+	if ti.step == stepNewHeight {
+		if ti.height > rs.height {
+			client.enterNewHeight(ti.height)
+		}
+		return
+	}
+	if ti.height != rs.height || ti.round != rs.round || ti.step != rs.step {
+		return
+	}
+	switch ti.step {
+	case stepPropose:
+		client.doPrevote()
+	case stepPrevote:
+		client.doPrecommit(nil)
+	case stepPrecommit:
+		client.enterNewRound(rs.height, rs.round+1)
+	}
+}
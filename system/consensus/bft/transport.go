@@ -0,0 +1,58 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bft
+
+import (
+	"errors"
+
+	"github.com/turingchain2020/turingchain/types"
+)
+
+const bftTopic = "consensus/bft"
+
+//transport 共识消息的广播通道
+type transport interface {
+	start() error
+	broadcast(msg *types.BftMessage) error
+}
+
+//newTransport 默认通过p2p 的订阅发布广播共识消息, 测试时可以替换
+var newTransport = func(client *Client) transport {
+	return &p2pTransport{client: client}
+}
+
+type p2pTransport struct {
+	client *Client
+}
+
+//start 订阅共识消息, 收到的消息由p2p 以EventReceiveSubData 发送给consensus 模块
+func (t *p2pTransport) start() error {
+	return t.request(types.EventSubTopic, &types.SubTopic{Topic: bftTopic, Module: "consensus"})
+}
+
+func (t *p2pTransport) broadcast(msg *types.BftMessage) error {
+	return t.request(types.EventPubTopicMsg, &types.PublishTopicMsg{Topic: bftTopic, Msg: types.Encode(msg)})
+}
+
+func (t *p2pTransport) request(ty int64, data types.Message) error {
+	qclient := t.client.GetQueueClient()
+	msg := qclient.NewMessage("p2p", ty, data)
+	err := qclient.Send(msg, true)
+	if err != nil {
+		return err
+	}
+	resp, err := qclient.Wait(msg)
+	if err != nil {
+		return err
+	}
+	reply, ok := resp.GetData().(*types.Reply)
+	if !ok {
+		return types.ErrTypeAsset
+	}
+	if !reply.GetIsOk() {
+		return errors.New(string(reply.GetMsg()))
+	}
+	return nil
+}
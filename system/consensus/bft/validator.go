@@ -0,0 +1,143 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bft
+
+import (
+	"errors"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+//validatorSet 验证节点集合, 每个节点的投票权重相同
+type validatorSet struct {
+	pubkeys [][]byte
+	index   map[string]int
+}
+
+func newValidatorSet(keys []string) (*validatorSet, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("validators is empty")
+	}
+	vs := &validatorSet{index: make(map[string]int)}
+	for _, key := range keys {
+		pub, err := common.FromHex(key)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := vs.index[string(pub)]; ok {
+			return nil, errors.New("validator repeat: " + key)
+		}
+		vs.index[string(pub)] = len(vs.pubkeys)
+		vs.pubkeys = append(vs.pubkeys, pub)
+	}
+	return vs, nil
+}
+
+func (vs *validatorSet) size() int {
+	return len(vs.pubkeys)
+}
+
+//quorum 超过2/3的节点数
+func (vs *validatorSet) quorum() int {
+	return vs.size()*2/3 + 1
+}
+
+//faulty 最多容忍的拜占庭节点数, 超过这个数量的节点进入更高的轮次时跟随
+func (vs *validatorSet) faulty() int {
+	return (vs.size() - 1) / 3
+}
+
+func (vs *validatorSet) has(pubkey []byte) bool {
+	_, ok := vs.index[string(pubkey)]
+	return ok
+}
+
+//proposer 每个高度和轮次轮流作为提议者
+func (vs *validatorSet) proposer(height int64, round int32) []byte {
+	n := int64(vs.size())
+	return vs.pubkeys[(height+int64(round))%n]
+}
+
+//verifyCommit 检查提交证明, 需要超过2/3 的验证节点在同一轮次对该区块投了precommit
+func (vs *validatorSet) verifyCommit(commit *types.BftCommit, height int64, hash []byte) error {
+	if commit.GetHeight() != height || string(commit.GetBlockHash()) != string(hash) {
+		return errInvalidCommit
+	}
+	signers := make(map[string]bool)
+	for _, msg := range commit.GetPrecommits() {
+		vote := msg.GetVote()
+		pub := msg.GetSignature().GetPubkey()
+		if vote == nil || vote.Type != voteTypePrecommit || vote.Height != height || vote.Round != commit.Round ||
+			string(vote.BlockHash) != string(hash) {
+			return errInvalidCommit
+		}
+		if !vs.has(pub) {
+			return errNotValidator
+		}
+		if signers[string(pub)] {
+			return errInvalidCommit
+		}
+		if !types.CheckSign(types.Encode(vote), "", msg.GetSignature()) {
+			return errInvalidSignature
+		}
+		signers[string(pub)] = true
+	}
+	if len(signers) < vs.quorum() {
+		return errInvalidCommit
+	}
+	return nil
+}
+
+//voteSet 一个轮次中同一种类型的投票
+type voteSet struct {
+	votes map[string]*types.BftMessage
+	count map[string]int
+}
+
+func newVoteSet() *voteSet {
+	return &voteSet{
+		votes: make(map[string]*types.BftMessage),
+		count: make(map[string]int),
+	}
+}
+
+//add 每个验证节点只记录第一次投票, 重复或者冲突的投票忽略
+func (s *voteSet) add(msg *types.BftMessage) bool {
+	pub := string(msg.GetSignature().GetPubkey())
+	if _, ok := s.votes[pub]; ok {
+		return false
+	}
+	s.votes[pub] = msg
+	s.count[string(msg.GetVote().GetBlockHash())]++
+	return true
+}
+
+func (s *voteSet) total() int {
+	return len(s.votes)
+}
+
+//majority 获取超过quorum票数的区块hash, 返回nil hash 表示多数投了nil
+func (s *voteSet) majority(quorum int) ([]byte, bool) {
+	for hash, n := range s.count {
+		if n >= quorum {
+			if hash == "" {
+				return nil, true
+			}
+			return []byte(hash), true
+		}
+	}
+	return nil, false
+}
+
+//list 获取投给指定区块的所有投票
+func (s *voteSet) list(hash []byte) (msgs []*types.BftMessage) {
+	for _, msg := range s.votes {
+		if string(msg.GetVote().GetBlockHash()) == string(hash) {
+			msgs = append(msgs, msg)
+		}
+	}
+	return msgs
+}
@@ -7,5 +7,6 @@ package init
 
 import (
 	//初始化
+	_ "github.com/turingchain2020/turingchain/system/consensus/bft"
 	_ "github.com/turingchain2020/turingchain/system/consensus/solo"
 )
@@ -28,6 +28,12 @@ type Client struct {
 func init() {
 	drivers.Reg("solo", New)
 	drivers.QueryData.Register("solo", &Client{})
+	types.RegisterLightHeaderCheck("solo", checkLightHeader)
+}
+
+//checkLightHeader solo 的区块没有出块节点的签名, 任何节点都可以构造, 轻节点无法验证
+func checkLightHeader(cfg *types.TuringchainConfig, parent, header *types.Header) error {
+	return types.ErrNotSupport
 }
 
 type subConfig struct {
@@ -18,6 +18,7 @@ EventTransfer -> 转移资产
 
 import (
 	drivers "github.com/turingchain2020/turingchain/system/dapp"
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -65,6 +66,16 @@ func (c *Coins) GetDriverName() string {
 // CheckTx check transaction amount 必须不能为负数
 func (c *Coins) CheckTx(tx *types.Transaction, index int) error {
 	ety := c.GetExecutorType()
+	_, v, err := ety.DecodePayloadValue(tx)
+	if err != nil {
+		return err
+	}
+	if multi, ok := v.Interface().(*cty.AssetsTransferMulti); ok {
+		return multi.Check()
+	}
+	if lock, ok := v.Interface().(*cty.AssetsTimeLock); ok {
+		return lock.Check()
+	}
 	amount, err := ety.Amount(tx)
 	if err != nil {
 		return err
@@ -7,6 +7,7 @@ package executor
 import (
 	"github.com/turingchain2020/turingchain/common/address"
 	drivers "github.com/turingchain2020/turingchain/system/dapp"
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -50,6 +51,70 @@ func (c *Coins) Exec_Withdraw(withdraw *types.AssetsWithdraw, tx *types.Transact
 	return nil, types.ErrActionNotSupport
 }
 
+// Exec_TransferMulti 一笔交易转账给多个地址, 任意一笔转账失败时整个交易失败
+func (c *Coins) Exec_TransferMulti(multi *cty.AssetsTransferMulti, tx *types.Transaction, index int) (*types.Receipt, error) {
+	types.AssertConfig(c.GetAPI())
+	cfg := c.GetAPI().GetConfig()
+	if !cfg.IsDappFork(c.GetHeight(), driverName, "ForkTransferMulti") {
+		return nil, types.ErrActionNotSupport
+	}
+	err := multi.Check()
+	if err != nil {
+		return nil, err
+	}
+	from := tx.From()
+	acc := c.GetCoinsAccount()
+	//先检查总金额, 余额不足时不需要逐笔执行
+	if acc.LoadAccount(from).GetBalance() < multi.GetAmount() {
+		return nil, types.ErrNoBalance
+	}
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	for _, transfer := range multi.Transfers {
+		var r *types.Receipt
+		if drivers.IsDriverAddress(transfer.To, c.GetHeight()) {
+			r, err = acc.TransferToExec(from, transfer.To, transfer.Amount)
+		} else {
+			r, err = acc.Transfer(from, transfer.To, transfer.Amount)
+		}
+		if err != nil {
+			return nil, err
+		}
+		receipt.KV = append(receipt.KV, r.KV...)
+		receipt.Logs = append(receipt.Logs, r.Logs...)
+	}
+	return receipt, nil
+}
+
+// Exec_TimeLock 锁定资金到beneficiary 的冻结余额, 按照阶段解锁
+func (c *Coins) Exec_TimeLock(lock *cty.AssetsTimeLock, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !c.isTimeLockFork() {
+		return nil, types.ErrActionNotSupport
+	}
+	return c.timeLock(lock, tx)
+}
+
+// Exec_TimeLockClaim 领取已经解锁的资金
+func (c *Coins) Exec_TimeLockClaim(claim *cty.AssetsTimeLockClaim, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !c.isTimeLockFork() {
+		return nil, types.ErrActionNotSupport
+	}
+	return c.timeLockClaim(claim, tx)
+}
+
+// Exec_TimeLockCancel 创建者撤销可以撤销的锁定
+func (c *Coins) Exec_TimeLockCancel(cancel *cty.AssetsTimeLockCancel, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if !c.isTimeLockFork() {
+		return nil, types.ErrActionNotSupport
+	}
+	return c.timeLockCancel(cancel, tx)
+}
+
+func (c *Coins) isTimeLockFork() bool {
+	types.AssertConfig(c.GetAPI())
+	cfg := c.GetAPI().GetConfig()
+	return cfg.IsDappFork(c.GetHeight(), driverName, "ForkTimeLock")
+}
+
 // Exec_Genesis genesis of exec
 func (c *Coins) Exec_Genesis(genesis *types.AssetsGenesis, tx *types.Transaction, index int) (*types.Receipt, error) {
 	if c.GetHeight() == 0 {
@@ -5,6 +5,7 @@
 package executor
 
 import (
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -35,3 +36,31 @@ func (c *Coins) ExecDelLocal_Withdraw(withdraw *types.AssetsWithdraw, tx *types.
 	}
 	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
 }
+
+// ExecDelLocal_TransferMulti delete transfer multi of local exec
+func (c *Coins) ExecDelLocal_TransferMulti(multi *cty.AssetsTransferMulti, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{}
+	for _, transfer := range multi.Transfers {
+		kv, err := updateAddrReciver(c.GetLocalDB(), transfer.To, transfer.Amount, false)
+		if err != nil {
+			return nil, err
+		}
+		set.KV = append(set.KV, kv)
+	}
+	return set, nil
+}
+
+// ExecDelLocal_TimeLock 回滚创建锁定时更新的地址索引
+func (c *Coins) ExecDelLocal_TimeLock(payload *cty.AssetsTimeLock, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: timeLockIndexKV(receipt, true)}, nil
+}
+
+// ExecDelLocal_TimeLockClaim 回滚领取时更新的地址索引
+func (c *Coins) ExecDelLocal_TimeLockClaim(payload *cty.AssetsTimeLockClaim, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: timeLockIndexKV(receipt, true)}, nil
+}
+
+// ExecDelLocal_TimeLockCancel 回滚撤销时更新的地址索引
+func (c *Coins) ExecDelLocal_TimeLockCancel(payload *cty.AssetsTimeLockCancel, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: timeLockIndexKV(receipt, true)}, nil
+}
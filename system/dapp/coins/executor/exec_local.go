@@ -5,6 +5,7 @@
 package executor
 
 import (
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -44,3 +45,31 @@ func (c *Coins) ExecLocal_Genesis(gen *types.AssetsGenesis, tx *types.Transactio
 	}
 	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
 }
+
+// ExecLocal_TransferMulti 更新每个收款地址的收款金额
+func (c *Coins) ExecLocal_TransferMulti(multi *cty.AssetsTransferMulti, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	set := &types.LocalDBSet{}
+	for _, transfer := range multi.Transfers {
+		kv, err := updateAddrReciver(c.GetLocalDB(), transfer.To, transfer.Amount, true)
+		if err != nil {
+			return nil, err
+		}
+		set.KV = append(set.KV, kv)
+	}
+	return set, nil
+}
+
+// ExecLocal_TimeLock 创建锁定之后建立地址到未结束锁定的索引
+func (c *Coins) ExecLocal_TimeLock(payload *cty.AssetsTimeLock, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: timeLockIndexKV(receipt, false)}, nil
+}
+
+// ExecLocal_TimeLockClaim 领取之后锁定结束时删除地址索引
+func (c *Coins) ExecLocal_TimeLockClaim(payload *cty.AssetsTimeLockClaim, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: timeLockIndexKV(receipt, false)}, nil
+}
+
+// ExecLocal_TimeLockCancel 撤销之后锁定结束时删除地址索引
+func (c *Coins) ExecLocal_TimeLockCancel(payload *cty.AssetsTimeLockCancel, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: timeLockIndexKV(receipt, false)}, nil
+}
@@ -5,6 +5,7 @@
 package executor
 
 import (
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -28,6 +29,46 @@ func (c *Coins) Query_GetAddrTxsCount(in *types.ReqKey) (types.Message, error) {
 	return c.GetAddrTxsCount(in)
 }
 
+// Query_GetNonce query the nonce of address, 开启ForkTxNonce 之后下一笔交易需要使用的nonce
+func (c *Coins) Query_GetNonce(in *types.ReqString) (types.Message, error) {
+	nonce := c.GetCoinsAccount().LoadNonce(in.Data)
+	return &types.Int64{Data: nonce}, nil
+}
+
+// Query_GetTimeLock query time lock by lock id
+func (c *Coins) Query_GetTimeLock(in *types.ReqString) (types.Message, error) {
+	return getTimeLock(c.GetStateDB(), in.Data)
+}
+
+// Query_GetTimeLocksByAddr query pending time locks of address, 包括作为创建者和beneficiary 的锁定
+func (c *Coins) Query_GetTimeLocksByAddr(in *cty.ReqTimeLocks) (types.Message, error) {
+	count := in.Count
+	if count <= 0 || count > MaxTimeLockCount {
+		count = MaxTimeLockCount
+	}
+	var key []byte
+	if in.LockID != "" {
+		info, err := getTimeLock(c.GetStateDB(), in.LockID)
+		if err != nil {
+			return nil, err
+		}
+		key = calcTimeLockAddrKey(in.Addr, info.Height, info.LockID)
+	}
+	values, err := c.GetLocalDB().List(calcTimeLockAddrPrefix(in.Addr), key, count, in.Direction)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &cty.ReplyTimeLocks{}
+	for _, id := range values {
+		info, err := getTimeLock(c.GetStateDB(), string(id))
+		if err != nil {
+			return nil, err
+		}
+		reply.Locks = append(reply.Locks, info)
+	}
+	return reply, nil
+}
+
 // GetAddrReciver get address reciver by address
 func (c *Coins) GetAddrReciver(addr *types.ReqAddr) (types.Message, error) {
 	reciver := types.Int64{}
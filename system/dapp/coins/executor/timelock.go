@@ -0,0 +1,196 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+// MaxTimeLockCount 一次查询最多返回的锁定数量
+const MaxTimeLockCount = 100
+
+func calcTimeLockKey(id string) []byte {
+	return []byte(fmt.Sprintf("mavl-coins-timelock-%s", id))
+}
+
+//calcTimeLockAddrKey 地址到未结束锁定的索引, 按照创建高度排序
+func calcTimeLockAddrKey(addr string, height int64, id string) []byte {
+	return []byte(fmt.Sprintf("LODB-coins-timelock:%s:%020d:%s", addr, height, id))
+}
+
+func calcTimeLockAddrPrefix(addr string) []byte {
+	return []byte(fmt.Sprintf("LODB-coins-timelock:%s:", addr))
+}
+
+func getTimeLock(db dbm.KV, id string) (*cty.TimeLockInfo, error) {
+	value, err := db.Get(calcTimeLockKey(id))
+	if err != nil || len(value) == 0 {
+		return nil, cty.ErrTimeLockNotExist
+	}
+	var info cty.TimeLockInfo
+	err = types.Decode(value, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (c *Coins) timeLockReceipt(ty int32, prev, current *cty.TimeLockInfo) *types.Receipt {
+	value := types.Encode(current)
+	c.GetStateDB().Set(calcTimeLockKey(current.LockID), value)
+	log := &cty.ReceiptTimeLock{Prev: prev, Current: current}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: calcTimeLockKey(current.LockID), Value: value}},
+		Logs: []*types.ReceiptLog{{Ty: ty, Log: types.Encode(log)}},
+	}
+}
+
+func mergeReceipt(receipt, receipt2 *types.Receipt) *types.Receipt {
+	receipt.KV = append(receipt.KV, receipt2.KV...)
+	receipt.Logs = append(receipt.Logs, receipt2.Logs...)
+	return receipt
+}
+
+//timeLock from 的可用余额转入beneficiary 的冻结余额, 锁定id 为交易hash
+func (c *Coins) timeLock(lock *cty.AssetsTimeLock, tx *types.Transaction) (*types.Receipt, error) {
+	err := lock.Check()
+	if err != nil {
+		return nil, err
+	}
+	from := tx.From()
+	beneficiary := lock.Beneficiary
+	if beneficiary == "" {
+		beneficiary = from
+	}
+	receipt, err := c.GetCoinsAccount().TransferToFrozen(from, beneficiary, lock.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+	info := &cty.TimeLockInfo{
+		LockID:      hex.EncodeToString(tx.Hash()),
+		From:        from,
+		Beneficiary: beneficiary,
+		Stages:      lock.Stages,
+		Cancelable:  lock.Cancelable,
+		Total:       lock.GetAmount(),
+		Status:      cty.TimeLockStatusLocked,
+		Height:      c.GetHeight(),
+		BlockTime:   c.GetBlockTime(),
+		Note:        lock.Note,
+	}
+	return mergeReceipt(receipt, c.timeLockReceipt(cty.TyLogTimeLockCreate, nil, info)), nil
+}
+
+//claimUnlocked 把已经解锁并且未领取的阶段标记为已领取, 返回领取的金额
+func (c *Coins) claimUnlocked(info *cty.TimeLockInfo) int64 {
+	var amount int64
+	for _, stage := range info.Stages {
+		if !stage.Claimed && stage.IsUnlocked(c.GetHeight(), c.GetBlockTime()) {
+			stage.Claimed = true
+			amount += stage.Amount
+		}
+	}
+	info.Claimed += amount
+	return amount
+}
+
+//timeLockClaim beneficiary 或者创建者领取已经解锁的资金, 资金总是解冻到beneficiary
+func (c *Coins) timeLockClaim(claim *cty.AssetsTimeLockClaim, tx *types.Transaction) (*types.Receipt, error) {
+	info, err := getTimeLock(c.GetStateDB(), claim.LockID)
+	if err != nil {
+		return nil, err
+	}
+	if info.Status != cty.TimeLockStatusLocked {
+		return nil, cty.ErrTimeLockStatus
+	}
+	from := tx.From()
+	if from != info.Beneficiary && from != info.From {
+		return nil, types.ErrFromAddr
+	}
+	prev := types.Clone(info).(*cty.TimeLockInfo)
+	amount := c.claimUnlocked(info)
+	if amount == 0 {
+		return nil, cty.ErrTimeLockNoUnlocked
+	}
+	receipt, err := c.GetCoinsAccount().TransferFrozen(info.Beneficiary, info.Beneficiary, amount)
+	if err != nil {
+		return nil, err
+	}
+	if info.Claimed == info.Total {
+		info.Status = cty.TimeLockStatusFinished
+	}
+	return mergeReceipt(receipt, c.timeLockReceipt(cty.TyLogTimeLockClaim, prev, info)), nil
+}
+
+//timeLockCancel 创建者撤销锁定, 已经解锁的部分解冻给beneficiary, 剩余部分退回创建者
+func (c *Coins) timeLockCancel(cancel *cty.AssetsTimeLockCancel, tx *types.Transaction) (*types.Receipt, error) {
+	info, err := getTimeLock(c.GetStateDB(), cancel.LockID)
+	if err != nil {
+		return nil, err
+	}
+	if info.Status != cty.TimeLockStatusLocked {
+		return nil, cty.ErrTimeLockStatus
+	}
+	if tx.From() != info.From {
+		return nil, types.ErrFromAddr
+	}
+	if !info.Cancelable {
+		return nil, cty.ErrTimeLockNotCancelable
+	}
+	prev := types.Clone(info).(*cty.TimeLockInfo)
+	acc := c.GetCoinsAccount()
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if amount := c.claimUnlocked(info); amount > 0 {
+		r, err := acc.TransferFrozen(info.Beneficiary, info.Beneficiary, amount)
+		if err != nil {
+			return nil, err
+		}
+		mergeReceipt(receipt, r)
+	}
+	if remain := info.Total - info.Claimed; remain > 0 {
+		r, err := acc.TransferFrozen(info.Beneficiary, info.From, remain)
+		if err != nil {
+			return nil, err
+		}
+		mergeReceipt(receipt, r)
+	}
+	info.Status = cty.TimeLockStatusCanceled
+	return mergeReceipt(receipt, c.timeLockReceipt(cty.TyLogTimeLockCancel, prev, info)), nil
+}
+
+//timeLockIndexKV 锁定创建时为from 和beneficiary 建立索引, 结束或撤销时删除, rollback 时反向操作
+func timeLockIndexKV(receipt *types.ReceiptData, rollback bool) []*types.KeyValue {
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != cty.TyLogTimeLockCreate && item.Ty != cty.TyLogTimeLockClaim && item.Ty != cty.TyLogTimeLockCancel {
+			continue
+		}
+		var log cty.ReceiptTimeLock
+		err := types.Decode(item.Log, &log)
+		if err != nil {
+			panic(err) //数据错误了，已经被修改了
+		}
+		info := log.Current
+		pending := log.Prev.GetStatus() == cty.TimeLockStatusLocked
+		if (info.Status == cty.TimeLockStatusLocked) == pending {
+			continue
+		}
+		var value []byte
+		if !pending != rollback {
+			value = []byte(info.LockID)
+		}
+		kvs = append(kvs, &types.KeyValue{Key: calcTimeLockAddrKey(info.From, info.Height, info.LockID), Value: value})
+		if info.Beneficiary != info.From {
+			kvs = append(kvs, &types.KeyValue{Key: calcTimeLockAddrKey(info.Beneficiary, info.Height, info.LockID), Value: value})
+		}
+	}
+	return kvs
+}
@@ -7,8 +7,8 @@ import (
 	fmt "fmt"
 	math "math"
 
-	types "github.com/turingchain2020/turingchain/types"
 	proto "github.com/golang/protobuf/proto"
+	types "github.com/turingchain2020/turingchain/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -29,6 +29,10 @@ type CoinsAction struct {
 	//	*CoinsAction_Withdraw
 	//	*CoinsAction_Genesis
 	//	*CoinsAction_TransferToExec
+	//	*CoinsAction_TransferMulti
+	//	*CoinsAction_TimeLock
+	//	*CoinsAction_TimeLockClaim
+	//	*CoinsAction_TimeLockCancel
 	Value                isCoinsAction_Value `protobuf_oneof:"value"`
 	Ty                   int32               `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -81,6 +85,22 @@ type CoinsAction_TransferToExec struct {
 	TransferToExec *types.AssetsTransferToExec `protobuf:"bytes,5,opt,name=transferToExec,proto3,oneof"`
 }
 
+type CoinsAction_TransferMulti struct {
+	TransferMulti *AssetsTransferMulti `protobuf:"bytes,6,opt,name=transferMulti,proto3,oneof"`
+}
+
+type CoinsAction_TimeLock struct {
+	TimeLock *AssetsTimeLock `protobuf:"bytes,7,opt,name=timeLock,proto3,oneof"`
+}
+
+type CoinsAction_TimeLockClaim struct {
+	TimeLockClaim *AssetsTimeLockClaim `protobuf:"bytes,8,opt,name=timeLockClaim,proto3,oneof"`
+}
+
+type CoinsAction_TimeLockCancel struct {
+	TimeLockCancel *AssetsTimeLockCancel `protobuf:"bytes,9,opt,name=timeLockCancel,proto3,oneof"`
+}
+
 func (*CoinsAction_Transfer) isCoinsAction_Value() {}
 
 func (*CoinsAction_Withdraw) isCoinsAction_Value() {}
@@ -89,6 +109,14 @@ func (*CoinsAction_Genesis) isCoinsAction_Value() {}
 
 func (*CoinsAction_TransferToExec) isCoinsAction_Value() {}
 
+func (*CoinsAction_TransferMulti) isCoinsAction_Value() {}
+
+func (*CoinsAction_TimeLock) isCoinsAction_Value() {}
+
+func (*CoinsAction_TimeLockClaim) isCoinsAction_Value() {}
+
+func (*CoinsAction_TimeLockCancel) isCoinsAction_Value() {}
+
 func (m *CoinsAction) GetValue() isCoinsAction_Value {
 	if m != nil {
 		return m.Value
@@ -124,6 +152,34 @@ func (m *CoinsAction) GetTransferToExec() *types.AssetsTransferToExec {
 	return nil
 }
 
+func (m *CoinsAction) GetTransferMulti() *AssetsTransferMulti {
+	if x, ok := m.GetValue().(*CoinsAction_TransferMulti); ok {
+		return x.TransferMulti
+	}
+	return nil
+}
+
+func (m *CoinsAction) GetTimeLock() *AssetsTimeLock {
+	if x, ok := m.GetValue().(*CoinsAction_TimeLock); ok {
+		return x.TimeLock
+	}
+	return nil
+}
+
+func (m *CoinsAction) GetTimeLockClaim() *AssetsTimeLockClaim {
+	if x, ok := m.GetValue().(*CoinsAction_TimeLockClaim); ok {
+		return x.TimeLockClaim
+	}
+	return nil
+}
+
+func (m *CoinsAction) GetTimeLockCancel() *AssetsTimeLockCancel {
+	if x, ok := m.GetValue().(*CoinsAction_TimeLockCancel); ok {
+		return x.TimeLockCancel
+	}
+	return nil
+}
+
 func (m *CoinsAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -138,11 +194,540 @@ func (*CoinsAction) XXX_OneofWrappers() []interface{} {
 		(*CoinsAction_Withdraw)(nil),
 		(*CoinsAction_Genesis)(nil),
 		(*CoinsAction_TransferToExec)(nil),
+		(*CoinsAction_TransferMulti)(nil),
+		(*CoinsAction_TimeLock)(nil),
+		(*CoinsAction_TimeLockClaim)(nil),
+		(*CoinsAction_TimeLockCancel)(nil),
+	}
+}
+
+// 一笔交易转账给多个地址, 每个地址的to, amount, note 在transfers 中设置
+type AssetsTransferMulti struct {
+	Transfers            []*types.AssetsTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *AssetsTransferMulti) Reset()         { *m = AssetsTransferMulti{} }
+func (m *AssetsTransferMulti) String() string { return proto.CompactTextString(m) }
+func (*AssetsTransferMulti) ProtoMessage()    {}
+func (*AssetsTransferMulti) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{1}
+}
+
+func (m *AssetsTransferMulti) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssetsTransferMulti.Unmarshal(m, b)
+}
+func (m *AssetsTransferMulti) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssetsTransferMulti.Marshal(b, m, deterministic)
+}
+func (m *AssetsTransferMulti) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetsTransferMulti.Merge(m, src)
+}
+func (m *AssetsTransferMulti) XXX_Size() int {
+	return xxx_messageInfo_AssetsTransferMulti.Size(m)
+}
+func (m *AssetsTransferMulti) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetsTransferMulti.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetsTransferMulti proto.InternalMessageInfo
+
+func (m *AssetsTransferMulti) GetTransfers() []*types.AssetsTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+// 锁定资金, 按照stages 分阶段解锁给beneficiary, beneficiary 为空时锁定给自己
+type AssetsTimeLock struct {
+	Stages      []*TimeLockStage `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+	Beneficiary string           `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// 创建者是否可以撤销, 撤销时已经解锁的部分仍然归beneficiary
+	Cancelable           bool     `protobuf:"varint,3,opt,name=cancelable,proto3" json:"cancelable,omitempty"`
+	Note                 string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssetsTimeLock) Reset()         { *m = AssetsTimeLock{} }
+func (m *AssetsTimeLock) String() string { return proto.CompactTextString(m) }
+func (*AssetsTimeLock) ProtoMessage()    {}
+func (*AssetsTimeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{2}
+}
+
+func (m *AssetsTimeLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssetsTimeLock.Unmarshal(m, b)
+}
+func (m *AssetsTimeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssetsTimeLock.Marshal(b, m, deterministic)
+}
+func (m *AssetsTimeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetsTimeLock.Merge(m, src)
+}
+func (m *AssetsTimeLock) XXX_Size() int {
+	return xxx_messageInfo_AssetsTimeLock.Size(m)
+}
+func (m *AssetsTimeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetsTimeLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetsTimeLock proto.InternalMessageInfo
+
+func (m *AssetsTimeLock) GetStages() []*TimeLockStage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *AssetsTimeLock) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
 	}
+	return ""
+}
+
+func (m *AssetsTimeLock) GetCancelable() bool {
+	if m != nil {
+		return m.Cancelable
+	}
+	return false
+}
+
+func (m *AssetsTimeLock) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// 一个解锁阶段, unlockHeight 和unlockTime 都设置时需要同时满足
+type TimeLockStage struct {
+	Amount               int64    `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	UnlockHeight         int64    `protobuf:"varint,2,opt,name=unlockHeight,proto3" json:"unlockHeight,omitempty"`
+	UnlockTime           int64    `protobuf:"varint,3,opt,name=unlockTime,proto3" json:"unlockTime,omitempty"`
+	Claimed              bool     `protobuf:"varint,4,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeLockStage) Reset()         { *m = TimeLockStage{} }
+func (m *TimeLockStage) String() string { return proto.CompactTextString(m) }
+func (*TimeLockStage) ProtoMessage()    {}
+func (*TimeLockStage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{3}
+}
+
+func (m *TimeLockStage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeLockStage.Unmarshal(m, b)
+}
+func (m *TimeLockStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeLockStage.Marshal(b, m, deterministic)
+}
+func (m *TimeLockStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeLockStage.Merge(m, src)
+}
+func (m *TimeLockStage) XXX_Size() int {
+	return xxx_messageInfo_TimeLockStage.Size(m)
+}
+func (m *TimeLockStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeLockStage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeLockStage proto.InternalMessageInfo
+
+func (m *TimeLockStage) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TimeLockStage) GetUnlockHeight() int64 {
+	if m != nil {
+		return m.UnlockHeight
+	}
+	return 0
+}
+
+func (m *TimeLockStage) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+func (m *TimeLockStage) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
+	}
+	return false
+}
+
+type AssetsTimeLockClaim struct {
+	LockID               string   `protobuf:"bytes,1,opt,name=lockID,proto3" json:"lockID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssetsTimeLockClaim) Reset()         { *m = AssetsTimeLockClaim{} }
+func (m *AssetsTimeLockClaim) String() string { return proto.CompactTextString(m) }
+func (*AssetsTimeLockClaim) ProtoMessage()    {}
+func (*AssetsTimeLockClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{4}
+}
+
+func (m *AssetsTimeLockClaim) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssetsTimeLockClaim.Unmarshal(m, b)
+}
+func (m *AssetsTimeLockClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssetsTimeLockClaim.Marshal(b, m, deterministic)
+}
+func (m *AssetsTimeLockClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetsTimeLockClaim.Merge(m, src)
+}
+func (m *AssetsTimeLockClaim) XXX_Size() int {
+	return xxx_messageInfo_AssetsTimeLockClaim.Size(m)
+}
+func (m *AssetsTimeLockClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetsTimeLockClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetsTimeLockClaim proto.InternalMessageInfo
+
+func (m *AssetsTimeLockClaim) GetLockID() string {
+	if m != nil {
+		return m.LockID
+	}
+	return ""
+}
+
+type AssetsTimeLockCancel struct {
+	LockID               string   `protobuf:"bytes,1,opt,name=lockID,proto3" json:"lockID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssetsTimeLockCancel) Reset()         { *m = AssetsTimeLockCancel{} }
+func (m *AssetsTimeLockCancel) String() string { return proto.CompactTextString(m) }
+func (*AssetsTimeLockCancel) ProtoMessage()    {}
+func (*AssetsTimeLockCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{5}
+}
+
+func (m *AssetsTimeLockCancel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssetsTimeLockCancel.Unmarshal(m, b)
+}
+func (m *AssetsTimeLockCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssetsTimeLockCancel.Marshal(b, m, deterministic)
+}
+func (m *AssetsTimeLockCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetsTimeLockCancel.Merge(m, src)
+}
+func (m *AssetsTimeLockCancel) XXX_Size() int {
+	return xxx_messageInfo_AssetsTimeLockCancel.Size(m)
+}
+func (m *AssetsTimeLockCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetsTimeLockCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetsTimeLockCancel proto.InternalMessageInfo
+
+func (m *AssetsTimeLockCancel) GetLockID() string {
+	if m != nil {
+		return m.LockID
+	}
+	return ""
+}
+
+type TimeLockInfo struct {
+	LockID               string           `protobuf:"bytes,1,opt,name=lockID,proto3" json:"lockID,omitempty"`
+	From                 string           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Beneficiary          string           `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Stages               []*TimeLockStage `protobuf:"bytes,4,rep,name=stages,proto3" json:"stages,omitempty"`
+	Cancelable           bool             `protobuf:"varint,5,opt,name=cancelable,proto3" json:"cancelable,omitempty"`
+	Total                int64            `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Claimed              int64            `protobuf:"varint,7,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	Status               int32            `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	Height               int64            `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	BlockTime            int64            `protobuf:"varint,10,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	Note                 string           `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TimeLockInfo) Reset()         { *m = TimeLockInfo{} }
+func (m *TimeLockInfo) String() string { return proto.CompactTextString(m) }
+func (*TimeLockInfo) ProtoMessage()    {}
+func (*TimeLockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{6}
+}
+
+func (m *TimeLockInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeLockInfo.Unmarshal(m, b)
+}
+func (m *TimeLockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeLockInfo.Marshal(b, m, deterministic)
+}
+func (m *TimeLockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeLockInfo.Merge(m, src)
+}
+func (m *TimeLockInfo) XXX_Size() int {
+	return xxx_messageInfo_TimeLockInfo.Size(m)
+}
+func (m *TimeLockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeLockInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeLockInfo proto.InternalMessageInfo
+
+func (m *TimeLockInfo) GetLockID() string {
+	if m != nil {
+		return m.LockID
+	}
+	return ""
+}
+
+func (m *TimeLockInfo) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TimeLockInfo) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *TimeLockInfo) GetStages() []*TimeLockStage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *TimeLockInfo) GetCancelable() bool {
+	if m != nil {
+		return m.Cancelable
+	}
+	return false
+}
+
+func (m *TimeLockInfo) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *TimeLockInfo) GetClaimed() int64 {
+	if m != nil {
+		return m.Claimed
+	}
+	return 0
+}
+
+func (m *TimeLockInfo) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *TimeLockInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TimeLockInfo) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *TimeLockInfo) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type ReceiptTimeLock struct {
+	Prev                 *TimeLockInfo `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *TimeLockInfo `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReceiptTimeLock) Reset()         { *m = ReceiptTimeLock{} }
+func (m *ReceiptTimeLock) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTimeLock) ProtoMessage()    {}
+func (*ReceiptTimeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{7}
+}
+
+func (m *ReceiptTimeLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTimeLock.Unmarshal(m, b)
+}
+func (m *ReceiptTimeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTimeLock.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTimeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTimeLock.Merge(m, src)
+}
+func (m *ReceiptTimeLock) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTimeLock.Size(m)
+}
+func (m *ReceiptTimeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTimeLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTimeLock proto.InternalMessageInfo
+
+func (m *ReceiptTimeLock) GetPrev() *TimeLockInfo {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTimeLock) GetCurrent() *TimeLockInfo {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+// 按地址查询还没有结束的锁定, from 和beneficiary 都可以查到
+type ReqTimeLocks struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	LockID               string   `protobuf:"bytes,4,opt,name=lockID,proto3" json:"lockID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTimeLocks) Reset()         { *m = ReqTimeLocks{} }
+func (m *ReqTimeLocks) String() string { return proto.CompactTextString(m) }
+func (*ReqTimeLocks) ProtoMessage()    {}
+func (*ReqTimeLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{8}
+}
+
+func (m *ReqTimeLocks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTimeLocks.Unmarshal(m, b)
+}
+func (m *ReqTimeLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTimeLocks.Marshal(b, m, deterministic)
+}
+func (m *ReqTimeLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTimeLocks.Merge(m, src)
+}
+func (m *ReqTimeLocks) XXX_Size() int {
+	return xxx_messageInfo_ReqTimeLocks.Size(m)
+}
+func (m *ReqTimeLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTimeLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTimeLocks proto.InternalMessageInfo
+
+func (m *ReqTimeLocks) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqTimeLocks) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqTimeLocks) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqTimeLocks) GetLockID() string {
+	if m != nil {
+		return m.LockID
+	}
+	return ""
+}
+
+type ReplyTimeLocks struct {
+	Locks                []*TimeLockInfo `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyTimeLocks) Reset()         { *m = ReplyTimeLocks{} }
+func (m *ReplyTimeLocks) String() string { return proto.CompactTextString(m) }
+func (*ReplyTimeLocks) ProtoMessage()    {}
+func (*ReplyTimeLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da4483c99519c66a, []int{9}
+}
+
+func (m *ReplyTimeLocks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTimeLocks.Unmarshal(m, b)
+}
+func (m *ReplyTimeLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTimeLocks.Marshal(b, m, deterministic)
+}
+func (m *ReplyTimeLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTimeLocks.Merge(m, src)
+}
+func (m *ReplyTimeLocks) XXX_Size() int {
+	return xxx_messageInfo_ReplyTimeLocks.Size(m)
+}
+func (m *ReplyTimeLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTimeLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTimeLocks proto.InternalMessageInfo
+
+func (m *ReplyTimeLocks) GetLocks() []*TimeLockInfo {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
 }
 
 func init() {
 	proto.RegisterType((*CoinsAction)(nil), "types.CoinsAction")
+	proto.RegisterType((*AssetsTransferMulti)(nil), "types.AssetsTransferMulti")
+	proto.RegisterType((*AssetsTimeLock)(nil), "types.AssetsTimeLock")
+	proto.RegisterType((*TimeLockStage)(nil), "types.TimeLockStage")
+	proto.RegisterType((*AssetsTimeLockClaim)(nil), "types.AssetsTimeLockClaim")
+	proto.RegisterType((*AssetsTimeLockCancel)(nil), "types.AssetsTimeLockCancel")
+	proto.RegisterType((*TimeLockInfo)(nil), "types.TimeLockInfo")
+	proto.RegisterType((*ReceiptTimeLock)(nil), "types.ReceiptTimeLock")
+	proto.RegisterType((*ReqTimeLocks)(nil), "types.ReqTimeLocks")
+	proto.RegisterType((*ReplyTimeLocks)(nil), "types.ReplyTimeLocks")
 }
 
 func init() {
@@ -150,19 +735,46 @@ func init() {
 }
 
 var fileDescriptor_da4483c99519c66a = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4e, 0xce, 0xcf, 0xcc,
-	0x2b, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x96, 0x12,
-	0x2c, 0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0x83, 0xc8, 0x28, 0x75, 0x32,
-	0x71, 0x71, 0x3b, 0x83, 0x54, 0x3a, 0x82, 0x45, 0x85, 0x8c, 0xb9, 0x38, 0xc0, 0x8a, 0xd2, 0x52,
-	0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x44, 0xf5, 0xc0, 0x9a, 0xf5, 0x1c, 0x8b, 0x8b,
-	0x53, 0x4b, 0x8a, 0x43, 0xa0, 0x92, 0x1e, 0x0c, 0x41, 0x70, 0x85, 0x20, 0x4d, 0xe5, 0x99, 0x25,
-	0x19, 0x29, 0x45, 0x89, 0xe5, 0x12, 0x2c, 0x58, 0x34, 0x85, 0x43, 0x25, 0x41, 0x9a, 0x60, 0x0a,
-	0x85, 0x0c, 0xb8, 0xd8, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0x25, 0x98, 0xc0, 0x7a, 0x44,
-	0x50, 0xf4, 0xb8, 0x43, 0xe4, 0x3c, 0x18, 0x82, 0x60, 0xca, 0x84, 0x5c, 0xb9, 0xf8, 0x60, 0x56,
-	0x86, 0xe4, 0xbb, 0x56, 0xa4, 0x26, 0x4b, 0xb0, 0x82, 0x35, 0x4a, 0x63, 0x75, 0x21, 0x44, 0x89,
-	0x07, 0x43, 0x10, 0x9a, 0x26, 0x21, 0x3e, 0x2e, 0xa6, 0x92, 0x4a, 0x09, 0x66, 0x05, 0x46, 0x0d,
-	0xd6, 0x20, 0xa6, 0x92, 0x4a, 0x27, 0x76, 0x2e, 0xd6, 0xb2, 0xc4, 0x9c, 0xd2, 0x54, 0x27, 0xf6,
-	0x28, 0x48, 0x38, 0x25, 0xb1, 0x81, 0xc3, 0xc6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x27,
-	0xc9, 0xb3, 0x44, 0x01, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x51, 0x6f, 0xd3, 0x3c,
+	0x14, 0x6d, 0x9b, 0xa6, 0x69, 0x6e, 0xb7, 0x7e, 0xfa, 0xbc, 0x31, 0x45, 0x03, 0xa1, 0x2a, 0x2f,
+	0x0c, 0x89, 0x55, 0x88, 0x3d, 0xf2, 0xb4, 0x8d, 0x89, 0x0e, 0xc1, 0x8b, 0x99, 0x84, 0xc4, 0x9b,
+	0x9b, 0xba, 0x9b, 0x45, 0x1a, 0x97, 0xd8, 0xd9, 0xe8, 0x0f, 0xe0, 0x91, 0x37, 0x7e, 0x0e, 0x3f,
+	0x0e, 0xf9, 0xc6, 0x6e, 0x93, 0x2e, 0x95, 0x78, 0xf3, 0x3d, 0xf7, 0x5c, 0xfb, 0xfa, 0x9c, 0xeb,
+	0x04, 0x06, 0x89, 0x14, 0x99, 0x1a, 0x2f, 0x73, 0xa9, 0x25, 0xf1, 0xf5, 0x6a, 0xc9, 0xd5, 0xf1,
+	0xff, 0x3a, 0x67, 0x99, 0x62, 0x89, 0x16, 0x32, 0x2b, 0x33, 0xf1, 0xaf, 0x2e, 0x0c, 0x2e, 0x0d,
+	0xf3, 0x1c, 0x51, 0x72, 0x06, 0x7d, 0x24, 0xcd, 0x79, 0x1e, 0xb5, 0x47, 0xed, 0x93, 0xc1, 0x9b,
+	0x27, 0x63, 0x2c, 0x1e, 0x9f, 0x2b, 0xc5, 0xb5, 0xba, 0xb1, 0xc9, 0x49, 0x8b, 0xae, 0x89, 0xa6,
+	0xe8, 0x41, 0xe8, 0xbb, 0x59, 0xce, 0x1e, 0xa2, 0x6e, 0x43, 0xd1, 0x17, 0x9b, 0x34, 0x45, 0x8e,
+	0x48, 0x5e, 0x43, 0x70, 0xcb, 0x33, 0xae, 0x84, 0x8a, 0x3a, 0x58, 0x73, 0x58, 0xab, 0x79, 0x5f,
+	0xe6, 0x26, 0x2d, 0xea, 0x68, 0xe4, 0x0a, 0x86, 0xee, 0xc8, 0x1b, 0x79, 0xf5, 0x83, 0x27, 0x91,
+	0x8f, 0x85, 0x4f, 0x1b, 0x3b, 0x2c, 0x29, 0x93, 0x16, 0xdd, 0x2a, 0x22, 0x17, 0xb0, 0xef, 0x90,
+	0x4f, 0x45, 0xaa, 0x45, 0xd4, 0xc3, 0x5d, 0x8e, 0x1b, 0x77, 0x41, 0xc6, 0xa4, 0x45, 0xeb, 0x25,
+	0x28, 0x93, 0x58, 0xf0, 0x8f, 0x32, 0xf9, 0x16, 0x05, 0x4d, 0x32, 0xd9, 0x24, 0xca, 0x64, 0xd7,
+	0x78, 0xb0, 0x5d, 0x5f, 0xa6, 0x4c, 0x2c, 0xa2, 0x7e, 0xd3, 0xc1, 0x55, 0x06, 0x1e, 0x5c, 0x05,
+	0x50, 0x03, 0x07, 0xb0, 0x2c, 0xe1, 0x69, 0x14, 0x36, 0x69, 0x50, 0xa3, 0xa0, 0x06, 0x35, 0x84,
+	0x0c, 0xa1, 0xa3, 0x57, 0x91, 0x37, 0x6a, 0x9f, 0xf8, 0xb4, 0xa3, 0x57, 0x17, 0x01, 0xf8, 0xf7,
+	0x2c, 0x2d, 0x78, 0xfc, 0x01, 0x0e, 0x1a, 0x04, 0x20, 0x67, 0x10, 0x3a, 0x01, 0x54, 0xd4, 0x1e,
+	0x79, 0x3b, 0xe7, 0x82, 0x6e, 0x78, 0xf1, 0xef, 0x36, 0x0c, 0xeb, 0xfd, 0x90, 0x57, 0xd0, 0x53,
+	0x9a, 0xdd, 0x72, 0xb7, 0x89, 0xf3, 0xdc, 0x11, 0x3e, 0x9b, 0x24, 0xb5, 0x1c, 0x32, 0x82, 0xc1,
+	0x94, 0x67, 0x7c, 0x2e, 0x12, 0xc1, 0xf2, 0x15, 0x8e, 0x49, 0x48, 0xab, 0x10, 0x79, 0x0e, 0x90,
+	0xe0, 0x8d, 0xd8, 0x34, 0xe5, 0x78, 0x9f, 0x3e, 0xad, 0x20, 0x84, 0x40, 0x37, 0x93, 0x9a, 0xe3,
+	0x54, 0x86, 0x14, 0xd7, 0xf1, 0xcf, 0x36, 0xec, 0xd7, 0xce, 0x23, 0x47, 0xd0, 0x63, 0x0b, 0x59,
+	0x64, 0x1a, 0x47, 0xde, 0xa3, 0x36, 0x22, 0x31, 0xec, 0x15, 0x59, 0x6a, 0x6c, 0xe4, 0xe2, 0xf6,
+	0x4e, 0x63, 0x03, 0x1e, 0xad, 0x61, 0xa6, 0x83, 0x32, 0x36, 0x5b, 0x62, 0x07, 0x1e, 0xad, 0x20,
+	0x24, 0x82, 0x20, 0x31, 0xce, 0xf1, 0x19, 0x36, 0xd1, 0xa7, 0x2e, 0x8c, 0x4f, 0xd7, 0x52, 0xd7,
+	0x1c, 0x3e, 0x82, 0x9e, 0x29, 0xbe, 0x7e, 0x87, 0xcd, 0x84, 0xd4, 0x46, 0xf1, 0x18, 0x0e, 0x9b,
+	0xcc, 0xdd, 0xc9, 0xff, 0xd3, 0x81, 0x3d, 0x47, 0xbd, 0xce, 0xe6, 0x72, 0x17, 0xd1, 0x68, 0x34,
+	0xcf, 0xe5, 0xc2, 0xca, 0x8b, 0xeb, 0x6d, 0xe5, 0xbd, 0xc7, 0xca, 0x6f, 0x9c, 0xec, 0xfe, 0x83,
+	0x93, 0x75, 0x9f, 0xfc, 0x47, 0x3e, 0x1d, 0x82, 0xaf, 0xa5, 0x66, 0x29, 0xbe, 0x45, 0x8f, 0x96,
+	0x41, 0x55, 0xbb, 0x00, 0x71, 0x17, 0x9a, 0xbb, 0x28, 0xcd, 0x74, 0xa1, 0xf0, 0x0d, 0xf9, 0xd4,
+	0x46, 0x06, 0xbf, 0x2b, 0xbd, 0x0a, 0x4b, 0x27, 0xcb, 0x88, 0x3c, 0x83, 0x70, 0xba, 0x36, 0x09,
+	0x30, 0xb5, 0x01, 0xd6, 0x53, 0x32, 0xa8, 0x4c, 0x89, 0x80, 0xff, 0x28, 0x4f, 0xb8, 0x58, 0xea,
+	0xf5, 0xf0, 0xbe, 0x80, 0xee, 0x32, 0xe7, 0xf7, 0xf6, 0xbb, 0x78, 0xb0, 0x75, 0x61, 0xa3, 0x31,
+	0x45, 0x02, 0x39, 0x85, 0x20, 0x29, 0xf2, 0x9c, 0x67, 0x3a, 0xea, 0xec, 0xe6, 0x3a, 0x4e, 0x9c,
+	0xc1, 0x1e, 0xe5, 0xdf, 0x5d, 0x4e, 0x99, 0x76, 0xd8, 0x6c, 0x96, 0x5b, 0x9b, 0x70, 0x6d, 0x04,
+	0x4a, 0x64, 0x61, 0x37, 0xf4, 0x69, 0x19, 0x98, 0x6b, 0xcd, 0x44, 0xce, 0xf1, 0xd3, 0x6d, 0x5f,
+	0xf3, 0x06, 0xa8, 0x18, 0xde, 0xad, 0x4d, 0xc6, 0x5b, 0x18, 0x52, 0xbe, 0x4c, 0x57, 0x9b, 0x13,
+	0x5f, 0x82, 0x6f, 0x72, 0xee, 0x55, 0x36, 0xb6, 0x5b, 0x32, 0x2e, 0x82, 0xaf, 0xe5, 0xcf, 0x64,
+	0xda, 0xc3, 0x1f, 0xc8, 0xd9, 0xdf, 0x01, 0x00, 0xf8, 0x2d, 0x60, 0xe7, 0x69, 0x06, 0x00, 0x00,
 }
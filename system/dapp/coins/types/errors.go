@@ -0,0 +1,20 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrTimeLockStage 解锁阶段为空, 超过最大数量或者解锁条件不合法
+	ErrTimeLockStage = errors.New("ErrTimeLockStage")
+	// ErrTimeLockNotExist 锁定不存在
+	ErrTimeLockNotExist = errors.New("ErrTimeLockNotExist")
+	// ErrTimeLockStatus 锁定已经结束或者被撤销
+	ErrTimeLockStatus = errors.New("ErrTimeLockStatus")
+	// ErrTimeLockNotCancelable 锁定创建时没有设置为可以撤销
+	ErrTimeLockNotCancelable = errors.New("ErrTimeLockNotCancelable")
+	// ErrTimeLockNoUnlocked 没有已经解锁并且未领取的资金
+	ErrTimeLockNoUnlocked = errors.New("ErrTimeLockNoUnlocked")
+)
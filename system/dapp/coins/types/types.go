@@ -7,6 +7,7 @@ package types
 import (
 	"reflect"
 
+	"github.com/turingchain2020/turingchain/common/address"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -19,8 +20,40 @@ const (
 	CoinsActionWithdraw = 3
 	// CoinsActionTransferToExec defines const number coinsactiontransfertoExec
 	CoinsActionTransferToExec = 10
+	// CoinsActionTransferMulti defines const number coinsactiontransfermulti
+	CoinsActionTransferMulti = 11
+	// CoinsActionTimeLock defines const number coinsactiontimelock
+	CoinsActionTimeLock = 12
+	// CoinsActionTimeLockClaim defines const number coinsactiontimelockclaim
+	CoinsActionTimeLockClaim = 13
+	// CoinsActionTimeLockCancel defines const number coinsactiontimelockcancel
+	CoinsActionTimeLockCancel = 14
 )
 
+const (
+	// TyLogTimeLockCreate 创建锁定的log
+	TyLogTimeLockCreate = iota + 430
+	// TyLogTimeLockClaim 领取已解锁资金的log
+	TyLogTimeLockClaim
+	// TyLogTimeLockCancel 撤销锁定的log
+	TyLogTimeLockCancel
+)
+
+const (
+	// TimeLockStatusLocked 还有资金没有领取
+	TimeLockStatusLocked = iota + 1
+	// TimeLockStatusFinished 全部资金已经领取
+	TimeLockStatusFinished
+	// TimeLockStatusCanceled 已经被创建者撤销
+	TimeLockStatusCanceled
+)
+
+// MaxTransferMultiNum 一笔TransferMulti 交易最多的收款地址数
+const MaxTransferMultiNum = 1000
+
+// MaxTimeLockStageNum 一个锁定最多的解锁阶段数
+const MaxTimeLockStageNum = 100
+
 var (
 	// CoinsX defines a global string
 	CoinsX = "coins"
@@ -31,8 +64,16 @@ var (
 		"TransferToExec": CoinsActionTransferToExec,
 		"Withdraw":       CoinsActionWithdraw,
 		"Genesis":        CoinsActionGenesis,
+		"TransferMulti":  CoinsActionTransferMulti,
+		"TimeLock":       CoinsActionTimeLock,
+		"TimeLockClaim":  CoinsActionTimeLockClaim,
+		"TimeLockCancel": CoinsActionTimeLockCancel,
+	}
+	logmap = map[int64]*types.LogInfo{
+		TyLogTimeLockCreate: {Ty: reflect.TypeOf(ReceiptTimeLock{}), Name: "LogTimeLockCreate"},
+		TyLogTimeLockClaim:  {Ty: reflect.TypeOf(ReceiptTimeLock{}), Name: "LogTimeLockClaim"},
+		TyLogTimeLockCancel: {Ty: reflect.TypeOf(ReceiptTimeLock{}), Name: "LogTimeLockCancel"},
 	}
-	logmap = make(map[int64]*types.LogInfo)
 )
 
 func init() {
@@ -44,6 +85,8 @@ func init() {
 // InitFork initials coins forks.
 func InitFork(cfg *types.TuringchainConfig) {
 	cfg.RegisterDappFork(CoinsX, "Enable", 0)
+	cfg.RegisterDappFork(CoinsX, "ForkTransferMulti", types.MaxHeight)
+	cfg.RegisterDappFork(CoinsX, "ForkTimeLock", types.MaxHeight)
 }
 
 // InitExecutor registers coins.
@@ -115,6 +158,18 @@ func (c *CoinsType) decodePayloadValue(tx *types.Transaction) (string, reflect.V
 	case CoinsActionGenesis:
 		name = "Genesis"
 		value = action.GetGenesis()
+	case CoinsActionTransferMulti:
+		name = "TransferMulti"
+		value = action.GetTransferMulti()
+	case CoinsActionTimeLock:
+		name = "TimeLock"
+		value = action.GetTimeLock()
+	case CoinsActionTimeLockClaim:
+		name = "TimeLockClaim"
+		value = action.GetTimeLockClaim()
+	case CoinsActionTimeLockCancel:
+		name = "TimeLockCancel"
+		value = action.GetTimeLockCancel()
 	}
 	if value == nil {
 		return "", reflect.ValueOf(nil), types.ErrActionNotSupport
@@ -147,9 +202,15 @@ func (c *CoinsType) RPC_Default_Process(action string, msg interface{}) (*types.
 // GetAssets return asset list
 func (c *CoinsType) GetAssets(tx *types.Transaction) ([]*types.Asset, error) {
 	assets, err := c.ExecTypeBase.GetAssets(tx)
-	if err != nil || len(assets) == 0 {
+	if err != nil {
 		return nil, err
 	}
+	if len(assets) == 0 {
+		assets = c.getMultiAssets(tx)
+		if len(assets) == 0 {
+			return nil, nil
+		}
+	}
 
 	types := c.GetConfig()
 	assets[0].Symbol = types.GetCoinSymbol()
@@ -159,3 +220,103 @@ func (c *CoinsType) GetAssets(tx *types.Transaction) ([]*types.Asset, error) {
 	}
 	return assets, nil
 }
+
+//getMultiAssets TransferMulti 交易的资产为所有收款金额的总和
+func (c *CoinsType) getMultiAssets(tx *types.Transaction) []*types.Asset {
+	_, v, err := c.DecodePayloadValue(tx)
+	if err != nil {
+		return nil
+	}
+	multi, ok := v.Interface().(*AssetsTransferMulti)
+	if !ok {
+		return nil
+	}
+	return []*types.Asset{{Exec: string(tx.Execer), Amount: multi.GetAmount()}}
+}
+
+// GetToAddrs TransferMulti 交易的所有收款地址
+func (c *CoinsType) GetToAddrs(tx *types.Transaction) []string {
+	_, v, err := c.DecodePayloadValue(tx)
+	if err != nil {
+		return nil
+	}
+	multi, ok := v.Interface().(*AssetsTransferMulti)
+	if !ok {
+		return nil
+	}
+	addrs := make([]string, len(multi.GetTransfers()))
+	for i, transfer := range multi.GetTransfers() {
+		addrs[i] = transfer.GetTo()
+	}
+	return addrs
+}
+
+// GetAmount 所有收款金额的总和, 实现types.Amounter 接口
+func (m *AssetsTransferMulti) GetAmount() int64 {
+	var total int64
+	for _, transfer := range m.GetTransfers() {
+		total += transfer.GetAmount()
+	}
+	return total
+}
+
+// Check 检查收款地址数量和每一笔转账的金额, 总金额不能溢出
+func (m *AssetsTransferMulti) Check() error {
+	if len(m.GetTransfers()) == 0 || len(m.GetTransfers()) > MaxTransferMultiNum {
+		return types.ErrInvalidParam
+	}
+	var total int64
+	for _, transfer := range m.GetTransfers() {
+		if !types.CheckAmount(transfer.GetAmount()) {
+			return types.ErrAmount
+		}
+		if err := address.CheckAddress(transfer.GetTo()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		total += transfer.GetAmount()
+		if !types.CheckAmount(total) {
+			return types.ErrAmount
+		}
+	}
+	return nil
+}
+
+// GetAmount 所有阶段锁定金额的总和, 实现types.Amounter 接口
+func (m *AssetsTimeLock) GetAmount() int64 {
+	var total int64
+	for _, stage := range m.GetStages() {
+		total += stage.GetAmount()
+	}
+	return total
+}
+
+// Check 检查解锁阶段和beneficiary 地址, 总金额不能溢出
+func (m *AssetsTimeLock) Check() error {
+	if len(m.GetStages()) == 0 || len(m.GetStages()) > MaxTimeLockStageNum {
+		return ErrTimeLockStage
+	}
+	if m.GetBeneficiary() != "" {
+		if err := address.CheckAddress(m.GetBeneficiary()); err != nil {
+			return types.ErrInvalidAddress
+		}
+	}
+	var total int64
+	for _, stage := range m.GetStages() {
+		if !types.CheckAmount(stage.GetAmount()) {
+			return types.ErrAmount
+		}
+		if stage.GetUnlockHeight() < 0 || stage.GetUnlockTime() < 0 || stage.GetClaimed() {
+			return ErrTimeLockStage
+		}
+		total += stage.GetAmount()
+		if !types.CheckAmount(total) {
+			return types.ErrAmount
+		}
+	}
+	return nil
+}
+
+// IsUnlocked 在height 和blocktime 时这个阶段是否已经解锁
+func (m *TimeLockStage) IsUnlocked(height, blocktime int64) bool {
+	return height >= m.GetUnlockHeight() && blocktime >= m.GetUnlockTime()
+}
@@ -65,3 +65,48 @@ func TestCoinsPb(t *testing.T) {
 	_, err = xxx_messageInfo_CoinsAction.Marshal(b, ca, true)
 	assert.NoError(t, err)
 }
+
+func TestTransferMulti(t *testing.T) {
+	ty := NewType(types.NewTuringchainConfig(types.GetDefaultCfgstring()))
+	to := "1JmFaA6unrCFYEWPGRi7uuXY1KthTJxJEP"
+	multi := &AssetsTransferMulti{Transfers: []*types.AssetsTransfer{{To: to, Amount: 10}, {To: to, Amount: 20}}}
+	data, err := types.PBToJSON(multi)
+	assert.Nil(t, err)
+	tx, err := ty.CreateTx("TransferMulti", json.RawMessage(data))
+	assert.Nil(t, err)
+	name, val, err := ty.DecodePayloadValue(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, "TransferMulti", name)
+	assert.Equal(t, int64(30), val.Interface().(*AssetsTransferMulti).GetAmount())
+	assert.Equal(t, []string{to, to}, ty.GetToAddrs(tx))
+	assets, err := ty.GetAssets(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(30), assets[0].Amount)
+
+	assert.Nil(t, multi.Check())
+	multi.Transfers[1].Amount = -1
+	assert.Equal(t, types.ErrAmount, multi.Check())
+	multi.Transfers[1].Amount = 1
+	multi.Transfers[1].To = "to"
+	assert.Equal(t, types.ErrInvalidAddress, multi.Check())
+	assert.Equal(t, types.ErrInvalidParam, (&AssetsTransferMulti{}).Check())
+}
+
+func TestTimeLockCheck(t *testing.T) {
+	lock := &AssetsTimeLock{Stages: []*TimeLockStage{{Amount: 10, UnlockHeight: 5}, {Amount: 20, UnlockTime: 100}}}
+	assert.Nil(t, lock.Check())
+	assert.Equal(t, int64(30), lock.GetAmount())
+	assert.True(t, lock.Stages[0].IsUnlocked(5, 0))
+	assert.False(t, lock.Stages[0].IsUnlocked(4, 1000))
+	assert.False(t, lock.Stages[1].IsUnlocked(1000, 99))
+
+	lock.Beneficiary = "addr"
+	assert.Equal(t, types.ErrInvalidAddress, lock.Check())
+	lock.Beneficiary = ""
+	lock.Stages[1].Amount = 0
+	assert.Equal(t, types.ErrAmount, lock.Check())
+	lock.Stages[1].Amount = 1
+	lock.Stages[1].UnlockHeight = -1
+	assert.Equal(t, ErrTimeLockStage, lock.Check())
+	assert.Equal(t, ErrTimeLockStage, (&AssetsTimeLock{}).Check())
+}
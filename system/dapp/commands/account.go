@@ -35,6 +35,10 @@ func AccountCmd() *cobra.Command {
 		DumpKeysFileCmd(),
 		ImportKeysFileCmd(),
 		GetAccountCmd(),
+		ExportXpubCmd(),
+		ImportWatchOnlyCmd(),
+		ImportXpubCmd(),
+		CreateWatchOnlyTxCmd(),
 	)
 
 	return cmd
@@ -97,7 +101,7 @@ func parseListAccountRes(arg interface{}) (interface{}, error) {
 			Balance:  balanceResult,
 			Frozen:   frozenResult,
 		}
-		result.Wallets = append(result.Wallets, &commandtypes.WalletResult{Acc: accResult, Label: r.Label})
+		result.Wallets = append(result.Wallets, &commandtypes.WalletResult{Acc: accResult, Label: r.Label, WatchOnly: r.WatchOnly})
 	}
 	return result, nil
 }
@@ -9,9 +9,13 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"math"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/turingchain2020/turingchain/common/address"
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
 	commandtypes "github.com/turingchain2020/turingchain/system/dapp/commands/types"
 	"github.com/turingchain2020/turingchain/types"
 	"github.com/spf13/cobra"
@@ -29,6 +33,8 @@ func CoinsCmd() *cobra.Command {
 		CreateRawWithdrawCmd(),
 		CreateRawSendToExecCmd(),
 		CreateTxGroupCmd(),
+		CreateRawTransferMultiCmd(),
+		TimeLockCmd(),
 	)
 	return cmd
 }
@@ -227,3 +233,80 @@ func createTxGroup(cmd *cobra.Command, args []string) {
 	grouptx := hex.EncodeToString(types.Encode(newtx))
 	fmt.Println(grouptx)
 }
+
+// CreateRawTransferMultiCmd create raw transfer multi tx
+func CreateRawTransferMultiCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_multi",
+		Short: "Create a transaction which transfers to multiple addresses",
+		Run:   createTransferMulti,
+	}
+	addCreateTransferMultiFlags(cmd)
+	return cmd
+}
+
+func addCreateTransferMultiFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("file", "f", "", "name of file which contains receivers, one \"address,amount[,note]\" per line")
+	cmd.MarkFlagRequired("file")
+}
+
+func createTransferMulti(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	file, _ := cmd.Flags().GetString("file")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	f, err := os.Open(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	defer f.Close()
+	multi := &cty.AssetsTransferMulti{}
+	rd := bufio.NewReader(f)
+	for {
+		line, _, err := rd.ReadLine()
+		if err != nil && err != io.EOF {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		if err == io.EOF {
+			break
+		}
+		lineStr := strings.Trim(string(line), " \t")
+		if lineStr == "" {
+			continue
+		}
+		fields := strings.SplitN(lineStr, ",", 3)
+		if len(fields) < 2 {
+			fmt.Fprintln(os.Stderr, "wrong line:", lineStr)
+			return
+		}
+		amount, err := strconv.ParseFloat(strings.TrimSpace(fields[1]), 64)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		transfer := &types.AssetsTransfer{To: strings.TrimSpace(fields[0]), Amount: int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4}
+		if len(fields) == 3 {
+			transfer.Note = []byte(fields[2])
+		}
+		multi.Transfers = append(multi.Transfers, transfer)
+	}
+	err = multi.Check()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	action := &cty.CoinsAction{Value: &cty.CoinsAction_TransferMulti{TransferMulti: multi}, Ty: cty.CoinsActionTransferMulti}
+	execer := getRealExecName(paraName, "coins")
+	tx := &types.Transaction{Execer: []byte(execer), Payload: types.Encode(action), To: multi.Transfers[0].To}
+	if paraName != "" {
+		tx.To = address.ExecAddress(execer)
+	}
+	tx, err = types.FormatTx(cfg, execer, tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
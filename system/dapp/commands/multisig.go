@@ -0,0 +1,447 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/turingchain2020/turingchain/rpc/jsonclient"
+	rpctypes "github.com/turingchain2020/turingchain/rpc/types"
+	mty "github.com/turingchain2020/turingchain/system/dapp/multisig/types"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/spf13/cobra"
+)
+
+// MultiSigCmd multisig command func
+func MultiSigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Construct system multisig transactions",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		MultiSigAccountCmd(),
+		MultiSigOwnerCmd(),
+		MultiSigTxCmd(),
+	)
+	return cmd
+}
+
+// MultiSigAccountCmd multisig account command
+func MultiSigAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account",
+		Short: "Create and manage multisig accounts",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		CreateMultiSigAccCreateCmd(),
+		CreateMultiSigAccWeightCmd(),
+		CreateMultiSigAccDailyLimitCmd(),
+		GetMultiSigAccInfoCmd(),
+		GetMultiSigAccListCmd(),
+	)
+	return cmd
+}
+
+// MultiSigOwnerCmd multisig owner command
+func MultiSigOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner",
+		Short: "Submit owner changes of multisig account",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		CreateMultiSigOwnerCmd("add", "Submit a transaction which adds an owner", mty.OwnerAdd),
+		CreateMultiSigOwnerCmd("del", "Submit a transaction which deletes an owner", mty.OwnerDel),
+		CreateMultiSigOwnerCmd("modify", "Submit a transaction which modifies the weight of an owner", mty.OwnerModify),
+		CreateMultiSigOwnerCmd("replace", "Submit a transaction which replaces an owner", mty.OwnerReplace),
+	)
+	return cmd
+}
+
+// MultiSigTxCmd multisig transaction command
+func MultiSigTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Transfer, confirm and query multisig transactions",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		CreateMultiSigTransferInCmd(),
+		CreateMultiSigTransferOutCmd(),
+		CreateMultiSigConfirmCmd(),
+		WalletMultiSigConfirmCmd(),
+		GetMultiSigTxInfoCmd(),
+		GetMultiSigTxListCmd(),
+	)
+	return cmd
+}
+
+func multiSigAmount(amount float64) int64 {
+	return int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4
+}
+
+func createMultiSigTx(cmd *cobra.Command, action *mty.MultiSigAction) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	paraName, _ := cmd.Flags().GetString("paraName")
+	execer := getRealExecName(paraName, mty.MultiSigX)
+	tx, err := types.FormatTx(cfg, execer, &types.Transaction{Payload: types.Encode(action)})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+func queryMultiSig(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, mty.MultiSigX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Turingchain.Query", params, res)
+	ctx.Run()
+}
+
+// CreateMultiSigAccCreateCmd create multisig account
+func CreateMultiSigAccCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a multisig account transaction",
+		Run:   createMultiSigAccCreate,
+	}
+	cmd.Flags().StringP("owners", "a", "", "owner addresses, separated by '-'")
+	cmd.MarkFlagRequired("owners")
+	cmd.Flags().StringP("weights", "w", "", "owner weights in the same order as owners, separated by '-'")
+	cmd.MarkFlagRequired("weights")
+	cmd.Flags().Uint64P("required_weight", "r", 0, "required weight to execute a multisig transaction")
+	cmd.MarkFlagRequired("required_weight")
+	cmd.Flags().Float64P("daily_limit", "d", 0, "amount which can be transferred out per day without enough weight")
+	return cmd
+}
+
+func createMultiSigAccCreate(cmd *cobra.Command, args []string) {
+	owners, _ := cmd.Flags().GetString("owners")
+	weights, _ := cmd.Flags().GetString("weights")
+	required, _ := cmd.Flags().GetUint64("required_weight")
+	dailyLimit, _ := cmd.Flags().GetFloat64("daily_limit")
+
+	addrs := strings.Split(owners, "-")
+	weightArr := strings.Split(weights, "-")
+	if len(addrs) != len(weightArr) {
+		fmt.Fprintln(os.Stderr, "the number of owners and weights does not match")
+		return
+	}
+	create := &mty.MultiSigAccCreate{RequiredWeight: required, DailyLimit: multiSigAmount(dailyLimit)}
+	for i, addr := range addrs {
+		weight, err := strconv.ParseUint(strings.TrimSpace(weightArr[i]), 10, 64)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "wrong weight:", weightArr[i])
+			return
+		}
+		create.Owners = append(create.Owners, &mty.MultiSigOwner{OwnerAddr: strings.TrimSpace(addr), Weight: weight})
+	}
+	if err := create.Check(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	action := &mty.MultiSigAction{Value: &mty.MultiSigAction_AccCreate{AccCreate: create}, Ty: mty.MultiSigActionAccCreate}
+	createMultiSigTx(cmd, action)
+}
+
+// CreateMultiSigAccWeightCmd submit the change of required weight
+func CreateMultiSigAccWeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weight",
+		Short: "Submit a transaction which modifies the required weight",
+		Run:   createMultiSigAccWeight,
+	}
+	cmd.Flags().StringP("multisig_addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("multisig_addr")
+	cmd.Flags().Uint64P("weight", "w", 0, "new required weight")
+	cmd.MarkFlagRequired("weight")
+	return cmd
+}
+
+func createMultiSigAccWeight(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("multisig_addr")
+	weight, _ := cmd.Flags().GetUint64("weight")
+	op := &mty.MultiSigAccOperate{MultiSigAccAddr: addr, NewRequiredWeight: weight, OperateFlag: mty.AccWeightOp}
+	createMultiSigAccOperate(cmd, op)
+}
+
+// CreateMultiSigAccDailyLimitCmd submit the change of daily limit
+func CreateMultiSigAccDailyLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dailylimit",
+		Short: "Submit a transaction which modifies the daily limit",
+		Run:   createMultiSigAccDailyLimit,
+	}
+	cmd.Flags().StringP("multisig_addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("multisig_addr")
+	cmd.Flags().Float64P("daily_limit", "d", 0, "new daily limit")
+	cmd.MarkFlagRequired("daily_limit")
+	return cmd
+}
+
+func createMultiSigAccDailyLimit(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("multisig_addr")
+	dailyLimit, _ := cmd.Flags().GetFloat64("daily_limit")
+	op := &mty.MultiSigAccOperate{MultiSigAccAddr: addr, NewDailyLimit: multiSigAmount(dailyLimit), OperateFlag: mty.AccDailyLimitOp}
+	createMultiSigAccOperate(cmd, op)
+}
+
+func createMultiSigAccOperate(cmd *cobra.Command, op *mty.MultiSigAccOperate) {
+	if err := op.Check(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	action := &mty.MultiSigAction{Value: &mty.MultiSigAction_AccOperate{AccOperate: op}, Ty: mty.MultiSigActionAccOperate}
+	createMultiSigTx(cmd, action)
+}
+
+// CreateMultiSigOwnerCmd submit owner changes
+func CreateMultiSigOwnerCmd(use, short string, flag int32) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Run: func(cmd *cobra.Command, args []string) {
+			createMultiSigOwnerOperate(cmd, flag)
+		},
+	}
+	cmd.Flags().StringP("multisig_addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("multisig_addr")
+	if flag != mty.OwnerAdd {
+		cmd.Flags().StringP("owner", "o", "", "address of the existing owner")
+		cmd.MarkFlagRequired("owner")
+	}
+	if flag == mty.OwnerAdd || flag == mty.OwnerReplace {
+		cmd.Flags().StringP("new_owner", "n", "", "address of the new owner")
+		cmd.MarkFlagRequired("new_owner")
+	}
+	if flag == mty.OwnerAdd || flag == mty.OwnerModify {
+		cmd.Flags().Uint64P("weight", "w", 0, "weight of the owner")
+		cmd.MarkFlagRequired("weight")
+	}
+	return cmd
+}
+
+func createMultiSigOwnerOperate(cmd *cobra.Command, flag int32) {
+	addr, _ := cmd.Flags().GetString("multisig_addr")
+	owner, _ := cmd.Flags().GetString("owner")
+	newOwner, _ := cmd.Flags().GetString("new_owner")
+	weight, _ := cmd.Flags().GetUint64("weight")
+	op := &mty.MultiSigOwnerOperate{MultiSigAccAddr: addr, OldOwner: owner, NewOwner: newOwner, NewWeight: weight, OperateFlag: flag}
+	if err := op.Check(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	action := &mty.MultiSigAction{Value: &mty.MultiSigAction_OwnerOperate{OwnerOperate: op}, Ty: mty.MultiSigActionOwnerOperate}
+	createMultiSigTx(cmd, action)
+}
+
+// GetMultiSigAccInfoCmd query multisig account
+func GetMultiSigAccInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Get multisig account info",
+		Run:   getMultiSigAccInfo,
+	}
+	cmd.Flags().StringP("multisig_addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("multisig_addr")
+	return cmd
+}
+
+func getMultiSigAccInfo(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("multisig_addr")
+	var res mty.MultiSigAccount
+	queryMultiSig(cmd, "MultiSigAccountInfo", &types.ReqString{Data: addr}, &res)
+}
+
+// GetMultiSigAccListCmd query multisig accounts of owner
+func GetMultiSigAccListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Get multisig accounts of an owner",
+		Run:   getMultiSigAccList,
+	}
+	cmd.Flags().StringP("owner", "o", "", "owner address")
+	cmd.MarkFlagRequired("owner")
+	return cmd
+}
+
+func getMultiSigAccList(cmd *cobra.Command, args []string) {
+	owner, _ := cmd.Flags().GetString("owner")
+	var res mty.ReplyMultiSigAccounts
+	queryMultiSig(cmd, "MultiSigAccountsByOwner", &types.ReqString{Data: owner}, &res)
+}
+
+// CreateMultiSigTransferInCmd transfer into multisig account
+func CreateMultiSigTransferInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_in",
+		Short: "Create a transaction which transfers balance in multisig executor to multisig account",
+		Run:   createMultiSigTransferIn,
+	}
+	cmd.Flags().StringP("to", "t", "", "multisig account address")
+	cmd.MarkFlagRequired("to")
+	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+	return cmd
+}
+
+func createMultiSigTransferIn(cmd *cobra.Command, args []string) {
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	note, _ := cmd.Flags().GetString("note")
+	transfer := &mty.MultiSigTransferTo{To: to, Amount: multiSigAmount(amount), Note: note}
+	if err := transfer.Check(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	action := &mty.MultiSigAction{Value: &mty.MultiSigAction_TransferTo{TransferTo: transfer}, Ty: mty.MultiSigActionTransferTo}
+	createMultiSigTx(cmd, action)
+}
+
+// CreateMultiSigTransferOutCmd transfer out of multisig account
+func CreateMultiSigTransferOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_out",
+		Short: "Submit a transaction which transfers out of multisig account",
+		Run:   createMultiSigTransferOut,
+	}
+	cmd.Flags().StringP("from", "f", "", "multisig account address")
+	cmd.MarkFlagRequired("from")
+	cmd.Flags().StringP("to", "t", "", "receiver account address")
+	cmd.MarkFlagRequired("to")
+	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+	return cmd
+}
+
+func createMultiSigTransferOut(cmd *cobra.Command, args []string) {
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	note, _ := cmd.Flags().GetString("note")
+	transfer := &mty.MultiSigTransferFrom{From: from, To: to, Amount: multiSigAmount(amount), Note: note}
+	if err := transfer.Check(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	action := &mty.MultiSigAction{Value: &mty.MultiSigAction_TransferFrom{TransferFrom: transfer}, Ty: mty.MultiSigActionTransferFrom}
+	createMultiSigTx(cmd, action)
+}
+
+func addMultiSigConfirmFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("multisig_addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("multisig_addr")
+	cmd.Flags().Uint64P("txid", "i", 0, "multisig transaction id")
+	cmd.MarkFlagRequired("txid")
+	cmd.Flags().BoolP("revoke", "r", false, "revoke the confirmation instead of confirming")
+}
+
+// CreateMultiSigConfirmCmd confirm or revoke multisig transaction
+func CreateMultiSigConfirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm",
+		Short: "Create a transaction which confirms or revokes a multisig transaction",
+		Run:   createMultiSigConfirm,
+	}
+	addMultiSigConfirmFlags(cmd)
+	return cmd
+}
+
+func createMultiSigConfirm(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+	revoke, _ := cmd.Flags().GetBool("revoke")
+	confirm := &mty.MultiSigConfirmTx{MultiSigAccAddr: addr, TxId: txid, ConfirmOrRevoke: !revoke}
+	action := &mty.MultiSigAction{Value: &mty.MultiSigAction_ConfirmTx{ConfirmTx: confirm}, Ty: mty.MultiSigActionConfirmTx}
+	createMultiSigTx(cmd, action)
+}
+
+// WalletMultiSigConfirmCmd confirm or revoke multisig transaction with owners in wallet
+func WalletMultiSigConfirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet_confirm",
+		Short: "Confirm or revoke a multisig transaction with owners in wallet",
+		Run:   walletMultiSigConfirm,
+	}
+	addMultiSigConfirmFlags(cmd)
+	cmd.Flags().StringP("owner", "o", "", "owner address in wallet, all owners in wallet if empty")
+	return cmd
+}
+
+func walletMultiSigConfirm(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+	revoke, _ := cmd.Flags().GetBool("revoke")
+	owner, _ := cmd.Flags().GetString("owner")
+	req := &mty.ReqMultiSigWalletConfirm{MultiSigAccAddr: addr, TxId: txid, ConfirmOrRevoke: !revoke, Owner: owner}
+	params := rpctypes.ChainExecutor{
+		Driver:   "wallet",
+		FuncName: "MultiSigConfirmTx",
+		Payload:  types.MustPBToJSON(req),
+	}
+	var res types.ReplyHashes
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Turingchain.ExecWallet", params, &res)
+	ctx.Run()
+}
+
+// GetMultiSigTxInfoCmd query multisig transaction
+func GetMultiSigTxInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Get multisig transaction info",
+		Run:   getMultiSigTxInfo,
+	}
+	cmd.Flags().StringP("multisig_addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("multisig_addr")
+	cmd.Flags().Uint64P("txid", "i", 0, "multisig transaction id")
+	cmd.MarkFlagRequired("txid")
+	return cmd
+}
+
+func getMultiSigTxInfo(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+	var res mty.MultiSigTx
+	queryMultiSig(cmd, "MultiSigTxInfo", &mty.ReqMultiSigTxInfo{MultiSigAddr: addr, TxId: txid}, &res)
+}
+
+// GetMultiSigTxListCmd query multisig transactions
+func GetMultiSigTxListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Get multisig transactions in id range",
+		Run:   getMultiSigTxList,
+	}
+	cmd.Flags().StringP("multisig_addr", "a", "", "multisig account address")
+	cmd.MarkFlagRequired("multisig_addr")
+	cmd.Flags().Uint64P("from", "s", 0, "start transaction id")
+	cmd.Flags().Uint64P("to", "e", 0, "end transaction id")
+	cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+func getMultiSigTxList(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("multisig_addr")
+	from, _ := cmd.Flags().GetUint64("from")
+	to, _ := cmd.Flags().GetUint64("to")
+	var res mty.ReplyMultiSigTxs
+	queryMultiSig(cmd, "MultiSigTxs", &mty.ReqMultiSigTxs{MultiSigAddr: addr, FromTxId: from, ToTxId: to}, &res)
+}
@@ -0,0 +1,206 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	commandtypes "github.com/turingchain2020/turingchain/system/dapp/commands/types"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/spf13/cobra"
+)
+
+// PartialTxCmd partially signed transaction command
+func PartialTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partial",
+		Short: "Partially signed transaction for offline and multi-party signing",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		CreatePartialTxCmd(),
+		SignPartialTxCmd(),
+		CombinePartialTxCmd(),
+		FinalizePartialTxCmd(),
+		PartialTxStatusCmd(),
+	)
+	return cmd
+}
+
+func addPartialTxInputFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("data", "d", "", "partially signed transaction data")
+	cmd.Flags().StringP("file", "i", "", "read partially signed transaction from file")
+}
+
+func addPartialTxOutputFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("out", "o", "", "write result to file instead of stdout")
+}
+
+//readPartialTx 从data 或者文件中读取部分签名交易, 多个数据以逗号分隔
+func readPartialTx(cmd *cobra.Command) ([]string, error) {
+	data, _ := cmd.Flags().GetString("data")
+	file, _ := cmd.Flags().GetString("file")
+	if data != "" {
+		return strings.Split(data, ","), nil
+	}
+	if file == "" {
+		return nil, types.ErrInvalidParam
+	}
+	var datas []string
+	for _, name := range strings.Split(file, ",") {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		datas = append(datas, strings.TrimSpace(string(b)))
+	}
+	return datas, nil
+}
+
+func runPartialTx(cmd *cobra.Command, funcName string, req types.Message) {
+	var res types.ReplyString
+	_, err := execWallet(cmd, funcName, req, &res).RunResult()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	out, _ := cmd.Flags().GetString("out")
+	if out == "" {
+		fmt.Println(res.Data)
+		return
+	}
+	err = ioutil.WriteFile(out, []byte(res.Data+"\n"), 0600)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+// CreatePartialTxCmd create partially signed tx
+func CreatePartialTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create partially signed transaction from unsigned transaction or group",
+		Run:   createPartialTx,
+	}
+	cmd.Flags().StringP("data", "d", "", "unsigned raw transaction or transaction group")
+	cmd.MarkFlagRequired("data")
+	cmd.Flags().StringP("signers", "s", "", "signer address of each transaction in group, separated by ','")
+	cmd.MarkFlagRequired("signers")
+	cmd.Flags().StringP("expire", "e", "120s", "transaction expire time")
+	cmd.Flags().Float64P("fee", "f", 0, "transaction fee (optional), auto set proper fee if not set or zero fee")
+	addPartialTxOutputFlags(cmd)
+	return cmd
+}
+
+func createPartialTx(cmd *cobra.Command, args []string) {
+	data, _ := cmd.Flags().GetString("data")
+	signers, _ := cmd.Flags().GetString("signers")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+	expire, _ := cmd.Flags().GetString("expire")
+	expire, err := commandtypes.CheckExpireOpt(expire)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	req := &types.ReqCreatePartialTx{
+		TxHex:   data,
+		Signers: strings.Split(signers, ","),
+		Expire:  expire,
+		Fee:     int64(fee*1e4) * 1e4,
+	}
+	runPartialTx(cmd, "CreatePartialTx", req)
+}
+
+// SignPartialTxCmd sign partially signed tx
+func SignPartialTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign",
+		Short: "Sign transactions whose signer matches, use all wallet addresses if addr and key are not set",
+		Run:   signPartialTx,
+	}
+	addPartialTxInputFlags(cmd)
+	cmd.Flags().StringP("addr", "a", "", "account address in wallet (optional)")
+	cmd.Flags().StringP("key", "k", "", "private key (optional)")
+	addPartialTxOutputFlags(cmd)
+	return cmd
+}
+
+func signPartialTx(cmd *cobra.Command, args []string) {
+	datas, err := readPartialTx(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	addr, _ := cmd.Flags().GetString("addr")
+	key, _ := cmd.Flags().GetString("key")
+	runPartialTx(cmd, "SignPartialTx", &types.ReqSignPartialTx{Data: datas[0], Addr: addr, Privkey: key})
+}
+
+// CombinePartialTxCmd combine signatures of partially signed tx
+func CombinePartialTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "combine",
+		Short: "Combine signatures of the same partially signed transaction",
+		Run:   combinePartialTx,
+	}
+	cmd.Flags().StringP("data", "d", "", "partially signed transactions, separated by ','")
+	cmd.Flags().StringP("file", "i", "", "read partially signed transactions from files, separated by ','")
+	addPartialTxOutputFlags(cmd)
+	return cmd
+}
+
+func combinePartialTx(cmd *cobra.Command, args []string) {
+	datas, err := readPartialTx(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	runPartialTx(cmd, "CombinePartialTx", &types.ReqCombinePartialTx{Datas: datas})
+}
+
+// FinalizePartialTxCmd finalize partially signed tx
+func FinalizePartialTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize",
+		Short: "Build signed transaction which can be sent when all signatures are collected",
+		Run:   finalizePartialTx,
+	}
+	addPartialTxInputFlags(cmd)
+	addPartialTxOutputFlags(cmd)
+	return cmd
+}
+
+func finalizePartialTx(cmd *cobra.Command, args []string) {
+	datas, err := readPartialTx(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	runPartialTx(cmd, "FinalizePartialTx", &types.ReqString{Data: datas[0]})
+}
+
+// PartialTxStatusCmd show signature status of partially signed tx
+func PartialTxStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show signers and missing signatures of partially signed transaction",
+		Run:   partialTxStatus,
+	}
+	addPartialTxInputFlags(cmd)
+	return cmd
+}
+
+func partialTxStatus(cmd *cobra.Command, args []string) {
+	datas, err := readPartialTx(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res types.ReplyPartialTxStatus
+	execWallet(cmd, "PartialTxStatus", &types.ReqString{Data: datas[0]}, &res).Run()
+}
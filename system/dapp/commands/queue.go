@@ -0,0 +1,43 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"github.com/turingchain2020/turingchain/rpc/jsonclient"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/spf13/cobra"
+)
+
+// QueueCmd queue command
+func QueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queue",
+		Short: "Message queue operation",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		QueueStatsCmd(),
+	)
+	return cmd
+}
+
+// QueueStatsCmd get live queue stats
+func QueueStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Get depth, message types and latency(us) of queue topics",
+		Run:   queueStats,
+	}
+	cmd.Flags().StringP("topic", "t", "", "topic name, all topics if empty")
+	return cmd
+}
+
+func queueStats(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	topic, _ := cmd.Flags().GetString("topic")
+	var res types.QueueStats
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Turingchain.GetQueueStats", &types.ReqString{Data: topic}, &res)
+	ctx.Run()
+}
@@ -0,0 +1,90 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/wallet/softhsm"
+	"github.com/spf13/cobra"
+)
+
+// SoftHSMCmd manage key files of file based soft hsm signer
+func SoftHSMCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "softhsm",
+		Short: "Manage key files of soft hsm signer, keys are never saved in wallet db",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		SoftHSMGenKeyCmd(),
+		SoftHSMImportKeyCmd(),
+	)
+	return cmd
+}
+
+func addSoftHSMFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("dir", "d", "", "key file directory")
+	cmd.MarkFlagRequired("dir")
+	cmd.Flags().StringP("pin", "p", "", "pin to encrypt key file")
+	cmd.MarkFlagRequired("pin")
+	cmd.Flags().StringP("type", "t", "secp256k1", "sign type")
+}
+
+// SoftHSMGenKeyCmd generate key in soft hsm key directory
+func SoftHSMGenKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genkey",
+		Short: "Generate new key file, run on the signing machine",
+		Run:   softHSMGenKey,
+	}
+	addSoftHSMFlags(cmd)
+	return cmd
+}
+
+func softHSMGenKey(cmd *cobra.Command, args []string) {
+	dir, _ := cmd.Flags().GetString("dir")
+	pin, _ := cmd.Flags().GetString("pin")
+	signType, _ := cmd.Flags().GetString("type")
+	addr, err := softhsm.GenerateKey(dir, pin, signType)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(addr)
+}
+
+// SoftHSMImportKeyCmd import private key into soft hsm key directory
+func SoftHSMImportKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import private key into key file",
+		Run:   softHSMImportKey,
+	}
+	addSoftHSMFlags(cmd)
+	cmd.Flags().StringP("key", "k", "", "private key")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+func softHSMImportKey(cmd *cobra.Command, args []string) {
+	dir, _ := cmd.Flags().GetString("dir")
+	pin, _ := cmd.Flags().GetString("pin")
+	signType, _ := cmd.Flags().GetString("type")
+	key, _ := cmd.Flags().GetString("key")
+	keyByte, err := common.FromHex(key)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	addr, err := softhsm.ImportKey(dir, pin, signType, keyByte)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(addr)
+}
@@ -0,0 +1,224 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/turingchain2020/turingchain/common/address"
+	"github.com/turingchain2020/turingchain/rpc/jsonclient"
+	rpctypes "github.com/turingchain2020/turingchain/rpc/types"
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/spf13/cobra"
+)
+
+// TimeLockCmd coins time lock command
+func TimeLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelock",
+		Short: "Time locked and vesting transfers",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		CreateTimeLockCmd(),
+		CreateTimeLockClaimCmd(),
+		CreateTimeLockCancelCmd(),
+		GetTimeLockCmd(),
+		ListTimeLocksCmd(),
+	)
+	return cmd
+}
+
+func parseInt64List(s string) ([]int64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var list []int64
+	for _, v := range strings.Split(s, "-") {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, n)
+	}
+	return list, nil
+}
+
+func createTimeLockTx(cmd *cobra.Command, action *cty.CoinsAction, to string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	paraName, _ := cmd.Flags().GetString("paraName")
+	execer := getRealExecName(paraName, cty.CoinsX)
+	tx := &types.Transaction{Execer: []byte(execer), Payload: types.Encode(action), To: to}
+	if paraName != "" || to == "" {
+		tx.To = address.ExecAddress(execer)
+	}
+	tx, err := types.FormatTx(cfg, execer, tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+// CreateTimeLockCmd create time lock tx
+func CreateTimeLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a transaction which locks coins until height or block time",
+		Run:   createTimeLock,
+	}
+	cmd.Flags().StringP("amounts", "a", "", "amount of each unlock stage, separated by '-'")
+	cmd.MarkFlagRequired("amounts")
+	cmd.Flags().StringP("heights", "e", "", "unlock height of each stage, separated by '-'")
+	cmd.Flags().StringP("times", "t", "", "unlock block time(unix seconds) of each stage, separated by '-'")
+	cmd.Flags().StringP("beneficiary", "b", "", "beneficiary address, default is the sender")
+	cmd.Flags().BoolP("cancelable", "c", false, "whether the sender can cancel the lock")
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+	return cmd
+}
+
+func createTimeLock(cmd *cobra.Command, args []string) {
+	amountStr, _ := cmd.Flags().GetString("amounts")
+	heightStr, _ := cmd.Flags().GetString("heights")
+	timeStr, _ := cmd.Flags().GetString("times")
+	beneficiary, _ := cmd.Flags().GetString("beneficiary")
+	cancelable, _ := cmd.Flags().GetBool("cancelable")
+	note, _ := cmd.Flags().GetString("note")
+	heights, err := parseInt64List(heightStr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	times, err := parseInt64List(timeStr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	lock := &cty.AssetsTimeLock{Beneficiary: beneficiary, Cancelable: cancelable, Note: note}
+	for i, v := range strings.Split(amountStr, "-") {
+		amount, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		stage := &cty.TimeLockStage{Amount: int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4}
+		if i < len(heights) {
+			stage.UnlockHeight = heights[i]
+		}
+		if i < len(times) {
+			stage.UnlockTime = times[i]
+		}
+		lock.Stages = append(lock.Stages, stage)
+	}
+	if len(heights) > len(lock.Stages) || len(times) > len(lock.Stages) {
+		fmt.Fprintln(os.Stderr, "heights and times should not be more than amounts")
+		return
+	}
+	err = lock.Check()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	action := &cty.CoinsAction{Value: &cty.CoinsAction_TimeLock{TimeLock: lock}, Ty: cty.CoinsActionTimeLock}
+	createTimeLockTx(cmd, action, beneficiary)
+}
+
+// CreateTimeLockClaimCmd create time lock claim tx
+func CreateTimeLockClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim",
+		Short: "Create a transaction which claims unlocked coins",
+		Run:   createTimeLockClaim,
+	}
+	cmd.Flags().StringP("id", "i", "", "lock id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func createTimeLockClaim(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	claim := &cty.AssetsTimeLockClaim{LockID: id}
+	action := &cty.CoinsAction{Value: &cty.CoinsAction_TimeLockClaim{TimeLockClaim: claim}, Ty: cty.CoinsActionTimeLockClaim}
+	createTimeLockTx(cmd, action, "")
+}
+
+// CreateTimeLockCancelCmd create time lock cancel tx
+func CreateTimeLockCancelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Create a transaction which cancels a cancelable lock",
+		Run:   createTimeLockCancel,
+	}
+	cmd.Flags().StringP("id", "i", "", "lock id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func createTimeLockCancel(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	cancel := &cty.AssetsTimeLockCancel{LockID: id}
+	action := &cty.CoinsAction{Value: &cty.CoinsAction_TimeLockCancel{TimeLockCancel: cancel}, Ty: cty.CoinsActionTimeLockCancel}
+	createTimeLockTx(cmd, action, "")
+}
+
+func queryCoins(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, cty.CoinsX)
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Turingchain.Query", params, res)
+	ctx.Run()
+}
+
+// GetTimeLockCmd get time lock info
+func GetTimeLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Get time lock info",
+		Run:   getTimeLockInfo,
+	}
+	cmd.Flags().StringP("id", "i", "", "lock id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func getTimeLockInfo(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res cty.TimeLockInfo
+	queryCoins(cmd, "GetTimeLock", &types.ReqString{Data: id}, &res)
+}
+
+// ListTimeLocksCmd list pending time locks of address
+func ListTimeLocksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List pending time locks of address, as sender or beneficiary",
+		Run:   listTimeLocks,
+	}
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Int32P("count", "c", 10, "count")
+	cmd.Flags().Int32P("direction", "d", 0, "query direction, 0: desc, 1: asc")
+	cmd.Flags().StringP("id", "i", "", "lock id to start from")
+	return cmd
+}
+
+func listTimeLocks(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	id, _ := cmd.Flags().GetString("id")
+	var res cty.ReplyTimeLocks
+	queryCoins(cmd, "GetTimeLocksByAddr", &cty.ReqTimeLocks{Addr: addr, Count: count, Direction: direction, LockID: id}, &res)
+}
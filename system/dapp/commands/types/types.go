@@ -16,8 +16,9 @@ type AccountsResult struct {
 
 // WalletResult defines walletresult command
 type WalletResult struct {
-	Acc   *AccountResult `json:"acc,omitempty"`
-	Label string         `json:"label,omitempty"`
+	Acc       *AccountResult `json:"acc,omitempty"`
+	Label     string         `json:"label,omitempty"`
+	WatchOnly bool           `json:"watchOnly,omitempty"`
 }
 
 // AccountResult defines account result command
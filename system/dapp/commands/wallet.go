@@ -37,6 +37,8 @@ func WalletCmd() *cobra.Command {
 		NoBalanceCmd(),
 		SetFeeCmd(),
 		SendTxCmd(),
+		PartialTxCmd(),
+		SoftHSMCmd(),
 	)
 
 	return cmd
@@ -0,0 +1,141 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"math"
+
+	"github.com/turingchain2020/turingchain/rpc/jsonclient"
+	rpctypes "github.com/turingchain2020/turingchain/rpc/types"
+	commandtypes "github.com/turingchain2020/turingchain/system/dapp/commands/types"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/spf13/cobra"
+)
+
+func execWallet(cmd *cobra.Command, funcName string, req types.Message, res interface{}) *jsonclient.RPCCtx {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	params := rpctypes.ChainExecutor{
+		Driver:   "wallet",
+		FuncName: funcName,
+		Payload:  types.MustPBToJSON(req),
+	}
+	return jsonclient.NewRPCCtx(rpcLaddr, "Turingchain.ExecWallet", params, res)
+}
+
+// ExportXpubCmd export extended public key of wallet seed
+func ExportXpubCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export_xpub",
+		Short: "Export extended public key(xpub) of wallet seed, only for secp256k1",
+		Run:   exportXpub,
+	}
+	return cmd
+}
+
+func exportXpub(cmd *cobra.Command, args []string) {
+	var res types.ReplyString
+	execWallet(cmd, "ExportXpub", &types.ReqNil{}, &res).Run()
+}
+
+// ImportWatchOnlyCmd import watch-only address
+func ImportWatchOnlyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import_watch",
+		Short: "Import watch-only address with label",
+		Run:   importWatchOnly,
+	}
+	cmd.Flags().StringP("addr", "a", "", "watch-only address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("label", "l", "", "label for address")
+	cmd.MarkFlagRequired("label")
+	return cmd
+}
+
+func importWatchOnly(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	label, _ := cmd.Flags().GetString("label")
+	req := &types.ReqWalletImportWatchOnly{Addr: addr, Label: label}
+	var res types.WalletAccount
+	ctx := execWallet(cmd, "WalletImportWatchOnly", req, &res)
+	ctx.SetResultCb(parseImportKeyRes)
+	ctx.Run()
+}
+
+// ImportXpubCmd import watch-only addresses derived from xpub
+func ImportXpubCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import_xpub",
+		Short: "Import watch-only addresses derived from extended public key",
+		Run:   importXpub,
+	}
+	cmd.Flags().StringP("xpub", "x", "", "extended public key")
+	cmd.MarkFlagRequired("xpub")
+	cmd.Flags().StringP("label", "l", "", "label prefix, address label is label-index")
+	cmd.MarkFlagRequired("label")
+	cmd.Flags().Int32P("start", "s", 0, "start address index")
+	cmd.Flags().Int32P("count", "c", 20, "address count")
+	return cmd
+}
+
+func importXpub(cmd *cobra.Command, args []string) {
+	xpub, _ := cmd.Flags().GetString("xpub")
+	label, _ := cmd.Flags().GetString("label")
+	start, _ := cmd.Flags().GetInt32("start")
+	count, _ := cmd.Flags().GetInt32("count")
+	req := &types.ReqWalletImportXpub{Xpub: xpub, Label: label, Start: start, Count: count}
+	var res types.WalletAccounts
+	ctx := execWallet(cmd, "WalletImportXpub", req, &res)
+	ctx.SetResultCb(parseImportXpubRes)
+	ctx.Run()
+}
+
+func parseImportXpubRes(arg interface{}) (interface{}, error) {
+	res := arg.(*types.WalletAccounts)
+	var result commandtypes.AccountsResult
+	for _, r := range res.Wallets {
+		result.Wallets = append(result.Wallets, &commandtypes.WalletResult{
+			Acc:       &commandtypes.AccountResult{Addr: r.GetAcc().GetAddr()},
+			Label:     r.Label,
+			WatchOnly: r.WatchOnly,
+		})
+	}
+	return result, nil
+}
+
+// CreateWatchOnlyTxCmd create unsigned transfer tx from address in wallet
+func CreateWatchOnlyTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create_watch_tx",
+		Short: "Create unsigned transfer transaction from watch-only address",
+		Run:   createWatchOnlyTx,
+	}
+	cmd.Flags().StringP("from", "f", "", "sender address in wallet")
+	cmd.MarkFlagRequired("from")
+	cmd.Flags().StringP("to", "t", "", "receiver address")
+	cmd.MarkFlagRequired("to")
+	cmd.Flags().Float64P("amount", "a", 0, "transfer amount")
+	cmd.MarkFlagRequired("amount")
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+	cmd.Flags().StringP("symbol", "s", "", "token symbol, transfer coins if empty")
+	return cmd
+}
+
+func createWatchOnlyTx(cmd *cobra.Command, args []string) {
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	note, _ := cmd.Flags().GetString("note")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	req := &types.ReqWalletSendToAddress{
+		From:        from,
+		To:          to,
+		Amount:      int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4,
+		Note:        note,
+		IsToken:     symbol != "",
+		TokenSymbol: symbol,
+	}
+	var res types.ReplyString
+	execWallet(cmd, "CreateWatchOnlyTx", req, &res).Run()
+}
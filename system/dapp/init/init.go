@@ -6,7 +6,8 @@
 package init
 
 import (
-	_ "github.com/turingchain2020/turingchain/system/dapp/coins"  // register coins package
-	_ "github.com/turingchain2020/turingchain/system/dapp/manage" // register manage package
-	_ "github.com/turingchain2020/turingchain/system/dapp/none"   // register none package
+	_ "github.com/turingchain2020/turingchain/system/dapp/coins"    // register coins package
+	_ "github.com/turingchain2020/turingchain/system/dapp/manage"   // register manage package
+	_ "github.com/turingchain2020/turingchain/system/dapp/multisig" // register multisig package
+	_ "github.com/turingchain2020/turingchain/system/dapp/none"     // register none package
 )
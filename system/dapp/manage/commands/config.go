@@ -30,6 +30,7 @@ func ConfigCmd() *cobra.Command {
 	cmd.AddCommand(
 		ConfigTxCmd(),
 		QueryConfigCmd(),
+		ProposalCmd(),
 	)
 
 	return cmd
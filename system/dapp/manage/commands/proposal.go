@@ -0,0 +1,208 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/turingchain2020/turingchain/rpc/jsonclient"
+	rpctypes "github.com/turingchain2020/turingchain/rpc/types"
+	pty "github.com/turingchain2020/turingchain/system/dapp/manage/types"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/spf13/cobra"
+)
+
+// ProposalCmd config proposal command
+func ProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal",
+		Short: "Config proposal and voting",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		ProposeCmd(),
+		ProposeApproversCmd(),
+		VoteCmd(),
+		QueryProposalCmd(),
+		ListProposalCmd(),
+		QueryApproversCmd(),
+	)
+	return cmd
+}
+
+func createManageTx(cmd *cobra.Command, action *pty.ManageAction) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	paraName, _ := cmd.Flags().GetString("paraName")
+	tx := &types.Transaction{Payload: types.Encode(action)}
+	tx, err := types.FormatTx(cfg, util.GetParaExecName(paraName, "manage"), tx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+// ProposeCmd propose config modification
+func ProposeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose",
+		Short: "Propose a config modification",
+		Run:   propose,
+	}
+	addConfigTxFlags(cmd)
+	addProposalHeightFlags(cmd)
+	return cmd
+}
+
+func addProposalHeightFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64P("start", "s", 0, "start height of voting")
+	cmd.MarkFlagRequired("start")
+	cmd.Flags().Int64P("end", "e", 0, "end height of voting")
+	cmd.MarkFlagRequired("end")
+	cmd.Flags().Int64P("target", "t", 0, "height at which the approved proposal takes effect, must be greater than end")
+	cmd.MarkFlagRequired("target")
+}
+
+func propose(cmd *cobra.Command, args []string) {
+	key, _ := cmd.Flags().GetString("config_key")
+	op, _ := cmd.Flags().GetString("operation")
+	value, _ := cmd.Flags().GetString("value")
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+	target, _ := cmd.Flags().GetInt64("target")
+
+	proposal := &pty.ConfigProposal{
+		Modify:       &types.ModifyConfig{Key: key, Op: op, Value: value},
+		StartHeight:  start,
+		EndHeight:    end,
+		TargetHeight: target,
+	}
+	createManageTx(cmd, &pty.ManageAction{Ty: pty.ManageActionPropose, Value: &pty.ManageAction_Propose{Propose: proposal}})
+}
+
+// VoteCmd vote config proposal
+func VoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote",
+		Short: "Vote for a config proposal",
+		Run:   vote,
+	}
+	cmd.Flags().StringP("id", "i", "", "proposal id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().BoolP("oppose", "o", false, "oppose the proposal instead of approving")
+	return cmd
+}
+
+func vote(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	oppose, _ := cmd.Flags().GetBool("oppose")
+	v := &pty.ConfigProposalVote{ProposalID: id, Approve: !oppose}
+	createManageTx(cmd, &pty.ManageAction{Ty: pty.ManageActionVote, Value: &pty.ManageAction_Vote{Vote: v}})
+}
+
+// ProposeApproversCmd propose approvers modification
+func ProposeApproversCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose_approvers",
+		Short: "Propose to replace the approvers on chain",
+		Run:   proposeApprovers,
+	}
+	cmd.Flags().StringSliceP("approvers", "a", nil, "approver addresses, separated by comma")
+	cmd.MarkFlagRequired("approvers")
+	cmd.Flags().Int32P("threshold", "n", 0, "number of approves required")
+	cmd.MarkFlagRequired("threshold")
+	cmd.Flags().BoolP("proposal_only", "p", false, "config can only be modified by proposal")
+	addProposalHeightFlags(cmd)
+	return cmd
+}
+
+func proposeApprovers(cmd *cobra.Command, args []string) {
+	approvers, _ := cmd.Flags().GetStringSlice("approvers")
+	threshold, _ := cmd.Flags().GetInt32("threshold")
+	proposalOnly, _ := cmd.Flags().GetBool("proposal_only")
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+	target, _ := cmd.Flags().GetInt64("target")
+
+	proposal := &pty.ConfigProposal{
+		Approvers:    &pty.ConfigApprovers{Approvers: approvers, Threshold: threshold, ProposalOnly: proposalOnly},
+		StartHeight:  start,
+		EndHeight:    end,
+		TargetHeight: target,
+	}
+	createManageTx(cmd, &pty.ManageAction{Ty: pty.ManageActionPropose, Value: &pty.ManageAction_Propose{Propose: proposal}})
+}
+
+func queryManage(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	var params rpctypes.Query4Jrpc
+	params.Execer = util.GetParaExecName(paraName, "manage")
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Turingchain.Query", params, res)
+	ctx.Run()
+}
+
+// QueryProposalCmd query config proposal
+func QueryProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query config proposal",
+		Run:   queryProposal,
+	}
+	cmd.Flags().StringP("id", "i", "", "proposal id")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+func queryProposal(cmd *cobra.Command, args []string) {
+	id, _ := cmd.Flags().GetString("id")
+	var res pty.ConfigProposalInfo
+	queryManage(cmd, "GetConfigProposal", &types.ReqString{Data: id}, &res)
+}
+
+// ListProposalCmd list config proposals
+func ListProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List config proposals",
+		Run:   listProposal,
+	}
+	cmd.Flags().Int32P("status", "s", 0, "status: 0 all, 1 voting, 2 approved, 3 applied, 4 rejected, 5 expired, 6 failed")
+	cmd.Flags().Int32P("count", "c", 10, "count")
+	cmd.Flags().Int32P("direction", "d", 0, "direction: 0 descending, 1 ascending")
+	cmd.Flags().StringP("id", "i", "", "list from proposal id")
+	return cmd
+}
+
+func listProposal(cmd *cobra.Command, args []string) {
+	status, _ := cmd.Flags().GetInt32("status")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	id, _ := cmd.Flags().GetString("id")
+	req := &pty.ReqConfigProposals{Status: status, Count: count, Direction: direction, ProposalID: id}
+	var res pty.ReplyConfigProposals
+	queryManage(cmd, "ListConfigProposals", req, &res)
+}
+
+// QueryApproversCmd query approvers on chain
+func QueryApproversCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approvers",
+		Short: "Query approvers on chain",
+		Run:   queryApprovers,
+	}
+	return cmd
+}
+
+func queryApprovers(cmd *cobra.Command, args []string) {
+	var res pty.ConfigApprovers
+	queryManage(cmd, "GetConfigApprovers", &types.ReqNil{}, &res)
+}
@@ -33,7 +33,48 @@ func (c *Manage) Exec_Modify(manageAction *types.ModifyConfig, tx *types.Transac
 			return nil, err
 		}
 	}
+	if isProposalOnly(cfg, c.GetStateDB(), c.GetHeight()) {
+		return nil, mty.ErrProposalRequired
+	}
 	action := NewAction(c, tx)
 	return action.modifyConfig(manageAction)
 
 }
+
+func (c *Manage) checkProposalFork(tx *types.Transaction, index int) error {
+	types.AssertConfig(c.GetAPI())
+	cfg := c.GetAPI().GetConfig()
+	if !cfg.IsDappFork(c.GetHeight(), mty.ManageX, "ForkManageProposal") {
+		return types.ErrActionNotSupport
+	}
+	return c.checkTxToAddress(tx, index)
+}
+
+// Exec_Propose 超级管理员发起配置修改提案
+func (c *Manage) Exec_Propose(proposal *mty.ConfigProposal, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkProposalFork(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.propose(proposal)
+}
+
+// Exec_Vote 审批人对提案投票
+func (c *Manage) Exec_Vote(vote *mty.ConfigProposalVote, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := c.checkProposalFork(tx, index); err != nil {
+		return nil, err
+	}
+	action := NewAction(c, tx)
+	return action.vote(vote)
+}
+
+// BlockBegin 开启ForkManageProposal 之后, 每个区块执行第一笔交易之前处理到达生效高度和投票结束的提案
+func (c *Manage) BlockBegin() (*types.Receipt, error) {
+	types.AssertConfig(c.GetAPI())
+	cfg := c.GetAPI().GetConfig()
+	if !cfg.IsDappFork(c.GetHeight(), mty.ManageX, "ForkManageProposal") {
+		return nil, nil
+	}
+	action := &Action{db: c.GetStateDB(), height: c.GetHeight(), cfg: cfg}
+	return action.blockBegin()
+}
@@ -34,3 +34,8 @@ func (c *Manage) ExecDelLocal_Modify(transfer *types.ModifyConfig, tx *types.Tra
 	}
 	return set, nil
 }
+
+// ExecDelLocal_Propose 回滚提案的索引
+func (c *Manage) ExecDelLocal_Propose(payload *pty.ConfigProposal, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: proposalIndexKV(receipt, true)}, nil
+}
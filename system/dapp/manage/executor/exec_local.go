@@ -27,3 +27,30 @@ func (c *Manage) ExecLocal_Modify(transfer *types.ModifyConfig, tx *types.Transa
 	}
 	return set, nil
 }
+
+//proposalIndexKV 发起提案时保存按照发起高度的索引, 提案的状态从状态数据库中获取
+func proposalIndexKV(receipt *types.ReceiptData, rollback bool) []*types.KeyValue {
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != pty.TyLogConfigPropose {
+			continue
+		}
+		var log pty.ReceiptConfigProposal
+		err := types.Decode(item.Log, &log)
+		if err != nil {
+			panic(err) //数据错误了，已经被修改了
+		}
+		info := log.Current
+		kv := &types.KeyValue{Key: proposalLocalKey(info.Height, info.ProposalID), Value: []byte(info.ProposalID)}
+		if rollback {
+			kv.Value = nil
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs
+}
+
+// ExecLocal_Propose 保存提案的索引
+func (c *Manage) ExecLocal_Propose(payload *pty.ConfigProposal, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: proposalIndexKV(receipt, false)}, nil
+}
@@ -20,6 +20,7 @@ var (
 func Init(name string, cfg *types.TuringchainConfig, sub []byte) {
 	// 需要先 RegisterDappFork才可以Register dapp
 	drivers.Register(cfg, GetName(), newManage, cfg.GetDappFork(driverName, "Enable"))
+	drivers.RegisterBlockBeginner(GetName())
 	InitExecType()
 }
 
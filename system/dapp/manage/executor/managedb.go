@@ -14,6 +14,7 @@ import (
 type Action struct {
 	db       dbm.KV
 	fromaddr string
+	txhash   []byte
 	height   int64
 	cfg      *types.TuringchainConfig
 }
@@ -21,7 +22,7 @@ type Action struct {
 // NewAction new a action object
 func NewAction(m *Manage, tx *types.Transaction) *Action {
 	types.AssertConfig(m.GetAPI())
-	return &Action{db: m.GetStateDB(), fromaddr: tx.From(), height: m.GetHeight(), cfg: m.GetAPI().GetConfig()}
+	return &Action{db: m.GetStateDB(), fromaddr: tx.From(), txhash: tx.Hash(), height: m.GetHeight(), cfg: m.GetAPI().GetConfig()}
 
 }
 
@@ -37,6 +38,11 @@ func (m *Action) modifyConfig(modify *types.ModifyConfig) (*types.Receipt, error
 	if !IsSuperManager(m.cfg, m.fromaddr) {
 		return nil, pty.ErrNoPrivilege
 	}
+	return m.applyModify(modify)
+}
+
+//applyModify 修改配置项, 超级管理员直接修改和提案通过之后执行共用
+func (m *Action) applyModify(modify *types.ModifyConfig) (*types.Receipt, error) {
 	if len(modify.Key) == 0 {
 		return nil, pty.ErrBadConfigKey
 	}
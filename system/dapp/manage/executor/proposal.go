@@ -0,0 +1,347 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/turingchain2020/turingchain/common/address"
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	pty "github.com/turingchain2020/turingchain/system/dapp/manage/types"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+/*
+配置修改提案:
+1. 超级管理员发起提案, 提案可以修改一个配置项, 或者修改链上的审批人
+2. 审批人和通过数量保存在链上, 在ForkManageProposal 之后第一个区块按照配置文件初始化, 之后只能通过提案修改
+3. 审批人在[startHeight, endHeight] 之间投票, 发起提案时的审批人和通过数量保存在提案中
+4. 通过的提案在targetHeight 之后第一笔交易执行之前自动生效, 投票结束仍没有通过的提案同时标记为过期
+*/
+
+func proposalKey(id string) []byte {
+	return []byte(types.ManageKey("proposal-" + id))
+}
+
+func approversKey() []byte {
+	return []byte(types.ManageKey("proposal-approvers"))
+}
+
+func openProposalsKey() []byte {
+	return []byte(types.ManageKey("proposal-open"))
+}
+
+//proposalLocalKey 按照发起高度的提案索引
+func proposalLocalKey(height int64, id string) []byte {
+	return localKey(fmt.Sprintf("proposal-%020d-%s", height, id))
+}
+
+func proposalLocalPrefix() []byte {
+	return localKey("proposal-")
+}
+
+func getProposal(db dbm.KV, id string) (*pty.ConfigProposalInfo, error) {
+	value, err := db.Get(proposalKey(id))
+	if err != nil || value == nil {
+		return nil, pty.ErrProposalNotExist
+	}
+	var info pty.ConfigProposalInfo
+	err = types.Decode(value, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+//getApprovers 获取链上的审批人, 还没有初始化时返回ErrNoApprover
+func getApprovers(db dbm.KV) (*pty.ConfigApprovers, error) {
+	value, err := db.Get(approversKey())
+	if err == types.ErrNotFound || (err == nil && value == nil) {
+		return nil, pty.ErrNoApprover
+	}
+	if err != nil {
+		return nil, err
+	}
+	var approvers pty.ConfigApprovers
+	err = types.Decode(value, &approvers)
+	if err != nil {
+		return nil, err
+	}
+	return &approvers, nil
+}
+
+func getOpenProposals(db dbm.KV) (*pty.ConfigOpenProposals, error) {
+	value, err := db.Get(openProposalsKey())
+	if err == types.ErrNotFound || (err == nil && value == nil) {
+		return &pty.ConfigOpenProposals{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var open pty.ConfigOpenProposals
+	err = types.Decode(value, &open)
+	if err != nil {
+		return nil, err
+	}
+	return &open, nil
+}
+
+//checkApprovers 审批人不能为空和重复, 通过数量在[1, 审批人数量] 之间
+func checkApprovers(approvers *pty.ConfigApprovers) error {
+	if len(approvers.GetApprovers()) == 0 || approvers.Threshold <= 0 || int(approvers.Threshold) > len(approvers.Approvers) {
+		return pty.ErrBadApprovers
+	}
+	dup := make(map[string]bool)
+	for _, addr := range approvers.Approvers {
+		if dup[addr] || address.CheckAddress(addr) != nil {
+			return pty.ErrBadApprovers
+		}
+		dup[addr] = true
+	}
+	return nil
+}
+
+//isProposalOnly 开启之后配置只能通过提案修改
+func isProposalOnly(cfg *types.TuringchainConfig, db dbm.KV, height int64) bool {
+	if !cfg.IsDappFork(height, pty.ManageX, "ForkManageProposal") {
+		return false
+	}
+	approvers, err := getApprovers(db)
+	return err == nil && approvers.ProposalOnly
+}
+
+func hasAddr(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeReceipt(receipt, r *types.Receipt) {
+	receipt.KV = append(receipt.KV, r.KV...)
+	receipt.Logs = append(receipt.Logs, r.Logs...)
+}
+
+func (m *Action) saveProposal(info *pty.ConfigProposalInfo) *types.KeyValue {
+	kv := &types.KeyValue{Key: proposalKey(info.ProposalID), Value: types.Encode(info)}
+	m.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (m *Action) saveOpenProposals(open *pty.ConfigOpenProposals) *types.KeyValue {
+	kv := &types.KeyValue{Key: openProposalsKey(), Value: types.Encode(open)}
+	m.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (m *Action) saveApprovers(prev, current *pty.ConfigApprovers) *types.Receipt {
+	kv := &types.KeyValue{Key: approversKey(), Value: types.Encode(current)}
+	m.db.Set(kv.Key, kv.Value)
+	log := &pty.ReceiptConfigApprovers{Prev: prev, Current: current}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{kv},
+		Logs: []*types.ReceiptLog{{Ty: pty.TyLogConfigApprovers, Log: types.Encode(log)}},
+	}
+}
+
+func (m *Action) proposalReceipt(ty int32, prev, current *pty.ConfigProposalInfo) *types.Receipt {
+	log := &pty.ReceiptConfigProposal{Prev: prev, Current: current}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{m.saveProposal(current)},
+		Logs: []*types.ReceiptLog{{Ty: ty, Log: types.Encode(log)}},
+	}
+}
+
+//propose 超级管理员发起提案, 审批人和通过数量在发起时按照链上的审批人确定
+func (m *Action) propose(proposal *pty.ConfigProposal) (*types.Receipt, error) {
+	if !IsSuperManager(m.cfg, m.fromaddr) {
+		return nil, pty.ErrNoPrivilege
+	}
+	modify := proposal.GetModify()
+	if (modify == nil) == (proposal.GetApprovers() == nil) {
+		return nil, pty.ErrBadConfigKey
+	}
+	if modify != nil {
+		if len(modify.GetKey()) == 0 {
+			return nil, pty.ErrBadConfigKey
+		}
+		if modify.Op != "add" && modify.Op != "delete" {
+			return nil, pty.ErrBadConfigOp
+		}
+	} else if err := checkApprovers(proposal.Approvers); err != nil {
+		return nil, err
+	}
+	//投票在生效高度之前结束, 保证提案在targetHeight 的区块开始时生效
+	if proposal.StartHeight > proposal.EndHeight || proposal.EndHeight >= proposal.TargetHeight || proposal.EndHeight < m.height {
+		return nil, pty.ErrProposalHeight
+	}
+	approvers, err := getApprovers(m.db)
+	if err != nil {
+		return nil, err
+	}
+	if checkApprovers(approvers) != nil {
+		return nil, pty.ErrNoApprover
+	}
+	info := &pty.ConfigProposalInfo{
+		ProposalID: hex.EncodeToString(m.txhash),
+		Proposal:   proposal,
+		Proposer:   m.fromaddr,
+		Height:     m.height,
+		Threshold:  approvers.Threshold,
+		Approvers:  approvers.Approvers,
+		Status:     pty.ProposalStatusVoting,
+	}
+	open, err := getOpenProposals(m.db)
+	if err != nil {
+		return nil, err
+	}
+	open.ProposalIDs = append(open.ProposalIDs, info.ProposalID)
+	clog.Info("propose", "id", info.ProposalID, "key", modify.GetKey(), "target", proposal.TargetHeight)
+	receipt := m.proposalReceipt(pty.TyLogConfigPropose, nil, info)
+	receipt.KV = append(receipt.KV, m.saveOpenProposals(open))
+	return receipt, nil
+}
+
+//vote 审批人在投票区间内投票, 赞成票达到threshold 时提案通过, 反对票使提案无法通过时提案被否决
+func (m *Action) vote(vote *pty.ConfigProposalVote) (*types.Receipt, error) {
+	info, err := getProposal(m.db, vote.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+	if info.Status != pty.ProposalStatusVoting {
+		return nil, pty.ErrProposalStatus
+	}
+	if m.height < info.Proposal.StartHeight || m.height > info.Proposal.EndHeight {
+		return nil, pty.ErrProposalNotVoting
+	}
+	if !hasAddr(info.Approvers, m.fromaddr) {
+		return nil, pty.ErrNoApprover
+	}
+	if hasAddr(info.Approves, m.fromaddr) || hasAddr(info.Opposes, m.fromaddr) {
+		return nil, pty.ErrProposalVoted
+	}
+	prev := types.Clone(info).(*pty.ConfigProposalInfo)
+	if vote.Approve {
+		info.Approves = append(info.Approves, m.fromaddr)
+	} else {
+		info.Opposes = append(info.Opposes, m.fromaddr)
+	}
+	if len(info.Approves) >= int(info.Threshold) {
+		info.Status = pty.ProposalStatusApproved
+	} else if len(info.Opposes) > len(info.Approvers)-int(info.Threshold) {
+		info.Status = pty.ProposalStatusRejected
+	}
+	receipt := m.proposalReceipt(pty.TyLogConfigVote, prev, info)
+	//否决的提案不需要再检查
+	if info.Status == pty.ProposalStatusRejected {
+		kv, err := m.removeOpenProposal(info.ProposalID)
+		if err != nil {
+			return nil, err
+		}
+		receipt.KV = append(receipt.KV, kv)
+	}
+	return receipt, nil
+}
+
+func (m *Action) removeOpenProposal(id string) (*types.KeyValue, error) {
+	open, err := getOpenProposals(m.db)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, openid := range open.ProposalIDs {
+		if openid != id {
+			ids = append(ids, openid)
+		}
+	}
+	open.ProposalIDs = ids
+	return m.saveOpenProposals(open), nil
+}
+
+//blockBegin 第一次调用时按照配置文件初始化链上的审批人, 之后执行到达targetHeight 的提案, 关闭投票结束仍没有通过的提案
+func (m *Action) blockBegin() (*types.Receipt, error) {
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	_, err := getApprovers(m.db)
+	conf := types.ConfSub(m.cfg, pty.ManageX)
+	//没有配置审批人的链不写入, 提案返回ErrNoApprover
+	if err == pty.ErrNoApprover && len(conf.GStrList("approvers")) > 0 {
+		approvers := &pty.ConfigApprovers{
+			Approvers:    conf.GStrList("approvers"),
+			Threshold:    int32(conf.GInt("approveThreshold")),
+			ProposalOnly: conf.IsEnable("proposalOnly"),
+		}
+		clog.Info("init approvers", "height", m.height, "approvers", approvers.Approvers, "threshold", approvers.Threshold)
+		mergeReceipt(receipt, m.saveApprovers(nil, approvers))
+	} else if err != nil && err != pty.ErrNoApprover {
+		return nil, err
+	}
+	open, err := getOpenProposals(m.db)
+	if err != nil {
+		return nil, err
+	}
+	var remain []string
+	for _, id := range open.ProposalIDs {
+		info, err := getProposal(m.db, id)
+		if err != nil {
+			return nil, err
+		}
+		r, err := m.closeProposal(info)
+		if err != nil {
+			return nil, err
+		}
+		if r == nil {
+			remain = append(remain, id)
+			continue
+		}
+		mergeReceipt(receipt, r)
+	}
+	if len(remain) != len(open.ProposalIDs) {
+		receipt.KV = append(receipt.KV, m.saveOpenProposals(&pty.ConfigOpenProposals{ProposalIDs: remain}))
+	}
+	return receipt, nil
+}
+
+//closeProposal 通过的提案到达targetHeight 时生效, 投票中的提案超过endHeight 时过期, 其他情况返回nil
+func (m *Action) closeProposal(info *pty.ConfigProposalInfo) (*types.Receipt, error) {
+	prev := types.Clone(info).(*pty.ConfigProposalInfo)
+	switch {
+	case info.Status == pty.ProposalStatusApproved && m.height >= info.Proposal.TargetHeight:
+		return m.applyProposal(prev, info)
+	case info.Status == pty.ProposalStatusVoting && m.height > info.Proposal.EndHeight:
+		info.Status = pty.ProposalStatusExpired
+		return m.proposalReceipt(pty.TyLogConfigApply, prev, info), nil
+	}
+	return nil, nil
+}
+
+//applyProposal 执行通过的提案, 配置项修改失败时提案标记为失败, 不影响区块中的交易
+func (m *Action) applyProposal(prev, info *pty.ConfigProposalInfo) (*types.Receipt, error) {
+	var receipt *types.Receipt
+	var err error
+	if approvers := info.Proposal.GetApprovers(); approvers != nil {
+		var old *pty.ConfigApprovers
+		old, err = getApprovers(m.db)
+		if err == nil {
+			receipt = m.saveApprovers(old, approvers)
+		}
+	} else {
+		receipt, err = m.applyModify(info.Proposal.Modify)
+	}
+	info.ApplyHeight = m.height
+	if err != nil {
+		clog.Error("applyProposal", "id", info.ProposalID, "height", m.height, "err", err)
+		info.Status = pty.ProposalStatusFailed
+		return m.proposalReceipt(pty.TyLogConfigApply, prev, info), nil
+	}
+	info.Status = pty.ProposalStatusApplied
+	mergeReceipt(receipt, m.proposalReceipt(pty.TyLogConfigApply, prev, info))
+	clog.Info("applyProposal", "id", info.ProposalID, "key", info.Proposal.Modify.GetKey(), "height", m.height)
+	return receipt, nil
+}
@@ -0,0 +1,162 @@
+package executor
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common/crypto"
+	pty "github.com/turingchain2020/turingchain/system/dapp/manage/types"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/turingchain2020/turingchain/util/testnode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigProposal(t *testing.T) {
+	addr3, _ := util.Genaddress()
+	outsider, outsiderKey := util.Genaddress()
+	//审批人: hotkey, genesis 和一个不投票的地址, 两票通过
+	conf := `approvers=["12qyocayNF7Lv6C9qW4avxs2E7U41fKSfv","14KEKbYtKKQm4wMthSK9J4La4nAiidGozt","` + addr3 + `"]
+approveThreshold=2
+proposalOnly=true
+[exec.sub.autonomy]`
+	cfg := types.NewTuringchainConfig(strings.Replace(types.GetDefaultCfgstring(), "[exec.sub.autonomy]", conf, 1))
+	mocker := testnode.NewWithConfig(cfg, nil)
+	defer mocker.Close()
+	mocker.Listen()
+	assert.Nil(t, mocker.SendHot())
+	hotkey := mocker.GetHotKey()
+	genkey := mocker.GetGenesisKey()
+
+	send := func(priv crypto.PrivKey, action *pty.ManageAction) (string, int32) {
+		tx, err := types.FormatTx(cfg, pty.ManageX, &types.Transaction{Payload: types.Encode(action)})
+		assert.Nil(t, err)
+		tx.Sign(types.SECP256K1, priv)
+		detail, err := mocker.WaitTx(mocker.SendTx(tx))
+		assert.Nil(t, err)
+		return hex.EncodeToString(tx.Hash()), detail.Receipt.Ty
+	}
+	modify := &types.ModifyConfig{Key: "token-blacklist", Op: "add", Value: "ABC"}
+	propose := func(p *pty.ConfigProposal) string {
+		id, ty := send(hotkey, &pty.ManageAction{Ty: pty.ManageActionPropose, Value: &pty.ManageAction_Propose{Propose: p}})
+		assert.Equal(t, int32(types.ExecOk), ty)
+		return id
+	}
+	vote := func(priv crypto.PrivKey, id string, approve bool) int32 {
+		v := &pty.ConfigProposalVote{ProposalID: id, Approve: approve}
+		_, ty := send(priv, &pty.ManageAction{Ty: pty.ManageActionVote, Value: &pty.ManageAction_Vote{Vote: v}})
+		return ty
+	}
+	getProposal := func(id string) *pty.ConfigProposalInfo {
+		msg, err := mocker.GetAPI().Query(pty.ManageX, "GetConfigProposal", &types.ReqString{Data: id})
+		assert.Nil(t, err)
+		return msg.(*pty.ConfigProposalInfo)
+	}
+	getApprovers := func() *pty.ConfigApprovers {
+		msg, err := mocker.GetAPI().Query(pty.ManageX, "GetConfigApprovers", &types.ReqNil{})
+		assert.Nil(t, err)
+		return msg.(*pty.ConfigApprovers)
+	}
+	//其他交易所在的区块也会处理提案
+	waitHeight := func(height int64) {
+		for mocker.GetLastBlock().Height < height {
+			detail, err := mocker.WaitTx(mocker.SendTx(util.CreateCoinsTx(cfg, genkey, outsider, types.Coin)))
+			assert.Nil(t, err)
+			assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+		}
+	}
+
+	//给非审批人转账用于支付手续费
+	waitHeight(mocker.GetLastBlock().Height + 1)
+
+	//审批人在分叉之后的第一个区块按照配置文件写入链上
+	approvers := getApprovers()
+	assert.Equal(t, int32(2), approvers.Threshold)
+	assert.Equal(t, 3, len(approvers.Approvers))
+	assert.True(t, approvers.ProposalOnly)
+
+	//proposalOnly 开启之后不能直接修改配置
+	_, ty := send(hotkey, &pty.ManageAction{Ty: pty.ManageActionModifyConfig, Value: &pty.ManageAction_Modify{Modify: modify}})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	//投票需要在生效高度之前结束
+	height := mocker.GetLastBlock().Height
+	_, ty = send(hotkey, &pty.ManageAction{Ty: pty.ManageActionPropose, Value: &pty.ManageAction_Propose{
+		Propose: &pty.ConfigProposal{Modify: modify, EndHeight: height + 5, TargetHeight: height + 5}}})
+	assert.Equal(t, int32(types.ExecPack), ty)
+
+	height = mocker.GetLastBlock().Height
+	target := height + 8
+	id := propose(&pty.ConfigProposal{Modify: modify, EndHeight: height + 5, TargetHeight: target})
+	//非审批人不能投票
+	assert.Equal(t, int32(types.ExecPack), vote(outsiderKey, id, true))
+	assert.Equal(t, int32(types.ExecOk), vote(genkey, id, true))
+	assert.Equal(t, int32(types.ExecPack), vote(genkey, id, true))
+	assert.Equal(t, int32(types.ExecOk), vote(hotkey, id, true))
+	info := getProposal(id)
+	assert.Equal(t, int32(pty.ProposalStatusApproved), info.Status)
+	assert.Equal(t, 2, len(info.Approves))
+
+	//到达targetHeight 之后自动生效, 不需要单独的交易, 区块第一笔交易是交易组时也会处理
+	waitHeight(target - 1)
+	assert.Equal(t, int32(pty.ProposalStatusApproved), getProposal(id).Status)
+	group, err := types.CreateTxGroup([]*types.Transaction{
+		util.CreateCoinsTx(cfg, genkey, outsider, types.Coin),
+		util.CreateCoinsTx(cfg, genkey, outsider, types.Coin),
+	}, cfg.GetMinTxFeeRate())
+	assert.Nil(t, err)
+	group.SignN(0, types.SECP256K1, genkey)
+	group.SignN(1, types.SECP256K1, genkey)
+	detail, err := mocker.WaitTx(mocker.SendTx(group.Tx()))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+	assert.Equal(t, target, detail.Height)
+	info = getProposal(id)
+	assert.Equal(t, int32(pty.ProposalStatusApplied), info.Status)
+	assert.Equal(t, target, info.ApplyHeight)
+	msg, err := mocker.GetAPI().Query(pty.ManageX, "GetConfigItem", &types.ReqString{Data: "token-blacklist"})
+	assert.Nil(t, err)
+	assert.Contains(t, msg.(*types.ReplyConfig).Value, "ABC")
+
+	//反对票超过 审批人数-threshold 时提案被否决
+	height = mocker.GetLastBlock().Height
+	id2 := propose(&pty.ConfigProposal{Modify: modify, EndHeight: height + 10, TargetHeight: height + 11})
+	assert.Equal(t, int32(types.ExecOk), vote(genkey, id2, false))
+	assert.Equal(t, int32(types.ExecOk), vote(hotkey, id2, false))
+	assert.Equal(t, int32(pty.ProposalStatusRejected), getProposal(id2).Status)
+	assert.Equal(t, int32(types.ExecPack), vote(outsiderKey, id2, true))
+
+	//投票结束没有通过的提案过期
+	height = mocker.GetLastBlock().Height
+	id3 := propose(&pty.ConfigProposal{Modify: modify, EndHeight: height + 2, TargetHeight: height + 3})
+	assert.Equal(t, int32(types.ExecOk), vote(genkey, id3, true))
+	waitHeight(height + 3)
+	assert.Equal(t, int32(pty.ProposalStatusExpired), getProposal(id3).Status)
+
+	//审批人只能通过提案修改, 修改之后超级管理员可以直接修改配置
+	height = mocker.GetLastBlock().Height
+	newApprovers := &pty.ConfigApprovers{Approvers: []string{mocker.GetGenesisAddress()}, Threshold: 1}
+	id4 := propose(&pty.ConfigProposal{Approvers: newApprovers, EndHeight: height + 3, TargetHeight: height + 4})
+	assert.Equal(t, int32(types.ExecOk), vote(genkey, id4, true))
+	assert.Equal(t, int32(types.ExecOk), vote(hotkey, id4, true))
+	waitHeight(height + 4)
+	assert.Equal(t, int32(pty.ProposalStatusApplied), getProposal(id4).Status)
+	approvers = getApprovers()
+	assert.Equal(t, newApprovers.Approvers, approvers.Approvers)
+	assert.Equal(t, int32(1), approvers.Threshold)
+	assert.False(t, approvers.ProposalOnly)
+	_, ty = send(hotkey, &pty.ManageAction{Ty: pty.ManageActionModifyConfig, Value: &pty.ManageAction_Modify{Modify: modify}})
+	assert.Equal(t, int32(types.ExecOk), ty)
+
+	list := func(status int32) int {
+		msg, err := mocker.GetAPI().Query(pty.ManageX, "ListConfigProposals", &pty.ReqConfigProposals{Status: status})
+		assert.Nil(t, err)
+		return len(msg.(*pty.ReplyConfigProposals).Proposals)
+	}
+	assert.Equal(t, 4, list(0))
+	assert.Equal(t, 1, list(pty.ProposalStatusRejected))
+	assert.Equal(t, 2, list(pty.ProposalStatusApplied))
+	assert.Equal(t, 1, list(pty.ProposalStatusExpired))
+	assert.Equal(t, 0, list(pty.ProposalStatusApproved))
+}
@@ -7,9 +7,13 @@ package executor
 import (
 	"fmt"
 
+	pty "github.com/turingchain2020/turingchain/system/dapp/manage/types"
 	"github.com/turingchain2020/turingchain/types"
 )
 
+//一次最多查询的提案数量
+const maxProposalCount = 100
+
 // Query_GetConfigItem get config item
 func (c *Manage) Query_GetConfigItem(in *types.ReqString) (types.Message, error) {
 	// Load config from state db
@@ -44,3 +48,54 @@ func (c *Manage) Query_GetConfigItem(in *types.ReqString) (types.Message, error)
 
 	return &reply, nil
 }
+
+// Query_GetConfigProposal get config proposal
+func (c *Manage) Query_GetConfigProposal(in *types.ReqString) (types.Message, error) {
+	return getProposal(c.GetStateDB(), in.Data)
+}
+
+// Query_GetConfigApprovers get approvers on chain
+func (c *Manage) Query_GetConfigApprovers(in *types.ReqNil) (types.Message, error) {
+	return getApprovers(c.GetStateDB())
+}
+
+// Query_ListConfigProposals list config proposals by status
+func (c *Manage) Query_ListConfigProposals(in *pty.ReqConfigProposals) (types.Message, error) {
+	count := in.Count
+	if count <= 0 || count > maxProposalCount {
+		count = maxProposalCount
+	}
+	var key []byte
+	if in.ProposalID != "" {
+		info, err := getProposal(c.GetStateDB(), in.ProposalID)
+		if err != nil {
+			return nil, err
+		}
+		key = proposalLocalKey(info.Height, info.ProposalID)
+	}
+	//索引中没有状态, 按照状态过滤时需要继续向后查找
+	reply := &pty.ReplyConfigProposals{}
+	for int32(len(reply.Proposals)) < count {
+		values, err := c.GetLocalDB().List(proposalLocalPrefix(), key, count, in.Direction)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		for _, id := range values {
+			info, err := getProposal(c.GetStateDB(), string(id))
+			if err != nil {
+				return nil, err
+			}
+			key = proposalLocalKey(info.Height, info.ProposalID)
+			if in.Status == 0 || in.Status == info.Status {
+				reply.Proposals = append(reply.Proposals, info)
+			}
+			if int32(len(reply.Proposals)) >= count {
+				break
+			}
+		}
+		if int32(len(values)) < count {
+			break
+		}
+	}
+	return reply, nil
+}
@@ -7,14 +7,30 @@ package types
 // ManageActionModifyConfig manager action
 const (
 	ManageActionModifyConfig = iota
+	ManageActionPropose
+	ManageActionVote
 )
 
 // TyLogModifyConfig log
 const (
-	TyLogModifyConfig = 410
+	TyLogModifyConfig    = 410
+	TyLogConfigPropose   = 411
+	TyLogConfigVote      = 412
+	TyLogConfigApply     = 413
+	TyLogConfigApprovers = 414
 )
 
 // ConfigItemArrayConfig config Item
 const (
 	ConfigItemArrayConfig = iota
 )
+
+// ProposalStatusVoting 配置修改提案的状态
+const (
+	ProposalStatusVoting = iota + 1
+	ProposalStatusApproved
+	ProposalStatusApplied
+	ProposalStatusRejected
+	ProposalStatusExpired
+	ProposalStatusFailed
+)
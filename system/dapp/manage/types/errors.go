@@ -15,4 +15,20 @@ var (
 	ErrBadConfigOp = errors.New("ErrBadConfigOp")
 	// ErrBadConfigValue defines a err string errbadconfigvalue
 	ErrBadConfigValue = errors.New("ErrBadConfigValue")
+	// ErrProposalRequired defines a err string errproposalrequired
+	ErrProposalRequired = errors.New("ErrProposalRequired")
+	// ErrProposalHeight defines a err string errproposalheight
+	ErrProposalHeight = errors.New("ErrProposalHeight")
+	// ErrNoApprover defines a err string errnoapprover
+	ErrNoApprover = errors.New("ErrNoApprover")
+	// ErrProposalNotExist defines a err string errproposalnotexist
+	ErrProposalNotExist = errors.New("ErrProposalNotExist")
+	// ErrProposalStatus defines a err string errproposalstatus
+	ErrProposalStatus = errors.New("ErrProposalStatus")
+	// ErrProposalVoted defines a err string errproposalvoted
+	ErrProposalVoted = errors.New("ErrProposalVoted")
+	// ErrProposalNotVoting defines a err string errproposalnotvoting
+	ErrProposalNotVoting = errors.New("ErrProposalNotVoting")
+	// ErrBadApprovers defines a err string errbadapprovers
+	ErrBadApprovers = errors.New("ErrBadApprovers")
 )
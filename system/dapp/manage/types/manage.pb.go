@@ -7,8 +7,8 @@ import (
 	fmt "fmt"
 	math "math"
 
-	types "github.com/turingchain2020/turingchain/types"
 	proto "github.com/golang/protobuf/proto"
+	types "github.com/turingchain2020/turingchain/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -25,6 +25,8 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type ManageAction struct {
 	// Types that are valid to be assigned to Value:
 	//	*ManageAction_Modify
+	//	*ManageAction_Propose
+	//	*ManageAction_Vote
 	Value                isManageAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                `protobuf:"varint,2,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -65,8 +67,20 @@ type ManageAction_Modify struct {
 	Modify *types.ModifyConfig `protobuf:"bytes,1,opt,name=modify,proto3,oneof"`
 }
 
+type ManageAction_Propose struct {
+	Propose *ConfigProposal `protobuf:"bytes,3,opt,name=propose,proto3,oneof"`
+}
+
+type ManageAction_Vote struct {
+	Vote *ConfigProposalVote `protobuf:"bytes,4,opt,name=vote,proto3,oneof"`
+}
+
 func (*ManageAction_Modify) isManageAction_Value() {}
 
+func (*ManageAction_Propose) isManageAction_Value() {}
+
+func (*ManageAction_Vote) isManageAction_Value() {}
+
 func (m *ManageAction) GetValue() isManageAction_Value {
 	if m != nil {
 		return m.Value
@@ -81,6 +95,20 @@ func (m *ManageAction) GetModify() *types.ModifyConfig {
 	return nil
 }
 
+func (m *ManageAction) GetPropose() *ConfigProposal {
+	if x, ok := m.GetValue().(*ManageAction_Propose); ok {
+		return x.Propose
+	}
+	return nil
+}
+
+func (m *ManageAction) GetVote() *ConfigProposalVote {
+	if x, ok := m.GetValue().(*ManageAction_Vote); ok {
+		return x.Vote
+	}
+	return nil
+}
+
 func (m *ManageAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -92,11 +120,547 @@ func (m *ManageAction) GetTy() int32 {
 func (*ManageAction) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ManageAction_Modify)(nil),
+		(*ManageAction_Propose)(nil),
+		(*ManageAction_Vote)(nil),
+	}
+}
+
+// 配置修改提案, 审批人在[startHeight, endHeight] 之间投票, 通过之后在targetHeight 自动生效
+// modify 和approvers 只能设置一个, approvers 用于修改链上的审批人
+type ConfigProposal struct {
+	Modify               *types.ModifyConfig `protobuf:"bytes,1,opt,name=modify,proto3" json:"modify,omitempty"`
+	StartHeight          int64               `protobuf:"varint,2,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	EndHeight            int64               `protobuf:"varint,3,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	TargetHeight         int64               `protobuf:"varint,4,opt,name=targetHeight,proto3" json:"targetHeight,omitempty"`
+	Approvers            *ConfigApprovers    `protobuf:"bytes,5,opt,name=approvers,proto3" json:"approvers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ConfigProposal) Reset()         { *m = ConfigProposal{} }
+func (m *ConfigProposal) String() string { return proto.CompactTextString(m) }
+func (*ConfigProposal) ProtoMessage()    {}
+func (*ConfigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{1}
+}
+
+func (m *ConfigProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigProposal.Unmarshal(m, b)
+}
+func (m *ConfigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigProposal.Marshal(b, m, deterministic)
+}
+func (m *ConfigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigProposal.Merge(m, src)
+}
+func (m *ConfigProposal) XXX_Size() int {
+	return xxx_messageInfo_ConfigProposal.Size(m)
+}
+func (m *ConfigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigProposal proto.InternalMessageInfo
+
+func (m *ConfigProposal) GetModify() *types.ModifyConfig {
+	if m != nil {
+		return m.Modify
+	}
+	return nil
+}
+
+func (m *ConfigProposal) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ConfigProposal) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ConfigProposal) GetTargetHeight() int64 {
+	if m != nil {
+		return m.TargetHeight
+	}
+	return 0
+}
+
+func (m *ConfigProposal) GetApprovers() *ConfigApprovers {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
+// 链上保存的审批人和通过需要的赞成票数量, 在ForkManageProposal 按照配置文件初始化, 之后只能通过提案修改
+// proposalOnly 开启之后超级管理员不能直接修改配置
+type ConfigApprovers struct {
+	Approvers            []string `protobuf:"bytes,1,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	Threshold            int32    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ProposalOnly         bool     `protobuf:"varint,3,opt,name=proposalOnly,proto3" json:"proposalOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigApprovers) Reset()         { *m = ConfigApprovers{} }
+func (m *ConfigApprovers) String() string { return proto.CompactTextString(m) }
+func (*ConfigApprovers) ProtoMessage()    {}
+func (*ConfigApprovers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{2}
+}
+
+func (m *ConfigApprovers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigApprovers.Unmarshal(m, b)
+}
+func (m *ConfigApprovers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigApprovers.Marshal(b, m, deterministic)
+}
+func (m *ConfigApprovers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigApprovers.Merge(m, src)
+}
+func (m *ConfigApprovers) XXX_Size() int {
+	return xxx_messageInfo_ConfigApprovers.Size(m)
+}
+func (m *ConfigApprovers) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigApprovers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigApprovers proto.InternalMessageInfo
+
+func (m *ConfigApprovers) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
 	}
+	return nil
+}
+
+func (m *ConfigApprovers) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ConfigApprovers) GetProposalOnly() bool {
+	if m != nil {
+		return m.ProposalOnly
+	}
+	return false
+}
+
+type ReceiptConfigApprovers struct {
+	Prev                 *ConfigApprovers `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *ConfigApprovers `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReceiptConfigApprovers) Reset()         { *m = ReceiptConfigApprovers{} }
+func (m *ReceiptConfigApprovers) String() string { return proto.CompactTextString(m) }
+func (*ReceiptConfigApprovers) ProtoMessage()    {}
+func (*ReceiptConfigApprovers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{3}
+}
+
+func (m *ReceiptConfigApprovers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptConfigApprovers.Unmarshal(m, b)
+}
+func (m *ReceiptConfigApprovers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptConfigApprovers.Marshal(b, m, deterministic)
+}
+func (m *ReceiptConfigApprovers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptConfigApprovers.Merge(m, src)
+}
+func (m *ReceiptConfigApprovers) XXX_Size() int {
+	return xxx_messageInfo_ReceiptConfigApprovers.Size(m)
+}
+func (m *ReceiptConfigApprovers) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptConfigApprovers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptConfigApprovers proto.InternalMessageInfo
+
+func (m *ReceiptConfigApprovers) GetPrev() *ConfigApprovers {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptConfigApprovers) GetCurrent() *ConfigApprovers {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+// 还没有结束的提案, 每个区块执行第一笔交易之前检查是否需要生效或者过期
+type ConfigOpenProposals struct {
+	ProposalIDs          []string `protobuf:"bytes,1,rep,name=proposalIDs,proto3" json:"proposalIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigOpenProposals) Reset()         { *m = ConfigOpenProposals{} }
+func (m *ConfigOpenProposals) String() string { return proto.CompactTextString(m) }
+func (*ConfigOpenProposals) ProtoMessage()    {}
+func (*ConfigOpenProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{4}
+}
+
+func (m *ConfigOpenProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigOpenProposals.Unmarshal(m, b)
+}
+func (m *ConfigOpenProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigOpenProposals.Marshal(b, m, deterministic)
+}
+func (m *ConfigOpenProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigOpenProposals.Merge(m, src)
+}
+func (m *ConfigOpenProposals) XXX_Size() int {
+	return xxx_messageInfo_ConfigOpenProposals.Size(m)
+}
+func (m *ConfigOpenProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigOpenProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigOpenProposals proto.InternalMessageInfo
+
+func (m *ConfigOpenProposals) GetProposalIDs() []string {
+	if m != nil {
+		return m.ProposalIDs
+	}
+	return nil
+}
+
+type ConfigProposalVote struct {
+	ProposalID           string   `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Approve              bool     `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigProposalVote) Reset()         { *m = ConfigProposalVote{} }
+func (m *ConfigProposalVote) String() string { return proto.CompactTextString(m) }
+func (*ConfigProposalVote) ProtoMessage()    {}
+func (*ConfigProposalVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{5}
+}
+
+func (m *ConfigProposalVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigProposalVote.Unmarshal(m, b)
+}
+func (m *ConfigProposalVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigProposalVote.Marshal(b, m, deterministic)
+}
+func (m *ConfigProposalVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigProposalVote.Merge(m, src)
+}
+func (m *ConfigProposalVote) XXX_Size() int {
+	return xxx_messageInfo_ConfigProposalVote.Size(m)
+}
+func (m *ConfigProposalVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigProposalVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigProposalVote proto.InternalMessageInfo
+
+func (m *ConfigProposalVote) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+func (m *ConfigProposalVote) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+type ConfigProposalInfo struct {
+	ProposalID           string          `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Proposal             *ConfigProposal `protobuf:"bytes,2,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	Proposer             string          `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Height               int64           `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Threshold            int32           `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Approvers            []string        `protobuf:"bytes,6,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	Approves             []string        `protobuf:"bytes,7,rep,name=approves,proto3" json:"approves,omitempty"`
+	Opposes              []string        `protobuf:"bytes,8,rep,name=opposes,proto3" json:"opposes,omitempty"`
+	Status               int32           `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	ApplyHeight          int64           `protobuf:"varint,10,opt,name=applyHeight,proto3" json:"applyHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ConfigProposalInfo) Reset()         { *m = ConfigProposalInfo{} }
+func (m *ConfigProposalInfo) String() string { return proto.CompactTextString(m) }
+func (*ConfigProposalInfo) ProtoMessage()    {}
+func (*ConfigProposalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{6}
+}
+
+func (m *ConfigProposalInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigProposalInfo.Unmarshal(m, b)
+}
+func (m *ConfigProposalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigProposalInfo.Marshal(b, m, deterministic)
+}
+func (m *ConfigProposalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigProposalInfo.Merge(m, src)
+}
+func (m *ConfigProposalInfo) XXX_Size() int {
+	return xxx_messageInfo_ConfigProposalInfo.Size(m)
+}
+func (m *ConfigProposalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigProposalInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigProposalInfo proto.InternalMessageInfo
+
+func (m *ConfigProposalInfo) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+func (m *ConfigProposalInfo) GetProposal() *ConfigProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
+func (m *ConfigProposalInfo) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *ConfigProposalInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConfigProposalInfo) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ConfigProposalInfo) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
+func (m *ConfigProposalInfo) GetApproves() []string {
+	if m != nil {
+		return m.Approves
+	}
+	return nil
+}
+
+func (m *ConfigProposalInfo) GetOpposes() []string {
+	if m != nil {
+		return m.Opposes
+	}
+	return nil
+}
+
+func (m *ConfigProposalInfo) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ConfigProposalInfo) GetApplyHeight() int64 {
+	if m != nil {
+		return m.ApplyHeight
+	}
+	return 0
+}
+
+type ReceiptConfigProposal struct {
+	Prev                 *ConfigProposalInfo `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *ConfigProposalInfo `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReceiptConfigProposal) Reset()         { *m = ReceiptConfigProposal{} }
+func (m *ReceiptConfigProposal) String() string { return proto.CompactTextString(m) }
+func (*ReceiptConfigProposal) ProtoMessage()    {}
+func (*ReceiptConfigProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{7}
+}
+
+func (m *ReceiptConfigProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptConfigProposal.Unmarshal(m, b)
+}
+func (m *ReceiptConfigProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptConfigProposal.Marshal(b, m, deterministic)
+}
+func (m *ReceiptConfigProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptConfigProposal.Merge(m, src)
+}
+func (m *ReceiptConfigProposal) XXX_Size() int {
+	return xxx_messageInfo_ReceiptConfigProposal.Size(m)
+}
+func (m *ReceiptConfigProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptConfigProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptConfigProposal proto.InternalMessageInfo
+
+func (m *ReceiptConfigProposal) GetPrev() *ConfigProposalInfo {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptConfigProposal) GetCurrent() *ConfigProposalInfo {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+// status 为0 时查询所有状态的提案
+type ReqConfigProposals struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	ProposalID           string   `protobuf:"bytes,4,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqConfigProposals) Reset()         { *m = ReqConfigProposals{} }
+func (m *ReqConfigProposals) String() string { return proto.CompactTextString(m) }
+func (*ReqConfigProposals) ProtoMessage()    {}
+func (*ReqConfigProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{8}
+}
+
+func (m *ReqConfigProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqConfigProposals.Unmarshal(m, b)
+}
+func (m *ReqConfigProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqConfigProposals.Marshal(b, m, deterministic)
+}
+func (m *ReqConfigProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqConfigProposals.Merge(m, src)
+}
+func (m *ReqConfigProposals) XXX_Size() int {
+	return xxx_messageInfo_ReqConfigProposals.Size(m)
+}
+func (m *ReqConfigProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqConfigProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqConfigProposals proto.InternalMessageInfo
+
+func (m *ReqConfigProposals) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ReqConfigProposals) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqConfigProposals) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqConfigProposals) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+type ReplyConfigProposals struct {
+	Proposals            []*ConfigProposalInfo `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReplyConfigProposals) Reset()         { *m = ReplyConfigProposals{} }
+func (m *ReplyConfigProposals) String() string { return proto.CompactTextString(m) }
+func (*ReplyConfigProposals) ProtoMessage()    {}
+func (*ReplyConfigProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519fa8ed5ffbbc8f, []int{9}
+}
+
+func (m *ReplyConfigProposals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyConfigProposals.Unmarshal(m, b)
+}
+func (m *ReplyConfigProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyConfigProposals.Marshal(b, m, deterministic)
+}
+func (m *ReplyConfigProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyConfigProposals.Merge(m, src)
+}
+func (m *ReplyConfigProposals) XXX_Size() int {
+	return xxx_messageInfo_ReplyConfigProposals.Size(m)
+}
+func (m *ReplyConfigProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyConfigProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyConfigProposals proto.InternalMessageInfo
+
+func (m *ReplyConfigProposals) GetProposals() []*ConfigProposalInfo {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
 }
 
 func init() {
 	proto.RegisterType((*ManageAction)(nil), "types.ManageAction")
+	proto.RegisterType((*ConfigProposal)(nil), "types.ConfigProposal")
+	proto.RegisterType((*ConfigApprovers)(nil), "types.ConfigApprovers")
+	proto.RegisterType((*ReceiptConfigApprovers)(nil), "types.ReceiptConfigApprovers")
+	proto.RegisterType((*ConfigOpenProposals)(nil), "types.ConfigOpenProposals")
+	proto.RegisterType((*ConfigProposalVote)(nil), "types.ConfigProposalVote")
+	proto.RegisterType((*ConfigProposalInfo)(nil), "types.ConfigProposalInfo")
+	proto.RegisterType((*ReceiptConfigProposal)(nil), "types.ReceiptConfigProposal")
+	proto.RegisterType((*ReqConfigProposals)(nil), "types.ReqConfigProposals")
+	proto.RegisterType((*ReplyConfigProposals)(nil), "types.ReplyConfigProposals")
 }
 
 func init() {
@@ -104,14 +668,42 @@ func init() {
 }
 
 var fileDescriptor_519fa8ed5ffbbc8f = []byte{
-	// 136 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xc9, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x96,
-	0xe2, 0x4b, 0xad, 0x48, 0x4d, 0x2e, 0x2d, 0xc9, 0x2f, 0x82, 0x08, 0x2b, 0x85, 0x71, 0xf1, 0xf8,
-	0x82, 0x95, 0x39, 0x26, 0x97, 0x64, 0xe6, 0xe7, 0x09, 0xe9, 0x72, 0xb1, 0xe5, 0xe6, 0xa7, 0x64,
-	0xa6, 0x55, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x09, 0xeb, 0x81, 0xf5, 0xe9, 0xf9, 0x82,
-	0x05, 0x9d, 0xf3, 0xf3, 0xd2, 0x32, 0xd3, 0x3d, 0x18, 0x82, 0xa0, 0x8a, 0x84, 0xf8, 0xb8, 0x98,
-	0x42, 0x2a, 0x25, 0x98, 0x14, 0x18, 0x35, 0x58, 0x83, 0x98, 0x42, 0x2a, 0x9d, 0xd8, 0xb9, 0x58,
-	0xcb, 0x12, 0x73, 0x4a, 0x53, 0x93, 0xd8, 0xc0, 0xc6, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xf1, 0x53, 0xa6, 0x25, 0x85, 0x00, 0x00, 0x00,
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xee, 0x26, 0xd9, 0x24, 0x3b, 0x8d, 0x82, 0xe4, 0xb6, 0x91, 0x89, 0x10, 0x8a, 0xf6, 0x14,
+	0x81, 0x1a, 0x28, 0x45, 0xea, 0xb9, 0xc0, 0x21, 0x3d, 0x94, 0x20, 0xab, 0xe2, 0xbe, 0x24, 0x4e,
+	0xb2, 0xd2, 0x76, 0xed, 0x7a, 0x9d, 0x88, 0x15, 0x17, 0x9e, 0x8b, 0x17, 0xe0, 0x11, 0x78, 0x1d,
+	0xb4, 0xb3, 0xf6, 0xfe, 0x51, 0x02, 0xc7, 0xf9, 0xe6, 0xb3, 0xe7, 0x9b, 0x99, 0xcf, 0x86, 0xc1,
+	0x7d, 0x10, 0x07, 0x1b, 0x3e, 0x93, 0x4a, 0x68, 0x41, 0x5c, 0x9d, 0x4a, 0x9e, 0x8c, 0x87, 0xfc,
+	0x2b, 0x5f, 0xee, 0xb4, 0x50, 0x39, 0xec, 0xff, 0x70, 0x60, 0x70, 0x8b, 0xbc, 0xeb, 0xa5, 0x0e,
+	0x45, 0x4c, 0xce, 0xa1, 0x7b, 0x2f, 0x56, 0xe1, 0x3a, 0xa5, 0xce, 0xc4, 0x99, 0x1e, 0xbf, 0x39,
+	0x99, 0xe1, 0xc1, 0xd9, 0x2d, 0x82, 0xef, 0x45, 0xbc, 0x0e, 0x37, 0xf3, 0x23, 0x66, 0x48, 0xe4,
+	0x02, 0x7a, 0x52, 0x09, 0x29, 0x12, 0x4e, 0xdb, 0xc8, 0x3f, 0x33, 0xfc, 0x9c, 0xf9, 0x09, 0x73,
+	0x41, 0x34, 0x3f, 0x62, 0x96, 0x47, 0x5e, 0x41, 0x67, 0x2f, 0x34, 0xa7, 0x1d, 0xe4, 0x3f, 0x7d,
+	0x94, 0xff, 0x59, 0x68, 0x3e, 0x3f, 0x62, 0x48, 0x24, 0x43, 0x68, 0xdd, 0xa5, 0xb4, 0x35, 0x71,
+	0xa6, 0x2e, 0x6b, 0xdd, 0xa5, 0xef, 0x7a, 0xe0, 0xee, 0x83, 0x68, 0xc7, 0xfd, 0x5f, 0x0e, 0x0c,
+	0xeb, 0xe7, 0xc8, 0xcb, 0xff, 0x90, 0x5f, 0x88, 0x9f, 0xc0, 0x71, 0xa2, 0x03, 0xa5, 0xe7, 0x3c,
+	0xdc, 0x6c, 0x35, 0x56, 0x68, 0xb3, 0x2a, 0x44, 0x9e, 0x81, 0xc7, 0xe3, 0x95, 0xc9, 0xb7, 0x31,
+	0x5f, 0x02, 0xc4, 0x87, 0x81, 0x0e, 0xd4, 0x86, 0xdb, 0x0b, 0x3a, 0x48, 0xa8, 0x61, 0xe4, 0x2d,
+	0x78, 0x81, 0x94, 0x4a, 0xec, 0xb9, 0x4a, 0xa8, 0x8b, 0x9a, 0x46, 0xb5, 0x96, 0xaf, 0x6d, 0x96,
+	0x95, 0x44, 0xff, 0x01, 0x9e, 0x34, 0xb2, 0x99, 0x94, 0xf2, 0x22, 0x67, 0xd2, 0x9e, 0x7a, 0x95,
+	0x03, 0x59, 0x56, 0x6f, 0x15, 0x4f, 0xb6, 0x22, 0x5a, 0x99, 0x51, 0x95, 0x40, 0x26, 0x54, 0x9a,
+	0x09, 0x2d, 0xe2, 0x28, 0xc5, 0x4e, 0xfa, 0xac, 0x86, 0xf9, 0x7b, 0x18, 0x31, 0xbe, 0xe4, 0xa1,
+	0xd4, 0xcd, 0xca, 0x2f, 0xa0, 0x23, 0x15, 0xdf, 0x53, 0xe7, 0xa0, 0x7a, 0xe4, 0x90, 0xd7, 0xd0,
+	0x5b, 0xee, 0x94, 0xe2, 0x71, 0x3e, 0xce, 0xbf, 0xd3, 0x2d, 0xcd, 0xbf, 0x82, 0x93, 0x3c, 0xb7,
+	0x90, 0x3c, 0xb6, 0x7b, 0x4c, 0xb2, 0xdd, 0x58, 0x79, 0x37, 0x1f, 0x6c, 0xc3, 0x55, 0xc8, 0xff,
+	0x08, 0xe4, 0x4f, 0xd3, 0x90, 0xe7, 0x00, 0x25, 0x09, 0x25, 0x7b, 0xac, 0x82, 0x10, 0x0a, 0x3d,
+	0x33, 0x35, 0x14, 0xd8, 0x67, 0x36, 0xf4, 0x7f, 0xb6, 0x9a, 0x17, 0xde, 0xc4, 0x6b, 0xf1, 0xcf,
+	0x0b, 0x2f, 0xa0, 0x6f, 0x23, 0xda, 0x3a, 0xf0, 0x04, 0x58, 0x41, 0x23, 0x63, 0x7b, 0x84, 0x2b,
+	0x5c, 0x85, 0xc7, 0x8a, 0x98, 0x8c, 0xa0, 0xbb, 0xad, 0xba, 0xc9, 0x44, 0xf5, 0x05, 0xbb, 0xcd,
+	0x05, 0xd7, 0xcc, 0xd1, 0x6d, 0x9a, 0x63, 0x0c, 0x7d, 0x13, 0x24, 0xb4, 0x87, 0xc9, 0x22, 0xce,
+	0xe6, 0x21, 0x64, 0x56, 0x3a, 0xa1, 0x7d, 0x4c, 0xd9, 0x30, 0x53, 0x92, 0xe8, 0x40, 0xef, 0x12,
+	0xea, 0x61, 0x39, 0x13, 0x65, 0x9b, 0x09, 0xa4, 0x8c, 0x52, 0x63, 0x7a, 0xc8, 0x5f, 0x4d, 0x05,
+	0xf2, 0xbf, 0xc1, 0x59, 0xcd, 0x4a, 0xc5, 0xeb, 0x3c, 0xaf, 0x39, 0xe9, 0xf1, 0xa7, 0x9f, 0x0d,
+	0xdd, 0x98, 0xe9, 0xb2, 0x69, 0xa6, 0x03, 0x27, 0x0a, 0x3f, 0x7d, 0x77, 0x80, 0x30, 0xfe, 0x50,
+	0xa7, 0x54, 0xbb, 0x71, 0x6a, 0xdd, 0x9c, 0x82, 0xbb, 0x14, 0x3b, 0x53, 0xc1, 0x65, 0x79, 0x90,
+	0xcd, 0x73, 0x15, 0x2a, 0x8e, 0x5f, 0x22, 0xae, 0xc8, 0x65, 0x25, 0xd0, 0xb0, 0x44, 0xa7, 0x69,
+	0x09, 0x7f, 0x01, 0xa7, 0x8c, 0xcb, 0x28, 0x6d, 0x6a, 0xb8, 0x02, 0xcf, 0xb2, 0x72, 0x47, 0x1f,
+	0xec, 0xa8, 0xe4, 0x7e, 0xe9, 0xe2, 0x67, 0x7d, 0xf9, 0x7b, 0x00, 0x45, 0xa4, 0x86, 0x87, 0xd3,
+	0x05, 0x00, 0x00,
 }
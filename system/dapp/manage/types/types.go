@@ -16,11 +16,17 @@ var (
 	// ManageX defines a global string
 	ManageX    = "manage"
 	actionName = map[string]int32{
-		"Modify": ManageActionModifyConfig,
+		"Modify":  ManageActionModifyConfig,
+		"Propose": ManageActionPropose,
+		"Vote":    ManageActionVote,
 	}
 	logmap = map[int64]*types.LogInfo{
 		// 这里reflect.TypeOf类型必须是proto.Message类型，且是交易的回持结构
-		TyLogModifyConfig: {Ty: reflect.TypeOf(types.ReceiptConfig{}), Name: "LogModifyConfig"},
+		TyLogModifyConfig:    {Ty: reflect.TypeOf(types.ReceiptConfig{}), Name: "LogModifyConfig"},
+		TyLogConfigPropose:   {Ty: reflect.TypeOf(ReceiptConfigProposal{}), Name: "LogConfigPropose"},
+		TyLogConfigVote:      {Ty: reflect.TypeOf(ReceiptConfigProposal{}), Name: "LogConfigVote"},
+		TyLogConfigApply:     {Ty: reflect.TypeOf(ReceiptConfigProposal{}), Name: "LogConfigApply"},
+		TyLogConfigApprovers: {Ty: reflect.TypeOf(ReceiptConfigApprovers{}), Name: "LogConfigApprovers"},
 	}
 )
 
@@ -34,6 +40,7 @@ func init() {
 func InitFork(cfg *types.TuringchainConfig) {
 	cfg.RegisterDappFork(ManageX, "Enable", 120000)
 	cfg.RegisterDappFork(ManageX, "ForkManageExec", 400000)
+	cfg.RegisterDappFork(ManageX, "ForkManageProposal", types.MaxHeight)
 }
 
 //InitExecutor init Executor
@@ -0,0 +1,61 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/turingchain2020/turingchain/system/dapp/multisig/types"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+// Exec_AccCreate 创建多重签名账户
+func (m *MultiSig) Exec_AccCreate(create *mty.MultiSigAccCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := create.Check(); err != nil {
+		return nil, err
+	}
+	action := newAction(m, tx)
+	return action.accCreate(create)
+}
+
+// Exec_OwnerOperate 提交拥有者的修改
+func (m *MultiSig) Exec_OwnerOperate(op *mty.MultiSigOwnerOperate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := op.Check(); err != nil {
+		return nil, err
+	}
+	action := newAction(m, tx)
+	return action.submitTx(op.MultiSigAccAddr, &mty.MultiSigTx{TxType: mty.OwnerOperateType, OwnerOperate: op})
+}
+
+// Exec_AccOperate 提交账户requiredWeight 或者每日额度的修改
+func (m *MultiSig) Exec_AccOperate(op *mty.MultiSigAccOperate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := op.Check(); err != nil {
+		return nil, err
+	}
+	action := newAction(m, tx)
+	return action.submitTx(op.MultiSigAccAddr, &mty.MultiSigTx{TxType: mty.AccountOperateType, AccOperate: op})
+}
+
+// Exec_TransferFrom 提交从多重签名账户转出的交易
+func (m *MultiSig) Exec_TransferFrom(transfer *mty.MultiSigTransferFrom, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := transfer.Check(); err != nil {
+		return nil, err
+	}
+	action := newAction(m, tx)
+	return action.submitTx(transfer.From, &mty.MultiSigTx{TxType: mty.TransferOperateType, TransferFrom: transfer})
+}
+
+// Exec_ConfirmTx 确认或者撤销确认多重签名交易
+func (m *MultiSig) Exec_ConfirmTx(confirm *mty.MultiSigConfirmTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(m, tx)
+	return action.confirmTx(confirm)
+}
+
+// Exec_TransferTo 转入多重签名账户
+func (m *MultiSig) Exec_TransferTo(transfer *mty.MultiSigTransferTo, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if err := transfer.Check(); err != nil {
+		return nil, err
+	}
+	action := newAction(m, tx)
+	return action.transferTo(transfer)
+}
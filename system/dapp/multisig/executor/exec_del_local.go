@@ -0,0 +1,25 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/turingchain2020/turingchain/system/dapp/multisig/types"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+// ExecDelLocal_AccCreate 回滚拥有者到多重签名账户的索引
+func (m *MultiSig) ExecDelLocal_AccCreate(payload *mty.MultiSigAccCreate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: ownerIndexKV(receipt, true)}, nil
+}
+
+// ExecDelLocal_OwnerOperate 回滚拥有者到多重签名账户的索引
+func (m *MultiSig) ExecDelLocal_OwnerOperate(payload *mty.MultiSigOwnerOperate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: ownerIndexKV(receipt, true)}, nil
+}
+
+// ExecDelLocal_ConfirmTx 回滚拥有者到多重签名账户的索引
+func (m *MultiSig) ExecDelLocal_ConfirmTx(payload *mty.MultiSigConfirmTx, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: ownerIndexKV(receipt, true)}, nil
+}
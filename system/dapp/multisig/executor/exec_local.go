@@ -0,0 +1,56 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/turingchain2020/turingchain/system/dapp/multisig/types"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+//ownerIndexKV 根据账户拥有者的变化更新拥有者到多重签名账户的索引, rollback 时交换prev 和current
+func ownerIndexKV(receipt *types.ReceiptData, rollback bool) []*types.KeyValue {
+	var kvs []*types.KeyValue
+	for _, item := range receipt.Logs {
+		if item.Ty != mty.TyLogMultiSigAccCreate && item.Ty != mty.TyLogMultiSigAccChange {
+			continue
+		}
+		var log mty.ReceiptMultiSigAccount
+		err := types.Decode(item.Log, &log)
+		if err != nil {
+			panic(err) //数据错误了，已经被修改了
+		}
+		prev, current := log.Prev.GetOwners(), log.Current.GetOwners()
+		if rollback {
+			prev, current = current, prev
+		}
+		addr := log.Current.GetMultiSigAddr()
+		for _, owner := range prev {
+			if mty.FindOwner(current, owner.OwnerAddr) < 0 {
+				kvs = append(kvs, &types.KeyValue{Key: calcOwnerAccountKey(owner.OwnerAddr, addr), Value: nil})
+			}
+		}
+		for _, owner := range current {
+			if mty.FindOwner(prev, owner.OwnerAddr) < 0 {
+				kvs = append(kvs, &types.KeyValue{Key: calcOwnerAccountKey(owner.OwnerAddr, addr), Value: []byte(addr)})
+			}
+		}
+	}
+	return kvs
+}
+
+// ExecLocal_AccCreate 保存拥有者到多重签名账户的索引
+func (m *MultiSig) ExecLocal_AccCreate(payload *mty.MultiSigAccCreate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: ownerIndexKV(receipt, false)}, nil
+}
+
+// ExecLocal_OwnerOperate 拥有者的修改执行之后更新索引
+func (m *MultiSig) ExecLocal_OwnerOperate(payload *mty.MultiSigOwnerOperate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: ownerIndexKV(receipt, false)}, nil
+}
+
+// ExecLocal_ConfirmTx 确认之后执行了拥有者的修改时更新索引
+func (m *MultiSig) ExecLocal_ConfirmTx(payload *mty.MultiSigConfirmTx, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{KV: ownerIndexKV(receipt, false)}, nil
+}
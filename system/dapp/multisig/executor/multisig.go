@@ -0,0 +1,74 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package executor 多重签名插件执行器
+package executor
+
+import (
+	log "github.com/turingchain2020/turingchain/common/log/log15"
+	drivers "github.com/turingchain2020/turingchain/system/dapp"
+	mty "github.com/turingchain2020/turingchain/system/dapp/multisig/types"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+var (
+	mlog       = log.New("module", "execs.multisig")
+	driverName = mty.MultiSigX
+)
+
+// Init resister a dirver
+func Init(name string, cfg *types.TuringchainConfig, sub []byte) {
+	// 需要先 RegisterDappFork才可以Register dapp
+	drivers.Register(cfg, GetName(), newMultiSig, cfg.GetDappFork(driverName, "Enable"))
+	InitExecType()
+}
+
+// InitExecType initials multisig functions.
+func InitExecType() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&MultiSig{}))
+}
+
+// GetName return multisig name
+func GetName() string {
+	return newMultiSig().GetName()
+}
+
+// MultiSig defines MultiSig object
+type MultiSig struct {
+	drivers.DriverBase
+}
+
+func newMultiSig() drivers.Driver {
+	m := &MultiSig{}
+	m.SetChild(m)
+	m.SetExecutorType(types.LoadExecutorType(driverName))
+	return m
+}
+
+// GetDriverName return a drivername
+func (m *MultiSig) GetDriverName() string {
+	return driverName
+}
+
+type checker interface {
+	Check() error
+}
+
+// CheckTx 检查交易参数
+func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
+	_, v, err := m.GetExecutorType().DecodePayloadValue(tx)
+	if err != nil {
+		return err
+	}
+	if c, ok := v.Interface().(checker); ok {
+		return c.Check()
+	}
+	return nil
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (m *MultiSig) CheckReceiptExecOk() bool {
+	return true
+}
@@ -0,0 +1,142 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common/address"
+	"github.com/turingchain2020/turingchain/common/crypto"
+	_ "github.com/turingchain2020/turingchain/system"
+	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
+	mty "github.com/turingchain2020/turingchain/system/dapp/multisig/types"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/turingchain2020/turingchain/util/testnode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiSig(t *testing.T) {
+	mock33 := testnode.New("--free--", nil)
+	defer mock33.Close()
+	mock33.Listen()
+	cfg := mock33.GetClient().GetConfig()
+	execaddr := address.ExecAddress(mty.MultiSigX)
+	genkey := mock33.GetGenesisKey()
+	genaddr := mock33.GetGenesisAddress()
+	addr2, priv2 := util.Genaddress()
+	addr3, _ := util.Genaddress()
+	recv, _ := util.Genaddress()
+
+	send := func(priv crypto.PrivKey, execer, to string, payload types.Message) *types.TransactionDetail {
+		tx, err := types.FormatTx(cfg, execer, &types.Transaction{Payload: types.Encode(payload), To: to})
+		assert.Nil(t, err)
+		tx.Sign(types.SECP256K1, priv)
+		detail, err := mock33.WaitTx(mock33.SendTx(tx))
+		assert.Nil(t, err)
+		return &types.TransactionDetail{Tx: tx, Receipt: &types.ReceiptData{Ty: detail.Receipt.Ty}}
+	}
+	sendMultiSig := func(priv crypto.PrivKey, action *mty.MultiSigAction) *types.TransactionDetail {
+		return send(priv, mty.MultiSigX, "", action)
+	}
+	query := func(funcname string, param types.Message) types.Message {
+		msg, err := mock33.GetAPI().Query(mty.MultiSigX, funcname, param)
+		assert.Nil(t, err)
+		return msg
+	}
+	confirm := func(priv crypto.PrivKey, addr string, txid uint64, ok bool) int32 {
+		c := &mty.MultiSigConfirmTx{MultiSigAccAddr: addr, TxId: txid, ConfirmOrRevoke: ok}
+		return sendMultiSig(priv, &mty.MultiSigAction{Value: &mty.MultiSigAction_ConfirmTx{ConfirmTx: c}, Ty: mty.MultiSigActionConfirmTx}).Receipt.Ty
+	}
+
+	//拥有者需要手续费
+	detail, err := mock33.WaitTx(mock33.SendTx(util.CreateCoinsTx(cfg, genkey, addr2, types.Coin)))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	create := &mty.MultiSigAccCreate{
+		Owners: []*mty.MultiSigOwner{
+			{OwnerAddr: genaddr, Weight: 1},
+			{OwnerAddr: addr2, Weight: 1},
+			{OwnerAddr: addr3, Weight: 1},
+		},
+		RequiredWeight: 2,
+		DailyLimit:     types.Coin,
+	}
+	created := sendMultiSig(genkey, &mty.MultiSigAction{Value: &mty.MultiSigAction_AccCreate{AccCreate: create}, Ty: mty.MultiSigActionAccCreate})
+	assert.Equal(t, int32(types.ExecOk), created.Receipt.Ty)
+	msAddr := address.MultiSignAddress(created.Tx.Hash())
+	acc := query("MultiSigAccountInfo", &types.ReqString{Data: msAddr}).(*mty.MultiSigAccount)
+	assert.Equal(t, 3, len(acc.Owners))
+	assert.Equal(t, uint64(2), acc.RequiredWeight)
+
+	//先转入multisig 合约, 再转入多重签名账户
+	toExec := &types.AssetsTransferToExec{Amount: 10 * types.Coin, ExecName: mty.MultiSigX, To: execaddr}
+	assert.Equal(t, int32(types.ExecOk), send(genkey, "coins", execaddr, &cty.CoinsAction{Value: &cty.CoinsAction_TransferToExec{TransferToExec: toExec}, Ty: cty.CoinsActionTransferToExec}).Receipt.Ty)
+	transferTo := &mty.MultiSigTransferTo{To: msAddr, Amount: 10 * types.Coin}
+	assert.Equal(t, int32(types.ExecOk), sendMultiSig(genkey, &mty.MultiSigAction{Value: &mty.MultiSigAction_TransferTo{TransferTo: transferTo}, Ty: mty.MultiSigActionTransferTo}).Receipt.Ty)
+
+	transferFrom := func(amount int64) {
+		tf := &mty.MultiSigTransferFrom{From: msAddr, To: recv, Amount: amount}
+		assert.Equal(t, int32(types.ExecOk), sendMultiSig(genkey, &mty.MultiSigAction{Value: &mty.MultiSigAction_TransferFrom{TransferFrom: tf}, Ty: mty.MultiSigActionTransferFrom}).Receipt.Ty)
+	}
+	//每日额度之内直接执行
+	transferFrom(types.Coin / 2)
+	block := mock33.GetLastBlock()
+	assert.Equal(t, types.Coin/2, mock33.GetExecAccount(block.StateHash, mty.MultiSigX, recv).Balance)
+	tx := query("MultiSigTxInfo", &mty.ReqMultiSigTxInfo{MultiSigAddr: msAddr, TxId: 0}).(*mty.MultiSigTx)
+	assert.True(t, tx.Executed)
+
+	//超出额度需要其他拥有者确认
+	transferFrom(5 * types.Coin)
+	tx = query("MultiSigTxInfo", &mty.ReqMultiSigTxInfo{MultiSigAddr: msAddr, TxId: 1}).(*mty.MultiSigTx)
+	assert.False(t, tx.Executed)
+	assert.Equal(t, int32(types.ExecPack), confirm(genkey, msAddr, 1, true))
+	assert.Equal(t, int32(types.ExecOk), confirm(priv2, msAddr, 1, true))
+	block = mock33.GetLastBlock()
+	assert.Equal(t, types.Coin/2+5*types.Coin, mock33.GetExecAccount(block.StateHash, mty.MultiSigX, recv).Balance)
+	assert.Equal(t, int32(types.ExecPack), confirm(priv2, msAddr, 1, false))
+
+	//删除拥有者, 撤销确认之后需要重新达到requiredWeight
+	op := &mty.MultiSigOwnerOperate{MultiSigAccAddr: msAddr, OldOwner: addr3, OperateFlag: mty.OwnerDel}
+	assert.Equal(t, int32(types.ExecOk), sendMultiSig(genkey, &mty.MultiSigAction{Value: &mty.MultiSigAction_OwnerOperate{OwnerOperate: op}, Ty: mty.MultiSigActionOwnerOperate}).Receipt.Ty)
+	assert.Equal(t, int32(types.ExecOk), confirm(genkey, msAddr, 2, false))
+	assert.Equal(t, int32(types.ExecOk), confirm(priv2, msAddr, 2, true))
+	acc = query("MultiSigAccountInfo", &types.ReqString{Data: msAddr}).(*mty.MultiSigAccount)
+	assert.Equal(t, 3, len(acc.Owners))
+	assert.Equal(t, int32(types.ExecOk), confirm(genkey, msAddr, 2, true))
+	acc = query("MultiSigAccountInfo", &types.ReqString{Data: msAddr}).(*mty.MultiSigAccount)
+	assert.Equal(t, 2, len(acc.Owners))
+	assert.Equal(t, uint64(3), acc.TxCount)
+
+	accs := query("MultiSigAccountsByOwner", &types.ReqString{Data: addr2}).(*mty.ReplyMultiSigAccounts)
+	assert.Equal(t, []string{msAddr}, accs.Address)
+	accs = query("MultiSigAccountsByOwner", &types.ReqString{Data: addr3}).(*mty.ReplyMultiSigAccounts)
+	assert.Equal(t, 0, len(accs.Address))
+	txs := query("MultiSigTxs", &mty.ReqMultiSigTxs{MultiSigAddr: msAddr, FromTxId: 0, ToTxId: 10}).(*mty.ReplyMultiSigTxs)
+	assert.Equal(t, 3, len(txs.Txs))
+}
+
+func TestMultiSigAccCreateCheck(t *testing.T) {
+	addr1, _ := util.Genaddress()
+	addr2, _ := util.Genaddress()
+	create := &mty.MultiSigAccCreate{
+		Owners:         []*mty.MultiSigOwner{{OwnerAddr: addr1, Weight: 1}, {OwnerAddr: addr2, Weight: 2}},
+		RequiredWeight: 3,
+	}
+	assert.Nil(t, create.Check())
+	create.RequiredWeight = 4
+	assert.Equal(t, mty.ErrRequiredWeight, create.Check())
+	create.RequiredWeight = 0
+	assert.Equal(t, mty.ErrRequiredWeight, create.Check())
+	create.RequiredWeight = 1
+	create.Owners[1].OwnerAddr = addr1
+	assert.Equal(t, mty.ErrOwnerExist, create.Check())
+	create.Owners[1].OwnerAddr = addr2
+	create.Owners[1].Weight = 0
+	assert.Equal(t, mty.ErrOwnerWeight, create.Check())
+	create.Owners = nil
+	assert.Equal(t, mty.ErrOwnerCount, create.Check())
+}
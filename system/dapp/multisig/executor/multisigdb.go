@@ -0,0 +1,327 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/turingchain2020/turingchain/account"
+	"github.com/turingchain2020/turingchain/common/address"
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/system/dapp"
+	mty "github.com/turingchain2020/turingchain/system/dapp/multisig/types"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+var (
+	accountKeyPrefix = "mavl-multisig-account-"
+	txKeyPrefix      = "mavl-multisig-tx-"
+	ownerKeyPrefix   = "LODB-multisig-owner-"
+)
+
+func calcAccountKey(addr string) []byte {
+	return []byte(accountKeyPrefix + addr)
+}
+
+func calcTxKey(addr string, txid uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%020d", txKeyPrefix, addr, txid))
+}
+
+//calcOwnerAccountKey 拥有者到多重签名账户的本地索引
+func calcOwnerAccountKey(owner, addr string) []byte {
+	return []byte(ownerKeyPrefix + owner + "-" + addr)
+}
+
+func calcOwnerAccountPrefix(owner string) []byte {
+	return []byte(ownerKeyPrefix + owner + "-")
+}
+
+func getAccount(db dbm.KV, addr string) (*mty.MultiSigAccount, error) {
+	value, err := db.Get(calcAccountKey(addr))
+	if err != nil || value == nil {
+		return nil, mty.ErrAccountNotExist
+	}
+	var acc mty.MultiSigAccount
+	err = types.Decode(value, &acc)
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+func getTx(db dbm.KV, addr string, txid uint64) (*mty.MultiSigTx, error) {
+	value, err := db.Get(calcTxKey(addr, txid))
+	if err != nil || value == nil {
+		return nil, mty.ErrTxNotExist
+	}
+	var tx mty.MultiSigTx
+	err = types.Decode(value, &tx)
+	if err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+type action struct {
+	coinsAccount *account.DB
+	db           dbm.KV
+	txhash       []byte
+	fromaddr     string
+	blocktime    int64
+	height       int64
+	execaddr     string
+}
+
+func newAction(m *MultiSig, tx *types.Transaction) *action {
+	return &action{
+		coinsAccount: m.GetCoinsAccount(),
+		db:           m.GetStateDB(),
+		txhash:       tx.Hash(),
+		fromaddr:     tx.From(),
+		blocktime:    m.GetBlockTime(),
+		height:       m.GetHeight(),
+		execaddr:     dapp.ExecAddress(string(tx.Execer)),
+	}
+}
+
+func (a *action) saveAccount(acc *mty.MultiSigAccount) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcAccountKey(acc.MultiSigAddr), Value: types.Encode(acc)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func (a *action) saveTx(tx *mty.MultiSigTx) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcTxKey(tx.MultiSigAddr, tx.TxId), Value: types.Encode(tx)}
+	a.db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+//accCreate 多重签名账户的地址由创建交易的hash 生成
+func (a *action) accCreate(create *mty.MultiSigAccCreate) (*types.Receipt, error) {
+	addr := address.MultiSignAddress(a.txhash)
+	if _, err := getAccount(a.db, addr); err == nil {
+		return nil, mty.ErrAccountExist
+	}
+	acc := &mty.MultiSigAccount{
+		CreateAddr:     a.fromaddr,
+		MultiSigAddr:   addr,
+		Owners:         create.Owners,
+		DailyLimit:     &mty.DailyLimit{Limit: create.DailyLimit},
+		RequiredWeight: create.RequiredWeight,
+		CreateHeight:   a.height,
+	}
+	kv := a.saveAccount(acc)
+	log := &mty.ReceiptMultiSigAccount{Current: acc}
+	logs := []*types.ReceiptLog{{Ty: mty.TyLogMultiSigAccCreate, Log: types.Encode(log)}}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: logs}, nil
+}
+
+//submitTx 拥有者提交交易, 提交者自动确认, 达到执行条件时立即执行
+func (a *action) submitTx(addr string, tx *mty.MultiSigTx) (*types.Receipt, error) {
+	acc, err := getAccount(a.db, addr)
+	if err != nil {
+		return nil, err
+	}
+	index := mty.FindOwner(acc.Owners, a.fromaddr)
+	if index < 0 {
+		return nil, mty.ErrOwnerNotExist
+	}
+	tx.MultiSigAddr = addr
+	tx.TxId = acc.TxCount
+	tx.TxHash = hex.EncodeToString(a.txhash)
+	tx.ConfirmedOwners = []*mty.MultiSigOwner{{OwnerAddr: a.fromaddr, Weight: acc.Owners[index].Weight}}
+	acc.TxCount++
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	receipt.KV = append(receipt.KV, a.saveAccount(acc), a.saveTx(tx))
+	log := &mty.ReceiptMultiSigTx{Current: tx}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: mty.TyLogMultiSigTxSubmit, Log: types.Encode(log)})
+	return a.tryExecute(acc, tx, receipt)
+}
+
+//confirmTx 确认或者撤销确认, 已经执行的交易不能再修改
+func (a *action) confirmTx(confirm *mty.MultiSigConfirmTx) (*types.Receipt, error) {
+	acc, err := getAccount(a.db, confirm.MultiSigAccAddr)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := getTx(a.db, confirm.MultiSigAccAddr, confirm.TxId)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Executed {
+		return nil, mty.ErrTxExecuted
+	}
+	index := mty.FindOwner(acc.Owners, a.fromaddr)
+	if index < 0 {
+		return nil, mty.ErrOwnerNotExist
+	}
+	prev := types.Clone(tx).(*mty.MultiSigTx)
+	confirmed := mty.FindOwner(tx.ConfirmedOwners, a.fromaddr)
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if !confirm.ConfirmOrRevoke {
+		if confirmed < 0 {
+			return nil, mty.ErrNotConfirmed
+		}
+		tx.ConfirmedOwners = append(tx.ConfirmedOwners[:confirmed], tx.ConfirmedOwners[confirmed+1:]...)
+		receipt.KV = append(receipt.KV, a.saveTx(tx))
+		log := &mty.ReceiptMultiSigTx{Prev: prev, Current: tx}
+		receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: mty.TyLogMultiSigTxRevoke, Log: types.Encode(log)})
+		return receipt, nil
+	}
+	if confirmed >= 0 {
+		return nil, mty.ErrAlreadyConfirmed
+	}
+	tx.ConfirmedOwners = append(tx.ConfirmedOwners, &mty.MultiSigOwner{OwnerAddr: a.fromaddr, Weight: acc.Owners[index].Weight})
+	receipt.KV = append(receipt.KV, a.saveTx(tx))
+	log := &mty.ReceiptMultiSigTx{Prev: prev, Current: tx}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: mty.TyLogMultiSigTxConfirm, Log: types.Encode(log)})
+	return a.tryExecute(acc, tx, receipt)
+}
+
+//confirmedWeight 按照拥有者当前的权重计算确认的权重, 已经被删除的拥有者的确认不再计算
+func confirmedWeight(acc *mty.MultiSigAccount, tx *mty.MultiSigTx) uint64 {
+	var weight uint64
+	for _, owner := range tx.ConfirmedOwners {
+		index := mty.FindOwner(acc.Owners, owner.OwnerAddr)
+		if index >= 0 {
+			weight += acc.Owners[index].Weight
+		}
+	}
+	return weight
+}
+
+//spendDailyLimit 转出金额在当天剩余额度之内时扣除额度, 额度按照区块时间每天重置
+func (a *action) spendDailyLimit(acc *mty.MultiSigAccount, amount int64) bool {
+	limit := acc.DailyLimit
+	day := a.blocktime / mty.OneDaySecond
+	if day > limit.LastDay {
+		limit.SpentToday = 0
+		limit.LastDay = day
+	}
+	if limit.Limit-limit.SpentToday < amount {
+		return false
+	}
+	limit.SpentToday += amount
+	return true
+}
+
+//tryExecute 达到执行条件时执行交易, 否则等待其他拥有者的确认
+func (a *action) tryExecute(acc *mty.MultiSigAccount, tx *mty.MultiSigTx, receipt *types.Receipt) (*types.Receipt, error) {
+	prevAcc := types.Clone(acc).(*mty.MultiSigAccount)
+	weight := confirmedWeight(acc, tx)
+	//权重没有达到要求的转出交易使用每日额度执行, 需要保存额度的变化
+	changed := true
+	var err error
+	switch tx.TxType {
+	case mty.TransferOperateType:
+		if weight >= acc.RequiredWeight {
+			changed = false
+		} else if !a.spendDailyLimit(acc, tx.TransferFrom.Amount) {
+			return receipt, nil
+		}
+		transfer := tx.TransferFrom
+		r, err := a.coinsAccount.ExecTransfer(acc.MultiSigAddr, transfer.To, a.execaddr, transfer.Amount)
+		if err != nil {
+			mlog.Error("tryExecute", "multisig", acc.MultiSigAddr, "txid", tx.TxId, "err", err)
+			return nil, err
+		}
+		receipt.KV = append(receipt.KV, r.KV...)
+		receipt.Logs = append(receipt.Logs, r.Logs...)
+	case mty.OwnerOperateType:
+		if weight < acc.RequiredWeight {
+			return receipt, nil
+		}
+		err = applyOwnerOperate(acc, tx.OwnerOperate)
+	case mty.AccountOperateType:
+		if weight < acc.RequiredWeight {
+			return receipt, nil
+		}
+		err = applyAccOperate(acc, tx.AccOperate)
+	default:
+		err = mty.ErrInvalidOperateFlag
+	}
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		receipt.KV = append(receipt.KV, a.saveAccount(acc))
+		log := &mty.ReceiptMultiSigAccount{Prev: prevAcc, Current: acc}
+		receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: mty.TyLogMultiSigAccChange, Log: types.Encode(log)})
+	}
+	prevTx := types.Clone(tx).(*mty.MultiSigTx)
+	tx.Executed = true
+	receipt.KV = append(receipt.KV, a.saveTx(tx))
+	log := &mty.ReceiptMultiSigTx{Prev: prevTx, Current: tx}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: mty.TyLogMultiSigTxExecute, Log: types.Encode(log)})
+	return receipt, nil
+}
+
+func applyOwnerOperate(acc *mty.MultiSigAccount, op *mty.MultiSigOwnerOperate) error {
+	switch op.OperateFlag {
+	case mty.OwnerAdd:
+		if mty.FindOwner(acc.Owners, op.NewOwner) >= 0 {
+			return mty.ErrOwnerExist
+		}
+		if len(acc.Owners) >= mty.MultiSigMaxOwner {
+			return mty.ErrOwnerCount
+		}
+		acc.Owners = append(acc.Owners, &mty.MultiSigOwner{OwnerAddr: op.NewOwner, Weight: op.NewWeight})
+	case mty.OwnerDel:
+		index := mty.FindOwner(acc.Owners, op.OldOwner)
+		if index < 0 {
+			return mty.ErrOwnerNotExist
+		}
+		acc.Owners = append(acc.Owners[:index], acc.Owners[index+1:]...)
+		if len(acc.Owners) == 0 {
+			return mty.ErrOwnerCount
+		}
+	case mty.OwnerModify:
+		index := mty.FindOwner(acc.Owners, op.OldOwner)
+		if index < 0 {
+			return mty.ErrOwnerNotExist
+		}
+		acc.Owners[index].Weight = op.NewWeight
+	case mty.OwnerReplace:
+		index := mty.FindOwner(acc.Owners, op.OldOwner)
+		if index < 0 {
+			return mty.ErrOwnerNotExist
+		}
+		if mty.FindOwner(acc.Owners, op.NewOwner) >= 0 {
+			return mty.ErrOwnerExist
+		}
+		acc.Owners[index].OwnerAddr = op.NewOwner
+	default:
+		return mty.ErrInvalidOperateFlag
+	}
+	if mty.GetTotalWeight(acc.Owners) < acc.RequiredWeight {
+		return mty.ErrRequiredWeight
+	}
+	return nil
+}
+
+func applyAccOperate(acc *mty.MultiSigAccount, op *mty.MultiSigAccOperate) error {
+	switch op.OperateFlag {
+	case mty.AccWeightOp:
+		if op.NewRequiredWeight > mty.GetTotalWeight(acc.Owners) {
+			return mty.ErrRequiredWeight
+		}
+		acc.RequiredWeight = op.NewRequiredWeight
+	case mty.AccDailyLimitOp:
+		acc.DailyLimit.Limit = op.NewDailyLimit
+	default:
+		return mty.ErrInvalidOperateFlag
+	}
+	return nil
+}
+
+//transferTo 将自己在multisig 合约中的余额转入多重签名账户
+func (a *action) transferTo(transfer *mty.MultiSigTransferTo) (*types.Receipt, error) {
+	if _, err := getAccount(a.db, transfer.To); err != nil {
+		return nil, err
+	}
+	return a.coinsAccount.ExecTransfer(a.fromaddr, transfer.To, a.execaddr, transfer.Amount)
+}
@@ -0,0 +1,56 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	mty "github.com/turingchain2020/turingchain/system/dapp/multisig/types"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+//一次最多查询的多重签名交易数量
+const maxQueryTxCount = 100
+
+// Query_MultiSigAccountInfo 查询多重签名账户信息
+func (m *MultiSig) Query_MultiSigAccountInfo(in *types.ReqString) (types.Message, error) {
+	return getAccount(m.GetStateDB(), in.Data)
+}
+
+// Query_MultiSigTxInfo 查询多重签名交易信息
+func (m *MultiSig) Query_MultiSigTxInfo(in *mty.ReqMultiSigTxInfo) (types.Message, error) {
+	return getTx(m.GetStateDB(), in.MultiSigAddr, in.TxId)
+}
+
+// Query_MultiSigTxs 查询[fromTxId, toTxId] 范围内的多重签名交易
+func (m *MultiSig) Query_MultiSigTxs(in *mty.ReqMultiSigTxs) (types.Message, error) {
+	if in.FromTxId > in.ToTxId || in.ToTxId-in.FromTxId >= maxQueryTxCount {
+		return nil, types.ErrInvalidParam
+	}
+	acc, err := getAccount(m.GetStateDB(), in.MultiSigAddr)
+	if err != nil {
+		return nil, err
+	}
+	reply := &mty.ReplyMultiSigTxs{}
+	for id := in.FromTxId; id <= in.ToTxId && id < acc.TxCount; id++ {
+		tx, err := getTx(m.GetStateDB(), in.MultiSigAddr, id)
+		if err != nil {
+			return nil, err
+		}
+		reply.Txs = append(reply.Txs, tx)
+	}
+	return reply, nil
+}
+
+// Query_MultiSigAccountsByOwner 查询地址拥有的多重签名账户
+func (m *MultiSig) Query_MultiSigAccountsByOwner(in *types.ReqString) (types.Message, error) {
+	values, err := m.GetLocalDB().List(calcOwnerAccountPrefix(in.Data), nil, 0, 0)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &mty.ReplyMultiSigAccounts{}
+	for _, value := range values {
+		reply.Address = append(reply.Address, string(value))
+	}
+	return reply, nil
+}
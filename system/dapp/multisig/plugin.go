@@ -0,0 +1,26 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package multisig 多重签名账户插件
+// 1. 创建由多个拥有者按照权重共同控制的账户
+// 2. 拥有者提交, 确认, 撤销多重签名交易
+// 3. 拥有者以及每日额度的管理
+package multisig
+
+import (
+	"github.com/turingchain2020/turingchain/pluginmgr"
+	"github.com/turingchain2020/turingchain/system/dapp/commands"
+	"github.com/turingchain2020/turingchain/system/dapp/multisig/executor"
+	"github.com/turingchain2020/turingchain/system/dapp/multisig/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.MultiSigX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.MultiSigCmd,
+		RPC:      nil,
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// action type
+const (
+	MultiSigActionAccCreate = iota + 1
+	MultiSigActionOwnerOperate
+	MultiSigActionAccOperate
+	MultiSigActionConfirmTx
+	MultiSigActionTransferTo
+	MultiSigActionTransferFrom
+)
+
+// log type
+const (
+	TyLogMultiSigAccCreate = iota + 420
+	TyLogMultiSigAccChange
+	TyLogMultiSigTxSubmit
+	TyLogMultiSigTxConfirm
+	TyLogMultiSigTxRevoke
+	TyLogMultiSigTxExecute
+)
+
+// 拥有者操作类型
+const (
+	OwnerAdd = iota + 1
+	OwnerDel
+	OwnerModify
+	OwnerReplace
+)
+
+// 账户操作类型
+const (
+	AccWeightOp = iota + 1
+	AccDailyLimitOp
+)
+
+// 多重签名交易类型
+const (
+	OwnerOperateType = iota + 1
+	AccountOperateType
+	TransferOperateType
+)
+
+const (
+	//MultiSigMaxOwner 多重签名账户最多拥有者数量
+	MultiSigMaxOwner = 20
+	//OneDaySecond 每日额度按照区块时间计算的一天的秒数
+	OneDaySecond = 24 * 3600
+)
@@ -0,0 +1,40 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrRequiredWeight requiredWeight 为0 或者大于拥有者的总权重
+	ErrRequiredWeight = errors.New("ErrRequiredWeight")
+	// ErrOwnerCount 拥有者数量为0 或者超过最大数量
+	ErrOwnerCount = errors.New("ErrOwnerCount")
+	// ErrOwnerWeight 拥有者的权重为0
+	ErrOwnerWeight = errors.New("ErrOwnerWeight")
+	// ErrOwnerExist 拥有者已经存在
+	ErrOwnerExist = errors.New("ErrOwnerExist")
+	// ErrOwnerNotExist 拥有者不存在
+	ErrOwnerNotExist = errors.New("ErrOwnerNotExist")
+	// ErrDailyLimit 每日额度小于0
+	ErrDailyLimit = errors.New("ErrDailyLimit")
+	// ErrAccountNotExist 多重签名账户不存在
+	ErrAccountNotExist = errors.New("ErrAccountNotExist")
+	// ErrAccountExist 多重签名账户已经存在
+	ErrAccountExist = errors.New("ErrAccountExist")
+	// ErrInvalidOperateFlag 无效的操作类型
+	ErrInvalidOperateFlag = errors.New("ErrInvalidOperateFlag")
+	// ErrTxNotExist 多重签名交易不存在
+	ErrTxNotExist = errors.New("ErrTxNotExist")
+	// ErrTxExecuted 多重签名交易已经执行
+	ErrTxExecuted = errors.New("ErrTxExecuted")
+	// ErrAlreadyConfirmed 拥有者已经确认过交易
+	ErrAlreadyConfirmed = errors.New("ErrAlreadyConfirmed")
+	// ErrNotConfirmed 拥有者没有确认过交易, 无法撤销
+	ErrNotConfirmed = errors.New("ErrNotConfirmed")
+	// ErrToAddrIsMultiSig 不能转出到多重签名账户自己
+	ErrToAddrIsMultiSig = errors.New("ErrToAddrIsMultiSig")
+	// ErrNoOwnerInWallet 钱包中没有可以确认交易的拥有者
+	ErrNoOwnerInWallet = errors.New("ErrNoOwnerInWallet")
+)
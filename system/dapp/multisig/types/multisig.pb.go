@@ -0,0 +1,1262 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: multisig.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// message for execs.multisig
+type MultiSigAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*MultiSigAction_AccCreate
+	//	*MultiSigAction_OwnerOperate
+	//	*MultiSigAction_AccOperate
+	//	*MultiSigAction_ConfirmTx
+	//	*MultiSigAction_TransferTo
+	//	*MultiSigAction_TransferFrom
+	Value                isMultiSigAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                  `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *MultiSigAction) Reset()         { *m = MultiSigAction{} }
+func (m *MultiSigAction) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAction) ProtoMessage()    {}
+func (*MultiSigAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{0}
+}
+
+func (m *MultiSigAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAction.Unmarshal(m, b)
+}
+func (m *MultiSigAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAction.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAction.Merge(m, src)
+}
+func (m *MultiSigAction) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAction.Size(m)
+}
+func (m *MultiSigAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAction proto.InternalMessageInfo
+
+type isMultiSigAction_Value interface {
+	isMultiSigAction_Value()
+}
+
+type MultiSigAction_AccCreate struct {
+	AccCreate *MultiSigAccCreate `protobuf:"bytes,1,opt,name=accCreate,proto3,oneof"`
+}
+
+type MultiSigAction_OwnerOperate struct {
+	OwnerOperate *MultiSigOwnerOperate `protobuf:"bytes,2,opt,name=ownerOperate,proto3,oneof"`
+}
+
+type MultiSigAction_AccOperate struct {
+	AccOperate *MultiSigAccOperate `protobuf:"bytes,3,opt,name=accOperate,proto3,oneof"`
+}
+
+type MultiSigAction_ConfirmTx struct {
+	ConfirmTx *MultiSigConfirmTx `protobuf:"bytes,4,opt,name=confirmTx,proto3,oneof"`
+}
+
+type MultiSigAction_TransferTo struct {
+	TransferTo *MultiSigTransferTo `protobuf:"bytes,5,opt,name=transferTo,proto3,oneof"`
+}
+
+type MultiSigAction_TransferFrom struct {
+	TransferFrom *MultiSigTransferFrom `protobuf:"bytes,6,opt,name=transferFrom,proto3,oneof"`
+}
+
+func (*MultiSigAction_AccCreate) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_OwnerOperate) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_AccOperate) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_ConfirmTx) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_TransferTo) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_TransferFrom) isMultiSigAction_Value() {}
+
+func (m *MultiSigAction) GetValue() isMultiSigAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetAccCreate() *MultiSigAccCreate {
+	if x, ok := m.GetValue().(*MultiSigAction_AccCreate); ok {
+		return x.AccCreate
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetOwnerOperate() *MultiSigOwnerOperate {
+	if x, ok := m.GetValue().(*MultiSigAction_OwnerOperate); ok {
+		return x.OwnerOperate
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetAccOperate() *MultiSigAccOperate {
+	if x, ok := m.GetValue().(*MultiSigAction_AccOperate); ok {
+		return x.AccOperate
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetConfirmTx() *MultiSigConfirmTx {
+	if x, ok := m.GetValue().(*MultiSigAction_ConfirmTx); ok {
+		return x.ConfirmTx
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetTransferTo() *MultiSigTransferTo {
+	if x, ok := m.GetValue().(*MultiSigAction_TransferTo); ok {
+		return x.TransferTo
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetTransferFrom() *MultiSigTransferFrom {
+	if x, ok := m.GetValue().(*MultiSigAction_TransferFrom); ok {
+		return x.TransferFrom
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MultiSigAction) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MultiSigAction_AccCreate)(nil),
+		(*MultiSigAction_OwnerOperate)(nil),
+		(*MultiSigAction_AccOperate)(nil),
+		(*MultiSigAction_ConfirmTx)(nil),
+		(*MultiSigAction_TransferTo)(nil),
+		(*MultiSigAction_TransferFrom)(nil),
+	}
+}
+
+// 多重签名账户的拥有者以及拥有的权重
+type MultiSigOwner struct {
+	OwnerAddr            string   `protobuf:"bytes,1,opt,name=ownerAddr,proto3" json:"ownerAddr,omitempty"`
+	Weight               uint64   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigOwner) Reset()         { *m = MultiSigOwner{} }
+func (m *MultiSigOwner) String() string { return proto.CompactTextString(m) }
+func (*MultiSigOwner) ProtoMessage()    {}
+func (*MultiSigOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{1}
+}
+
+func (m *MultiSigOwner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigOwner.Unmarshal(m, b)
+}
+func (m *MultiSigOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigOwner.Marshal(b, m, deterministic)
+}
+func (m *MultiSigOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigOwner.Merge(m, src)
+}
+func (m *MultiSigOwner) XXX_Size() int {
+	return xxx_messageInfo_MultiSigOwner.Size(m)
+}
+func (m *MultiSigOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigOwner proto.InternalMessageInfo
+
+func (m *MultiSigOwner) GetOwnerAddr() string {
+	if m != nil {
+		return m.OwnerAddr
+	}
+	return ""
+}
+
+func (m *MultiSigOwner) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// 每日转出额度, lastDay 为最近一次转出时的区块时间所在的天数
+type DailyLimit struct {
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	SpentToday           int64    `protobuf:"varint,2,opt,name=spentToday,proto3" json:"spentToday,omitempty"`
+	LastDay              int64    `protobuf:"varint,3,opt,name=lastDay,proto3" json:"lastDay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DailyLimit) Reset()         { *m = DailyLimit{} }
+func (m *DailyLimit) String() string { return proto.CompactTextString(m) }
+func (*DailyLimit) ProtoMessage()    {}
+func (*DailyLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{2}
+}
+
+func (m *DailyLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DailyLimit.Unmarshal(m, b)
+}
+func (m *DailyLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DailyLimit.Marshal(b, m, deterministic)
+}
+func (m *DailyLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyLimit.Merge(m, src)
+}
+func (m *DailyLimit) XXX_Size() int {
+	return xxx_messageInfo_DailyLimit.Size(m)
+}
+func (m *DailyLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailyLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailyLimit proto.InternalMessageInfo
+
+func (m *DailyLimit) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DailyLimit) GetSpentToday() int64 {
+	if m != nil {
+		return m.SpentToday
+	}
+	return 0
+}
+
+func (m *DailyLimit) GetLastDay() int64 {
+	if m != nil {
+		return m.LastDay
+	}
+	return 0
+}
+
+// 多重签名账户, 地址由创建交易的hash 生成, 没有对应的私钥
+type MultiSigAccount struct {
+	CreateAddr           string           `protobuf:"bytes,1,opt,name=createAddr,proto3" json:"createAddr,omitempty"`
+	MultiSigAddr         string           `protobuf:"bytes,2,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	Owners               []*MultiSigOwner `protobuf:"bytes,3,rep,name=owners,proto3" json:"owners,omitempty"`
+	DailyLimit           *DailyLimit      `protobuf:"bytes,4,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
+	TxCount              uint64           `protobuf:"varint,5,opt,name=txCount,proto3" json:"txCount,omitempty"`
+	RequiredWeight       uint64           `protobuf:"varint,6,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
+	CreateHeight         int64            `protobuf:"varint,7,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MultiSigAccount) Reset()         { *m = MultiSigAccount{} }
+func (m *MultiSigAccount) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAccount) ProtoMessage()    {}
+func (*MultiSigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{3}
+}
+
+func (m *MultiSigAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAccount.Unmarshal(m, b)
+}
+func (m *MultiSigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAccount.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAccount.Merge(m, src)
+}
+func (m *MultiSigAccount) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAccount.Size(m)
+}
+func (m *MultiSigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAccount proto.InternalMessageInfo
+
+func (m *MultiSigAccount) GetCreateAddr() string {
+	if m != nil {
+		return m.CreateAddr
+	}
+	return ""
+}
+
+func (m *MultiSigAccount) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *MultiSigAccount) GetOwners() []*MultiSigOwner {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *MultiSigAccount) GetDailyLimit() *DailyLimit {
+	if m != nil {
+		return m.DailyLimit
+	}
+	return nil
+}
+
+func (m *MultiSigAccount) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *MultiSigAccount) GetRequiredWeight() uint64 {
+	if m != nil {
+		return m.RequiredWeight
+	}
+	return 0
+}
+
+func (m *MultiSigAccount) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+// 创建多重签名账户
+type MultiSigAccCreate struct {
+	Owners               []*MultiSigOwner `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+	RequiredWeight       uint64           `protobuf:"varint,2,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
+	DailyLimit           int64            `protobuf:"varint,3,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MultiSigAccCreate) Reset()         { *m = MultiSigAccCreate{} }
+func (m *MultiSigAccCreate) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAccCreate) ProtoMessage()    {}
+func (*MultiSigAccCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{4}
+}
+
+func (m *MultiSigAccCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAccCreate.Unmarshal(m, b)
+}
+func (m *MultiSigAccCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAccCreate.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAccCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAccCreate.Merge(m, src)
+}
+func (m *MultiSigAccCreate) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAccCreate.Size(m)
+}
+func (m *MultiSigAccCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAccCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAccCreate proto.InternalMessageInfo
+
+func (m *MultiSigAccCreate) GetOwners() []*MultiSigOwner {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *MultiSigAccCreate) GetRequiredWeight() uint64 {
+	if m != nil {
+		return m.RequiredWeight
+	}
+	return 0
+}
+
+func (m *MultiSigAccCreate) GetDailyLimit() int64 {
+	if m != nil {
+		return m.DailyLimit
+	}
+	return 0
+}
+
+// 多重签名账户拥有者的增加, 删除, 修改权重, 替换, 需要达到requiredWeight 之后才会执行
+type MultiSigOwnerOperate struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	OldOwner             string   `protobuf:"bytes,2,opt,name=oldOwner,proto3" json:"oldOwner,omitempty"`
+	NewOwner             string   `protobuf:"bytes,3,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
+	NewWeight            uint64   `protobuf:"varint,4,opt,name=newWeight,proto3" json:"newWeight,omitempty"`
+	OperateFlag          int32    `protobuf:"varint,5,opt,name=operateFlag,proto3" json:"operateFlag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigOwnerOperate) Reset()         { *m = MultiSigOwnerOperate{} }
+func (m *MultiSigOwnerOperate) String() string { return proto.CompactTextString(m) }
+func (*MultiSigOwnerOperate) ProtoMessage()    {}
+func (*MultiSigOwnerOperate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{5}
+}
+
+func (m *MultiSigOwnerOperate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigOwnerOperate.Unmarshal(m, b)
+}
+func (m *MultiSigOwnerOperate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigOwnerOperate.Marshal(b, m, deterministic)
+}
+func (m *MultiSigOwnerOperate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigOwnerOperate.Merge(m, src)
+}
+func (m *MultiSigOwnerOperate) XXX_Size() int {
+	return xxx_messageInfo_MultiSigOwnerOperate.Size(m)
+}
+func (m *MultiSigOwnerOperate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigOwnerOperate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigOwnerOperate proto.InternalMessageInfo
+
+func (m *MultiSigOwnerOperate) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigOwnerOperate) GetOldOwner() string {
+	if m != nil {
+		return m.OldOwner
+	}
+	return ""
+}
+
+func (m *MultiSigOwnerOperate) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *MultiSigOwnerOperate) GetNewWeight() uint64 {
+	if m != nil {
+		return m.NewWeight
+	}
+	return 0
+}
+
+func (m *MultiSigOwnerOperate) GetOperateFlag() int32 {
+	if m != nil {
+		return m.OperateFlag
+	}
+	return 0
+}
+
+// 修改多重签名账户的requiredWeight 或者每日额度, 需要达到requiredWeight 之后才会执行
+type MultiSigAccOperate struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	NewRequiredWeight    uint64   `protobuf:"varint,2,opt,name=newRequiredWeight,proto3" json:"newRequiredWeight,omitempty"`
+	NewDailyLimit        int64    `protobuf:"varint,3,opt,name=newDailyLimit,proto3" json:"newDailyLimit,omitempty"`
+	OperateFlag          int32    `protobuf:"varint,4,opt,name=operateFlag,proto3" json:"operateFlag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigAccOperate) Reset()         { *m = MultiSigAccOperate{} }
+func (m *MultiSigAccOperate) String() string { return proto.CompactTextString(m) }
+func (*MultiSigAccOperate) ProtoMessage()    {}
+func (*MultiSigAccOperate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{6}
+}
+
+func (m *MultiSigAccOperate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigAccOperate.Unmarshal(m, b)
+}
+func (m *MultiSigAccOperate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigAccOperate.Marshal(b, m, deterministic)
+}
+func (m *MultiSigAccOperate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigAccOperate.Merge(m, src)
+}
+func (m *MultiSigAccOperate) XXX_Size() int {
+	return xxx_messageInfo_MultiSigAccOperate.Size(m)
+}
+func (m *MultiSigAccOperate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigAccOperate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigAccOperate proto.InternalMessageInfo
+
+func (m *MultiSigAccOperate) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigAccOperate) GetNewRequiredWeight() uint64 {
+	if m != nil {
+		return m.NewRequiredWeight
+	}
+	return 0
+}
+
+func (m *MultiSigAccOperate) GetNewDailyLimit() int64 {
+	if m != nil {
+		return m.NewDailyLimit
+	}
+	return 0
+}
+
+func (m *MultiSigAccOperate) GetOperateFlag() int32 {
+	if m != nil {
+		return m.OperateFlag
+	}
+	return 0
+}
+
+// 拥有者确认或者撤销对多重签名交易的确认
+type MultiSigConfirmTx struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	TxId                 uint64   `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	ConfirmOrRevoke      bool     `protobuf:"varint,3,opt,name=confirmOrRevoke,proto3" json:"confirmOrRevoke,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigConfirmTx) Reset()         { *m = MultiSigConfirmTx{} }
+func (m *MultiSigConfirmTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigConfirmTx) ProtoMessage()    {}
+func (*MultiSigConfirmTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{7}
+}
+
+func (m *MultiSigConfirmTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigConfirmTx.Unmarshal(m, b)
+}
+func (m *MultiSigConfirmTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigConfirmTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigConfirmTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigConfirmTx.Merge(m, src)
+}
+func (m *MultiSigConfirmTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigConfirmTx.Size(m)
+}
+func (m *MultiSigConfirmTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigConfirmTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigConfirmTx proto.InternalMessageInfo
+
+func (m *MultiSigConfirmTx) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigConfirmTx) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *MultiSigConfirmTx) GetConfirmOrRevoke() bool {
+	if m != nil {
+		return m.ConfirmOrRevoke
+	}
+	return false
+}
+
+// 将自己在multisig 合约中的余额转入多重签名账户
+type MultiSigTransferTo struct {
+	To                   string   `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note                 string   `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigTransferTo) Reset()         { *m = MultiSigTransferTo{} }
+func (m *MultiSigTransferTo) String() string { return proto.CompactTextString(m) }
+func (*MultiSigTransferTo) ProtoMessage()    {}
+func (*MultiSigTransferTo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{8}
+}
+
+func (m *MultiSigTransferTo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigTransferTo.Unmarshal(m, b)
+}
+func (m *MultiSigTransferTo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigTransferTo.Marshal(b, m, deterministic)
+}
+func (m *MultiSigTransferTo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigTransferTo.Merge(m, src)
+}
+func (m *MultiSigTransferTo) XXX_Size() int {
+	return xxx_messageInfo_MultiSigTransferTo.Size(m)
+}
+func (m *MultiSigTransferTo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigTransferTo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigTransferTo proto.InternalMessageInfo
+
+func (m *MultiSigTransferTo) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MultiSigTransferTo) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MultiSigTransferTo) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// 从多重签名账户转出到to 地址在multisig 合约中的账户, 在每日额度之内或者达到requiredWeight 之后才会执行
+type MultiSigTransferFrom struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note                 string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigTransferFrom) Reset()         { *m = MultiSigTransferFrom{} }
+func (m *MultiSigTransferFrom) String() string { return proto.CompactTextString(m) }
+func (*MultiSigTransferFrom) ProtoMessage()    {}
+func (*MultiSigTransferFrom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{9}
+}
+
+func (m *MultiSigTransferFrom) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigTransferFrom.Unmarshal(m, b)
+}
+func (m *MultiSigTransferFrom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigTransferFrom.Marshal(b, m, deterministic)
+}
+func (m *MultiSigTransferFrom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigTransferFrom.Merge(m, src)
+}
+func (m *MultiSigTransferFrom) XXX_Size() int {
+	return xxx_messageInfo_MultiSigTransferFrom.Size(m)
+}
+func (m *MultiSigTransferFrom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigTransferFrom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigTransferFrom proto.InternalMessageInfo
+
+func (m *MultiSigTransferFrom) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MultiSigTransferFrom) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MultiSigTransferFrom) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MultiSigTransferFrom) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+// 多重签名账户提交的交易, txId 在账户内从0 开始递增
+type MultiSigTx struct {
+	MultiSigAddr         string                `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	TxId                 uint64                `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	TxHash               string                `protobuf:"bytes,3,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	TxType               int32                 `protobuf:"varint,4,opt,name=txType,proto3" json:"txType,omitempty"`
+	Executed             bool                  `protobuf:"varint,5,opt,name=executed,proto3" json:"executed,omitempty"`
+	ConfirmedOwners      []*MultiSigOwner      `protobuf:"bytes,6,rep,name=confirmedOwners,proto3" json:"confirmedOwners,omitempty"`
+	OwnerOperate         *MultiSigOwnerOperate `protobuf:"bytes,7,opt,name=ownerOperate,proto3" json:"ownerOperate,omitempty"`
+	AccOperate           *MultiSigAccOperate   `protobuf:"bytes,8,opt,name=accOperate,proto3" json:"accOperate,omitempty"`
+	TransferFrom         *MultiSigTransferFrom `protobuf:"bytes,9,opt,name=transferFrom,proto3" json:"transferFrom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MultiSigTx) Reset()         { *m = MultiSigTx{} }
+func (m *MultiSigTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigTx) ProtoMessage()    {}
+func (*MultiSigTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{10}
+}
+
+func (m *MultiSigTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigTx.Unmarshal(m, b)
+}
+func (m *MultiSigTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigTx.Merge(m, src)
+}
+func (m *MultiSigTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigTx.Size(m)
+}
+func (m *MultiSigTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigTx proto.InternalMessageInfo
+
+func (m *MultiSigTx) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetTxType() int32 {
+	if m != nil {
+		return m.TxType
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func (m *MultiSigTx) GetConfirmedOwners() []*MultiSigOwner {
+	if m != nil {
+		return m.ConfirmedOwners
+	}
+	return nil
+}
+
+func (m *MultiSigTx) GetOwnerOperate() *MultiSigOwnerOperate {
+	if m != nil {
+		return m.OwnerOperate
+	}
+	return nil
+}
+
+func (m *MultiSigTx) GetAccOperate() *MultiSigAccOperate {
+	if m != nil {
+		return m.AccOperate
+	}
+	return nil
+}
+
+func (m *MultiSigTx) GetTransferFrom() *MultiSigTransferFrom {
+	if m != nil {
+		return m.TransferFrom
+	}
+	return nil
+}
+
+// 多重签名账户发生变化的回执
+type ReceiptMultiSigAccount struct {
+	Prev                 *MultiSigAccount `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *MultiSigAccount `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReceiptMultiSigAccount) Reset()         { *m = ReceiptMultiSigAccount{} }
+func (m *ReceiptMultiSigAccount) String() string { return proto.CompactTextString(m) }
+func (*ReceiptMultiSigAccount) ProtoMessage()    {}
+func (*ReceiptMultiSigAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{11}
+}
+
+func (m *ReceiptMultiSigAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptMultiSigAccount.Unmarshal(m, b)
+}
+func (m *ReceiptMultiSigAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptMultiSigAccount.Marshal(b, m, deterministic)
+}
+func (m *ReceiptMultiSigAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiSigAccount.Merge(m, src)
+}
+func (m *ReceiptMultiSigAccount) XXX_Size() int {
+	return xxx_messageInfo_ReceiptMultiSigAccount.Size(m)
+}
+func (m *ReceiptMultiSigAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptMultiSigAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptMultiSigAccount proto.InternalMessageInfo
+
+func (m *ReceiptMultiSigAccount) GetPrev() *MultiSigAccount {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptMultiSigAccount) GetCurrent() *MultiSigAccount {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+// 多重签名交易发生变化的回执
+type ReceiptMultiSigTx struct {
+	Prev                 *MultiSigTx `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *MultiSigTx `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReceiptMultiSigTx) Reset()         { *m = ReceiptMultiSigTx{} }
+func (m *ReceiptMultiSigTx) String() string { return proto.CompactTextString(m) }
+func (*ReceiptMultiSigTx) ProtoMessage()    {}
+func (*ReceiptMultiSigTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{12}
+}
+
+func (m *ReceiptMultiSigTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptMultiSigTx.Unmarshal(m, b)
+}
+func (m *ReceiptMultiSigTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptMultiSigTx.Marshal(b, m, deterministic)
+}
+func (m *ReceiptMultiSigTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiSigTx.Merge(m, src)
+}
+func (m *ReceiptMultiSigTx) XXX_Size() int {
+	return xxx_messageInfo_ReceiptMultiSigTx.Size(m)
+}
+func (m *ReceiptMultiSigTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptMultiSigTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptMultiSigTx proto.InternalMessageInfo
+
+func (m *ReceiptMultiSigTx) GetPrev() *MultiSigTx {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptMultiSigTx) GetCurrent() *MultiSigTx {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqMultiSigTxInfo struct {
+	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	TxId                 uint64   `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqMultiSigTxInfo) Reset()         { *m = ReqMultiSigTxInfo{} }
+func (m *ReqMultiSigTxInfo) String() string { return proto.CompactTextString(m) }
+func (*ReqMultiSigTxInfo) ProtoMessage()    {}
+func (*ReqMultiSigTxInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{13}
+}
+
+func (m *ReqMultiSigTxInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMultiSigTxInfo.Unmarshal(m, b)
+}
+func (m *ReqMultiSigTxInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMultiSigTxInfo.Marshal(b, m, deterministic)
+}
+func (m *ReqMultiSigTxInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigTxInfo.Merge(m, src)
+}
+func (m *ReqMultiSigTxInfo) XXX_Size() int {
+	return xxx_messageInfo_ReqMultiSigTxInfo.Size(m)
+}
+func (m *ReqMultiSigTxInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMultiSigTxInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMultiSigTxInfo proto.InternalMessageInfo
+
+func (m *ReqMultiSigTxInfo) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *ReqMultiSigTxInfo) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+type ReqMultiSigTxs struct {
+	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	FromTxId             uint64   `protobuf:"varint,2,opt,name=fromTxId,proto3" json:"fromTxId,omitempty"`
+	ToTxId               uint64   `protobuf:"varint,3,opt,name=toTxId,proto3" json:"toTxId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqMultiSigTxs) Reset()         { *m = ReqMultiSigTxs{} }
+func (m *ReqMultiSigTxs) String() string { return proto.CompactTextString(m) }
+func (*ReqMultiSigTxs) ProtoMessage()    {}
+func (*ReqMultiSigTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{14}
+}
+
+func (m *ReqMultiSigTxs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMultiSigTxs.Unmarshal(m, b)
+}
+func (m *ReqMultiSigTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMultiSigTxs.Marshal(b, m, deterministic)
+}
+func (m *ReqMultiSigTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigTxs.Merge(m, src)
+}
+func (m *ReqMultiSigTxs) XXX_Size() int {
+	return xxx_messageInfo_ReqMultiSigTxs.Size(m)
+}
+func (m *ReqMultiSigTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMultiSigTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMultiSigTxs proto.InternalMessageInfo
+
+func (m *ReqMultiSigTxs) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *ReqMultiSigTxs) GetFromTxId() uint64 {
+	if m != nil {
+		return m.FromTxId
+	}
+	return 0
+}
+
+func (m *ReqMultiSigTxs) GetToTxId() uint64 {
+	if m != nil {
+		return m.ToTxId
+	}
+	return 0
+}
+
+type ReplyMultiSigTxs struct {
+	Txs                  []*MultiSigTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReplyMultiSigTxs) Reset()         { *m = ReplyMultiSigTxs{} }
+func (m *ReplyMultiSigTxs) String() string { return proto.CompactTextString(m) }
+func (*ReplyMultiSigTxs) ProtoMessage()    {}
+func (*ReplyMultiSigTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{15}
+}
+
+func (m *ReplyMultiSigTxs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyMultiSigTxs.Unmarshal(m, b)
+}
+func (m *ReplyMultiSigTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyMultiSigTxs.Marshal(b, m, deterministic)
+}
+func (m *ReplyMultiSigTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMultiSigTxs.Merge(m, src)
+}
+func (m *ReplyMultiSigTxs) XXX_Size() int {
+	return xxx_messageInfo_ReplyMultiSigTxs.Size(m)
+}
+func (m *ReplyMultiSigTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyMultiSigTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyMultiSigTxs proto.InternalMessageInfo
+
+func (m *ReplyMultiSigTxs) GetTxs() []*MultiSigTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type ReplyMultiSigAccounts struct {
+	Address              []string `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyMultiSigAccounts) Reset()         { *m = ReplyMultiSigAccounts{} }
+func (m *ReplyMultiSigAccounts) String() string { return proto.CompactTextString(m) }
+func (*ReplyMultiSigAccounts) ProtoMessage()    {}
+func (*ReplyMultiSigAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{16}
+}
+
+func (m *ReplyMultiSigAccounts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyMultiSigAccounts.Unmarshal(m, b)
+}
+func (m *ReplyMultiSigAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyMultiSigAccounts.Marshal(b, m, deterministic)
+}
+func (m *ReplyMultiSigAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyMultiSigAccounts.Merge(m, src)
+}
+func (m *ReplyMultiSigAccounts) XXX_Size() int {
+	return xxx_messageInfo_ReplyMultiSigAccounts.Size(m)
+}
+func (m *ReplyMultiSigAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyMultiSigAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyMultiSigAccounts proto.InternalMessageInfo
+
+func (m *ReplyMultiSigAccounts) GetAddress() []string {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+// 钱包使用自己拥有的私钥构造并发送多重签名交易的确认, owner 为空时使用钱包中所有符合条件的拥有者
+type ReqMultiSigWalletConfirm struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	TxId                 uint64   `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	ConfirmOrRevoke      bool     `protobuf:"varint,3,opt,name=confirmOrRevoke,proto3" json:"confirmOrRevoke,omitempty"`
+	Owner                string   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqMultiSigWalletConfirm) Reset()         { *m = ReqMultiSigWalletConfirm{} }
+func (m *ReqMultiSigWalletConfirm) String() string { return proto.CompactTextString(m) }
+func (*ReqMultiSigWalletConfirm) ProtoMessage()    {}
+func (*ReqMultiSigWalletConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{17}
+}
+
+func (m *ReqMultiSigWalletConfirm) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMultiSigWalletConfirm.Unmarshal(m, b)
+}
+func (m *ReqMultiSigWalletConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMultiSigWalletConfirm.Marshal(b, m, deterministic)
+}
+func (m *ReqMultiSigWalletConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMultiSigWalletConfirm.Merge(m, src)
+}
+func (m *ReqMultiSigWalletConfirm) XXX_Size() int {
+	return xxx_messageInfo_ReqMultiSigWalletConfirm.Size(m)
+}
+func (m *ReqMultiSigWalletConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMultiSigWalletConfirm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMultiSigWalletConfirm proto.InternalMessageInfo
+
+func (m *ReqMultiSigWalletConfirm) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *ReqMultiSigWalletConfirm) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *ReqMultiSigWalletConfirm) GetConfirmOrRevoke() bool {
+	if m != nil {
+		return m.ConfirmOrRevoke
+	}
+	return false
+}
+
+func (m *ReqMultiSigWalletConfirm) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MultiSigAction)(nil), "types.MultiSigAction")
+	proto.RegisterType((*MultiSigOwner)(nil), "types.MultiSigOwner")
+	proto.RegisterType((*DailyLimit)(nil), "types.DailyLimit")
+	proto.RegisterType((*MultiSigAccount)(nil), "types.MultiSigAccount")
+	proto.RegisterType((*MultiSigAccCreate)(nil), "types.MultiSigAccCreate")
+	proto.RegisterType((*MultiSigOwnerOperate)(nil), "types.MultiSigOwnerOperate")
+	proto.RegisterType((*MultiSigAccOperate)(nil), "types.MultiSigAccOperate")
+	proto.RegisterType((*MultiSigConfirmTx)(nil), "types.MultiSigConfirmTx")
+	proto.RegisterType((*MultiSigTransferTo)(nil), "types.MultiSigTransferTo")
+	proto.RegisterType((*MultiSigTransferFrom)(nil), "types.MultiSigTransferFrom")
+	proto.RegisterType((*MultiSigTx)(nil), "types.MultiSigTx")
+	proto.RegisterType((*ReceiptMultiSigAccount)(nil), "types.ReceiptMultiSigAccount")
+	proto.RegisterType((*ReceiptMultiSigTx)(nil), "types.ReceiptMultiSigTx")
+	proto.RegisterType((*ReqMultiSigTxInfo)(nil), "types.ReqMultiSigTxInfo")
+	proto.RegisterType((*ReqMultiSigTxs)(nil), "types.ReqMultiSigTxs")
+	proto.RegisterType((*ReplyMultiSigTxs)(nil), "types.ReplyMultiSigTxs")
+	proto.RegisterType((*ReplyMultiSigAccounts)(nil), "types.ReplyMultiSigAccounts")
+	proto.RegisterType((*ReqMultiSigWalletConfirm)(nil), "types.ReqMultiSigWalletConfirm")
+}
+
+func init() {
+	proto.RegisterFile("multisig.proto", fileDescriptor_62b8b91adf3febfa)
+}
+
+var fileDescriptor_62b8b91adf3febfa = []byte{
+	// 926 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x8f, 0xdc, 0x44,
+	0x10, 0x8e, 0xc7, 0x9e, 0x57, 0x6d, 0x32, 0x61, 0x5a, 0xcb, 0xca, 0x04, 0x14, 0xad, 0xcc, 0x43,
+	0x2b, 0x88, 0x56, 0x24, 0x1c, 0x00, 0x21, 0x11, 0x6d, 0x76, 0x89, 0x36, 0x02, 0xb4, 0xa8, 0xb1,
+	0x14, 0x09, 0x71, 0x31, 0x76, 0xcf, 0xac, 0x85, 0xc7, 0x3d, 0x69, 0xf7, 0xcc, 0x78, 0xc4, 0x2f,
+	0xe0, 0xc0, 0x9d, 0x03, 0xfc, 0x0b, 0x0e, 0xfc, 0x3c, 0xd4, 0x0f, 0xdb, 0xed, 0xb6, 0x61, 0x13,
+	0x0e, 0xb9, 0xb9, 0xaa, 0xbe, 0xea, 0xaf, 0x1e, 0xdd, 0x55, 0x86, 0xd9, 0x6a, 0x93, 0xf1, 0xb4,
+	0x48, 0x97, 0xa7, 0x6b, 0x46, 0x39, 0x45, 0x43, 0xbe, 0x5f, 0x93, 0x22, 0xf8, 0xd3, 0x85, 0xd9,
+	0xb7, 0xc2, 0xf2, 0x7d, 0xba, 0x3c, 0x8b, 0x79, 0x4a, 0x73, 0xf4, 0x19, 0x4c, 0xa3, 0x38, 0x3e,
+	0x67, 0x24, 0xe2, 0xc4, 0x77, 0x8e, 0x9d, 0x93, 0x83, 0x47, 0xfe, 0xa9, 0x44, 0x9f, 0x36, 0x48,
+	0x6d, 0xbf, 0xbc, 0x85, 0x1b, 0x30, 0x3a, 0x83, 0xdb, 0x74, 0x97, 0x13, 0x76, 0xb5, 0x26, 0x4c,
+	0x38, 0x0f, 0xa4, 0xf3, 0xdb, 0x96, 0xf3, 0x95, 0x01, 0xb9, 0xbc, 0x85, 0x5b, 0x2e, 0xe8, 0x0b,
+	0x80, 0x28, 0x8e, 0xab, 0x03, 0x5c, 0x79, 0xc0, 0x5b, 0x5d, 0xf6, 0xc6, 0xdd, 0x80, 0x8b, 0xc8,
+	0x63, 0x9a, 0x2f, 0x52, 0xb6, 0x0a, 0x4b, 0xdf, 0xeb, 0x8d, 0xfc, 0xbc, 0xb2, 0x8b, 0xc8, 0x6b,
+	0xb0, 0xa0, 0xe5, 0x2c, 0xca, 0x8b, 0x05, 0x61, 0x21, 0xf5, 0x87, 0xbd, 0xb4, 0x61, 0x0d, 0x10,
+	0xb4, 0x0d, 0x5c, 0xa4, 0x5d, 0x49, 0x4f, 0x19, 0x5d, 0xf9, 0xa3, 0xde, 0xb4, 0x43, 0x03, 0x22,
+	0xd2, 0x36, 0x5d, 0xd0, 0x0c, 0x06, 0xe1, 0xde, 0x1f, 0x1f, 0x3b, 0x27, 0x43, 0x3c, 0x08, 0xf7,
+	0x4f, 0xc6, 0x30, 0xdc, 0x46, 0xd9, 0x86, 0x04, 0x5f, 0xc1, 0x9d, 0x56, 0xdd, 0xd0, 0x3b, 0x30,
+	0x95, 0x05, 0x3b, 0x4b, 0x12, 0x26, 0xbb, 0x33, 0xc5, 0x8d, 0x02, 0x1d, 0xc1, 0x68, 0x47, 0xd2,
+	0xe5, 0x35, 0x97, 0xb5, 0xf7, 0xb0, 0x96, 0x82, 0x1f, 0x01, 0x2e, 0xa2, 0x34, 0xdb, 0x7f, 0x93,
+	0xae, 0x52, 0x8e, 0x0e, 0x61, 0x98, 0x89, 0x0f, 0xe9, 0xef, 0x62, 0x25, 0xa0, 0xfb, 0x00, 0xc5,
+	0x9a, 0xe4, 0x3c, 0xa4, 0x49, 0xb4, 0x97, 0xfe, 0x2e, 0x36, 0x34, 0xc8, 0x87, 0x71, 0x16, 0x15,
+	0xfc, 0x22, 0xda, 0xcb, 0xbe, 0xb8, 0xb8, 0x12, 0x83, 0x3f, 0x06, 0x70, 0xd7, 0x68, 0x0e, 0xdd,
+	0xe4, 0xf2, 0xb4, 0x58, 0xde, 0x0a, 0x23, 0x50, 0x43, 0x83, 0x02, 0xb8, 0xbd, 0xaa, 0x5c, 0x04,
+	0x62, 0x20, 0x11, 0x2d, 0x1d, 0x7a, 0x00, 0x23, 0x99, 0x5a, 0xe1, 0xbb, 0xc7, 0xee, 0xc9, 0xc1,
+	0xa3, 0xc3, 0xbe, 0x9b, 0x84, 0x35, 0x06, 0x3d, 0x04, 0x48, 0xea, 0x1c, 0x75, 0xfb, 0xe7, 0xda,
+	0xa3, 0x49, 0x1e, 0x1b, 0x20, 0x91, 0x12, 0x2f, 0xcf, 0x45, 0xbc, 0xb2, 0xe7, 0x1e, 0xae, 0x44,
+	0xf4, 0x01, 0xcc, 0x18, 0x79, 0xb1, 0x49, 0x19, 0x49, 0x9e, 0xab, 0x82, 0x8e, 0x24, 0xc0, 0xd2,
+	0x8a, 0x34, 0x54, 0x52, 0x97, 0x0a, 0x35, 0x96, 0x95, 0x69, 0xe9, 0x82, 0x5f, 0x1d, 0x98, 0x77,
+	0x5e, 0x8e, 0x91, 0x9c, 0xf3, 0x12, 0xc9, 0x75, 0xe3, 0x19, 0xf4, 0xc6, 0x73, 0xbf, 0x55, 0x04,
+	0xd5, 0x27, 0x43, 0x13, 0xfc, 0xed, 0xc0, 0x61, 0xdf, 0x43, 0x44, 0x27, 0x70, 0x77, 0xd5, 0xc4,
+	0x68, 0x34, 0xcd, 0x56, 0xa3, 0x7b, 0x30, 0xa1, 0x59, 0x22, 0x9d, 0x75, 0xd7, 0x6a, 0x59, 0xd8,
+	0x72, 0xb2, 0x53, 0x36, 0x57, 0xd9, 0x2a, 0x59, 0xdc, 0xdc, 0x9c, 0xec, 0x74, 0xf4, 0x9e, 0x8c,
+	0xbe, 0x51, 0xa0, 0x63, 0x38, 0xa0, 0x2a, 0x94, 0xa7, 0x59, 0xb4, 0x94, 0xed, 0x18, 0x62, 0x53,
+	0x15, 0xfc, 0xe5, 0x00, 0xea, 0x8e, 0x80, 0x57, 0x08, 0xfc, 0x01, 0xcc, 0x73, 0xb2, 0xc3, 0x7d,
+	0x65, 0xec, 0x1a, 0xd0, 0x7b, 0x70, 0x27, 0x27, 0xbb, 0x0b, 0xbb, 0x98, 0x6d, 0xa5, 0x1d, 0xb6,
+	0xd7, 0x0d, 0xfb, 0x17, 0x98, 0x77, 0x86, 0xcf, 0x2b, 0x04, 0x8d, 0xc0, 0xe3, 0xe5, 0xb3, 0x44,
+	0xc7, 0x29, 0xbf, 0x85, 0xb7, 0x1e, 0x5d, 0x57, 0x0c, 0x93, 0x2d, 0xfd, 0x59, 0x4d, 0xca, 0x09,
+	0xb6, 0xd5, 0xc1, 0x77, 0x80, 0xec, 0xf9, 0x13, 0x52, 0x31, 0x6d, 0x38, 0xd5, 0x84, 0x03, 0x4e,
+	0xc5, 0xd4, 0x88, 0x56, 0xf2, 0x15, 0xa8, 0x57, 0xaf, 0x25, 0xc1, 0x9d, 0x53, 0x3d, 0x86, 0xa7,
+	0x58, 0x7e, 0x07, 0x0b, 0x38, 0xb4, 0x4f, 0x94, 0x13, 0x0c, 0x81, 0xb7, 0x10, 0xc3, 0x4f, 0x9d,
+	0x2a, 0xbf, 0x35, 0xcf, 0xa0, 0x87, 0xc7, 0xed, 0xe5, 0xf1, 0x0c, 0x9e, 0xdf, 0x5c, 0x80, 0x9a,
+	0xa8, 0xec, 0x8c, 0x0b, 0xa7, 0x67, 0x5c, 0xf4, 0x95, 0xea, 0x08, 0x46, 0xbc, 0xbc, 0x8c, 0x8a,
+	0x6b, 0x9d, 0x84, 0x96, 0x94, 0x3e, 0xdc, 0xaf, 0x89, 0x6e, 0x99, 0x96, 0xc4, 0x05, 0x26, 0x25,
+	0x89, 0x37, 0x9c, 0x24, 0xf2, 0x0e, 0x4e, 0x70, 0x2d, 0xa3, 0x2f, 0xeb, 0xb2, 0x13, 0x75, 0xdd,
+	0x0b, 0x7f, 0xf4, 0x1f, 0x4f, 0xd7, 0x06, 0xa3, 0xc7, 0xd6, 0x7a, 0x1c, 0xdf, 0xb8, 0x1e, 0xad,
+	0xe5, 0xf8, 0x79, 0x6b, 0x39, 0x4e, 0x6e, 0x58, 0x8e, 0xad, 0xd5, 0xf8, 0xd8, 0xda, 0x51, 0xd3,
+	0x1b, 0x77, 0x54, 0x7b, 0x43, 0x05, 0x5b, 0x38, 0xc2, 0x24, 0x26, 0xe9, 0x9a, 0xdb, 0x93, 0xfe,
+	0x43, 0xf0, 0xd6, 0x8c, 0x6c, 0xf5, 0xaf, 0xc2, 0x51, 0x37, 0x1e, 0x81, 0xc2, 0x12, 0x83, 0x3e,
+	0x86, 0x71, 0xbc, 0x61, 0x8c, 0xe8, 0xab, 0xf6, 0xef, 0xf0, 0x0a, 0x16, 0x2c, 0x61, 0x6e, 0xf1,
+	0x86, 0x25, 0x7a, 0xbf, 0x45, 0x39, 0xb7, 0xb3, 0x28, 0x35, 0xdb, 0x47, 0x36, 0x5b, 0x0f, 0xb2,
+	0x26, 0xfa, 0x5a, 0x10, 0xbd, 0x68, 0x2c, 0xcf, 0xf2, 0x05, 0xfd, 0xbf, 0xd7, 0x2e, 0xb8, 0x86,
+	0x59, 0xeb, 0xb0, 0xe2, 0xa5, 0x4e, 0xba, 0x07, 0x13, 0xf1, 0x6e, 0xc2, 0xe6, 0xb4, 0x5a, 0x96,
+	0x17, 0x96, 0x4a, 0x8b, 0xab, 0x36, 0xbb, 0x92, 0x82, 0x4f, 0xe1, 0x0d, 0x4c, 0xd6, 0xd9, 0xde,
+	0xe4, 0x7a, 0x17, 0x5c, 0x5e, 0x56, 0x7b, 0xa5, 0x27, 0x67, 0x61, 0x0d, 0x1e, 0xc2, 0x9b, 0x2d,
+	0x47, 0x5d, 0xf9, 0x42, 0x2c, 0xc5, 0x28, 0x49, 0x18, 0x29, 0xd4, 0x09, 0x53, 0x5c, 0x89, 0xc1,
+	0xef, 0x0e, 0xf8, 0x46, 0x5a, 0xcf, 0xa3, 0x2c, 0x23, 0x5c, 0x0f, 0xb5, 0xd7, 0x35, 0xd2, 0xc4,
+	0xcf, 0x8b, 0x7c, 0x14, 0x7a, 0x5a, 0x28, 0xe1, 0xc9, 0xf8, 0x07, 0xf5, 0x43, 0xfb, 0xd3, 0x48,
+	0xfe, 0xde, 0x7e, 0xf2, 0xcf, 0x00, 0xf0, 0x2b, 0x84, 0x0c, 0xf0, 0x0a, 0x00, 0x00,
+}
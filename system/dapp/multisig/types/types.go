@@ -0,0 +1,199 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"reflect"
+
+	"github.com/turingchain2020/turingchain/common/address"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+var (
+	// MultiSigX defines a global string
+	MultiSigX  = "multisig"
+	actionName = map[string]int32{
+		"AccCreate":    MultiSigActionAccCreate,
+		"OwnerOperate": MultiSigActionOwnerOperate,
+		"AccOperate":   MultiSigActionAccOperate,
+		"ConfirmTx":    MultiSigActionConfirmTx,
+		"TransferTo":   MultiSigActionTransferTo,
+		"TransferFrom": MultiSigActionTransferFrom,
+	}
+	logmap = map[int64]*types.LogInfo{
+		// 这里reflect.TypeOf类型必须是proto.Message类型，且是交易的回持结构
+		TyLogMultiSigAccCreate: {Ty: reflect.TypeOf(ReceiptMultiSigAccount{}), Name: "LogMultiSigAccCreate"},
+		TyLogMultiSigAccChange: {Ty: reflect.TypeOf(ReceiptMultiSigAccount{}), Name: "LogMultiSigAccChange"},
+		TyLogMultiSigTxSubmit:  {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigTxSubmit"},
+		TyLogMultiSigTxConfirm: {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigTxConfirm"},
+		TyLogMultiSigTxRevoke:  {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigTxRevoke"},
+		TyLogMultiSigTxExecute: {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigTxExecute"},
+	}
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(MultiSigX))
+	types.RegFork(MultiSigX, InitFork)
+	types.RegExec(MultiSigX, InitExecutor)
+}
+
+// InitFork init multisig fork
+func InitFork(cfg *types.TuringchainConfig) {
+	cfg.RegisterDappFork(MultiSigX, "Enable", types.MaxHeight)
+}
+
+// InitExecutor register multisig executor type
+func InitExecutor(cfg *types.TuringchainConfig) {
+	types.RegistorExecutor(MultiSigX, NewType(cfg))
+}
+
+// MultiSigType defines exec type
+type MultiSigType struct {
+	types.ExecTypeBase
+}
+
+// NewType new a multisig exec type
+func NewType(cfg *types.TuringchainConfig) *MultiSigType {
+	c := &MultiSigType{}
+	c.SetChild(c)
+	c.SetConfig(cfg)
+	return c
+}
+
+// GetPayload return payload
+func (m *MultiSigType) GetPayload() types.Message {
+	return &MultiSigAction{}
+}
+
+// GetLogMap return log map
+func (m *MultiSigType) GetLogMap() map[int64]*types.LogInfo {
+	return logmap
+}
+
+// GetTypeMap return action type map
+func (m *MultiSigType) GetTypeMap() map[string]int32 {
+	return actionName
+}
+
+// GetName return multisig name
+func (m *MultiSigType) GetName() string {
+	return MultiSigX
+}
+
+// GetTotalWeight 拥有者的总权重
+func GetTotalWeight(owners []*MultiSigOwner) uint64 {
+	var total uint64
+	for _, owner := range owners {
+		total += owner.Weight
+	}
+	return total
+}
+
+// FindOwner 查找拥有者所在的位置, 不存在时返回-1
+func FindOwner(owners []*MultiSigOwner, addr string) int {
+	for i, owner := range owners {
+		if owner.OwnerAddr == addr {
+			return i
+		}
+	}
+	return -1
+}
+
+// Check 检查创建账户的参数
+func (m *MultiSigAccCreate) Check() error {
+	if len(m.Owners) == 0 || len(m.Owners) > MultiSigMaxOwner {
+		return ErrOwnerCount
+	}
+	for i, owner := range m.Owners {
+		if err := address.CheckAddress(owner.OwnerAddr); err != nil {
+			return err
+		}
+		if owner.Weight == 0 {
+			return ErrOwnerWeight
+		}
+		if FindOwner(m.Owners[:i], owner.OwnerAddr) >= 0 {
+			return ErrOwnerExist
+		}
+	}
+	if m.RequiredWeight == 0 || m.RequiredWeight > GetTotalWeight(m.Owners) {
+		return ErrRequiredWeight
+	}
+	if m.DailyLimit < 0 {
+		return ErrDailyLimit
+	}
+	return nil
+}
+
+// Check 检查拥有者操作的参数
+func (m *MultiSigOwnerOperate) Check() error {
+	if err := address.CheckMultiSignAddress(m.MultiSigAccAddr); err != nil {
+		return err
+	}
+	switch m.OperateFlag {
+	case OwnerAdd:
+		if m.NewWeight == 0 {
+			return ErrOwnerWeight
+		}
+		return address.CheckAddress(m.NewOwner)
+	case OwnerDel:
+		return address.CheckAddress(m.OldOwner)
+	case OwnerModify:
+		if m.NewWeight == 0 {
+			return ErrOwnerWeight
+		}
+		return address.CheckAddress(m.OldOwner)
+	case OwnerReplace:
+		if err := address.CheckAddress(m.OldOwner); err != nil {
+			return err
+		}
+		return address.CheckAddress(m.NewOwner)
+	}
+	return ErrInvalidOperateFlag
+}
+
+// Check 检查账户操作的参数
+func (m *MultiSigAccOperate) Check() error {
+	if err := address.CheckMultiSignAddress(m.MultiSigAccAddr); err != nil {
+		return err
+	}
+	switch m.OperateFlag {
+	case AccWeightOp:
+		if m.NewRequiredWeight == 0 {
+			return ErrRequiredWeight
+		}
+		return nil
+	case AccDailyLimitOp:
+		if m.NewDailyLimit < 0 {
+			return ErrDailyLimit
+		}
+		return nil
+	}
+	return ErrInvalidOperateFlag
+}
+
+// Check 检查转入多重签名账户的参数
+func (m *MultiSigTransferTo) Check() error {
+	if m.Amount <= 0 {
+		return types.ErrAmount
+	}
+	return address.CheckMultiSignAddress(m.To)
+}
+
+// Check 检查从多重签名账户转出的参数
+func (m *MultiSigTransferFrom) Check() error {
+	if m.Amount <= 0 {
+		return types.ErrAmount
+	}
+	if err := address.CheckMultiSignAddress(m.From); err != nil {
+		return err
+	}
+	if m.From == m.To {
+		return ErrToAddrIsMultiSig
+	}
+	if address.CheckAddress(m.To) != nil && address.CheckMultiSignAddress(m.To) != nil {
+		return types.ErrInvalidAddress
+	}
+	return nil
+}
@@ -26,8 +26,31 @@ var (
 	execDrivers        = make(map[string]*driverWithHeight)
 	execAddressNameMap = make(map[string]string)
 	registedExecDriver = make(map[string]*driverWithHeight)
+	blockBeginners     []string
 )
 
+//BlockBeginner 需要在固定高度修改状态的执行器实现这个接口, 例如到达生效高度的提案.
+//每个区块执行交易之前调用一次, 返回的KV 和日志放到区块单独的回执中, 不属于任何交易.
+//返回错误会导致整个区块无法执行, 只能返回临时错误
+type BlockBeginner interface {
+	BlockBegin() (*types.Receipt, error)
+}
+
+//RegisterBlockBeginner 注册实现了BlockBeginner 的执行器, 按照注册的顺序调用
+func RegisterBlockBeginner(name string) {
+	for _, n := range blockBeginners {
+		if n == name {
+			panic("Execute: RegisterBlockBeginner called twice for driver " + name)
+		}
+	}
+	blockBeginners = append(blockBeginners, name)
+}
+
+//GetBlockBeginners 获取注册的BlockBeginner 执行器
+func GetBlockBeginners() []string {
+	return blockBeginners
+}
+
 // Register register dcriver height in name
 func Register(cfg *types.TuringchainConfig, name string, create DriverCreate, height int64) {
 	if cfg == nil {
@@ -78,6 +101,12 @@ func LoadDriver(name string, height int64) (driver Driver, err error) {
 	return nil, types.ErrUnknowDriver
 }
 
+//IsDriverRegisted 执行器是否注册过, user.evm.xxxx 按照evm 判断
+func IsDriverRegisted(name string) bool {
+	_, ok := registedExecDriver[string(types.GetRealExecName([]byte(name)))]
+	return ok
+}
+
 //LoadDriverWithClient load
 func LoadDriverWithClient(qclent client.QueueProtocolAPI, name string, height int64) (driver Driver, err error) {
 	driver, err = LoadDriver(name, height)
@@ -9,6 +9,8 @@ import (
 type AccountTxIndex struct {
 	maxperaccount int
 	accMap        map[string]*listmap.ListMap
+	//开启ForkTxNonce 之后, nonce 不连续的交易暂存在账户的future 队列中, 等待前面的交易到达
+	future map[string]map[int64]*Item
 }
 
 //NewAccountTxIndex 创建一个新的索引
@@ -16,15 +18,17 @@ func NewAccountTxIndex(maxperaccount int) *AccountTxIndex {
 	return &AccountTxIndex{
 		maxperaccount: maxperaccount,
 		accMap:        make(map[string]*listmap.ListMap),
+		future:        make(map[string]map[int64]*Item),
 	}
 }
 
-// TxNumOfAccount 返回账户在Mempool中交易数量
+// TxNumOfAccount 返回账户在Mempool中交易数量, 包括future 队列中的交易
 func (cache *AccountTxIndex) TxNumOfAccount(addr string) int {
+	num := len(cache.future[addr])
 	if _, ok := cache.accMap[addr]; ok {
-		return cache.accMap[addr].Size()
+		num += cache.accMap[addr].Size()
 	}
-	return 0
+	return num
 }
 
 // GetAccTxs 用来获取对应账户地址（列表）中的全部交易详细信息
@@ -52,6 +56,23 @@ func (cache *AccountTxIndex) GetAccTxs(addrs *types.ReqAddrs) *types.Transaction
 	return res
 }
 
+//GetTxByNonce 获取账户在排队队列中nonce 相同的交易
+func (cache *AccountTxIndex) GetTxByNonce(addr string, nonce int64) *types.Transaction {
+	lm, ok := cache.accMap[addr]
+	if !ok {
+		return nil
+	}
+	var tx *types.Transaction
+	lm.Walk(func(val interface{}) bool {
+		if v := val.(*types.Transaction); v.Nonce == nonce {
+			tx = v
+			return false
+		}
+		return true
+	})
+	return tx
+}
+
 //Remove 根据交易哈希删除对应账户的对应交易
 func (cache *AccountTxIndex) Remove(tx *types.Transaction) {
 	addr := tx.From()
@@ -79,8 +100,76 @@ func (cache *AccountTxIndex) Push(tx *types.Transaction) error {
 
 //CanPush 是否可以push 进 account index
 func (cache *AccountTxIndex) CanPush(tx *types.Transaction) bool {
-	if item, ok := cache.accMap[tx.From()]; ok {
-		return item.Size() < cache.maxperaccount
+	return cache.TxNumOfAccount(tx.From()) < cache.maxperaccount
+}
+
+//NextNonce 账户从stateNonce 开始, 排队队列中连续的nonce 之后的下一个nonce
+func (cache *AccountTxIndex) NextNonce(addr string, stateNonce int64) int64 {
+	lm, ok := cache.accMap[addr]
+	if !ok {
+		return stateNonce
+	}
+	nonces := make(map[int64]bool)
+	lm.Walk(func(val interface{}) bool {
+		nonces[val.(*types.Transaction).Nonce] = true
+		return true
+	})
+	next := stateNonce
+	for nonces[next] {
+		next++
+	}
+	return next
+}
+
+//MinNonce 账户在排队队列中最小的nonce
+func (cache *AccountTxIndex) MinNonce(addr string) (int64, bool) {
+	lm, ok := cache.accMap[addr]
+	if !ok {
+		return 0, false
+	}
+	var min int64
+	first := true
+	lm.Walk(func(val interface{}) bool {
+		if nonce := val.(*types.Transaction).Nonce; first || nonce < min {
+			min = nonce
+			first = false
+		}
+		return true
+	})
+	return min, true
+}
+
+//PushFuture 放入nonce 不连续的交易
+func (cache *AccountTxIndex) PushFuture(item *Item) {
+	addr := item.Value.From()
+	if _, ok := cache.future[addr]; !ok {
+		cache.future[addr] = make(map[int64]*Item)
+	}
+	cache.future[addr][item.Value.Nonce] = item
+}
+
+//GetFuture 获取账户future 队列中nonce 对应的交易
+func (cache *AccountTxIndex) GetFuture(addr string, nonce int64) *Item {
+	return cache.future[addr][nonce]
+}
+
+//RemoveFuture 删除账户future 队列中nonce 对应的交易
+func (cache *AccountTxIndex) RemoveFuture(addr string, nonce int64) {
+	if items, ok := cache.future[addr]; ok {
+		delete(items, nonce)
+		if len(items) == 0 {
+			delete(cache.future, addr)
+		}
+	}
+}
+
+//WalkFuture 遍历所有future 队列中的交易
+func (cache *AccountTxIndex) WalkFuture(cb func(item *Item) bool) {
+	for _, items := range cache.future {
+		for _, item := range items {
+			if !cb(item) {
+				return
+			}
+		}
 	}
-	return true
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/turingchain2020/turingchain/common"
 	log "github.com/turingchain2020/turingchain/common/log/log15"
 	"github.com/turingchain2020/turingchain/queue"
+	"github.com/turingchain2020/turingchain/trace"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -53,6 +54,9 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	if cfg.PoolCacheSize == 0 {
 		cfg.PoolCacheSize = poolCacheSize
 	}
+	if cfg.ReplaceFeeBump == 0 {
+		cfg.ReplaceFeeBump = replaceFeeBump
+	}
 	pool.in = make(chan *queue.Message)
 	pool.out = make(<-chan *queue.Message)
 	pool.done = make(chan struct{})
@@ -60,6 +64,10 @@ func NewMempool(cfg *types.Mempool) *Mempool {
 	pool.poolHeader = make(chan struct{}, 2)
 	pool.removeBlockTicket = time.NewTicker(time.Minute)
 	pool.cache = newCache(cfg.MaxTxNumPerAccount, cfg.MaxTxLast, cfg.PoolCacheSize)
+	pool.cache.replaceFeeBump = cfg.ReplaceFeeBump
+	if cfg.EnableJournal {
+		pool.cache.journal = newJournal(cfg)
+	}
 	return pool
 }
 
@@ -76,6 +84,9 @@ func (mem *Mempool) Close() {
 	mem.removeBlockTicket.Stop()
 	mlog.Info("mempool module closing")
 	mem.wg.Wait()
+	if mem.cache.journal != nil {
+		mem.cache.journal.close()
+	}
 	mlog.Info("mempool module closed")
 }
 
@@ -92,6 +103,10 @@ func (mem *Mempool) SetQueueClient(client queue.Client) {
 
 	mem.wg.Add(1)
 	go mem.eventProcess()
+	if mem.cache.journal != nil {
+		mem.wg.Add(1)
+		go mem.compactJournalRoutine()
+	}
 }
 
 // Size 返回mempool中txCache大小
@@ -122,7 +137,13 @@ func (mem *Mempool) getTxList(filterList *types.TxHashList) (txs []*types.Transa
 	for i := 0; i < len(filterList.GetHashes()); i++ {
 		dupMap[string(filterList.GetHashes()[i])] = true
 	}
-	return mem.filterTxList(count, dupMap, false)
+	txs = mem.filterTxList(count, dupMap, false)
+	//开启ForkTxNonce 之后, 打包的交易需要按照账户nonce 顺序排列
+	cfg := mem.client.GetConfig()
+	if cfg.IsFork(mem.header.GetHeight()+1, "ForkTxNonce") {
+		txs = mem.cache.sortNonceTxs(txs)
+	}
+	return txs
 }
 
 func (mem *Mempool) filterTxList(count int64, dupMap map[string]bool, isAll bool) (txs []*types.Transaction) {
@@ -171,6 +192,9 @@ func (mem *Mempool) removeTxs(hashes [][]byte) {
 
 // PushTx 将交易推入mempool，并返回结果（error）
 func (mem *Mempool) PushTx(tx *types.Transaction) error {
+	if mem.isNonceMode() {
+		return mem.pushNonceTx(tx)
+	}
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
 	err := mem.cache.Push(tx)
@@ -224,6 +248,16 @@ func (mem *Mempool) TxNumOfAccount(addr string) int64 {
 	return int64(mem.cache.TxNumOfAccount(addr))
 }
 
+// GetReplacedTx 返回mempool中将被tx 替换的交易, 只有开启ForkTxNonce 之后才支持替换
+func (mem *Mempool) GetReplacedTx(tx *types.Transaction) *types.Transaction {
+	if !mem.isNonceMode() {
+		return nil
+	}
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	return mem.cache.GetReplacedTx(tx)
+}
+
 // GetLatestTx 返回最新十条加入到mempool的交易
 func (mem *Mempool) GetLatestTx() []*types.Transaction {
 	mem.proxyMtx.Lock()
@@ -257,6 +291,8 @@ func (mem *Mempool) pollLastHeader() {
 		}
 		h := lastHeader.(*queue.Message).Data.(*types.Header)
 		mem.setHeader(h)
+		//获取到区块头之后才能重新检查日志中的交易
+		mem.loadJournal()
 		return
 	}
 }
@@ -411,12 +447,13 @@ func (mem *Mempool) Wait() {
 	<-mem.poolHeader
 }
 
-// SendTxToP2P 向"p2p"发送消息
-func (mem *Mempool) sendTxToP2P(tx *types.Transaction) {
+// SendTxToP2P 向"p2p"发送消息, sc 为交易所在的调用链
+func (mem *Mempool) sendTxToP2P(tx *types.Transaction, sc trace.SpanContext) {
 	if mem.client == nil {
 		panic("client not bind message queue.")
 	}
 	msg := mem.client.NewMessage("p2p", types.EventTxBroadcast, tx)
+	msg.SetTrace(sc)
 	err := mem.client.Send(msg, false)
 	if err != nil {
 		mlog.Error("tx sent to p2p", "tx.Hash", common.ToHex(tx.Hash()))
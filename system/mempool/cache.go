@@ -5,6 +5,9 @@
 package mempool
 
 import (
+	"sort"
+
+	"github.com/turingchain2020/turingchain/common"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -20,6 +23,14 @@ type QueueCache interface {
 	GetCacheBytes() int64
 }
 
+//QueueEvictor mempool 满时可以淘汰低优先级交易的排队策略需要实现这个接口,
+//淘汰的交易由txCache 删除, 保证账户索引等缓存一致
+type QueueEvictor interface {
+	//GetEvictItem 返回放入tx 需要淘汰的交易, 从优先级最低的交易开始选择canEvict 返回true 的交易,
+	//不需要淘汰时返回nil, 没有优先级比tx 低并且可以淘汰的交易时返回ErrMemFull
+	GetEvictItem(tx *Item, canEvict func(*Item) bool) (*Item, error)
+}
+
 // Item 为Mempool中包装交易的数据结构
 type Item struct {
 	Value     *types.Transaction
@@ -34,6 +45,12 @@ type txCache struct {
 	qcache   QueueCache
 	totalFee int64
 	*SHashTxCache
+	//替换交易手续费最少提高的百分比
+	replaceFeeBump int64
+	//交易日志, 没有开启时为nil
+	journal *journal
+	//开启ForkTxNonce 之后, 交易需要按照账户nonce 顺序执行
+	nonceMode bool
 }
 
 //NewTxCache init accountIndex and last cache
@@ -65,6 +82,9 @@ func (cache *txCache) Remove(hash string) {
 	cache.LastTxCache.Remove(tx)
 	cache.totalFee -= tx.Fee
 	cache.SHashTxCache.Remove(tx)
+	if cache.journal != nil {
+		cache.journal.remove(tx.Hash())
+	}
 }
 
 //Exist 是否存在
@@ -103,12 +123,85 @@ func (cache *txCache) RemoveTxs(txs []string) {
 	}
 }
 
-//Push 存入交易到cache 中
+//GetReplacedTx 开启ForkTxNonce 之后获取将被tx 替换的交易, 同一账户nonce 相同的交易视为替换.
+//没有开启时nonce 只是随机数, 链上不会拒绝执行nonce 相同的另一笔交易, 替换无法让原交易失效, 所以不支持替换
+func (cache *txCache) GetReplacedTx(tx *types.Transaction) *types.Transaction {
+	from := tx.From()
+	if old := cache.AccountTxIndex.GetTxByNonce(from, tx.Nonce); old != nil {
+		return old
+	}
+	if item := cache.AccountTxIndex.GetFuture(from, tx.Nonce); item != nil {
+		return item.Value
+	}
+	return nil
+}
+
+//checkReplaceFee 替换交易的手续费需要比原交易提高replaceFeeBump 的比例
+func (cache *txCache) checkReplaceFee(old, tx *types.Transaction) error {
+	if tx.Fee <= old.Fee || tx.Fee < old.Fee+old.Fee*cache.replaceFeeBump/100 {
+		return types.ErrReplaceTxFeeTooLow
+	}
+	return nil
+}
+
+//Push 没有开启ForkTxNonce 时存入交易到cache 中, 不会替换nonce 相同的交易
 func (cache *txCache) Push(tx *types.Transaction) error {
+	return cache.push(tx)
+}
+
+//replace 使用tx 替换排队队列中的old
+func (cache *txCache) replace(old, tx *types.Transaction) error {
+	if cache.Exist(string(tx.Hash())) {
+		return types.ErrTxExist
+	}
+	err := cache.checkReplaceFee(old, tx)
+	if err != nil {
+		return err
+	}
+	cache.Remove(string(old.Hash()))
+	err = cache.push(tx)
+	if err != nil {
+		//替换失败, 恢复原交易
+		if e := cache.push(old); e != nil {
+			mlog.Error("Push", "restore replaced tx err", e)
+		}
+		return err
+	}
+	mlog.Info("replace tx", "old", common.ToHex(old.Hash()), "new", common.ToHex(tx.Hash()), "from", tx.From(), "fee", tx.Fee)
+	return nil
+}
+
+//canEvict 开启ForkTxNonce 之后只能淘汰账户nonce 连续的交易中最后一笔, 淘汰中间的交易会让后面的交易无法执行,
+//也不能淘汰tx 所在账户的交易, 否则tx 的nonce 不再连续
+func (cache *txCache) canEvict(tx *types.Transaction, evict *Item) bool {
+	if !cache.nonceMode {
+		return true
+	}
+	from := evict.Value.From()
+	if from == tx.From() {
+		return false
+	}
+	next := evict.Value.Nonce + 1
+	return cache.AccountTxIndex.NextNonce(from, next) == next
+}
+
+func (cache *txCache) push(tx *types.Transaction) error {
 	if !cache.AccountTxIndex.CanPush(tx) {
 		return types.ErrManyTx
 	}
 	item := &Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}
+	if evictor, ok := cache.qcache.(QueueEvictor); ok && !cache.qcache.Exist(string(tx.Hash())) {
+		evict, err := evictor.GetEvictItem(item, func(evict *Item) bool {
+			return cache.canEvict(tx, evict)
+		})
+		if err != nil {
+			return err
+		}
+		if evict != nil {
+			mlog.Debug("push evict tx", "hash", common.ToHex(evict.Value.Hash()), "fee", evict.Value.Fee)
+			cache.Remove(string(evict.Value.Hash()))
+		}
+	}
 	err := cache.qcache.Push(item)
 	if err != nil {
 		return err
@@ -120,9 +213,136 @@ func (cache *txCache) Push(tx *types.Transaction) error {
 	cache.LastTxCache.Push(tx)
 	cache.totalFee += tx.Fee
 	cache.SHashTxCache.Push(tx)
+	if cache.journal != nil {
+		cache.journal.add(tx)
+	}
+	return nil
+}
+
+//PushNonceTx 开启ForkTxNonce 之后存入交易, stateNonce 为账户在最新状态中的nonce.
+//nonce 连续的交易放入排队队列, 否则放入账户的future 队列, 前面的交易到达之后再放入排队队列
+func (cache *txCache) PushNonceTx(tx *types.Transaction, stateNonce int64) error {
+	cache.nonceMode = true
+	if tx.Nonce < stateNonce {
+		return types.ErrTxNonceTooLow
+	}
+	from := tx.From()
+	next := cache.AccountTxIndex.NextNonce(from, stateNonce)
+	if tx.Nonce < next {
+		return cache.replace(cache.AccountTxIndex.GetTxByNonce(from, tx.Nonce), tx)
+	}
+	if tx.Nonce > next {
+		return cache.pushFuture(tx)
+	}
+	err := cache.push(tx)
+	if err != nil {
+		return err
+	}
+	cache.promote(from, next+1)
+	return nil
+}
+
+//pushFuture 放入账户的future 队列, 存在相同nonce 的交易时按照手续费替换
+func (cache *txCache) pushFuture(tx *types.Transaction) error {
+	from := tx.From()
+	old := cache.AccountTxIndex.GetFuture(from, tx.Nonce)
+	if old != nil {
+		if string(old.Value.Hash()) == string(tx.Hash()) {
+			return types.ErrTxExist
+		}
+		err := cache.checkReplaceFee(old.Value, tx)
+		if err != nil {
+			return err
+		}
+		if cache.journal != nil {
+			cache.journal.remove(old.Value.Hash())
+		}
+	} else if !cache.AccountTxIndex.CanPush(tx) {
+		return types.ErrManyTx
+	}
+	cache.AccountTxIndex.PushFuture(&Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()})
+	if cache.journal != nil {
+		cache.journal.add(tx)
+	}
 	return nil
 }
 
+//promote 从nonce 开始把future 队列中连续的交易放入排队队列
+func (cache *txCache) promote(addr string, nonce int64) {
+	for {
+		item := cache.AccountTxIndex.GetFuture(addr, nonce)
+		if item == nil {
+			return
+		}
+		cache.AccountTxIndex.RemoveFuture(addr, nonce)
+		err := cache.push(item.Value)
+		if err != nil {
+			mlog.Error("promote", "hash", common.ToHex(item.Value.Hash()), "err", err)
+			if cache.journal != nil {
+				cache.journal.remove(item.Value.Hash())
+			}
+			return
+		}
+		nonce++
+	}
+}
+
+//ResetNonce 账户nonce 更新之后, 删除nonce 已经使用的交易, 并放入已经连续的future 交易
+func (cache *txCache) ResetNonce(addr string, stateNonce int64) {
+	var stale []string
+	if lm, ok := cache.AccountTxIndex.accMap[addr]; ok {
+		lm.Walk(func(val interface{}) bool {
+			if tx := val.(*types.Transaction); tx.Nonce < stateNonce {
+				stale = append(stale, string(tx.Hash()))
+			}
+			return true
+		})
+	}
+	cache.RemoveTxs(stale)
+	for nonce, item := range cache.AccountTxIndex.future[addr] {
+		if nonce < stateNonce {
+			cache.removeFuture(item)
+		}
+	}
+	cache.promote(addr, cache.AccountTxIndex.NextNonce(addr, stateNonce))
+}
+
+func (cache *txCache) removeFuture(item *Item) {
+	cache.AccountTxIndex.RemoveFuture(item.Value.From(), item.Value.Nonce)
+	if cache.journal != nil {
+		cache.journal.remove(item.Value.Hash())
+	}
+}
+
+//sortNonceTxs 同一账户的交易按照nonce 从小到大排列, 并去掉nonce 不连续的交易
+func (cache *txCache) sortNonceTxs(txs []*types.Transaction) []*types.Transaction {
+	accTxs := make(map[string][]*types.Transaction)
+	for _, tx := range txs {
+		from := tx.From()
+		accTxs[from] = append(accTxs[from], tx)
+	}
+	for from, list := range accTxs {
+		sort.Slice(list, func(i, j int) bool { return list[i].Nonce < list[j].Nonce })
+		min, _ := cache.AccountTxIndex.MinNonce(from)
+		n := 0
+		for n < len(list) && list[n].Nonce == min+int64(n) {
+			n++
+		}
+		accTxs[from] = list[:n]
+	}
+	//每个账户的交易按照nonce 顺序占用该账户原来的位置
+	result := make([]*types.Transaction, 0, len(txs))
+	used := make(map[string]int)
+	for _, tx := range txs {
+		from := tx.From()
+		if i := used[from]; i < len(accTxs[from]) {
+			result = append(result, accTxs[from][i])
+			used[from]++
+		}
+	}
+	return result
+}
+
 func (cache *txCache) removeExpiredTx(cfg *types.TuringchainConfig, height, blocktime int64) {
 	var txs []string
 	cache.qcache.Walk(0, func(tx *Item) bool {
@@ -131,6 +351,16 @@ func (cache *txCache) removeExpiredTx(cfg *types.TuringchainConfig, height, bloc
 		}
 		return true
 	})
+	var futures []*Item
+	cache.AccountTxIndex.WalkFuture(func(item *Item) bool {
+		if isExpired(cfg, item, height, blocktime) {
+			futures = append(futures, item)
+		}
+		return true
+	})
+	for _, item := range futures {
+		cache.removeFuture(item)
+	}
 	if len(txs) > 0 {
 		mlog.Info("removeExpiredTx", "height", height, "totalTxs", cache.Size(), "expiredTxs", len(txs))
 		cache.RemoveTxs(txs)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/turingchain2020/turingchain/common/address"
 	"github.com/turingchain2020/turingchain/queue"
+	"github.com/turingchain2020/turingchain/trace"
 	"github.com/turingchain2020/turingchain/types"
 )
 
@@ -29,11 +30,12 @@ func (mem *Mempool) CheckExpireValid(msg *queue.Message) (bool, error) {
 }
 
 // checkTxListRemote 发送消息给执行模块检查交易
-func (mem *Mempool) checkTxListRemote(txlist *types.ExecTxList) (*types.ReceiptCheckTxList, error) {
+func (mem *Mempool) checkTxListRemote(txlist *types.ExecTxList, sc trace.SpanContext) (*types.ReceiptCheckTxList, error) {
 	if mem.client == nil {
 		panic("client not bind message queue.")
 	}
 	msg := mem.client.NewMessage("execs", types.EventCheckTx, txlist)
+	msg.SetTrace(sc)
 	err := mem.client.Send(msg, true)
 	if err != nil {
 		mlog.Error("execs closed", "err", err.Error())
@@ -69,8 +71,9 @@ func (mem *Mempool) checkTx(msg *queue.Message) *queue.Message {
 		return msg
 	}
 	// 检查交易账户在mempool中是否存在过多交易
+	//替换交易不会增加账户交易数量
 	from := tx.From()
-	if mem.TxNumOfAccount(from) >= mem.cfg.MaxTxNumPerAccount {
+	if mem.TxNumOfAccount(from) >= mem.cfg.MaxTxNumPerAccount && mem.GetReplacedTx(tx) == nil {
 		msg.Data = types.ErrManyTx
 		return msg
 	}
@@ -184,7 +187,7 @@ func (mem *Mempool) checkTxRemote(msg *queue.Message) *queue.Message {
 		txlist.Difficulty = uint64(lastheader.Difficulty)
 		txlist.IsMempool = true
 
-		result, err := mem.checkTxListRemote(txlist)
+		result, err := mem.checkTxListRemote(txlist, msg.Trace())
 
 		if err == nil && result.Errs[0] != "" {
 			err = errors.New(result.Errs[0])
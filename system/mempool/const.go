@@ -14,6 +14,7 @@ var (
 	mempoolExpiredInterval int64 = 600   // mempool内交易过期时间，10分钟
 	maxTxNumPerAccount     int64 = 100   // TODO 每个账户在mempool中最大交易数量，10
 	maxTxLast              int64 = 10
+	replaceFeeBump         int64 = 10 // 替换交易手续费最少提高的百分比
 	processNum             int
 )
 
@@ -6,3 +6,6 @@ package mempool
 
 //mempool 模块的功能：实现交易暂存的功能。主要是解决共识模块可能比rpc模块速度慢的问题。
 //模块的接口的设计：
+//交易替换：开启ForkTxNonce 之后，同一账户nonce 相同的交易，手续费提高replaceFeeBump 比例后可以替换mempool 中的原交易，并重新广播。
+//链上每个nonce 只能执行一笔交易，原交易即使已经广播到其他节点也无法再被打包。没有开启ForkTxNonce 时nonce 只是随机数，不支持替换。
+//账户nonce：开启ForkTxNonce 之后，交易nonce 需要和账户nonce 连续，nonce 不连续的交易暂存在账户的future 队列，前面的交易到达后再进入排队队列。
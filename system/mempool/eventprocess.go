@@ -1,19 +1,50 @@
 package mempool
 
 import (
+	"github.com/turingchain2020/turingchain/metrics"
 	"github.com/turingchain2020/turingchain/queue"
 	"github.com/turingchain2020/turingchain/types"
 )
 
+//rejectReasons 交易被拒绝的原因, 作为监控的label
+//执行器检查返回的错误种类不固定, 不在列表中的错误统一记为other
+var rejectReasons = map[string]bool{
+	types.ErrNotSync.Error():                    true,
+	types.ErrSign.Error():                       true,
+	types.ErrTxExpire.Error():                   true,
+	types.ErrTxFeeTooLow.Error():                true,
+	types.ErrReplaceTxFeeTooLow.Error():         true,
+	types.ErrTxNonceTooLow.Error():              true,
+	types.ErrManyTx.Error():                     true,
+	types.ErrMemFull.Error():                    true,
+	types.ErrTxExist.Error():                    true,
+	types.ErrDupTx.Error():                      true,
+	types.ErrEmptyTx.Error():                    true,
+	types.ErrInvalidAddress.Error():             true,
+	types.ErrHeaderNotSet.Error():               true,
+	types.ErrNoBalance.Error():                  true,
+	types.ErrBalanceLessThanTenTimesFee.Error(): true,
+}
+
+func rejectReason(err error) string {
+	if rejectReasons[err.Error()] {
+		return err.Error()
+	}
+	return "other"
+}
+
 func (mem *Mempool) reply() {
 	defer mlog.Info("piple line quit")
 	defer mem.wg.Done()
 	for m := range mem.out {
 		if m.Err() != nil {
+			metrics.Counter("mempool_tx_rejected", "reason", rejectReason(m.Err())).Inc(1)
 			m.Reply(mem.client.NewMessage("rpc", types.EventReply,
 				&types.Reply{IsOk: false, Msg: []byte(m.Err().Error())}))
 		} else { //TODO, rpc和p2p交易发送需要区分， rpc需要消息答复，p2p不需要
-			mem.sendTxToP2P(m.GetData().(types.TxGroup).Tx())
+			metrics.Counter("mempool_tx_accepted").Inc(1)
+			metrics.Gauge("mempool_size").Update(int64(mem.Size()))
+			mem.sendTxToP2P(m.GetData().(types.TxGroup).Tx(), m.Trace())
 			m.Reply(mem.client.NewMessage("rpc", types.EventReply, &types.Reply{IsOk: true, Msg: nil}))
 		}
 	}
@@ -106,6 +137,7 @@ func (mem *Mempool) eventProcess() {
 //EventTx 初步筛选后存入mempool
 func (mem *Mempool) eventTx(msg *queue.Message) {
 	if !mem.getSync() {
+		metrics.Counter("mempool_tx_rejected", "reason", rejectReason(types.ErrNotSync)).Inc(1)
 		msg.Reply(mem.client.NewMessage("", types.EventReply, &types.Reply{Msg: []byte(types.ErrNotSync.Error())}))
 		mlog.Debug("wrong tx", "err", types.ErrNotSync.Error())
 	} else {
@@ -168,6 +200,17 @@ func (mem *Mempool) eventAddBlock(msg *queue.Message) {
 		mem.RemoveTxsOfBlock(block)
 		mem.removeExpired()
 	}
+	if mem.isNonceMode() {
+		mem.resetNonces(block)
+	}
+	mem.updateMetrics()
+}
+
+//updateMetrics 区块打包之后更新交易池大小和手续费率
+func (mem *Mempool) updateMetrics() {
+	metrics.Gauge("mempool_size").Update(int64(mem.Size()))
+	metrics.Gauge("mempool_cache_bytes").Update(mem.GetTotalCacheBytes())
+	metrics.Gauge("mempool_fee_rate").Update(mem.GetProperFeeRate(nil))
 }
 
 // EventGetMempoolSize 获取mempool大小
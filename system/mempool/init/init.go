@@ -5,5 +5,7 @@
 package init
 
 import (
+	_ "github.com/turingchain2020/turingchain/system/mempool/price"    //按照手续费率排序
+	_ "github.com/turingchain2020/turingchain/system/mempool/score"    //按照手续费率, 时间和交易大小综合排序
 	_ "github.com/turingchain2020/turingchain/system/mempool/timeline" //最简单的排队模式，按照时间
 )
@@ -0,0 +1,191 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/turingchain2020/turingchain/common"
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/common/listmap"
+	"github.com/turingchain2020/turingchain/queue"
+	"github.com/turingchain2020/turingchain/trace"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+var journalPrefix = []byte("mempool-journal-")
+
+//交易日志压缩的时间间隔
+var journalCompactInterval = 10 * time.Minute
+
+func calcJournalKey(seq int64) []byte {
+	return append(append([]byte{}, journalPrefix...), []byte(fmt.Sprintf("%020d", seq))...)
+}
+
+//journal mempool 交易日志, 按照顺序记录加入和删除的交易, 节点重启时重放
+type journal struct {
+	db  dbm.DB
+	seq int64
+	//上次压缩之后写入的日志数
+	count int64
+}
+
+func newJournal(cfg *types.Mempool) *journal {
+	if cfg.JournalDbPath == "" {
+		cfg.JournalDbPath = "datadir/mempool"
+	}
+	if cfg.JournalDriver == "" {
+		cfg.JournalDriver = "leveldb"
+	}
+	j := &journal{db: dbm.NewDB("mempool", cfg.JournalDriver, cfg.JournalDbPath, 16)}
+	//从最后一条日志之后开始编号
+	it := j.db.Iterator(journalPrefix, nil, true)
+	defer it.Close()
+	if it.Rewind() && it.Valid() {
+		seq, err := strconv.ParseInt(string(bytes.TrimPrefix(it.Key(), journalPrefix)), 10, 64)
+		if err == nil {
+			j.seq = seq + 1
+		}
+	}
+	return j
+}
+
+func (j *journal) write(entry *types.MempoolJournal) {
+	err := j.db.Set(calcJournalKey(j.seq), types.Encode(entry))
+	if err != nil {
+		mlog.Error("journal write", "seq", j.seq, "err", err)
+		return
+	}
+	j.seq++
+	j.count++
+}
+
+//add 记录加入mempool 的交易
+func (j *journal) add(tx *types.Transaction) {
+	j.write(&types.MempoolJournal{Tx: tx})
+}
+
+//remove 记录从mempool 删除的交易
+func (j *journal) remove(hash []byte) {
+	j.write(&types.MempoolJournal{Hash: hash})
+}
+
+//load 按照顺序重放日志, 返回没有被删除的交易
+func (j *journal) load() []*types.Transaction {
+	txs := listmap.New()
+	it := j.db.Iterator(journalPrefix, nil, false)
+	defer it.Close()
+	for it.Rewind(); it.Valid(); it.Next() {
+		var entry types.MempoolJournal
+		err := types.Decode(it.Value(), &entry)
+		if err != nil {
+			mlog.Error("journal load", "key", string(it.Key()), "err", err)
+			continue
+		}
+		if entry.Tx != nil {
+			txs.Push(string(entry.Tx.Hash()), entry.Tx)
+		} else {
+			txs.Remove(string(entry.Hash))
+		}
+	}
+	var list []*types.Transaction
+	txs.Walk(func(value interface{}) bool {
+		list = append(list, value.(*types.Transaction))
+		return true
+	})
+	return list
+}
+
+//rewrite 压缩日志, 删除所有的日志后重新写入txs
+func (j *journal) rewrite(txs []*types.Transaction) error {
+	batch := j.db.NewBatch(true)
+	it := j.db.Iterator(journalPrefix, nil, false)
+	for it.Rewind(); it.Valid(); it.Next() {
+		batch.Delete(common.CopyBytes(it.Key()))
+	}
+	it.Close()
+	var seq int64
+	for _, tx := range txs {
+		batch.Set(calcJournalKey(seq), types.Encode(&types.MempoolJournal{Tx: tx}))
+		seq++
+	}
+	err := batch.Write()
+	if err != nil {
+		return err
+	}
+	j.seq = seq
+	j.count = 0
+	return nil
+}
+
+func (j *journal) close() {
+	j.db.Close()
+}
+
+//loadJournal 重放交易日志, 交易重新检查之后放入mempool 并广播
+func (mem *Mempool) loadJournal() {
+	if mem.cache.journal == nil {
+		return
+	}
+	txs := mem.cache.journal.load()
+	count := 0
+	for _, tx := range txs {
+		msg := mem.checkTxs(&queue.Message{Data: tx})
+		if msg.Err() == nil {
+			msg = mem.checkSign(msg)
+		}
+		if msg.Err() == nil {
+			msg = mem.checkTxRemote(msg)
+		}
+		if msg.Err() != nil {
+			mlog.Debug("loadJournal", "hash", common.ToHex(tx.Hash()), "err", msg.Err())
+			continue
+		}
+		count++
+		mem.sendTxToP2P(tx, trace.SpanContext{})
+	}
+	mlog.Info("loadJournal", "total", len(txs), "loaded", count)
+	mem.compactJournal()
+}
+
+//compactJournal 使用mempool 中当前的交易重写交易日志, 包括future 队列中nonce 不连续的交易
+func (mem *Mempool) compactJournal() {
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	var txs []*types.Transaction
+	mem.cache.Walk(0, func(item *Item) bool {
+		txs = append(txs, item.Value)
+		return true
+	})
+	mem.cache.AccountTxIndex.WalkFuture(func(item *Item) bool {
+		txs = append(txs, item.Value)
+		return true
+	})
+	if mem.cache.journal.count == 0 && mem.cache.journal.seq == int64(len(txs)) {
+		return
+	}
+	err := mem.cache.journal.rewrite(txs)
+	if err != nil {
+		mlog.Error("compactJournal", "err", err)
+	}
+}
+
+// compactJournalRoutine 定时压缩交易日志
+func (mem *Mempool) compactJournalRoutine() {
+	defer mem.wg.Done()
+	ticker := time.NewTicker(journalCompactInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			mem.compactJournal()
+		case <-mem.done:
+			return
+		}
+	}
+}
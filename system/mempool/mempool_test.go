@@ -6,7 +6,9 @@ package mempool
 
 import (
 	"errors"
+	"io/ioutil"
 	"math/rand"
+	"os"
 	"testing"
 
 	"github.com/turingchain2020/turingchain/util"
@@ -1338,3 +1340,244 @@ func TestCheckTxsExist(t *testing.T) {
 	require.Equal(t, 0, len(replyData.ExistFlags))
 	require.Equal(t, 0, int(replyData.ExistCount))
 }
+
+func sendTx(client queue.Client, tx *types.Transaction) error {
+	msg := client.NewMessage("mempool", types.EventTx, tx)
+	client.Send(msg, true)
+	resp, err := client.Wait(msg)
+	if err != nil {
+		return err
+	}
+	return checkReply(resp.GetData().(*types.Reply))
+}
+
+//没有开启ForkTxNonce 时nonce 相同的交易可以同时打包, 不能替换mempool 中的交易
+func TestReplaceTx(t *testing.T) {
+	q, mem := initEnv(0)
+	defer q.Close()
+	defer mem.Close()
+	mem.cfg.MaxTxNumPerAccount = 1
+	cfg := q.GetConfig()
+	require.False(t, mem.isNonceMode())
+	newTx := func(fee, nonce int64) *types.Transaction {
+		tx := createTx(cfg, privKey, toAddr, amount)
+		tx.Fee = fee
+		tx.Nonce = nonce
+		tx.Sign(types.SECP256K1, privKey)
+		return tx
+	}
+
+	txA := newTx(1e6, 1)
+	require.Nil(t, sendTx(mem.client, txA))
+	require.NotNil(t, sendTx(mem.client, txA))
+	//nonce 相同的交易不会被当作替换, 受账户交易数量限制
+	txB := newTx(2e6, 1)
+	require.Nil(t, mem.GetReplacedTx(txB))
+	require.Equal(t, types.ErrManyTx.Error(), sendTx(mem.client, txB).Error())
+
+	//作为另一笔交易放入mempool, 原交易不会被删除
+	mem.cfg.MaxTxNumPerAccount = 10
+	mem.cache.AccountTxIndex.maxperaccount = 10
+	require.Nil(t, sendTx(mem.client, txB))
+	require.Equal(t, 2, mem.Size())
+	require.Equal(t, txA.Hash(), mem.cache.getTxByHash(string(txA.Hash())).Hash())
+	require.Equal(t, txB.Hash(), mem.cache.getTxByHash(string(txB.Hash())).Hash())
+	require.Equal(t, txA.Fee+txB.Fee, mem.cache.TotalFee())
+}
+
+func TestPushNonceTx(t *testing.T) {
+	cfg := types.NewTuringchainConfig(types.GetDefaultCfgstring())
+	cache := newCache(4, 10, 100)
+	cache.SetQueueCache(NewSimpleQueue(SubConfig{PoolCacheSize: 100}))
+	cache.replaceFeeBump = 10
+	newTx := func(fee, nonce int64) *types.Transaction {
+		tx := createTx(cfg, privKey, toAddr, amount)
+		tx.Fee = fee
+		tx.Nonce = nonce
+		tx.Sign(types.SECP256K1, privKey)
+		return tx
+	}
+	from := newTx(1e6, 0).From()
+
+	tx0, tx1, tx2, tx3 := newTx(1e6, 0), newTx(1e6, 1), newTx(1e6, 2), newTx(1e6, 3)
+	require.Equal(t, types.ErrTxNonceTooLow, cache.PushNonceTx(tx0, 1))
+	//nonce 不连续的交易放入future 队列
+	require.Nil(t, cache.PushNonceTx(tx2, 0))
+	require.Nil(t, cache.PushNonceTx(tx3, 0))
+	require.Equal(t, types.ErrTxExist, cache.PushNonceTx(tx3, 0))
+	require.Equal(t, 0, cache.Size())
+	require.Equal(t, 2, cache.TxNumOfAccount(from))
+	//填补空缺之后, 连续的交易放入排队队列
+	require.Nil(t, cache.PushNonceTx(tx1, 0))
+	require.Equal(t, 0, cache.Size())
+	require.Nil(t, cache.PushNonceTx(tx0, 0))
+	require.Equal(t, 4, cache.Size())
+	require.Equal(t, 4, cache.TxNumOfAccount(from))
+	require.Equal(t, types.ErrManyTx, cache.PushNonceTx(newTx(1e6, 5), 0))
+
+	//打包时按照nonce 顺序排列
+	txs := cache.sortNonceTxs([]*types.Transaction{tx3, tx1, tx0, tx2})
+	require.Equal(t, []*types.Transaction{tx0, tx1, tx2, tx3}, txs)
+	txs = cache.sortNonceTxs([]*types.Transaction{tx3, tx0, tx1})
+	require.Equal(t, []*types.Transaction{tx0, tx1}, txs)
+
+	//替换排队队列中相同nonce 的交易
+	require.Equal(t, types.ErrReplaceTxFeeTooLow, cache.PushNonceTx(newTx(1e6+1e4, 1), 0))
+	tx1b := newTx(2e6, 1)
+	require.Nil(t, cache.PushNonceTx(tx1b, 0))
+	require.Equal(t, 4, cache.Size())
+	require.Nil(t, cache.getTxByHash(string(tx1.Hash())))
+
+	//区块执行之后删除nonce 已经使用的交易
+	cache.ResetNonce(from, 2)
+	require.Equal(t, 2, cache.Size())
+	require.Nil(t, cache.getTxByHash(string(tx1b.Hash())))
+	require.Nil(t, cache.PushNonceTx(newTx(1e6, 5), 2))
+	tx4 := newTx(1e6, 4)
+	require.Nil(t, cache.PushNonceTx(tx4, 2))
+	require.Equal(t, 4, cache.Size())
+	cache.ResetNonce(from, 6)
+	require.Equal(t, 0, cache.Size())
+	require.Equal(t, 0, cache.TxNumOfAccount(from))
+}
+
+func TestNonceEvict(t *testing.T) {
+	cfg := types.NewTuringchainConfig(types.GetDefaultCfgstring())
+	cache := newCache(10, 10, 100)
+	cache.SetQueueCache(NewSimpleQueue(SubConfig{PoolCacheSize: 100}))
+	privB, _ := c.GenKey()
+	newTx := func(priv crypto.PrivKey, nonce int64) *types.Transaction {
+		tx := createTx(cfg, priv, toAddr, amount)
+		tx.Nonce = nonce
+		tx.Sign(types.SECP256K1, priv)
+		return tx
+	}
+	tx0, tx1, tx2 := newTx(privKey, 0), newTx(privKey, 1), newTx(privKey, 2)
+	for _, tx := range []*types.Transaction{tx0, tx1, tx2} {
+		require.Nil(t, cache.PushNonceTx(tx, 0))
+	}
+	getItem := func(tx *types.Transaction) *Item {
+		item, err := cache.qcache.GetItem(string(tx.Hash()))
+		require.Nil(t, err)
+		return item
+	}
+	//只能淘汰其他账户nonce 连续交易中的最后一笔
+	txB := newTx(privB, 0)
+	require.False(t, cache.canEvict(txB, getItem(tx0)))
+	require.False(t, cache.canEvict(txB, getItem(tx1)))
+	require.True(t, cache.canEvict(txB, getItem(tx2)))
+	require.False(t, cache.canEvict(newTx(privKey, 3), getItem(tx2)))
+}
+
+//storeProcess 所有账户在状态数据库中的nonce 都为0
+func storeProcess(q queue.Queue) {
+	go func() {
+		client := q.Client()
+		client.Sub("store")
+		for msg := range client.Recv() {
+			if msg.Ty == types.EventStoreGet {
+				get := msg.GetData().(*types.StoreGet)
+				msg.Reply(client.NewMessage("", types.EventStoreGetReply, &types.StoreReplyValue{Values: make([][]byte, len(get.Keys))}))
+			}
+		}
+	}()
+}
+
+func initJournalEnv(dir string, nonce bool) (queue.Queue, *Mempool) {
+	cfg := types.NewTuringchainConfig(types.ReadFile("../../cmd/turingchain/turingchain.test.toml"))
+	mcfg := cfg.GetModuleConfig()
+	var q = queue.New("channel")
+	q.SetConfig(cfg)
+	blockchainProcess(q)
+	execProcess(q)
+	if nonce {
+		forks, _ := cfg.GetForks()
+		forks["ForkTxNonce"] = 0
+		storeProcess(q)
+	}
+	mcfg.Mempool.EnableJournal = true
+	mcfg.Mempool.JournalDbPath = dir
+	subConfig := SubConfig{mcfg.Mempool.PoolCacheSize, mcfg.Mempool.MinTxFeeRate}
+	mem := NewMempool(mcfg.Mempool)
+	mem.SetQueueCache(NewSimpleQueue(subConfig))
+	mem.SetQueueClient(q.Client())
+	mem.setSync(true)
+	mem.Wait()
+	return q, mem
+}
+
+func TestMempoolJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempool")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	q, mem := initJournalEnv(dir, false)
+	require.Nil(t, sendTx(mem.client, tx2))
+	require.Nil(t, sendTx(mem.client, tx3))
+	require.Nil(t, sendTx(mem.client, tx4))
+	require.Nil(t, mem.RemoveTxs(&types.TxHashList{Hashes: [][]byte{tx3.Hash()}}))
+	require.Equal(t, int64(4), mem.cache.journal.count)
+	mem.Close()
+	q.Close()
+
+	//重启之后从日志恢复交易, 并压缩日志
+	q, mem = initJournalEnv(dir, false)
+	require.Equal(t, 2, mem.Size())
+	require.NotNil(t, mem.cache.getTxByHash(string(tx2.Hash())))
+	require.NotNil(t, mem.cache.getTxByHash(string(tx4.Hash())))
+	require.Nil(t, mem.cache.getTxByHash(string(tx3.Hash())))
+	require.Equal(t, int64(2), mem.cache.journal.seq)
+	require.Equal(t, int64(0), mem.cache.journal.count)
+	require.Equal(t, 2, len(mem.cache.journal.load()))
+
+	mem.RemoveTxsOfBlock(&types.Block{Txs: []*types.Transaction{tx2}})
+	mem.compactJournal()
+	txs := mem.cache.journal.load()
+	require.Equal(t, 1, len(txs))
+	require.Equal(t, tx4.Hash(), txs[0].Hash())
+	mem.Close()
+	q.Close()
+}
+
+func TestMempoolJournalFuture(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempool")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	q, mem := initJournalEnv(dir, true)
+	newTx := func(nonce int64) *types.Transaction {
+		tx := createTx(q.GetConfig(), privKey, toAddr, amount)
+		tx.Nonce = nonce
+		tx.Sign(types.SECP256K1, privKey)
+		return tx
+	}
+	tx0, tx1, tx2 := newTx(0), newTx(1), newTx(2)
+	from := tx0.From()
+	require.Nil(t, sendTx(mem.client, tx0))
+	require.Nil(t, sendTx(mem.client, tx2))
+	require.Equal(t, 1, mem.Size())
+	require.Equal(t, 2, mem.cache.TxNumOfAccount(from))
+	mem.Close()
+	q.Close()
+
+	//重启之后nonce 不连续的交易恢复到future 队列, 压缩日志时不会丢失
+	q, mem = initJournalEnv(dir, true)
+	require.Equal(t, 1, mem.Size())
+	require.NotNil(t, mem.cache.AccountTxIndex.GetFuture(from, 2))
+	require.Equal(t, int64(2), mem.cache.journal.seq)
+	require.Equal(t, 2, len(mem.cache.journal.load()))
+
+	//填补空缺之后future 交易进入排队队列
+	require.Nil(t, sendTx(mem.client, tx1))
+	require.Equal(t, 3, mem.Size())
+	require.NotNil(t, mem.cache.getTxByHash(string(tx2.Hash())))
+	mem.Close()
+	q.Close()
+}
+
+func TestRejectReason(t *testing.T) {
+	require.Equal(t, types.ErrTxFeeTooLow.Error(), rejectReason(types.ErrTxFeeTooLow))
+	//执行器返回的错误通过字符串传回, 也可以识别
+	require.Equal(t, types.ErrNoBalance.Error(), rejectReason(errors.New("ErrNoBalance")))
+	require.Equal(t, "other", rejectReason(errors.New("some executor error")))
+}
@@ -0,0 +1,85 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"github.com/turingchain2020/turingchain/account"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+//isNonceMode 下一个区块是否开启了ForkTxNonce, 开启之后交易按照账户nonce 顺序执行
+func (mem *Mempool) isNonceMode() bool {
+	if mem.client == nil {
+		return false
+	}
+	cfg := mem.client.GetConfig()
+	return cfg != nil && cfg.IsFork(mem.GetHeader().GetHeight()+1, "ForkTxNonce")
+}
+
+//getStateNonces 从最新的状态中获取账户的nonce
+func (mem *Mempool) getStateNonces(addrs []string) ([]int64, error) {
+	get := &types.StoreGet{StateHash: mem.GetHeader().GetStateHash()}
+	for _, addr := range addrs {
+		get.Keys = append(get.Keys, account.CalcNonceKey(addr))
+	}
+	msg := mem.client.NewMessage("store", types.EventStoreGet, get)
+	err := mem.client.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := mem.client.Wait(msg)
+	if err != nil {
+		return nil, err
+	}
+	values := resp.GetData().(*types.StoreReplyValue).Values
+	nonces := make([]int64, len(addrs))
+	for i := range addrs {
+		nonces[i], err = account.DecodeNonce(values[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nonces, nil
+}
+
+//pushNonceTx 获取账户当前的nonce 之后存入交易
+func (mem *Mempool) pushNonceTx(tx *types.Transaction) error {
+	nonces, err := mem.getStateNonces([]string{tx.From()})
+	if err != nil {
+		return err
+	}
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	return mem.cache.PushNonceTx(tx, nonces[0])
+}
+
+//resetNonces 区块执行之后更新区块中账户的nonce, 删除已经无法执行的交易, 并放入nonce 已经连续的交易
+func (mem *Mempool) resetNonces(block *types.Block) {
+	var addrs []string
+	dup := make(map[string]bool)
+	mem.proxyMtx.Lock()
+	for _, tx := range block.Txs {
+		from := tx.From()
+		if dup[from] || mem.cache.TxNumOfAccount(from) == 0 {
+			continue
+		}
+		dup[from] = true
+		addrs = append(addrs, from)
+	}
+	mem.proxyMtx.Unlock()
+	if len(addrs) == 0 {
+		return
+	}
+	nonces, err := mem.getStateNonces(addrs)
+	if err != nil {
+		mlog.Error("resetNonces", "height", block.Height, "err", err)
+		return
+	}
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	for i, addr := range addrs {
+		mem.cache.ResetNonce(addr, nonces[i])
+	}
+}
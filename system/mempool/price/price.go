@@ -0,0 +1,180 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package price 按照手续费率排序的mempool, 手续费率=手续费/交易字节数, 费率相同时先到的交易优先
+package price
+
+import (
+	"github.com/turingchain2020/turingchain/common/skiplist"
+	"github.com/turingchain2020/turingchain/queue"
+	drivers "github.com/turingchain2020/turingchain/system/mempool"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+func init() {
+	drivers.Reg("price", New)
+}
+
+//默认每个账户优先排序的交易数
+const defaultFairTxNum = 10
+
+type subConfig struct {
+	PoolCacheSize int64 `json:"poolCacheSize"`
+	ProperFee     int64 `json:"properFee"`
+	// 每个账户按照费率优先排序的交易数, 超过的交易排在其他账户的交易之后, 默认10
+	FairTxNum int `json:"fairTxNum"`
+}
+
+//New 创建price cache 结构的 mempool
+func New(cfg *types.Mempool, sub []byte) queue.Module {
+	c := drivers.NewMempool(cfg)
+	var subcfg subConfig
+	types.MustDecode(sub, &subcfg)
+	if subcfg.PoolCacheSize == 0 {
+		subcfg.PoolCacheSize = cfg.PoolCacheSize
+	}
+	if subcfg.ProperFee == 0 {
+		subcfg.ProperFee = cfg.MinTxFeeRate
+	}
+	if subcfg.FairTxNum <= 0 {
+		subcfg.FairTxNum = defaultFairTxNum
+	}
+	c.SetQueueCache(NewQueue(subcfg))
+	return c
+}
+
+type priceScore struct {
+	*drivers.Item
+	score int64
+	size  int64
+}
+
+func newPriceScore(item *drivers.Item) *priceScore {
+	size := int64(types.Size(item.Value))
+	//每千字节的手续费
+	return &priceScore{Item: item, score: item.Value.Fee * 1000 / size, size: size}
+}
+
+func (item *priceScore) GetScore() int64 {
+	return item.score
+}
+
+func (item *priceScore) Hash() []byte {
+	return item.Value.Hash()
+}
+
+//Compare 费率相同时, 先到的交易优先
+func (item *priceScore) Compare(cmp skiplist.Scorer) int {
+	it := cmp.(*priceScore)
+	if item.EnterTime < it.EnterTime {
+		return skiplist.Big
+	}
+	if item.EnterTime == it.EnterTime {
+		return skiplist.Equal
+	}
+	return skiplist.Small
+}
+
+func (item *priceScore) ByteSize() int64 {
+	return item.size
+}
+
+//Queue 价格队列模式, 费率高的交易优先打包, mempool 满时淘汰费率最低的交易
+type Queue struct {
+	*skiplist.Queue
+	subConfig subConfig
+}
+
+//NewQueue 创建队列
+func NewQueue(subcfg subConfig) *Queue {
+	return &Queue{
+		Queue:     skiplist.NewQueue(subcfg.PoolCacheSize),
+		subConfig: subcfg,
+	}
+}
+
+//GetItem 获取数据通过 key
+func (cache *Queue) GetItem(hash string) (*drivers.Item, error) {
+	item, err := cache.Queue.GetItem(hash)
+	if err != nil {
+		return nil, err
+	}
+	return item.(*priceScore).Item, nil
+}
+
+//GetEvictItem mempool 满时, 新交易费率比最低费率的交易高才能放入, 并淘汰费率最低并且可以淘汰的交易
+func (cache *Queue) GetEvictItem(item *drivers.Item, canEvict func(*drivers.Item) bool) (*drivers.Item, error) {
+	if int64(cache.Size()) < cache.MaxSize() {
+		return nil, nil
+	}
+	it := newPriceScore(item)
+	var evict *drivers.Item
+	cache.Queue.WalkReverse(0, func(value skiplist.Scorer) bool {
+		tail := value.(*priceScore)
+		if it.score < tail.score || (it.score == tail.score && it.Compare(tail) != skiplist.Big) {
+			return false
+		}
+		if canEvict == nil || canEvict(tail.Item) {
+			evict = tail.Item
+			return false
+		}
+		return true
+	})
+	if evict == nil {
+		return nil, types.ErrMemFull
+	}
+	return evict, nil
+}
+
+//Push 把给定tx添加到Queue
+func (cache *Queue) Push(item *drivers.Item) error {
+	return cache.Queue.Push(newPriceScore(item))
+}
+
+//Walk 按照费率遍历队列, 每个账户超过FairTxNum 的交易在其他交易遍历完之后再遍历
+func (cache *Queue) Walk(count int, cb func(value *drivers.Item) bool) {
+	i := 0
+	stop := false
+	accounts := make(map[string]int)
+	var delayed []*drivers.Item
+	cache.Queue.Walk(0, func(value skiplist.Scorer) bool {
+		item := value.(*priceScore).Item
+		from := item.Value.From()
+		if accounts[from] >= cache.subConfig.FairTxNum {
+			delayed = append(delayed, item)
+			return true
+		}
+		accounts[from]++
+		if !cb(item) {
+			stop = true
+			return false
+		}
+		i++
+		stop = i == count
+		return !stop
+	})
+	for _, item := range delayed {
+		if stop || !cb(item) {
+			return
+		}
+		i++
+		stop = i == count
+	}
+}
+
+//GetProperFee 获取合适的手续费率, 取优先级最高的100 笔交易的平均费率
+func (cache *Queue) GetProperFee() int64 {
+	if cache.Size() == 0 {
+		return cache.subConfig.ProperFee
+	}
+	var sumFeeRate int64
+	i := 0
+	cache.Queue.Walk(100, func(value skiplist.Scorer) bool {
+		item := value.(*priceScore)
+		sumFeeRate += item.Value.Fee / (item.size/1000 + 1)
+		i++
+		return true
+	})
+	return sumFeeRate / int64(i)
+}
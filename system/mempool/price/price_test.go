@@ -0,0 +1,99 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package price
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common/crypto"
+	_ "github.com/turingchain2020/turingchain/system/crypto/init"
+	drivers "github.com/turingchain2020/turingchain/system/mempool"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTx(priv crypto.PrivKey, fee int64) *types.Transaction {
+	tx := &types.Transaction{Execer: []byte("none"), Payload: []byte("price"), Fee: fee, Nonce: rand.Int63()}
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
+func TestQueueWalk(t *testing.T) {
+	_, privA := util.Genaddress()
+	_, privB := util.Genaddress()
+	a1, a2, a3 := newTx(privA, 4e6), newTx(privA, 3e6), newTx(privA, 2e6)
+	b1 := newTx(privB, 1e6)
+	cache := NewQueue(subConfig{PoolCacheSize: 4, ProperFee: 1e5, FairTxNum: 2})
+	assert.Equal(t, int64(1e5), cache.GetProperFee())
+	for _, tx := range []*types.Transaction{b1, a3, a2, a1} {
+		require.Nil(t, cache.Push(&drivers.Item{Value: tx, Priority: tx.Fee, EnterTime: types.Now().Unix()}))
+	}
+	item, err := cache.GetItem(string(a1.Hash()))
+	require.Nil(t, err)
+	assert.Equal(t, a1, item.Value)
+
+	//每个账户优先排序两笔交易
+	var txs []*types.Transaction
+	cache.Walk(0, func(item *drivers.Item) bool {
+		txs = append(txs, item.Value)
+		return true
+	})
+	assert.Equal(t, []*types.Transaction{a1, a2, b1, a3}, txs)
+	txs = nil
+	cache.Walk(2, func(item *drivers.Item) bool {
+		txs = append(txs, item.Value)
+		return true
+	})
+	assert.Equal(t, []*types.Transaction{a1, a2}, txs)
+	txs = nil
+	cache.Walk(4, func(item *drivers.Item) bool {
+		txs = append(txs, item.Value)
+		return len(txs) < 3
+	})
+	assert.Equal(t, []*types.Transaction{a1, a2, b1}, txs)
+	assert.True(t, cache.GetProperFee() > 1e6)
+
+	//队列已满, 淘汰费率最低的交易
+	evict, err := cache.GetEvictItem(&drivers.Item{Value: newTx(privB, 2e6)}, nil)
+	require.Nil(t, err)
+	assert.Equal(t, b1, evict.Value)
+	_, err = cache.GetEvictItem(&drivers.Item{Value: newTx(privB, 1e5)}, nil)
+	assert.Equal(t, types.ErrMemFull, err)
+
+	//最低费率的交易不能淘汰时, 淘汰下一笔费率比新交易低的交易
+	skipB1 := func(item *drivers.Item) bool { return item.Value != b1 }
+	evict, err = cache.GetEvictItem(&drivers.Item{Value: newTx(privB, 25e5)}, skipB1)
+	require.Nil(t, err)
+	assert.Equal(t, a3, evict.Value)
+	_, err = cache.GetEvictItem(&drivers.Item{Value: newTx(privB, 15e5)}, skipB1)
+	assert.Equal(t, types.ErrMemFull, err)
+}
+
+func TestNewMempool(t *testing.T) {
+	sub, _ := json.Marshal(&subConfig{PoolCacheSize: 2})
+	module := New(&types.Mempool{}, sub)
+	mem := module.(*drivers.Mempool)
+	defer mem.Close()
+
+	addrA, privA := util.Genaddress()
+	addrB, privB := util.Genaddress()
+	a1, b1 := newTx(privA, 1e6), newTx(privB, 2e6)
+	require.Nil(t, mem.PushTx(a1))
+	require.Nil(t, mem.PushTx(b1))
+	assert.Equal(t, types.ErrMemFull, mem.PushTx(newTx(privB, 1e5)))
+	//淘汰的交易同时从账户索引中删除
+	b2 := newTx(privB, 3e6)
+	require.Nil(t, mem.PushTx(b2))
+	assert.Equal(t, 2, mem.Size())
+	assert.Equal(t, int64(0), mem.TxNumOfAccount(addrA))
+	assert.Equal(t, int64(2), mem.TxNumOfAccount(addrB))
+	for _, tx := range mem.GetLatestTx() {
+		assert.NotEqual(t, a1.Hash(), tx.Hash())
+	}
+}
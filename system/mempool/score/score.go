@@ -0,0 +1,172 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package score 按照分数排序的mempool, 分数由手续费率, 进入mempool 的时间和交易大小综合计算
+package score
+
+import (
+	"github.com/turingchain2020/turingchain/common/skiplist"
+	"github.com/turingchain2020/turingchain/queue"
+	drivers "github.com/turingchain2020/turingchain/system/mempool"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+func init() {
+	drivers.Reg("score", New)
+}
+
+type subConfig struct {
+	PoolCacheSize int64 `json:"poolCacheSize"`
+	ProperFee     int64 `json:"properFee"`
+	// 时间占价格比例
+	TimeParam int64 `json:"timeParam"`
+	// 手续费相对于时间的一个合适的常量
+	PriceConstant int64 `json:"priceConstant"`
+	// 常量比例
+	PricePower int64 `json:"pricePower"`
+	// 交易大小占比, 每千字节扣减的分数
+	SizeParam int64 `json:"sizeParam"`
+}
+
+//New 创建score cache 结构的 mempool
+func New(cfg *types.Mempool, sub []byte) queue.Module {
+	c := drivers.NewMempool(cfg)
+	var subcfg subConfig
+	types.MustDecode(sub, &subcfg)
+	if subcfg.PoolCacheSize == 0 {
+		subcfg.PoolCacheSize = cfg.PoolCacheSize
+	}
+	if subcfg.ProperFee == 0 {
+		subcfg.ProperFee = cfg.MinTxFeeRate
+	}
+	if subcfg.TimeParam == 0 {
+		subcfg.TimeParam = 1
+	}
+	if subcfg.PriceConstant == 0 {
+		subcfg.PriceConstant = 1544
+	}
+	if subcfg.PricePower == 0 {
+		subcfg.PricePower = 1
+	}
+	c.SetQueueCache(NewQueue(subcfg))
+	return c
+}
+
+type scoreItem struct {
+	*drivers.Item
+	score int64
+	size  int64
+}
+
+func (item *scoreItem) GetScore() int64 {
+	return item.score
+}
+
+func (item *scoreItem) Hash() []byte {
+	return item.Value.Hash()
+}
+
+//Compare 分数相同时, 交易小的优先, 再比较进入mempool 的时间
+func (item *scoreItem) Compare(cmp skiplist.Scorer) int {
+	it := cmp.(*scoreItem)
+	switch {
+	case item.size < it.size:
+		return skiplist.Big
+	case item.size > it.size:
+		return skiplist.Small
+	case item.EnterTime < it.EnterTime:
+		return skiplist.Big
+	case item.EnterTime > it.EnterTime:
+		return skiplist.Small
+	}
+	return skiplist.Equal
+}
+
+func (item *scoreItem) ByteSize() int64 {
+	return item.size
+}
+
+//Queue 分数队列模式, 分数高的交易优先打包, mempool 满时淘汰分数最低的交易
+type Queue struct {
+	*skiplist.Queue
+	subConfig subConfig
+}
+
+//NewQueue 创建队列
+func NewQueue(subcfg subConfig) *Queue {
+	return &Queue{
+		Queue:     skiplist.NewQueue(subcfg.PoolCacheSize),
+		subConfig: subcfg,
+	}
+}
+
+//newScoreItem 分数=常量*手续费率*常量比例 - 时间比例*进入时间 - 大小比例*千字节数, 等待越久分数越高
+func (cache *Queue) newScoreItem(item *drivers.Item) *scoreItem {
+	size := int64(types.Size(item.Value))
+	//每字节的手续费, 先乘以常量再除以大小, 避免整数除法丢失精度
+	score := cache.subConfig.PriceConstant*item.Value.Fee*cache.subConfig.PricePower/size -
+		cache.subConfig.TimeParam*item.EnterTime - cache.subConfig.SizeParam*size/1000
+	return &scoreItem{Item: item, score: score, size: size}
+}
+
+//GetItem 获取数据通过 key
+func (cache *Queue) GetItem(hash string) (*drivers.Item, error) {
+	item, err := cache.Queue.GetItem(hash)
+	if err != nil {
+		return nil, err
+	}
+	return item.(*scoreItem).Item, nil
+}
+
+//GetEvictItem mempool 满时, 新交易分数比最低分数的交易高才能放入, 并淘汰分数最低并且可以淘汰的交易
+func (cache *Queue) GetEvictItem(item *drivers.Item, canEvict func(*drivers.Item) bool) (*drivers.Item, error) {
+	if int64(cache.Size()) < cache.MaxSize() {
+		return nil, nil
+	}
+	it := cache.newScoreItem(item)
+	var evict *drivers.Item
+	cache.Queue.WalkReverse(0, func(value skiplist.Scorer) bool {
+		tail := value.(*scoreItem)
+		if it.score < tail.score || (it.score == tail.score && it.Compare(tail) != skiplist.Big) {
+			return false
+		}
+		if canEvict == nil || canEvict(tail.Item) {
+			evict = tail.Item
+			return false
+		}
+		return true
+	})
+	if evict == nil {
+		return nil, types.ErrMemFull
+	}
+	return evict, nil
+}
+
+//Push 把给定tx添加到Queue
+func (cache *Queue) Push(item *drivers.Item) error {
+	return cache.Queue.Push(cache.newScoreItem(item))
+}
+
+//Walk 按照分数遍历队列
+func (cache *Queue) Walk(count int, cb func(value *drivers.Item) bool) {
+	cache.Queue.Walk(count, func(value skiplist.Scorer) bool {
+		return cb(value.(*scoreItem).Item)
+	})
+}
+
+//GetProperFee 获取合适的手续费率, 取优先级最高的100 笔交易的平均费率
+func (cache *Queue) GetProperFee() int64 {
+	if cache.Size() == 0 {
+		return cache.subConfig.ProperFee
+	}
+	var sumFeeRate int64
+	i := 0
+	cache.Queue.Walk(100, func(value skiplist.Scorer) bool {
+		item := value.(*scoreItem)
+		sumFeeRate += item.Value.Fee / (item.size/1000 + 1)
+		i++
+		return true
+	})
+	return sumFeeRate / int64(i)
+}
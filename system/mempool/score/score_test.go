@@ -0,0 +1,58 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package score
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common/crypto"
+	_ "github.com/turingchain2020/turingchain/system/crypto/init"
+	drivers "github.com/turingchain2020/turingchain/system/mempool"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newItem(priv crypto.PrivKey, fee int64, size int, enterTime int64) *drivers.Item {
+	tx := &types.Transaction{Execer: []byte("none"), Payload: make([]byte, size), Fee: fee, Nonce: rand.Int63()}
+	tx.Sign(types.SECP256K1, priv)
+	return &drivers.Item{Value: tx, Priority: tx.Fee, EnterTime: enterTime}
+}
+
+func TestQueueScore(t *testing.T) {
+	_, priv := util.Genaddress()
+	cache := NewQueue(subConfig{PoolCacheSize: 3, ProperFee: 1e5, TimeParam: 1, PriceConstant: 1, PricePower: 1, SizeParam: 1000})
+	now := types.Now().Unix()
+	//手续费率高的优先, 等待时间长的优先, 交易大的扣减分数
+	high := newItem(priv, 1e6, 100, now)
+	old := newItem(priv, 1e5, 100, now-1000)
+	big := newItem(priv, 2e5, 1000, now)
+	for _, item := range []*drivers.Item{big, old, high} {
+		require.Nil(t, cache.Push(item))
+	}
+	var items []*drivers.Item
+	cache.Walk(0, func(item *drivers.Item) bool {
+		items = append(items, item)
+		return true
+	})
+	assert.Equal(t, []*drivers.Item{high, old, big}, items)
+	assert.True(t, cache.GetProperFee() > 1e5)
+
+	evict, err := cache.GetEvictItem(newItem(priv, 1e6, 200, now), nil)
+	require.Nil(t, err)
+	assert.Equal(t, big, evict)
+	_, err = cache.GetEvictItem(newItem(priv, 1e4, 1000, now), nil)
+	assert.Equal(t, types.ErrMemFull, err)
+}
+
+func TestNewMempool(t *testing.T) {
+	sub, _ := json.Marshal(&subConfig{PoolCacheSize: 2})
+	module := New(&types.Mempool{}, sub)
+	mem := module.(*drivers.Mempool)
+	mem.Close()
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/turingchain2020/turingchain/common/log/log15"
+	chmetrics "github.com/turingchain2020/turingchain/metrics"
 	p2pty "github.com/turingchain2020/turingchain/system/p2p/dht/types"
 	"github.com/turingchain2020/turingchain/types"
 	core "github.com/libp2p/go-libp2p-core"
@@ -108,12 +109,25 @@ func (s *ConnManager) MonitorAllPeers() {
 			return
 		case <-ticker1.C:
 			s.printMonitorInfo()
+			s.updateMetrics()
 		case <-ticker2.C:
 			s.procConnections()
 		}
 	}
 }
 
+//updateMetrics 更新连接数和带宽统计
+func (s *ConnManager) updateMetrics() {
+	insize, outsize := s.BoundSize()
+	chmetrics.Gauge("p2p_peers", "bound", "in").Update(int64(insize))
+	chmetrics.Gauge("p2p_peers", "bound", "out").Update(int64(outsize))
+	stat := s.bandwidthTracker.GetBandwidthTotals()
+	chmetrics.Gauge("p2p_bytes_in").Update(stat.TotalIn)
+	chmetrics.Gauge("p2p_bytes_out").Update(stat.TotalOut)
+	chmetrics.GaugeFloat64("p2p_rate_in").Update(stat.RateIn)
+	chmetrics.GaugeFloat64("p2p_rate_out").Update(stat.RateOut)
+}
+
 func (s *ConnManager) printMonitorInfo() {
 	var LatencyInfo = fmt.Sprintln("--------------时延--------------------")
 	peers := s.FetchConnPeers()
@@ -77,6 +77,7 @@ func (p *broadcastProtocol) recvLtBlock(ltBlock *types.LightBlock, pid, peerAddr
 	block := &types.Block{}
 	block.TxHash = ltBlock.Header.TxHash
 	block.Signature = ltBlock.Header.Signature
+	block.ConsensusProof = ltBlock.Header.ConsensusProof
 	block.ParentHash = ltBlock.Header.ParentHash
 	block.Height = ltBlock.Header.Height
 	block.BlockTime = ltBlock.Header.BlockTime
@@ -0,0 +1,74 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package light
+
+import (
+	"github.com/turingchain2020/turingchain/system/p2p/dht/protocol"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/libp2p/go-libp2p-core/network"
+)
+
+func (p *Protocol) handleStreamHeaders(stream network.Stream) {
+	var req types.ReqBlocks
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		return
+	}
+	if req.Start < 0 || req.End < req.Start {
+		log.Error("handleStreamHeaders", "error", "wrong parameter")
+		return
+	}
+	if req.End-req.Start >= maxHeadersPerRequest {
+		req.End = req.Start + maxHeadersPerRequest - 1
+	}
+	headers, err := p.API.GetHeaders(&req)
+	if err != nil {
+		log.Error("handleStreamHeaders", "start", req.Start, "end", req.End, "err", err)
+		return
+	}
+	err = protocol.WriteStream(headers, stream)
+	if err != nil {
+		log.Error("WriteStream", "error", err, "remote pid", stream.Conn().RemotePeer().String())
+	}
+}
+
+func (p *Protocol) handleStreamTxProof(stream network.Stream) {
+	var req types.ReqHash
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		return
+	}
+	detail, err := p.API.QueryTx(&req)
+	if err != nil {
+		log.Debug("handleStreamTxProof", "err", err)
+		return
+	}
+	err = protocol.WriteStream(detail, stream)
+	if err != nil {
+		log.Error("WriteStream", "error", err, "remote pid", stream.Conn().RemotePeer().String())
+	}
+}
+
+func (p *Protocol) handleStreamStateProof(stream network.Stream) {
+	var req types.ReqStateProof
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		return
+	}
+	if len(req.StateHash) == 0 {
+		log.Error("handleStreamStateProof", "error", "wrong parameter")
+		return
+	}
+	//只按照状态hash 查询, 已经被裁剪的状态会返回错误
+	proof, err := p.API.StoreGetStateProof(&types.ReqStateProof{StateHash: req.StateHash, Keys: req.Keys})
+	if err != nil {
+		log.Error("handleStreamStateProof", "err", err)
+		return
+	}
+	err = protocol.WriteStream(proof, stream)
+	if err != nil {
+		log.Error("WriteStream", "error", err, "remote pid", stream.Conn().RemotePeer().String())
+	}
+}
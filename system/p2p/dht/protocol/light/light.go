@@ -0,0 +1,127 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package light 为轻节点提供区块头, 交易证明和状态证明的下载服务
+package light
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/queue"
+	"github.com/turingchain2020/turingchain/system/p2p/dht/protocol"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	core "github.com/libp2p/go-libp2p-core/protocol"
+)
+
+var (
+	log = log15.New("module", "p2p.light")
+)
+
+func init() {
+	protocol.RegisterProtocolInitializer(InitProtocol)
+}
+
+const (
+	lightHeaders    = "/turingchain/light-headers/1.0.0"
+	lightTxProof    = "/turingchain/light-tx-proof/1.0.0"
+	lightStateProof = "/turingchain/light-state-proof/1.0.0"
+)
+
+//一次最多返回的区块头个数
+const maxHeadersPerRequest = 256
+
+// Protocol ...
+type Protocol struct {
+	*protocol.P2PEnv
+}
+
+// InitProtocol initials protocol
+func InitProtocol(env *protocol.P2PEnv) {
+	p := &Protocol{
+		P2PEnv: env,
+	}
+	//注册p2p通信协议，用于处理节点之间请求
+	//轻节点只保存区块头, 不能为其他节点提供交易和状态证明
+	protocol.RegisterStreamHandler(p.Host, lightHeaders, p.handleStreamHeaders)
+	if !env.ChainCfg.GetModuleConfig().BlockChain.LightMode {
+		protocol.RegisterStreamHandler(p.Host, lightTxProof, p.handleStreamTxProof)
+		protocol.RegisterStreamHandler(p.Host, lightStateProof, p.handleStreamStateProof)
+	}
+	//注册事件处理函数
+	protocol.RegisterEventHandler(types.EventFetchLightHeaders, p.handleEventFetch)
+	protocol.RegisterEventHandler(types.EventFetchTxProof, p.handleEventFetch)
+	protocol.RegisterEventHandler(types.EventFetchStateProof, p.handleEventFetch)
+}
+
+//lightPeers 获取高度不低于height 的节点, 按照随机顺序返回
+func (p *Protocol) lightPeers(height int64) []peer.ID {
+	var pids []peer.ID
+	for _, pid := range p.RoutingTable.ListPeers() {
+		if p.PeerInfoManager.PeerHeight(pid) >= height {
+			pids = append(pids, pid)
+		}
+	}
+	rand.Shuffle(len(pids), func(i, j int) { pids[i], pids[j] = pids[j], pids[i] })
+	return pids
+}
+
+//fetchFromPeer 向节点发送请求并读取返回的数据
+func (p *Protocol) fetchFromPeer(protocolID core.ID, req types.Message, pid peer.ID, resp types.Message) error {
+	ctx, cancel := context.WithTimeout(p.Ctx, time.Minute)
+	defer cancel()
+	stream, err := p.Host.NewStream(ctx, pid, protocolID)
+	if err != nil {
+		return err
+	}
+	defer protocol.CloseStream(stream)
+	err = protocol.WriteStream(req, stream)
+	if err != nil {
+		return err
+	}
+	return protocol.ReadStream(resp, stream)
+}
+
+//fetch 依次向其他节点请求, 直到有节点返回, 返回的数据由blockchain 和store 模块验证
+func (p *Protocol) fetch(ty int64, req types.Message) (types.Message, error) {
+	var protocolID core.ID
+	var resp types.Message
+	var height int64
+	switch ty {
+	case types.EventFetchLightHeaders:
+		protocolID, resp = lightHeaders, &types.Headers{}
+		height = req.(*types.ReqBlocks).End
+	case types.EventFetchTxProof:
+		protocolID, resp = lightTxProof, &types.TransactionDetail{}
+	case types.EventFetchStateProof:
+		protocolID, resp = lightStateProof, &types.StateProof{}
+		height = req.(*types.ReqStateProof).Height
+	default:
+		return nil, types.ErrActionNotSupport
+	}
+	for _, pid := range p.lightPeers(height) {
+		err := p.fetchFromPeer(protocolID, req, pid, resp)
+		if err != nil {
+			log.Debug("fetch", "protocol", protocolID, "pid", pid, "err", err)
+			continue
+		}
+		return resp, nil
+	}
+	return nil, errors.New("no peer for light request")
+}
+
+//handleEventFetch 处理轻节点blockchain 和store 模块的请求
+func (p *Protocol) handleEventFetch(msg *queue.Message) {
+	resp, err := p.fetch(msg.Ty, msg.GetData().(types.Message))
+	if err != nil {
+		log.Error("handleEventFetch", "event", types.GetEventName(int(msg.Ty)), "err", err)
+		msg.Reply(p.QueueClient.NewMessage("", msg.Ty, err))
+		return
+	}
+	msg.Reply(p.QueueClient.NewMessage("", msg.Ty, resp))
+}
@@ -270,17 +270,18 @@ func (p *Protocol) handleEventGetChunkBlock(m *queue.Message) {
 		body := bodys.Items[index]
 		header := headers.Items[index]
 		block := &types.Block{
-			Version:    header.Version,
-			ParentHash: header.ParentHash,
-			TxHash:     header.TxHash,
-			StateHash:  header.StateHash,
-			Height:     header.Height,
-			BlockTime:  header.BlockTime,
-			Difficulty: header.Difficulty,
-			MainHash:   body.MainHash,
-			MainHeight: body.MainHeight,
-			Signature:  header.Signature,
-			Txs:        body.Txs,
+			Version:        header.Version,
+			ParentHash:     header.ParentHash,
+			TxHash:         header.TxHash,
+			StateHash:      header.StateHash,
+			Height:         header.Height,
+			BlockTime:      header.BlockTime,
+			Difficulty:     header.Difficulty,
+			MainHash:       body.MainHash,
+			MainHeight:     body.MainHeight,
+			Signature:      header.Signature,
+			Txs:            body.Txs,
+			ConsensusProof: header.ConsensusProof,
 		}
 		blockList = append(blockList, block)
 	}
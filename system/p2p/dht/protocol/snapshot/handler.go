@@ -0,0 +1,106 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package snapshot
+
+import (
+	"bytes"
+
+	"github.com/turingchain2020/turingchain/queue"
+	"github.com/turingchain2020/turingchain/system/p2p/dht/protocol"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/libp2p/go-libp2p-core/network"
+)
+
+//getSnapshotBlock 获取快照高度的区块, 并检查区块hash 与请求中的一致
+func (p *Protocol) getSnapshotBlock(req *types.ReqStateSnapshot) (*types.BlockDetail, error) {
+	details, err := p.API.GetBlocks(&types.ReqBlocks{Start: req.Height, End: req.Height, IsDetail: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(details.Items) == 0 || details.Items[0] == nil {
+		return nil, types.ErrBlockNotFound
+	}
+	detail := details.Items[0]
+	if !bytes.Equal(detail.Block.Hash(p.ChainCfg), req.BlockHash) {
+		return nil, types.ErrBlockHashNoMatch
+	}
+	return detail, nil
+}
+
+//getSnapshotChunk 从store 模块获取快照分片
+func (p *Protocol) getSnapshotChunk(stateHash []byte, depth, index int32) (*types.StateSnapshotChunk, error) {
+	resp, err := p.QueryModule("store", types.EventStoreGetSnapshotChunk, &types.ReqSnapshotChunk{StateHash: stateHash, Depth: depth, Index: index})
+	if err != nil {
+		return nil, err
+	}
+	if err, ok := resp.(error); ok {
+		return nil, err
+	}
+	return resp.(*types.StateSnapshotChunk), nil
+}
+
+func (p *Protocol) handleStreamManifest(stream network.Stream) {
+	var req types.ReqStateSnapshot
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		return
+	}
+	detail, err := p.getSnapshotBlock(&req)
+	if err != nil {
+		log.Error("handleStreamManifest", "height", req.Height, "err", err)
+		return
+	}
+	chunk, err := p.getSnapshotChunk(detail.Block.StateHash, req.Depth, -1)
+	if err != nil {
+		log.Error("handleStreamManifest", "height", req.Height, "err", err)
+		return
+	}
+	err = protocol.WriteStream(&types.StateSnapshotManifest{Block: detail, Depth: chunk.Depth, Total: chunk.Total}, stream)
+	if err != nil {
+		log.Error("WriteStream", "error", err, "remote pid", stream.Conn().RemotePeer().String())
+	}
+}
+
+func (p *Protocol) handleStreamChunk(stream network.Stream) {
+	var req types.ReqStateSnapshot
+	err := protocol.ReadStream(&req, stream)
+	if err != nil {
+		return
+	}
+	if req.Index < 0 || req.Depth < 0 {
+		log.Error("handleStreamChunk", "error", "wrong parameter")
+		return
+	}
+	headers, err := p.API.GetHeaders(&types.ReqBlocks{Start: req.Height, End: req.Height})
+	if err != nil || len(headers.Items) == 0 {
+		log.Error("handleStreamChunk", "height", req.Height, "err", err)
+		return
+	}
+	header := headers.Items[0]
+	if !bytes.Equal(header.Hash, req.BlockHash) {
+		log.Error("handleStreamChunk", "height", req.Height, "err", types.ErrBlockHashNoMatch)
+		return
+	}
+	chunk, err := p.getSnapshotChunk(header.StateHash, req.Depth, req.Index)
+	if err != nil {
+		log.Error("handleStreamChunk", "height", req.Height, "index", req.Index, "err", err)
+		return
+	}
+	err = protocol.WriteStream(chunk, stream)
+	if err != nil {
+		log.Error("WriteStream", "error", err, "remote pid", stream.Conn().RemotePeer().String())
+	}
+}
+
+//handleEventFetchStateSnapshot 处理blockchain 模块的快照请求, index 小于0 时返回快照信息, 否则返回快照分片
+func (p *Protocol) handleEventFetchStateSnapshot(msg *queue.Message) {
+	req := msg.GetData().(*types.ReqStateSnapshot)
+	resp, err := p.fetchStateSnapshot(req)
+	if err != nil {
+		msg.Reply(p.QueueClient.NewMessage("", types.EventFetchStateSnapshot, err))
+		return
+	}
+	msg.Reply(p.QueueClient.NewMessage("", types.EventFetchStateSnapshot, resp))
+}
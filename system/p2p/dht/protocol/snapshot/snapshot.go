@@ -0,0 +1,96 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package snapshot 提供mavl 状态快照的下载服务, 新节点可以直接下载快照高度的状态, 不需要从创世区块开始执行
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/system/p2p/dht/protocol"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	core "github.com/libp2p/go-libp2p-core/protocol"
+)
+
+var (
+	log = log15.New("module", "p2p.snapshot")
+)
+
+func init() {
+	protocol.RegisterProtocolInitializer(InitProtocol)
+}
+
+const (
+	stateSnapshotManifest = "/turingchain/state-snapshot-manifest/1.0.0"
+	stateSnapshotChunk    = "/turingchain/state-snapshot-chunk/1.0.0"
+)
+
+// Protocol ...
+type Protocol struct {
+	*protocol.P2PEnv
+}
+
+// InitProtocol initials protocol
+func InitProtocol(env *protocol.P2PEnv) {
+	p := &Protocol{
+		P2PEnv: env,
+	}
+	//注册p2p通信协议，用于处理节点之间请求
+	protocol.RegisterStreamHandler(p.Host, stateSnapshotManifest, p.handleStreamManifest)
+	protocol.RegisterStreamHandler(p.Host, stateSnapshotChunk, p.handleStreamChunk)
+	//注册事件处理函数
+	protocol.RegisterEventHandler(types.EventFetchStateSnapshot, p.handleEventFetchStateSnapshot)
+}
+
+//snapshotPeers 获取高度不低于快照高度的节点, 按照随机顺序返回
+func (p *Protocol) snapshotPeers(height int64) []peer.ID {
+	var pids []peer.ID
+	for _, pid := range p.RoutingTable.ListPeers() {
+		if p.PeerInfoManager.PeerHeight(pid) >= height {
+			pids = append(pids, pid)
+		}
+	}
+	rand.Shuffle(len(pids), func(i, j int) { pids[i], pids[j] = pids[j], pids[i] })
+	return pids
+}
+
+//fetchFromPeer 向节点请求快照信息或者快照分片
+func (p *Protocol) fetchFromPeer(protocolID core.ID, req *types.ReqStateSnapshot, pid peer.ID, resp types.Message) error {
+	ctx, cancel := context.WithTimeout(p.Ctx, time.Minute)
+	defer cancel()
+	stream, err := p.Host.NewStream(ctx, pid, protocolID)
+	if err != nil {
+		return err
+	}
+	defer protocol.CloseStream(stream)
+	err = protocol.WriteStream(req, stream)
+	if err != nil {
+		return err
+	}
+	return protocol.ReadStream(resp, stream)
+}
+
+//fetchStateSnapshot 依次向其他节点请求, 直到有节点返回
+func (p *Protocol) fetchStateSnapshot(req *types.ReqStateSnapshot) (types.Message, error) {
+	for _, pid := range p.snapshotPeers(req.Height) {
+		var protocolID core.ID = stateSnapshotChunk
+		var resp types.Message = &types.StateSnapshotChunk{}
+		if req.Index < 0 {
+			protocolID, resp = stateSnapshotManifest, &types.StateSnapshotManifest{}
+		}
+		err := p.fetchFromPeer(protocolID, req, pid, resp)
+		if err != nil {
+			log.Error("fetchStateSnapshot", "height", req.Height, "index", req.Index, "pid", pid, "err", err)
+			continue
+		}
+		log.Debug("fetchStateSnapshot", "height", req.Height, "index", req.Index, "pid", pid)
+		return resp, nil
+	}
+	return nil, errors.New("no peer for state snapshot")
+}
@@ -6,10 +6,12 @@ package store
 
 import (
 	"sync"
+	"time"
 
 	dbm "github.com/turingchain2020/turingchain/common/db"
 	clog "github.com/turingchain2020/turingchain/common/log"
 	log "github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/metrics"
 	"github.com/turingchain2020/turingchain/queue"
 	"github.com/turingchain2020/turingchain/types"
 	"github.com/turingchain2020/turingchain/util"
@@ -57,6 +59,11 @@ type SubStore interface {
 	CommitUpgrade(hash *types.ReqHash) ([]byte, error)
 }
 
+// StoreGetter 查询状态可能失败的store 实现此接口, 失败时返回错误而不是空值, 例如轻节点的store
+type StoreGetter interface {
+	GetValues(datas *types.StoreGet) ([][]byte, error)
+}
+
 // BaseStore 基础的store结构体
 type BaseStore struct {
 	db      dbm.DB
@@ -113,6 +120,15 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 		go func() {
 			defer store.wg.Done()
 			datas := msg.GetData().(*types.StoreGet)
+			if getter, ok := store.child.(StoreGetter); ok {
+				values, err := getter.GetValues(datas)
+				if err != nil {
+					msg.Reply(client.NewMessage("", types.EventStoreGetReply, err))
+					return
+				}
+				msg.Reply(client.NewMessage("", types.EventStoreGetReply, &types.StoreReplyValue{Values: values}))
+				return
+			}
 			values := store.child.Get(datas)
 			msg.Reply(client.NewMessage("", types.EventStoreGetReply, &types.StoreReplyValue{Values: values}))
 		}()
@@ -141,6 +157,7 @@ func (store *BaseStore) processMessage(msg *queue.Message) {
 			req := msg.GetData().(*types.ReqHash)
 			var hash []byte
 			var err error
+			defer metrics.Timer("store_commit").UpdateSince(time.Now())
 			if req.Upgrade {
 				hash, err = store.child.CommitUpgrade(req)
 			} else {
@@ -7,5 +7,6 @@ package init
 
 import (
 	// Register some standard stuff
+	_ "github.com/turingchain2020/turingchain/system/store/light"
 	_ "github.com/turingchain2020/turingchain/system/store/mavl"
 )
@@ -0,0 +1,185 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package light 轻节点的store, 本地不保存状态, 查询时从全节点获取状态证明并使用状态hash 验证
+package light
+
+import (
+	"time"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/common/mavlproof"
+	"github.com/turingchain2020/turingchain/queue"
+	drivers "github.com/turingchain2020/turingchain/system/store"
+	"github.com/turingchain2020/turingchain/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+var llog = log15.New("module", "store.light")
+
+//默认缓存已经验证过的状态个数
+const defaultCacheSize = 10240
+
+func init() {
+	drivers.Reg("light", New)
+}
+
+type subConfig struct {
+	// 缓存已经验证过的状态个数
+	CacheSize int `json:"cacheSize"`
+	// 从其他节点获取状态证明的超时时间, 单位秒
+	FetchTimeout int64 `json:"fetchTimeout"`
+}
+
+// Store light store struct
+type Store struct {
+	*drivers.BaseStore
+	cache   *lru.Cache
+	timeout time.Duration
+}
+
+// New new light store module
+func New(cfg *types.Store, sub []byte, turingchaincfg *types.TuringchainConfig) queue.Module {
+	bs := drivers.NewBaseStore(cfg)
+	var subcfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	if subcfg.CacheSize <= 0 {
+		subcfg.CacheSize = defaultCacheSize
+	}
+	if subcfg.FetchTimeout <= 0 {
+		subcfg.FetchTimeout = 60
+	}
+	cache, err := lru.New(subcfg.CacheSize)
+	if err != nil {
+		panic(err)
+	}
+	store := &Store{BaseStore: bs, cache: cache, timeout: time.Duration(subcfg.FetchTimeout) * time.Second}
+	bs.SetChild(store)
+	return store
+}
+
+// Close close light store
+func (store *Store) Close() {
+	store.BaseStore.Close()
+	llog.Info("store light closed")
+}
+
+//fetchStateProof 通过p2p 模块从全节点获取状态证明
+func (store *Store) fetchStateProof(stateHash []byte, keys [][]byte) (*types.StateProof, error) {
+	client := store.GetQueueClient()
+	msg := client.NewMessage("p2p", types.EventFetchStateProof, &types.ReqStateProof{StateHash: stateHash, Keys: keys})
+	err := client.Send(msg, true)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.WaitTimeout(msg, store.timeout)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.Err(); err != nil {
+		return nil, err
+	}
+	return resp.GetData().(*types.StateProof), nil
+}
+
+func cacheKey(stateHash, key []byte) string {
+	return string(stateHash) + string(key)
+}
+
+// GetValues 先从缓存中获取, 其他的key 从全节点获取状态证明, 验证失败时返回错误
+func (store *Store) GetValues(datas *types.StoreGet) ([][]byte, error) {
+	values := make([][]byte, len(datas.Keys))
+	var keys [][]byte
+	var index []int
+	for i, key := range datas.Keys {
+		if value, ok := store.cache.Get(cacheKey(datas.StateHash, key)); ok {
+			values[i] = value.([]byte)
+			continue
+		}
+		keys = append(keys, key)
+		index = append(index, i)
+	}
+	if len(keys) == 0 {
+		return values, nil
+	}
+	proof, err := store.fetchStateProof(datas.StateHash, keys)
+	if err != nil {
+		llog.Error("GetValues", "stateHash", common.ToHex(datas.StateHash), "err", err)
+		return nil, err
+	}
+	kvs, err := mavlproof.VerifyProof(datas.StateHash, keys, proof.Proof)
+	if err != nil {
+		llog.Error("GetValues", "stateHash", common.ToHex(datas.StateHash), "err", err)
+		return nil, err
+	}
+	for i, kv := range kvs {
+		var value []byte
+		if kv.Exists {
+			value = kv.Value
+		}
+		values[index[i]] = value
+		store.cache.Add(cacheKey(datas.StateHash, kv.Key), value)
+	}
+	return values, nil
+}
+
+// Get get values by keys, 获取失败时返回空值
+func (store *Store) Get(datas *types.StoreGet) [][]byte {
+	values, err := store.GetValues(datas)
+	if err != nil {
+		return make([][]byte, len(datas.Keys))
+	}
+	return values
+}
+
+// Set 轻节点不保存状态
+func (store *Store) Set(datas *types.StoreSet, sync bool) ([]byte, error) {
+	return nil, types.ErrNotSupport
+}
+
+// MemSet 轻节点不执行区块
+func (store *Store) MemSet(datas *types.StoreSet, sync bool) ([]byte, error) {
+	return nil, types.ErrNotSupport
+}
+
+// Commit 轻节点不执行区块
+func (store *Store) Commit(req *types.ReqHash) ([]byte, error) {
+	return nil, types.ErrNotSupport
+}
+
+// Rollback 轻节点不执行区块
+func (store *Store) Rollback(req *types.ReqHash) ([]byte, error) {
+	return nil, types.ErrNotSupport
+}
+
+// Del 轻节点不保存状态
+func (store *Store) Del(req *types.StoreDel) ([]byte, error) {
+	return nil, types.ErrNotSupport
+}
+
+// MemSetUpgrade 轻节点不需要升级
+func (store *Store) MemSetUpgrade(datas *types.StoreSet, sync bool) ([]byte, error) {
+	return nil, types.ErrNotSupport
+}
+
+// CommitUpgrade 轻节点不需要升级
+func (store *Store) CommitUpgrade(req *types.ReqHash) ([]byte, error) {
+	return nil, types.ErrNotSupport
+}
+
+// IterateRangeByStateHash 范围查询无法用状态证明验证, 轻节点不支持
+func (store *Store) IterateRangeByStateHash(statehash []byte, start []byte, end []byte, ascending bool, fn func(key, value []byte) bool) {
+	llog.Debug("IterateRangeByStateHash not support in light mode")
+}
+
+// ProcEvent not support message
+func (store *Store) ProcEvent(msg *queue.Message) {
+	if msg == nil {
+		return
+	}
+	msg.ReplyErr("Store", types.ErrActionNotSupport)
+}
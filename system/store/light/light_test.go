@@ -0,0 +1,90 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package light
+
+import (
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/common/mavlproof"
+	"github.com/turingchain2020/turingchain/queue"
+	drivers "github.com/turingchain2020/turingchain/system/store"
+	mavl "github.com/turingchain2020/turingchain/system/store/mavl/db"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightStoreGet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "example")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	//全节点的状态
+	db := dbm.NewDB("mavltree", "leveldb", dir, 100)
+	defer db.Close()
+	kv := []*types.KeyValue{
+		{Key: []byte("k1"), Value: []byte("v1")},
+		{Key: []byte("k2"), Value: []byte("v2")},
+	}
+	hash, err := mavl.SetKVPair(db, &types.StoreSet{StateHash: drivers.EmptyRoot[:], KV: kv}, true, nil)
+	require.Nil(t, err)
+	kv = append(kv, &types.KeyValue{Key: []byte("k3"), Value: []byte("v3")})
+	hash2, err := mavl.SetKVPair(db, &types.StoreSet{StateHash: hash, KV: kv[2:]}, true, nil)
+	require.Nil(t, err)
+
+	cfg := types.NewTuringchainConfig(types.GetDefaultCfgstring())
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	store := New(&types.Store{Name: "light", Driver: "leveldb", DbPath: dir + "/light", DbCache: 100}, nil, cfg).(*Store)
+	store.SetQueueClient(q.Client())
+	defer store.Close()
+
+	//模拟p2p 模块, 总是返回hash 对应状态的证明
+	var fetched int32
+	p2p := q.Client()
+	p2p.Sub("p2p")
+	go func() {
+		for msg := range p2p.Recv() {
+			atomic.AddInt32(&fetched, 1)
+			req := msg.GetData().(*types.ReqStateProof)
+			proof, err := mavl.GetStateProof(db, hash, req.Keys, nil)
+			if err != nil {
+				msg.Reply(p2p.NewMessage("", msg.Ty, err))
+				continue
+			}
+			msg.Reply(p2p.NewMessage("", msg.Ty, proof))
+		}
+	}()
+
+	get := &types.StoreGet{StateHash: hash, Keys: [][]byte{[]byte("k1"), []byte("k3")}}
+	values, err := store.GetValues(get)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("v1"), nil}, values)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetched))
+	//已经验证过的状态从缓存获取
+	values, err = store.GetValues(get)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("v1"), nil}, values)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetched))
+
+	//返回的证明和请求的状态不一致
+	get = &types.StoreGet{StateHash: hash2, Keys: [][]byte{[]byte("k3")}}
+	_, err = store.GetValues(get)
+	assert.Equal(t, mavlproof.ErrProofRoot, err)
+	assert.Equal(t, [][]byte{nil}, store.Get(get))
+
+	client := q.Client()
+	msg := client.NewMessage("store", types.EventStoreGet, get)
+	require.Nil(t, client.Send(msg, true))
+	_, err = client.Wait(msg)
+	assert.Equal(t, mavlproof.ErrProofRoot, err)
+
+	_, err = store.Set(&types.StoreSet{StateHash: hash, KV: kv}, true)
+	assert.Equal(t, types.ErrNotSupport, err)
+}
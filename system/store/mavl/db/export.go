@@ -0,0 +1,311 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/golang/protobuf/proto"
+)
+
+/*
+状态文件格式:
+文件头 stateFileMagic, 状态根
+按照后序遍历的顺序保存树的节点, 叶子节点保存key 和value, inner 节点只保存一个标记,
+inner 节点的key, 高度和大小都可以从子节点计算出来, 所以只需要保存树的结构
+叶子节点按照key 的顺序出现, 和IterateRangeByStateHash 的结果相同, 但是只有叶子节点无法恢复出相同的树
+之后是可选的localdb 数据, 最后是叶子节点个数和前面全部内容的sha256 校验和
+*/
+
+const (
+	stateFileMagic = "TCSTATE1"
+
+	stateRecordEnd   = byte(0)
+	stateRecordLeaf  = byte(1)
+	stateRecordInner = byte(2)
+	stateRecordLocal = byte(3)
+
+	//导入时每批写入数据库的节点个数
+	stateImportBatch = 10000
+	//key 和value 的最大长度
+	maxStateRecordSize = 64 * 1024 * 1024
+)
+
+var (
+	// ErrStateFile state file is invalid
+	ErrStateFile = errors.New("ErrStateFile")
+	// ErrStateFileChecksum state file checksum mismatch
+	ErrStateFileChecksum = errors.New("ErrStateFileChecksum")
+)
+
+type stateWriter struct {
+	w   *bufio.Writer
+	err error
+	buf [binary.MaxVarintLen64]byte
+}
+
+func (sw *stateWriter) write(data []byte) {
+	if sw.err == nil {
+		_, sw.err = sw.w.Write(data)
+	}
+}
+
+func (sw *stateWriter) writeUvarint(v uint64) {
+	n := binary.PutUvarint(sw.buf[:], v)
+	sw.write(sw.buf[:n])
+}
+
+func (sw *stateWriter) writeBytes(data []byte) {
+	sw.writeUvarint(uint64(len(data)))
+	sw.write(data)
+}
+
+func (sw *stateWriter) writeRecord(ty byte, key, value []byte) {
+	sw.write([]byte{ty})
+	if ty == stateRecordLeaf || ty == stateRecordLocal {
+		sw.writeBytes(key)
+		sw.writeBytes(value)
+	}
+}
+
+//exportNode 按照后序遍历写入子树的节点, 返回叶子节点个数
+func exportNode(t *Tree, node *Node, sw *stateWriter) (int64, error) {
+	if node.height == 0 {
+		sw.writeRecord(stateRecordLeaf, node.key, node.value)
+		return 1, sw.err
+	}
+	var leaves int64
+	for _, hash := range [][]byte{node.leftHash, node.rightHash} {
+		child, err := t.ndb.GetNode(t, hash)
+		if err != nil {
+			return 0, err
+		}
+		n, err := exportNode(t, child, sw)
+		if err != nil {
+			return 0, err
+		}
+		leaves += n
+	}
+	sw.writeRecord(stateRecordInner, nil, nil)
+	return leaves, sw.err
+}
+
+// ExportState 把状态根对应的树写入w, localdb 不为nil 时同时写入localdb 中的全部数据, 返回叶子节点个数
+func ExportState(w io.Writer, db dbm.DB, roothash []byte, localdb dbm.IteratorDB, treeCfg *TreeConfig) (int64, error) {
+	if treeCfg != nil && (treeCfg.EnableMavlPrefix || treeCfg.EnableMVCC) {
+		return 0, types.ErrNotSupport
+	}
+	tree := NewTree(db, true, treeCfg)
+	err := tree.Load(roothash)
+	if err != nil {
+		return 0, err
+	}
+	hash := sha256.New()
+	bw := bufio.NewWriter(io.MultiWriter(w, hash))
+	sw := &stateWriter{w: bw}
+	sw.write([]byte(stateFileMagic))
+	sw.writeBytes(tree.Hash())
+
+	var leaves int64
+	if tree.root != nil {
+		leaves, err = exportNode(tree, tree.root, sw)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if localdb != nil {
+		it := localdb.Iterator(types.LocalPrefix, nil, false)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			if it.Error() != nil {
+				return 0, it.Error()
+			}
+			sw.writeRecord(stateRecordLocal, it.Key(), it.Value())
+		}
+	}
+	sw.write([]byte{stateRecordEnd})
+	sw.writeUvarint(uint64(leaves))
+	if sw.err != nil {
+		return 0, sw.err
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	//校验和不参与计算
+	_, err = w.Write(hash.Sum(nil))
+	return leaves, err
+}
+
+func readBytes(r io.ByteReader, rd io.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxStateRecordSize {
+		return nil, ErrStateFile
+	}
+	data := make([]byte, n)
+	_, err = io.ReadFull(rd, data)
+	return data, err
+}
+
+//byteTeeReader 读取的内容同时写入校验和
+type byteTeeReader struct {
+	r    *bufio.Reader
+	hash io.Writer
+}
+
+func (t *byteTeeReader) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	t.hash.Write(p[:n])
+	return n, err
+}
+
+func (t *byteTeeReader) ReadByte() (byte, error) {
+	b, err := t.r.ReadByte()
+	if err == nil {
+		t.hash.Write([]byte{b})
+	}
+	return b, err
+}
+
+// ImportState 从r 中读取状态文件, 把树的节点写入db, localdb 不为nil 时同时写入localdb 数据
+// 返回重新计算的状态根以及叶子节点个数, 状态根和文件中记录的不一致时返回错误
+// 导入失败时数据库中可能已经写入了部分数据, 应该导入到新的目录
+func ImportState(r io.Reader, db dbm.DB, localdb dbm.DB, treeCfg *TreeConfig) ([]byte, int64, error) {
+	if treeCfg != nil && (treeCfg.EnableMavlPrefix || treeCfg.EnableMVCC) {
+		return nil, 0, types.ErrNotSupport
+	}
+	type subtree struct {
+		hash   []byte
+		minKey []byte
+		height int32
+		size   int32
+	}
+	br := bufio.NewReader(r)
+	hash := sha256.New()
+	tr := &byteTeeReader{r: br, hash: hash}
+	magic := make([]byte, len(stateFileMagic))
+	if _, err := io.ReadFull(tr, magic); err != nil || string(magic) != stateFileMagic {
+		return nil, 0, ErrStateFile
+	}
+	roothash, err := readBytes(tr, tr)
+	if err != nil {
+		return nil, 0, ErrStateFile
+	}
+
+	batch := db.NewBatch(false)
+	var localBatch dbm.Batch
+	if localdb != nil {
+		localBatch = localdb.NewBatch(false)
+	}
+	var stack []subtree
+	var leaves, count, locals int64
+	writeNode := func(node *types.StoreNode, nodeHash []byte) error {
+		value, err := proto.Marshal(node)
+		if err != nil {
+			return err
+		}
+		batch.Set(nodeHash, value)
+		count++
+		if count%stateImportBatch == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+		return nil
+	}
+	for {
+		ty, err := tr.ReadByte()
+		if err != nil {
+			return nil, 0, ErrStateFile
+		}
+		if ty == stateRecordEnd {
+			break
+		}
+		switch ty {
+		case stateRecordLeaf, stateRecordLocal:
+			key, err := readBytes(tr, tr)
+			if err != nil {
+				return nil, 0, ErrStateFile
+			}
+			value, err := readBytes(tr, tr)
+			if err != nil {
+				return nil, 0, ErrStateFile
+			}
+			if ty == stateRecordLocal {
+				if localBatch != nil {
+					localBatch.Set(key, value)
+					locals++
+					if locals%stateImportBatch == 0 {
+						if err := localBatch.Write(); err != nil {
+							return nil, 0, err
+						}
+						localBatch.Reset()
+					}
+				}
+				continue
+			}
+			node := &types.StoreNode{Key: key, Value: value, Size: 1}
+			nodeHash := storeNodeHash(node)
+			if err := writeNode(node, nodeHash); err != nil {
+				return nil, 0, err
+			}
+			stack = append(stack, subtree{hash: nodeHash, minKey: key, size: 1})
+			leaves++
+		case stateRecordInner:
+			if len(stack) < 2 {
+				return nil, 0, ErrStateFile
+			}
+			left, right := stack[len(stack)-2], stack[len(stack)-1]
+			stack = stack[:len(stack)-2]
+			height := left.height
+			if right.height > height {
+				height = right.height
+			}
+			node := &types.StoreNode{Key: right.minKey, LeftHash: left.hash, RightHash: right.hash, Height: height + 1, Size: left.size + right.size}
+			nodeHash := storeNodeHash(node)
+			if err := writeNode(node, nodeHash); err != nil {
+				return nil, 0, err
+			}
+			stack = append(stack, subtree{hash: nodeHash, minKey: left.minKey, height: node.Height, size: node.Size})
+		default:
+			return nil, 0, ErrStateFile
+		}
+	}
+	total, err := binary.ReadUvarint(tr)
+	if err != nil || int64(total) != leaves || len(stack) > 1 {
+		return nil, 0, ErrStateFile
+	}
+	sum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(br, sum); err != nil || !bytes.Equal(sum, hash.Sum(nil)) {
+		return nil, 0, ErrStateFileChecksum
+	}
+	var root []byte
+	if len(stack) == 1 {
+		root = stack[0].hash
+	}
+	if !bytes.Equal(root, roothash) {
+		return nil, 0, ErrStateFile
+	}
+	//校验通过之后再写入最后一批数据
+	if err := batch.Write(); err != nil {
+		return nil, 0, err
+	}
+	if localBatch != nil {
+		if err := localBatch.Write(); err != nil {
+			return nil, 0, err
+		}
+	}
+	return root, leaves, nil
+}
@@ -0,0 +1,91 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db1 := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer db1.Close()
+
+	records := make(map[string]string)
+	tree := NewTree(db1, true, nil)
+	for i := 0; i < 300; i++ {
+		key := randstr(20)
+		records[key] = randstr(20)
+		tree.Set([]byte(key), []byte(records[key]))
+	}
+	root := tree.Save()
+	tree = NewTree(db1, true, nil)
+	require.NoError(t, tree.Load(root))
+	i := 0
+	for key := range records {
+		if i%4 == 0 {
+			tree.Remove([]byte(key))
+			delete(records, key)
+		}
+		i++
+	}
+	root = tree.Save()
+
+	localdb := db.NewDB("localdb", "memdb", "", 100)
+	localdb.Set(types.CalcLocalPrefix([]byte("coins")), []byte("local"))
+	localdb.Set([]byte("blockLastHeight"), []byte("100"))
+
+	var buf bytes.Buffer
+	leaves, err := ExportState(&buf, db1, root, localdb, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(records)), leaves)
+	data := buf.Bytes()
+
+	dir2, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir2)
+	db2 := db.NewDB("mavltree", "leveldb", dir2, 100)
+	defer db2.Close()
+	localdb2 := db.NewDB("localdb", "memdb", "", 100)
+	root2, leaves, err := ImportState(bytes.NewReader(data), db2, localdb2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, root, root2)
+	assert.Equal(t, int64(len(records)), leaves)
+	assert.Nil(t, CheckSnapshot(db2, root, nil))
+	tree2 := NewTree(db2, true, nil)
+	require.NoError(t, tree2.Load(root))
+	for key, value := range records {
+		_, v, exists := tree2.Get([]byte(key))
+		assert.True(t, exists)
+		assert.Equal(t, value, string(v))
+	}
+	//只导出localdb 的数据
+	value, err := localdb2.Get(types.CalcLocalPrefix([]byte("coins")))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("local"), value)
+	_, err = localdb2.Get([]byte("blockLastHeight"))
+	assert.NotNil(t, err)
+
+	//文件内容被修改
+	corrupt := append([]byte{}, data...)
+	corrupt[len(corrupt)/2] ^= 0xff
+	_, _, err = ImportState(bytes.NewReader(corrupt), db.NewDB("mavltree", "memdb", "", 100), nil, nil)
+	assert.NotNil(t, err)
+	_, _, err = ImportState(bytes.NewReader(data[:len(data)-1]), db.NewDB("mavltree", "memdb", "", 100), nil, nil)
+	assert.Equal(t, ErrStateFileChecksum, err)
+
+	_, err = ExportState(&buf, db1, root, nil, &TreeConfig{EnableMVCC: true})
+	assert.Equal(t, types.ErrNotSupport, err)
+}
@@ -6,7 +6,10 @@ package mavl
 
 import (
 	"bytes"
+	"sort"
 
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/common/mavlproof"
 	"github.com/turingchain2020/turingchain/types"
 	"github.com/golang/protobuf/proto"
 )
@@ -136,3 +139,89 @@ func (t *Tree) ConstructProof(key []byte) (value []byte, proof *Proof) {
 	}
 	return nil, nil
 }
+
+func proofHash(hash []byte) []byte {
+	if len(hash) > sha256Len {
+		return hash[len(hash)-sha256Len:]
+	}
+	return hash
+}
+
+//multiProof 按照后序遍历写入子树的证明节点, keys 是子树中需要展开的叶子节点, 已经排序
+func (node *Node) multiProof(t *Tree, keys [][]byte, proof *types.MAVLMultiProof) {
+	if len(keys) == 0 {
+		proof.Nodes = append(proof.Nodes, &types.MAVLMultiProofNode{Ty: mavlproof.NodeHash, Hash: proofHash(node.hash)})
+		return
+	}
+	if node.height == 0 {
+		proof.Nodes = append(proof.Nodes, &types.MAVLMultiProofNode{Ty: mavlproof.NodeLeaf, Key: node.key, Value: node.value})
+		return
+	}
+	split := sort.Search(len(keys), func(i int) bool { return bytes.Compare(keys[i], node.key) >= 0 })
+	node.getLeftNode(t).multiProof(t, keys[:split], proof)
+	node.getRightNode(t).multiProof(t, keys[split:], proof)
+	proof.Nodes = append(proof.Nodes, &types.MAVLMultiProofNode{Ty: mavlproof.NodeInner, Height: node.height, Size: node.size})
+}
+
+// MultiProof 构造多个key 的证明, key 不存在时展开前后相邻的叶子节点来证明不存在
+func (t *Tree) MultiProof(keys [][]byte) *types.MAVLMultiProof {
+	proof := &types.MAVLMultiProof{}
+	if t.root == nil {
+		return proof
+	}
+	t.root.Hash(t)
+	leaves := make(map[string][]byte)
+	for _, key := range keys {
+		index, _, exists := t.root.get(t, key)
+		if exists {
+			leaves[string(key)] = key
+			continue
+		}
+		if index > 0 {
+			prev, _ := t.root.getByIndex(t, index-1)
+			leaves[string(prev)] = prev
+		}
+		if index < t.root.size {
+			next, _ := t.root.getByIndex(t, index)
+			leaves[string(next)] = next
+		}
+	}
+	sorted := make([][]byte, 0, len(leaves))
+	for _, key := range leaves {
+		sorted = append(sorted, key)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+	t.root.multiProof(t, sorted, proof)
+	return proof
+}
+
+// GetStateProof 获取状态根下多个key 的值和证明
+func GetStateProof(db dbm.DB, roothash []byte, keys [][]byte, treeCfg *TreeConfig) (*types.StateProof, error) {
+	tree := NewTree(db, true, treeCfg)
+	err := tree.Load(roothash)
+	if err != nil {
+		return nil, err
+	}
+	reply := &types.StateProof{StateHash: roothash, Keys: keys}
+	for _, key := range keys {
+		_, value, _ := tree.Get(key)
+		reply.Values = append(reply.Values, value)
+	}
+	reply.Proof = types.Encode(tree.MultiProof(keys))
+	return reply, nil
+}
+
+// VerifyStateProof 验证多key 证明, 返回证明中每个key 的值, 不存在的key 值为nil
+func VerifyStateProof(roothash []byte, keys [][]byte, proof []byte) ([][]byte, error) {
+	kvs, err := mavlproof.VerifyProof(roothash, keys, proof)
+	if err != nil {
+		return nil, err
+	}
+	values := make([][]byte, len(kvs))
+	for i, kv := range kvs {
+		if kv.Exists {
+			values[i] = kv.Value
+		}
+	}
+	return values, nil
+}
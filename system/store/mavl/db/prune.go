@@ -25,6 +25,7 @@ const (
 	leafKeyCountPrefix     = "..mk.."
 	oldLeafKeyCountPrefix  = "..mok.."
 	secLvlPruningHeightKey = "_..mslphk.._"
+	prunedHeightKey        = "_..mpdhk.._"
 	blockHeightStrLen      = 10
 	hashLenStr             = 3
 	pruningStateStart      = 1
@@ -160,6 +161,45 @@ func setSecLvlPruningHeight(db dbm.DB, height int64) error {
 	return db.Set([]byte(secLvlPruningHeightKey), value)
 }
 
+//getPrunedHeight 低于这个高度的状态可能已经被裁剪
+func getPrunedHeight(db dbm.DB) int64 {
+	value, err := db.Get([]byte(prunedHeightKey))
+	if len(value) == 0 || err != nil {
+		return 0
+	}
+	h := &types.Int64{}
+	err = proto.Unmarshal(value, h)
+	if err != nil {
+		return 0
+	}
+	return h.Data
+}
+
+func setPrunedHeight(db dbm.DB, height int64) error {
+	value, err := proto.Marshal(&types.Int64{Data: height})
+	if err != nil {
+		return err
+	}
+	return db.Set([]byte(prunedHeightKey), value)
+}
+
+// CheckState 检查状态根对应的树是否存在, height 大于0 时同时检查该高度的状态是否已经被裁剪
+// 裁剪只删除部分节点, 状态根存在也不能保证整棵树完整, 所以先按照高度判断
+func CheckState(db dbm.DB, stateHash []byte, height int64, treeCfg *TreeConfig) error {
+	if treeCfg != nil && treeCfg.EnableMavlPrune && height > 0 && height < getPrunedHeight(db) {
+		return types.ErrStatePruned
+	}
+	tree := NewTree(db, true, treeCfg)
+	if err := tree.Load(stateHash); err != nil {
+		treelog.Debug("CheckState", "stateHash", common.ToHex(stateHash), "height", height, "err", err)
+		if treeCfg != nil && treeCfg.EnableMavlPrune && height > 0 {
+			return types.ErrStatePruned
+		}
+		return types.ErrStateNotFound
+	}
+	return nil
+}
+
 func pruning(db dbm.DB, curHeight int64, treeCfg *TreeConfig) {
 	defer wg.Done()
 	pruningTree(db, curHeight, treeCfg)
@@ -167,6 +207,12 @@ func pruning(db dbm.DB, curHeight int64, treeCfg *TreeConfig) {
 
 func pruningTree(db dbm.DB, curHeight int64, treeCfg *TreeConfig) {
 	setPruning(pruningStateStart)
+	// 开始删除节点之前记录裁剪高度
+	if height := curHeight - int64(treeCfg.PruneHeight); height > getPrunedHeight(db) {
+		if err := setPrunedHeight(db, height); err != nil {
+			treelog.Error("pruningTree setPrunedHeight", "height", height, "err", err)
+		}
+	}
 	// 一级遍历
 	pruningFirstLevel(db, curHeight, treeCfg)
 	// 二级遍历
@@ -0,0 +1,337 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+
+	dbm "github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/golang/protobuf/proto"
+)
+
+/*
+状态快照:
+以深度为depth 的节点为根的子树作为一个分片, 深度小于depth 的叶子节点单独作为一个分片, 分片按照从左到右的顺序编号
+分片中包含子树的全部节点, 以及子树根节点的全部祖先节点, 每个分片可以单独对状态根进行验证
+inner 节点的key 不参与hash 计算, 需要保证key 等于右子树最小的key, 祖先节点只有在分片中可以验证key 时才会写入
+*/
+
+const (
+	//每个快照分片包含的叶子节点个数的目标值
+	snapshotChunkLeaves = 4096
+	//分片所在的最大深度
+	maxSnapshotDepth = 20
+)
+
+var (
+	// ErrSnapshotVerify snapshot chunk verify failed
+	ErrSnapshotVerify = errors.New("ErrSnapshotVerify")
+)
+
+//snapshotDepth 根据叶子节点个数计算分片所在的深度
+func snapshotDepth(size int32) int32 {
+	var depth int32
+	for size > snapshotChunkLeaves && depth < maxSnapshotDepth {
+		size = (size + 1) / 2
+		depth++
+	}
+	return depth
+}
+
+//snapshotFrame 待访问的节点, path 为根节点到该节点的路径
+type snapshotFrame struct {
+	node  *Node
+	depth int32
+	path  []*Node
+}
+
+//snapshotCursor 按照从左到右的顺序遍历分片的根节点, 记录遍历的位置.
+//同步时按照序号顺序获取分片, 从上一次的位置继续遍历, 不需要每次都从第一个分片开始
+type snapshotCursor struct {
+	db    dbm.DB
+	tree  *Tree
+	hash  []byte
+	depth int32
+	total int32
+	//下一个分片的序号
+	index int32
+	stack []snapshotFrame
+}
+
+var (
+	snapshotMu sync.Mutex
+	//最近一次使用的遍历位置
+	lastSnapshotCursor *snapshotCursor
+)
+
+func newSnapshotCursor(db dbm.DB, tree *Tree, hash []byte, depth int32) *snapshotCursor {
+	return &snapshotCursor{db: db, tree: tree, hash: hash, depth: depth, stack: []snapshotFrame{{node: tree.root, depth: depth}}}
+}
+
+//next 返回下一个分片的根节点和路径, 遍历结束时返回nil
+func (c *snapshotCursor) next() (*Node, []*Node, error) {
+	for len(c.stack) > 0 {
+		f := c.stack[len(c.stack)-1]
+		c.stack = c.stack[:len(c.stack)-1]
+		if f.depth == 0 || f.node.height == 0 {
+			c.index++
+			return f.node, f.path, nil
+		}
+		left, err := c.tree.ndb.GetNode(c.tree, f.node.leftHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		right, err := c.tree.ndb.GetNode(c.tree, f.node.rightHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		path := append(f.path[:len(f.path):len(f.path)], f.node)
+		c.stack = append(c.stack, snapshotFrame{node: right, depth: f.depth - 1, path: path}, snapshotFrame{node: left, depth: f.depth - 1, path: path})
+	}
+	return nil, nil, nil
+}
+
+//countSnapshotChunks 分片的个数
+func countSnapshotChunks(tree *Tree, node *Node, depth int32) (int32, error) {
+	if depth == 0 || node.height == 0 {
+		return 1, nil
+	}
+	var total int32
+	for _, hash := range [][]byte{node.leftHash, node.rightHash} {
+		child, err := tree.ndb.GetNode(tree, hash)
+		if err != nil {
+			return 0, err
+		}
+		n, err := countSnapshotChunks(tree, child, depth-1)
+		if err != nil {
+			return 0, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+//getSnapshotCursor 获取可以继续遍历到index 的位置, 不能继续时重新开始遍历
+func getSnapshotCursor(db dbm.DB, tree *Tree, hash []byte, depth, index int32) (*snapshotCursor, error) {
+	c := lastSnapshotCursor
+	if c != nil && c.db == db && c.depth == depth && bytes.Equal(c.hash, hash) {
+		if index < 0 || c.index <= index {
+			return c, nil
+		}
+		total := c.total
+		c = newSnapshotCursor(db, c.tree, hash, depth)
+		c.total = total
+	} else {
+		total, err := countSnapshotChunks(tree, tree.root, depth)
+		if err != nil {
+			return nil, err
+		}
+		c = newSnapshotCursor(db, tree, hash, depth)
+		c.total = total
+	}
+	lastSnapshotCursor = c
+	return c, nil
+}
+
+func toStoreNode(node *Node) *types.StoreNode {
+	if node.height == 0 {
+		return &types.StoreNode{Key: node.key, Value: node.value, Height: node.height, Size: node.size}
+	}
+	return &types.StoreNode{Key: node.key, Height: node.height, Size: node.size, LeftHash: node.leftHash, RightHash: node.rightHash}
+}
+
+//exportSubtree 按照后序遍历导出子树的全部节点
+func exportSubtree(t *Tree, node *Node, nodes *[]*types.StoreNode) error {
+	if node.height > 0 {
+		for _, hash := range [][]byte{node.leftHash, node.rightHash} {
+			child, err := t.ndb.GetNode(t, hash)
+			if err != nil {
+				return err
+			}
+			if err := exportSubtree(t, child, nodes); err != nil {
+				return err
+			}
+		}
+	}
+	*nodes = append(*nodes, toStoreNode(node))
+	return nil
+}
+
+// GetSnapshotChunk 获取状态根对应的快照分片, depth 小于0 时根据树的大小计算深度, index 小于0 时只返回分片个数
+func GetSnapshotChunk(db dbm.DB, roothash []byte, depth, index int32, treeCfg *TreeConfig) (*types.StateSnapshotChunk, error) {
+	//加前缀和MVCC 模式下节点的hash 和保存的内容与默认模式不同, 暂不支持
+	if treeCfg != nil && (treeCfg.EnableMavlPrefix || treeCfg.EnableMVCC) {
+		return nil, types.ErrNotSupport
+	}
+	tree := NewTree(db, true, treeCfg)
+	err := tree.Load(roothash)
+	if err != nil {
+		return nil, err
+	}
+	chunk := &types.StateSnapshotChunk{StateHash: roothash, Depth: depth, Index: index}
+	if tree.root == nil {
+		return chunk, nil
+	}
+	if depth < 0 || depth > maxSnapshotDepth {
+		chunk.Depth = snapshotDepth(tree.root.size)
+	}
+	snapshotMu.Lock()
+	defer snapshotMu.Unlock()
+	cursor, err := getSnapshotCursor(db, tree, roothash, chunk.Depth, index)
+	if err != nil {
+		return nil, err
+	}
+	chunk.Total = cursor.total
+	if index < 0 {
+		return chunk, nil
+	}
+	if index >= chunk.Total {
+		return nil, types.ErrInvalidParam
+	}
+	var target *Node
+	var targetPath []*Node
+	for cursor.index <= index {
+		target, targetPath, err = cursor.next()
+		if err != nil {
+			lastSnapshotCursor = nil
+			return nil, err
+		}
+		if target == nil {
+			return nil, types.ErrInvalidParam
+		}
+	}
+	if err := exportSubtree(cursor.tree, target, &chunk.Nodes); err != nil {
+		return nil, err
+	}
+	for i := len(targetPath) - 1; i >= 0; i-- {
+		chunk.Proof = append(chunk.Proof, toStoreNode(targetPath[i]))
+	}
+	return chunk, nil
+}
+
+//checkSubtree 加载子树的全部节点
+func checkSubtree(t *Tree, node *Node) error {
+	if node.height == 0 {
+		return nil
+	}
+	for _, hash := range [][]byte{node.leftHash, node.rightHash} {
+		child, err := t.ndb.GetNode(t, hash)
+		if err != nil {
+			return err
+		}
+		if err := checkSubtree(t, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckSnapshot 检查状态根对应的树的全部节点都已经写入数据库, 导入全部快照分片之后调用
+func CheckSnapshot(db dbm.DB, roothash []byte, treeCfg *TreeConfig) error {
+	tree := NewTree(db, true, treeCfg)
+	err := tree.Load(roothash)
+	if err != nil {
+		return err
+	}
+	if tree.root == nil {
+		return nil
+	}
+	return checkSubtree(tree, tree.root)
+}
+
+func storeNodeHash(node *types.StoreNode) []byte {
+	if node.Height == 0 {
+		return (&types.LeafNode{Key: node.Key, Value: node.Value, Height: 0, Size: 1}).Hash()
+	}
+	return (&types.InnerNode{LeftHash: node.LeftHash, RightHash: node.RightHash, Height: node.Height, Size: node.Size}).Hash()
+}
+
+// VerifySnapshotChunk 验证分片中的节点可以计算出状态根, 返回需要写入数据库的节点以及节点hash
+func VerifySnapshotChunk(chunk *types.StateSnapshotChunk) ([]*types.StoreNode, [][]byte, error) {
+	type subtree struct {
+		hash   []byte
+		minKey []byte
+	}
+	var nodes []*types.StoreNode
+	var hashes [][]byte
+	var stack []subtree
+	for _, node := range chunk.Nodes {
+		if node.Height == 0 {
+			if node.Size != 1 {
+				return nil, nil, ErrSnapshotVerify
+			}
+			node = &types.StoreNode{Key: node.Key, Value: node.Value, Size: 1}
+			stack = append(stack, subtree{hash: storeNodeHash(node), minKey: node.Key})
+		} else {
+			if len(stack) < 2 {
+				return nil, nil, ErrSnapshotVerify
+			}
+			left, right := stack[len(stack)-2], stack[len(stack)-1]
+			stack = stack[:len(stack)-2]
+			//inner 节点的key 为右子树最小的key
+			if !bytes.Equal(left.hash, node.LeftHash) || !bytes.Equal(right.hash, node.RightHash) || !bytes.Equal(node.Key, right.minKey) {
+				return nil, nil, ErrSnapshotVerify
+			}
+			node = &types.StoreNode{Key: node.Key, LeftHash: node.LeftHash, RightHash: node.RightHash, Height: node.Height, Size: node.Size}
+			stack = append(stack, subtree{hash: storeNodeHash(node), minKey: left.minKey})
+		}
+		nodes = append(nodes, node)
+		hashes = append(hashes, stack[len(stack)-1].hash)
+	}
+	if len(stack) != 1 {
+		return nil, nil, ErrSnapshotVerify
+	}
+	hash, minKey := stack[0].hash, stack[0].minKey
+	for _, node := range chunk.Proof {
+		if node.Height == 0 {
+			return nil, nil, ErrSnapshotVerify
+		}
+		node = &types.StoreNode{Key: node.Key, LeftHash: node.LeftHash, RightHash: node.RightHash, Height: node.Height, Size: node.Size}
+		switch {
+		case bytes.Equal(node.LeftHash, hash):
+			//子树最小的key 也是父节点最小的key
+		case bytes.Equal(node.RightHash, hash):
+			//分片在右子树的最左边时, 可以验证父节点的key
+			if minKey != nil {
+				if !bytes.Equal(node.Key, minKey) {
+					return nil, nil, ErrSnapshotVerify
+				}
+				nodes = append(nodes, node)
+				hashes = append(hashes, storeNodeHash(node))
+			}
+			minKey = nil
+		default:
+			return nil, nil, ErrSnapshotVerify
+		}
+		hash = storeNodeHash(node)
+	}
+	if !bytes.Equal(hash, chunk.StateHash) {
+		return nil, nil, ErrSnapshotVerify
+	}
+	return nodes, hashes, nil
+}
+
+// ImportSnapshotChunk 验证分片并把节点写入数据库
+func ImportSnapshotChunk(db dbm.DB, chunk *types.StateSnapshotChunk, treeCfg *TreeConfig) error {
+	if treeCfg != nil && (treeCfg.EnableMavlPrefix || treeCfg.EnableMVCC) {
+		return types.ErrNotSupport
+	}
+	nodes, hashes, err := VerifySnapshotChunk(chunk)
+	if err != nil {
+		return err
+	}
+	batch := db.NewBatch(true)
+	for i, node := range nodes {
+		value, err := proto.Marshal(node)
+		if err != nil {
+			return err
+		}
+		batch.Set(hashes[i], value)
+	}
+	return batch.Write()
+}
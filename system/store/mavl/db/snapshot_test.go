@@ -0,0 +1,127 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mavl
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common/db"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotChunk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db1 := db.NewDB("mavltree", "leveldb", dir, 100)
+	defer db1.Close()
+
+	records := make(map[string]string)
+	tree := NewTree(db1, true, nil)
+	for i := 0; i < 500; i++ {
+		key := randstr(20)
+		records[key] = randstr(20)
+		tree.Set([]byte(key), []byte(records[key]))
+	}
+	root := tree.Save()
+	tree = NewTree(db1, true, nil)
+	require.NoError(t, tree.Load(root))
+	//删除部分key, 树的结构与按顺序插入得到的树不同
+	i := 0
+	for key := range records {
+		if i%3 == 0 {
+			tree.Remove([]byte(key))
+			delete(records, key)
+		}
+		i++
+	}
+	root = tree.Save()
+
+	for _, depth := range []int32{0, 3} {
+		dir2, err := ioutil.TempDir("", "datastore")
+		require.NoError(t, err)
+		db2 := db.NewDB("mavltree", "leveldb", dir2, 100)
+
+		info, err := GetSnapshotChunk(db1, root, depth, -1, nil)
+		require.NoError(t, err)
+		assert.Equal(t, depth, info.Depth)
+		for index := int32(0); index < info.Total; index++ {
+			//缺少分片时检查失败
+			assert.NotNil(t, CheckSnapshot(db2, root, nil))
+			chunk, err := GetSnapshotChunk(db1, root, depth, index, nil)
+			require.NoError(t, err)
+			assert.Equal(t, info.Total, chunk.Total)
+			assert.Nil(t, ImportSnapshotChunk(db2, chunk, nil))
+		}
+		assert.Nil(t, CheckSnapshot(db2, root, nil))
+
+		tree2 := NewTree(db2, true, nil)
+		require.NoError(t, tree2.Load(root))
+		for key, value := range records {
+			_, v, exists := tree2.Get([]byte(key))
+			assert.True(t, exists)
+			assert.Equal(t, value, string(v))
+		}
+		//树的结构相同, 继续更新后的状态根也相同
+		tree1 := NewTree(db1, true, nil)
+		require.NoError(t, tree1.Load(root))
+		tree1.Set([]byte("snapshot"), []byte("value"))
+		tree2.Set([]byte("snapshot"), []byte("value"))
+		assert.Equal(t, tree1.Hash(), tree2.Hash())
+		db2.Close()
+		os.RemoveAll(dir2)
+	}
+
+	//重复导入同一个分片代替其他分片, 检查失败
+	dir3, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir3)
+	db3 := db.NewDB("mavltree", "leveldb", dir3, 100)
+	defer db3.Close()
+	info, err := GetSnapshotChunk(db1, root, 3, -1, nil)
+	require.NoError(t, err)
+	chunk, err := GetSnapshotChunk(db1, root, 3, 2, nil)
+	require.NoError(t, err)
+	for index := int32(0); index < info.Total; index++ {
+		assert.Nil(t, ImportSnapshotChunk(db3, chunk, nil))
+	}
+	assert.NotNil(t, CheckSnapshot(db3, root, nil))
+
+	_, _, err = VerifySnapshotChunk(chunk)
+	assert.Nil(t, err)
+	//修改inner 节点的key 不影响hash, 但是无法通过验证
+	for _, node := range chunk.Nodes {
+		if node.Height > 0 {
+			node.Key = []byte("fake")
+			break
+		}
+	}
+	_, _, err = VerifySnapshotChunk(chunk)
+	assert.Equal(t, ErrSnapshotVerify, err)
+
+	chunk, err = GetSnapshotChunk(db1, root, 3, 2, nil)
+	require.NoError(t, err)
+	chunk.Nodes[0].Value = []byte("fake")
+	_, _, err = VerifySnapshotChunk(chunk)
+	assert.Equal(t, ErrSnapshotVerify, err)
+
+	//乱序获取分片时重新开始遍历, 结果和顺序获取的相同
+	chunk5, err := GetSnapshotChunk(db1, root, 3, 5, nil)
+	require.NoError(t, err)
+	_, err = GetSnapshotChunk(db1, root, 3, 1, nil)
+	require.NoError(t, err)
+	chunk, err = GetSnapshotChunk(db1, root, 3, 5, nil)
+	require.NoError(t, err)
+	assert.Equal(t, chunk5, chunk)
+	_, err = GetSnapshotChunk(db1, root, 3, info.Total, nil)
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	_, err = GetSnapshotChunk(db1, root, 3, 2, &TreeConfig{EnableMavlPrefix: true})
+	assert.Equal(t, types.ErrNotSupport, err)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/turingchain2020/turingchain/common"
 	dbm "github.com/turingchain2020/turingchain/common/db"
 	log "github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/metrics"
 	"github.com/turingchain2020/turingchain/system/store/mavl/db/ticket"
 	"github.com/turingchain2020/turingchain/types"
 	farm "github.com/dgryski/go-farm"
@@ -39,6 +40,9 @@ var (
 	heightMtx      sync.Mutex
 	memTree        MemTreeOpera
 	tkCloseCache   MemTreeOpera
+	// 节点缓存命中统计
+	nodeCacheHit  = metrics.Counter("mavl_node_cache_hit")
+	nodeCacheMiss = metrics.Counter("mavl_node_cache_miss")
 )
 
 // InitGlobalMem 初始化全局变量
@@ -466,8 +470,10 @@ func (ndb *nodeDB) GetNode(t *Tree, hash []byte) (*Node, error) {
 	if ndb.cache != nil {
 		elem, ok := ndb.cache.Get(string(hash))
 		if ok {
+			nodeCacheHit.Inc(1)
 			return elem.(*Node), nil
 		}
+		nodeCacheMiss.Inc(1)
 	}
 	//从memtree中获取
 	if t != nil && t.config != nil && t.config.EnableMemTree {
@@ -20,6 +20,7 @@ import (
 	. "github.com/turingchain2020/turingchain/common"
 	"github.com/turingchain2020/turingchain/common/db"
 	"github.com/turingchain2020/turingchain/common/log"
+	"github.com/turingchain2020/turingchain/common/mavlproof"
 	"github.com/turingchain2020/turingchain/types"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -675,6 +676,65 @@ func TestGetAndVerifyKVPairProof(t *testing.T) {
 	db.Close()
 }
 
+func TestGetAndVerifyStateProof(t *testing.T) {
+	for _, treeCfg := range []*TreeConfig{nil, {EnableMavlPrefix: true}} {
+		db := db.NewDB("mavltree", "memdb", "", 100)
+		var storeSet types.StoreSet
+		records := make(map[string]string)
+		for i := 0; i < 200; i++ {
+			key := fmt.Sprintf("key%03d", i*2)
+			records[key] = randstr(20)
+			storeSet.KV = append(storeSet.KV, &types.KeyValue{Key: []byte(key), Value: []byte(records[key])})
+		}
+		storeSet.StateHash = emptyRoot[:]
+		storeSet.Height = 10
+		root, err := SetKVPair(db, &storeSet, true, treeCfg)
+		require.NoError(t, err)
+
+		//存在, 不存在, 比最小的key 小, 比最大的key 大
+		keys := [][]byte{[]byte("key010"), []byte("key011"), []byte("key398"), []byte("a"), []byte("z"), []byte("key100")}
+		reply, err := GetStateProof(db, root, keys, treeCfg)
+		require.NoError(t, err)
+		values, err := VerifyStateProof(root, keys, reply.Proof)
+		require.NoError(t, err)
+		assert.Equal(t, reply.Values, values)
+		assert.Equal(t, []byte(records["key010"]), values[0])
+		assert.Nil(t, values[1])
+		assert.Equal(t, []byte(records["key398"]), values[2])
+		assert.Nil(t, values[3])
+		assert.Nil(t, values[4])
+		assert.Equal(t, []byte(records["key100"]), values[5])
+
+		//证明比单独的证明更紧凑
+		var proof types.MAVLMultiProof
+		require.NoError(t, types.Decode(reply.Proof, &proof))
+		assert.True(t, len(proof.Nodes) < 100)
+
+		//证明中没有包含的key
+		_, err = VerifyStateProof(root, [][]byte{[]byte("key201")}, reply.Proof)
+		assert.Equal(t, mavlproof.ErrProofKey, err)
+		_, err = VerifyStateProof([]byte("root"), keys, reply.Proof)
+		assert.Equal(t, mavlproof.ErrProofRoot, err)
+		//修改叶子节点的值
+		for _, node := range proof.Nodes {
+			if node.Ty == mavlproof.NodeLeaf {
+				node.Value = []byte("value")
+				break
+			}
+		}
+		_, err = VerifyStateProof(root, keys, types.Encode(&proof))
+		assert.Equal(t, mavlproof.ErrProofRoot, err)
+
+		//空树
+		reply, err = GetStateProof(db, emptyRoot[:], keys, treeCfg)
+		require.NoError(t, err)
+		values, err = VerifyStateProof(emptyRoot[:], keys, reply.Proof)
+		require.NoError(t, err)
+		assert.Equal(t, make([][]byte, len(keys)), values)
+		db.Close()
+	}
+}
+
 type traverser struct {
 	Values []string
 }
@@ -831,6 +891,50 @@ func TestPruningTree(t *testing.T) {
 	PruningTreePrintDB(db, []byte(leafNodePrefix))
 }
 
+func TestCheckState(t *testing.T) {
+	const txN = 5
+	const preB = 100
+	dir, err := ioutil.TempDir("", "datastore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := db.NewDB("test", "leveldb", dir, 100)
+	defer db.Close()
+
+	treeCfg := &TreeConfig{
+		EnableMavlPrefix: true,
+		EnableMavlPrune:  true,
+		PruneHeight:      10,
+	}
+	hashes := make([][]byte, 2*preB)
+	prevHash := make([]byte, 32)
+	for j := 0; j < 2; j++ {
+		for i := 0; i < preB; i++ {
+			height := int64(j*preB + i)
+			//不在Save 中自动裁剪
+			setPruning(pruningStateStart)
+			prevHash, err = saveUpdateBlock(db, int64(i), prevHash, txN, j, height, treeCfg)
+			require.NoError(t, err)
+			hashes[height] = prevHash
+		}
+	}
+	curHeight := int64(2*preB - 1)
+	assert.Nil(t, CheckState(db, hashes[10], 10, treeCfg))
+	setPruning(pruningStateStart)
+	pruningTree(db, curHeight, treeCfg)
+	assert.Equal(t, curHeight-10, getPrunedHeight(db))
+
+	assert.Equal(t, types.ErrStatePruned, CheckState(db, hashes[10], 10, treeCfg))
+	assert.Nil(t, CheckState(db, hashes[curHeight], curHeight, treeCfg))
+	assert.Nil(t, CheckState(db, hashes[curHeight-10], curHeight-10, treeCfg))
+	assert.Equal(t, types.ErrStateNotFound, CheckState(db, []byte("statehash"), 0, treeCfg))
+	//没有开启裁剪时不检查高度
+	assert.Nil(t, CheckState(db, hashes[curHeight], 10, &TreeConfig{EnableMavlPrefix: true}))
+	//裁剪高度不会降低
+	setPruning(pruningStateStart)
+	pruningTree(db, curHeight-1, treeCfg)
+	assert.Equal(t, curHeight-10, getPrunedHeight(db))
+}
+
 func genUpdateKV(height int64, txN int64, vIndex int) (kvs []*types.KeyValue) {
 	for i := int64(0); i < txN; i++ {
 		n := height*txN + i
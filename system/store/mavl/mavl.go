@@ -219,12 +219,47 @@ func (mavls *Store) IterateRangeByStateHash(statehash []byte, start []byte, end
 	mavl.IterateRangeByStateHash(mavls.GetDB(), statehash, start, end, ascending, mavls.treeCfg, fn)
 }
 
-// ProcEvent not support message
+// ProcEvent 处理状态快照的导出和导入, 历史状态检查以及状态证明, 其他消息不支持
 func (mavls *Store) ProcEvent(msg *queue.Message) {
 	if msg == nil {
 		return
 	}
-	msg.ReplyErr("Store", types.ErrActionNotSupport)
+	switch msg.Ty {
+	case types.EventStoreGetSnapshotChunk:
+		req := msg.GetData().(*types.ReqSnapshotChunk)
+		chunk, err := mavl.GetSnapshotChunk(mavls.GetDB(), req.StateHash, req.Depth, req.Index, mavls.treeCfg)
+		if err != nil {
+			mlog.Error("GetSnapshotChunk", "stateHash", common.ToHex(req.StateHash), "index", req.Index, "err", err)
+			msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreGetSnapshotChunk, err))
+			return
+		}
+		msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreGetSnapshotChunk, chunk))
+	case types.EventStoreImportSnapshotChunk:
+		chunk := msg.GetData().(*types.StateSnapshotChunk)
+		msg.ReplyErr("ImportSnapshotChunk", mavl.ImportSnapshotChunk(mavls.GetDB(), chunk, mavls.treeCfg))
+	case types.EventStoreCheckSnapshot:
+		req := msg.GetData().(*types.ReqHash)
+		msg.ReplyErr("CheckSnapshot", mavl.CheckSnapshot(mavls.GetDB(), req.Hash, mavls.treeCfg))
+	case types.EventStoreCheckState:
+		req := msg.GetData().(*types.StoreCheckState)
+		err := mavl.CheckState(mavls.GetDB(), req.StateHash, req.Height, mavls.treeCfg)
+		if err != nil {
+			msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreCheckState, err))
+			return
+		}
+		msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreCheckState, &types.Reply{IsOk: true}))
+	case types.EventStoreGetStateProof:
+		req := msg.GetData().(*types.ReqStateProof)
+		proof, err := mavl.GetStateProof(mavls.GetDB(), req.StateHash, req.Keys, mavls.treeCfg)
+		if err != nil {
+			mlog.Error("GetStateProof", "stateHash", common.ToHex(req.StateHash), "err", err)
+			msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreGetStateProof, err))
+			return
+		}
+		msg.Reply(mavls.GetQueueClient().NewMessage("", types.EventStoreGetStateProof, proof))
+	default:
+		msg.ReplyErr("Store", types.ErrActionNotSupport)
+	}
 }
 
 // Del ...
@@ -0,0 +1,182 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//Exporter 输出结束的span
+type Exporter interface {
+	Export(spans []*SpanData) error
+	Close() error
+}
+
+type fileExporter struct {
+	mu sync.Mutex
+	f  *os.File
+	w  *bufio.Writer
+}
+
+//NewFileExporter 把span 按照每行一个json 追加到文件中
+func NewFileExporter(file string) (Exporter, error) {
+	if file == "" {
+		file = "trace.json"
+	}
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileExporter{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+func (e *fileExporter) Export(spans []*SpanData) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	enc := json.NewEncoder(e.w)
+	for _, span := range spans {
+		if err := enc.Encode(span); err != nil {
+			return err
+		}
+	}
+	return e.w.Flush()
+}
+
+func (e *fileExporter) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err := e.w.Flush(); err != nil {
+		e.f.Close()
+		return err
+	}
+	return e.f.Close()
+}
+
+//otlp/http json 格式, 只包含用到的字段
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpScopeSpans struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResourceSpans struct {
+	Resource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	} `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+	otlpStatusError      = 2
+)
+
+type otlpExporter struct {
+	endpoint string
+	service  string
+	client   *http.Client
+}
+
+//NewOTLPExporter 通过otlp/http json 协议把span 发送到collector
+func NewOTLPExporter(endpoint, service string) Exporter {
+	if endpoint == "" {
+		endpoint = "http://localhost:4318/v1/traces"
+	}
+	return &otlpExporter{endpoint: endpoint, service: service, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func toOTLPAttrs(attrs []Attribute) []otlpAttribute {
+	var out []otlpAttribute
+	for _, attr := range attrs {
+		out = append(out, otlpAttribute{Key: attr.Key, Value: otlpValue{StringValue: attr.Value}})
+	}
+	return out
+}
+
+func (e *otlpExporter) encode(spans []*SpanData) ([]byte, error) {
+	var scope otlpScopeSpans
+	scope.Scope.Name = "turingchain"
+	for _, span := range spans {
+		s := otlpSpan{
+			TraceID:           span.TraceID,
+			SpanID:            span.SpanID,
+			ParentSpanID:      span.ParentID,
+			Name:              span.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+			Attributes:        toOTLPAttrs(span.Attrs),
+		}
+		if span.Err != "" {
+			s.Status = otlpStatus{Code: otlpStatusError, Message: span.Err}
+		}
+		scope.Spans = append(scope.Spans, s)
+	}
+	var rs otlpResourceSpans
+	rs.Resource.Attributes = toOTLPAttrs([]Attribute{{Key: "service.name", Value: e.service}})
+	rs.ScopeSpans = []otlpScopeSpans{scope}
+	return json.Marshal(&otlpRequest{ResourceSpans: []otlpResourceSpans{rs}})
+}
+
+func (e *otlpExporter) Export(spans []*SpanData) error {
+	data, err := e.encode(spans)
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("otlp collector return %s: %s", resp.Status, body)
+	}
+	return nil
+}
+
+func (e *otlpExporter) Close() error {
+	return nil
+}
@@ -0,0 +1,397 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package trace 跨模块的调用链跟踪, 跟踪上下文采用W3C traceparent 格式,
+// 可以随队列消息和rpc 请求传递, span 通过exporter 输出到文件或者otlp collector
+package trace
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+var (
+	tlog = log.New("module", "trace")
+	// ErrTraceParent traceparent 格式错误
+	ErrTraceParent = errors.New("ErrTraceParent")
+	// 当前生效的tracer, 未启动时为nil
+	current atomic.Value
+)
+
+//TraceID 调用链id
+type TraceID [16]byte
+
+//SpanID span id
+type SpanID [8]byte
+
+//String hex 编码
+func (id TraceID) String() string { return hex.EncodeToString(id[:]) }
+
+//String hex 编码
+func (id SpanID) String() string { return hex.EncodeToString(id[:]) }
+
+//SpanContext 在模块之间传递的跟踪上下文
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+//IsValid 是否是有效的跟踪上下文
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != TraceID{} && sc.SpanID != SpanID{}
+}
+
+//TraceParent 转换为W3C traceparent 格式
+func (sc SpanContext) TraceParent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return "00-" + sc.TraceID.String() + "-" + sc.SpanID.String() + "-" + flags
+}
+
+//ParseTraceParent 解析W3C traceparent 格式的跟踪上下文
+func ParseTraceParent(s string) (SpanContext, error) {
+	var sc SpanContext
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return SpanContext{}, ErrTraceParent
+	}
+	if _, err := hex.Decode(sc.TraceID[:], []byte(parts[1])); err != nil {
+		return SpanContext{}, ErrTraceParent
+	}
+	if _, err := hex.Decode(sc.SpanID[:], []byte(parts[2])); err != nil {
+		return SpanContext{}, ErrTraceParent
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil || !sc.IsValid() {
+		return SpanContext{}, ErrTraceParent
+	}
+	sc.Sampled = flags[0]&1 == 1
+	return sc, nil
+}
+
+//Attribute span 的属性
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+//SpanData 结束的span, 交给exporter 输出
+type SpanData struct {
+	Name     string      `json:"name"`
+	TraceID  string      `json:"traceId"`
+	SpanID   string      `json:"spanId"`
+	ParentID string      `json:"parentSpanId,omitempty"`
+	Start    time.Time   `json:"start"`
+	End      time.Time   `json:"end"`
+	Attrs    []Attribute `json:"attributes,omitempty"`
+	Err      string      `json:"error,omitempty"`
+}
+
+//Span 一次调用, nil 的span 表示没有被采样, 所有方法都可以安全调用
+type Span struct {
+	mu     sync.Mutex
+	t      *tracer
+	sc     SpanContext
+	parent SpanID
+	name   string
+	start  time.Time
+	attrs  []Attribute
+	err    string
+	ended  bool
+}
+
+//Context span 的跟踪上下文
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.sc
+}
+
+//SetAttr 设置属性
+func (s *Span) SetAttr(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.attrs = append(s.attrs, Attribute{Key: key, Value: fmt.Sprint(value)})
+	s.mu.Unlock()
+}
+
+//SetError 记录调用失败
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	s.err = err.Error()
+	s.mu.Unlock()
+}
+
+//End 结束span, 重复调用只输出一次
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	data := &SpanData{
+		Name:    s.name,
+		TraceID: s.sc.TraceID.String(),
+		SpanID:  s.sc.SpanID.String(),
+		Start:   s.start,
+		End:     time.Now(),
+		Attrs:   s.attrs,
+		Err:     s.err,
+	}
+	if s.parent != (SpanID{}) {
+		data.ParentID = s.parent.String()
+	}
+	s.mu.Unlock()
+	s.t.export(data)
+}
+
+//Finish 记录错误并结束span
+func (s *Span) Finish(err error) {
+	s.SetError(err)
+	s.End()
+}
+
+func getTracer() *tracer {
+	t, _ := current.Load().(*tracer)
+	return t
+}
+
+//Enabled 是否开启了调用链跟踪
+func Enabled() bool {
+	return getTracer() != nil
+}
+
+//StartSpan 开始一个span, parent 无效时按照采样比例新建调用链
+func StartSpan(parent SpanContext, name string) *Span {
+	t := getTracer()
+	if t == nil {
+		return nil
+	}
+	if !parent.IsValid() {
+		if !t.sample() {
+			return nil
+		}
+		return t.newSpan(t.newTraceID(), SpanID{}, name)
+	}
+	return StartChild(parent, name)
+}
+
+//StartChild 只在已经被采样的调用链上开始子span
+func StartChild(parent SpanContext, name string) *Span {
+	t := getTracer()
+	if t == nil || !parent.IsValid() || !parent.Sampled {
+		return nil
+	}
+	return t.newSpan(parent.TraceID, parent.SpanID, name)
+}
+
+type spanKey struct{}
+
+//NewContext 把span 保存到context 中
+func NewContext(ctx context.Context, s *Span) context.Context {
+	if s == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, spanKey{}, s)
+}
+
+//FromContext 从context 中获取span
+func FromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	s, _ := ctx.Value(spanKey{}).(*Span)
+	return s
+}
+
+//Start 根据配置启动调用链跟踪
+func Start(cfg *types.Trace) error {
+	if cfg == nil || !cfg.Enable {
+		tlog.Info("Trace is not enabled")
+		return nil
+	}
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = "turingchain"
+	}
+	var exporter Exporter
+	var err error
+	switch cfg.Exporter {
+	case "file":
+		exporter, err = NewFileExporter(cfg.File)
+	case "otlp":
+		exporter = NewOTLPExporter(cfg.Endpoint, serviceName)
+	default:
+		err = fmt.Errorf("trace exporter %s is not supported", cfg.Exporter)
+	}
+	if err != nil {
+		tlog.Error("Start", "exporter", cfg.Exporter, "err", err)
+		return err
+	}
+	sampleRate := cfg.SampleRate
+	if sampleRate <= 0 || sampleRate > 1 {
+		sampleRate = 1
+	}
+	tlog.Info("Start trace", "exporter", cfg.Exporter, "sampleRate", sampleRate)
+	Stop()
+	current.Store(newTracer(exporter, sampleRate))
+	return nil
+}
+
+//Stop 停止调用链跟踪, 输出缓存中的span
+func Stop() {
+	t := getTracer()
+	if t == nil {
+		return
+	}
+	current.Store((*tracer)(nil))
+	t.close()
+}
+
+const (
+	batchSize     = 512
+	queueSize     = 4096
+	flushInterval = time.Second
+)
+
+type tracer struct {
+	exporter   Exporter
+	sampleRate float64
+	mu         sync.Mutex
+	rand       *rand.Rand
+	spans      chan *SpanData
+	done       chan struct{}
+	closeOnce  sync.Once
+	wg         sync.WaitGroup
+}
+
+func newTracer(exporter Exporter, sampleRate float64) *tracer {
+	t := &tracer{
+		exporter:   exporter,
+		sampleRate: sampleRate,
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		spans:      make(chan *SpanData, queueSize),
+		done:       make(chan struct{}),
+	}
+	t.wg.Add(1)
+	go t.run()
+	return t
+}
+
+func (t *tracer) sample() bool {
+	if t.sampleRate >= 1 {
+		return true
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.rand.Float64() < t.sampleRate
+}
+
+func (t *tracer) newTraceID() (id TraceID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for id == (TraceID{}) {
+		t.rand.Read(id[:])
+	}
+	return id
+}
+
+func (t *tracer) newSpanID() (id SpanID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for id == (SpanID{}) {
+		t.rand.Read(id[:])
+	}
+	return id
+}
+
+func (t *tracer) newSpan(traceID TraceID, parent SpanID, name string) *Span {
+	return &Span{
+		t:      t,
+		sc:     SpanContext{TraceID: traceID, SpanID: t.newSpanID(), Sampled: true},
+		parent: parent,
+		name:   name,
+		start:  time.Now(),
+	}
+}
+
+func (t *tracer) export(data *SpanData) {
+	select {
+	case <-t.done:
+	case t.spans <- data:
+	default:
+		//导出速度跟不上时丢弃, 不影响正常流程
+		tlog.Debug("export span queue is full", "name", data.Name)
+	}
+}
+
+func (t *tracer) run() {
+	defer t.wg.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	batch := make([]*SpanData, 0, batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.exporter.Export(batch); err != nil {
+			tlog.Error("export spans", "count", len(batch), "err", err)
+		}
+		batch = make([]*SpanData, 0, batchSize)
+	}
+	for {
+		select {
+		case data := <-t.spans:
+			batch = append(batch, data)
+			if len(batch) >= batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-t.done:
+			for {
+				select {
+				case data := <-t.spans:
+					batch = append(batch, data)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (t *tracer) close() {
+	t.closeOnce.Do(func() {
+		close(t.done)
+		t.wg.Wait()
+		if err := t.exporter.Close(); err != nil {
+			tlog.Error("close exporter", "err", err)
+		}
+	})
+}
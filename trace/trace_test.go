@@ -0,0 +1,117 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package trace
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceParent(t *testing.T) {
+	sc, err := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.Nil(t, err)
+	assert.True(t, sc.IsValid())
+	assert.True(t, sc.Sampled)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", sc.TraceParent())
+
+	for _, s := range []string{"", "00-xyz-00f067aa0ba902b7-01", "00-00000000000000000000000000000000-00f067aa0ba902b7-01"} {
+		sc, err = ParseTraceParent(s)
+		assert.Equal(t, ErrTraceParent, err)
+		assert.False(t, sc.IsValid())
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	Stop()
+	assert.False(t, Enabled())
+	span := StartSpan(SpanContext{}, "noop")
+	assert.Nil(t, span)
+	//没有开启时所有方法都可以调用
+	span.SetAttr("k", "v")
+	span.Finish(errors.New("err"))
+	assert.False(t, span.Context().IsValid())
+}
+
+func readSpans(t *testing.T, file string) []*SpanData {
+	f, err := os.Open(file)
+	require.Nil(t, err)
+	defer f.Close()
+	var spans []*SpanData
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var span SpanData
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &span))
+		spans = append(spans, &span)
+	}
+	return spans
+}
+
+func TestFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trace")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "trace.json")
+	require.Nil(t, Start(&types.Trace{Enable: true, Exporter: "file", File: file}))
+	assert.True(t, Enabled())
+
+	root := StartSpan(SpanContext{}, "root")
+	require.NotNil(t, root)
+	child := StartChild(root.Context(), "child")
+	child.SetAttr("height", 10)
+	child.Finish(errors.New("ErrTest"))
+	root.End()
+	root.End()
+	//没有被采样的调用链不记录
+	assert.Nil(t, StartChild(SpanContext{TraceID: root.Context().TraceID, SpanID: root.Context().SpanID}, "unsampled"))
+	Stop()
+
+	spans := readSpans(t, file)
+	require.Equal(t, 2, len(spans))
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, root.Context().TraceID.String(), spans[0].TraceID)
+	assert.Equal(t, root.Context().SpanID.String(), spans[0].ParentID)
+	assert.Equal(t, []Attribute{{Key: "height", Value: "10"}}, spans[0].Attrs)
+	assert.Equal(t, "ErrTest", spans[0].Err)
+	assert.Equal(t, "root", spans[1].Name)
+	assert.Equal(t, "", spans[1].ParentID)
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var req otlpRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+	}))
+	defer server.Close()
+	require.Nil(t, Start(&types.Trace{Enable: true, Exporter: "otlp", Endpoint: server.URL, ServiceName: "test"}))
+	span := StartSpan(SpanContext{}, "send")
+	span.Finish(errors.New("ErrTest"))
+	Stop()
+
+	require.Equal(t, 1, len(req.ResourceSpans))
+	rs := req.ResourceSpans[0]
+	assert.Equal(t, "service.name", rs.Resource.Attributes[0].Key)
+	assert.Equal(t, "test", rs.Resource.Attributes[0].Value.StringValue)
+	require.Equal(t, 1, len(rs.ScopeSpans[0].Spans))
+	s := rs.ScopeSpans[0].Spans[0]
+	assert.Equal(t, "send", s.Name)
+	assert.Equal(t, span.Context().TraceID.String(), s.TraceID)
+	assert.Equal(t, otlpStatusError, s.Status.Code)
+
+	assert.NotNil(t, Start(&types.Trace{Enable: true, Exporter: "jaeger"}))
+	assert.False(t, Enabled())
+}
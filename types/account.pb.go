@@ -300,10 +300,12 @@ type ReqBalance struct {
 	//地址列表
 	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	//执行器名称
-	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
-	StateHash            string   `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
-	AssetExec            string   `protobuf:"bytes,4,opt,name=asset_exec,json=assetExec,proto3" json:"asset_exec,omitempty"`
-	AssetSymbol          string   `protobuf:"bytes,5,opt,name=asset_symbol,json=assetSymbol,proto3" json:"asset_symbol,omitempty"`
+	Execer      string `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	StateHash   string `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	AssetExec   string `protobuf:"bytes,4,opt,name=asset_exec,json=assetExec,proto3" json:"asset_exec,omitempty"`
+	AssetSymbol string `protobuf:"bytes,5,opt,name=asset_symbol,json=assetSymbol,proto3" json:"asset_symbol,omitempty"`
+	//查询指定高度的余额, 0 表示最新高度, 不能和stateHash 同时指定
+	Height               int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -369,6 +371,13 @@ func (m *ReqBalance) GetAssetSymbol() string {
 	return ""
 }
 
+func (m *ReqBalance) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Account 的列表
 type Accounts struct {
 	Acc                  []*Account `protobuf:"bytes,1,rep,name=acc,proto3" json:"acc,omitempty"`
@@ -507,10 +516,12 @@ type ReqAllExecBalance struct {
 	//地址列表
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	//执行器名称
-	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
-	StateHash            string   `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
-	AssetExec            string   `protobuf:"bytes,4,opt,name=asset_exec,json=assetExec,proto3" json:"asset_exec,omitempty"`
-	AssetSymbol          string   `protobuf:"bytes,5,opt,name=asset_symbol,json=assetSymbol,proto3" json:"asset_symbol,omitempty"`
+	Execer      string `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	StateHash   string `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	AssetExec   string `protobuf:"bytes,4,opt,name=asset_exec,json=assetExec,proto3" json:"asset_exec,omitempty"`
+	AssetSymbol string `protobuf:"bytes,5,opt,name=asset_symbol,json=assetSymbol,proto3" json:"asset_symbol,omitempty"`
+	//查询指定高度的余额, 0 表示最新高度, 不能和stateHash 同时指定
+	Height               int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -576,6 +587,13 @@ func (m *ReqAllExecBalance) GetAssetSymbol() string {
 	return ""
 }
 
+func (m *ReqAllExecBalance) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "types.Account")
 	proto.RegisterType((*ReceiptExecAccountTransfer)(nil), "types.ReceiptExecAccountTransfer")
@@ -594,33 +612,33 @@ func init() {
 }
 
 var fileDescriptor_8e28828dcb8d24f0 = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0x96, 0xf3, 0xd3, 0x74, 0x27, 0x50, 0x09, 0x1f, 0x2a, 0xab, 0xa2, 0x62, 0xf1, 0x69, 0x0f,
-	0x28, 0x91, 0x58, 0x5e, 0xa0, 0x91, 0x90, 0xb8, 0x20, 0x24, 0xc3, 0xa9, 0x17, 0xe4, 0x75, 0x27,
-	0x24, 0x22, 0xf5, 0x6e, 0x6d, 0x2f, 0x22, 0x3c, 0x00, 0xaf, 0x81, 0xc4, 0x93, 0x22, 0x4f, 0xbc,
-	0xcd, 0x86, 0x0a, 0xd4, 0x03, 0xa8, 0xb7, 0x9d, 0xef, 0x1b, 0xcf, 0xf7, 0xd9, 0x33, 0xb3, 0xf0,
-	0x58, 0x1b, 0x53, 0xb7, 0x36, 0xcc, 0x1a, 0x57, 0x87, 0x9a, 0x8f, 0xc3, 0xb6, 0x41, 0x2f, 0x3f,
-	0xc3, 0xe4, 0x62, 0x87, 0xf3, 0x33, 0x38, 0x36, 0xad, 0x73, 0x68, 0xcd, 0x56, 0xb0, 0x9c, 0x15,
-	0x63, 0x75, 0x1b, 0x73, 0x01, 0x93, 0x4a, 0x6f, 0xb4, 0x35, 0x28, 0x06, 0x39, 0x2b, 0x86, 0xaa,
-	0x0b, 0xf9, 0x29, 0x1c, 0x2d, 0x5d, 0xfd, 0x0d, 0xad, 0x18, 0x12, 0x91, 0x22, 0xce, 0x61, 0xa4,
-	0xaf, 0xae, 0x9c, 0x18, 0xe5, 0xac, 0xc8, 0x14, 0x7d, 0xcb, 0xef, 0x0c, 0xce, 0x14, 0x1a, 0x5c,
-	0x37, 0xe1, 0xf5, 0x57, 0x34, 0x49, 0xf8, 0x83, 0xd3, 0xd6, 0x2f, 0xd1, 0x45, 0x03, 0x18, 0xe1,
-	0x78, 0x8c, 0xd1, 0xb1, 0xdb, 0x98, 0x4b, 0x18, 0x35, 0x0e, 0xbf, 0x90, 0xfa, 0xf4, 0xe5, 0xc9,
-	0x8c, 0xdc, 0xcf, 0x52, 0x05, 0x45, 0x1c, 0x2f, 0x60, 0xb2, 0x33, 0x1c, 0xc8, 0xcb, 0xdd, 0xb4,
-	0x8e, 0x96, 0x4b, 0x38, 0x4d, 0x3e, 0x7e, 0xf7, 0xd0, 0xe9, 0xb0, 0xfb, 0xe9, 0x0c, 0xfe, 0xae,
-	0x53, 0x01, 0x3f, 0xd4, 0x79, 0xbb, 0xb6, 0xe1, 0x7f, 0x6b, 0x2c, 0x5a, 0x67, 0xff, 0xb1, 0xc6,
-	0x4f, 0x06, 0xa0, 0xf0, 0x66, 0x91, 0x7a, 0xfe, 0x14, 0xb2, 0xd8, 0x4f, 0xf4, 0x1e, 0xbd, 0x60,
-	0xf9, 0xb0, 0xc8, 0xd4, 0x1e, 0x88, 0x13, 0x11, 0xdb, 0x86, 0x8e, 0xaa, 0x66, 0x2a, 0x45, 0xf1,
-	0x94, 0x0f, 0x3a, 0xe0, 0x1b, 0xed, 0x57, 0xd4, 0xa0, 0x4c, 0xed, 0x01, 0x7e, 0x0e, 0xa0, 0xbd,
-	0xc7, 0xf0, 0x31, 0x66, 0xa7, 0xa9, 0xc9, 0x08, 0x89, 0xa3, 0xc2, 0x9f, 0xc3, 0xa3, 0x1d, 0xed,
-	0xb7, 0xd7, 0x55, 0xbd, 0x11, 0x63, 0x4a, 0x98, 0x12, 0xf6, 0x9e, 0x20, 0xf9, 0x02, 0x8e, 0x93,
-	0x71, 0xcf, 0x73, 0x18, 0x6a, 0x63, 0xc8, 0xdb, 0xdd, 0x6b, 0x45, 0x4a, 0xbe, 0x83, 0x69, 0x6f,
-	0x06, 0x7b, 0xa6, 0xd9, 0x81, 0xe9, 0x02, 0x26, 0x69, 0x6f, 0xfe, 0xf4, 0x46, 0x89, 0x96, 0x97,
-	0x70, 0x72, 0xb1, 0xd9, 0xc4, 0x9a, 0xdd, 0x33, 0x75, 0x2b, 0xc0, 0xf6, 0x2b, 0xc0, 0x5f, 0x1d,
-	0xc8, 0x8a, 0x01, 0x19, 0xe4, 0xa9, 0x66, 0x8f, 0x51, 0xfd, 0x34, 0xf9, 0x83, 0xc1, 0x13, 0x85,
-	0x37, 0xf7, 0xa8, 0xff, 0x40, 0x8f, 0xbf, 0x78, 0x76, 0x79, 0xfe, 0x69, 0x1d, 0x56, 0x6d, 0x35,
-	0x33, 0xf5, 0xf5, 0xbc, 0x2c, 0x8d, 0x9d, 0x9b, 0x95, 0x5e, 0xdb, 0xb2, 0x9c, 0xd3, 0xdd, 0xaa,
-	0x23, 0xfa, 0xed, 0x94, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x57, 0xdf, 0x86, 0xd3, 0x87, 0x04,
-	0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6e, 0xd4, 0x30,
+	0x10, 0x96, 0x37, 0xfb, 0xd3, 0xcc, 0x42, 0x25, 0x7c, 0xa8, 0xac, 0x0a, 0xa4, 0xe0, 0x53, 0x0e,
+	0x55, 0x5a, 0x2d, 0xbc, 0x40, 0x57, 0x42, 0xe2, 0x82, 0x90, 0x0c, 0xa7, 0x5e, 0x90, 0xe3, 0xce,
+	0x6e, 0x22, 0xb6, 0xce, 0xd6, 0x76, 0x10, 0xcb, 0x03, 0xf0, 0x4a, 0x48, 0x3c, 0x1d, 0xb2, 0xe3,
+	0x74, 0xb3, 0xfc, 0xa9, 0x07, 0x90, 0x7a, 0xcb, 0x7c, 0xdf, 0x78, 0xbe, 0x6f, 0x3c, 0xe3, 0xc0,
+	0x63, 0xa9, 0x54, 0xd3, 0x6a, 0x57, 0x6c, 0x4d, 0xe3, 0x1a, 0x3a, 0x71, 0xbb, 0x2d, 0x5a, 0xfe,
+	0x11, 0x66, 0x97, 0x1d, 0x4e, 0x4f, 0xe1, 0x48, 0xb5, 0xc6, 0xa0, 0x56, 0x3b, 0x46, 0x32, 0x92,
+	0x4f, 0xc4, 0x5d, 0x4c, 0x19, 0xcc, 0x4a, 0xb9, 0x91, 0x5a, 0x21, 0x1b, 0x65, 0x24, 0x4f, 0x44,
+	0x1f, 0xd2, 0x13, 0x98, 0xae, 0x4c, 0xf3, 0x05, 0x35, 0x4b, 0x02, 0x11, 0x23, 0x4a, 0x61, 0x2c,
+	0xaf, 0xaf, 0x0d, 0x1b, 0x67, 0x24, 0x4f, 0x45, 0xf8, 0xe6, 0x5f, 0x09, 0x9c, 0x0a, 0x54, 0x58,
+	0x6f, 0xdd, 0xab, 0xcf, 0xa8, 0xa2, 0xf0, 0x7b, 0x23, 0xb5, 0x5d, 0xa1, 0xf1, 0x06, 0xd0, 0xc3,
+	0xfe, 0x18, 0x09, 0xc7, 0xee, 0x62, 0xca, 0x61, 0xbc, 0x35, 0xf8, 0x29, 0xa8, 0xcf, 0x17, 0xc7,
+	0x45, 0x70, 0x5f, 0xc4, 0x0a, 0x22, 0x70, 0x34, 0x87, 0x59, 0x67, 0xd8, 0xb1, 0xe4, 0xb7, 0x69,
+	0x3d, 0xcd, 0x57, 0x70, 0x12, 0x7d, 0xfc, 0xec, 0xa1, 0xd7, 0x21, 0xf7, 0xd3, 0x19, 0xfd, 0x5d,
+	0xa7, 0x04, 0x7a, 0xa8, 0xf3, 0xa6, 0xd6, 0xee, 0x7f, 0x6b, 0x2c, 0x5b, 0xa3, 0xff, 0xb1, 0xc6,
+	0x77, 0x02, 0x20, 0xf0, 0x76, 0x19, 0x67, 0xfe, 0x14, 0x52, 0x3f, 0x4f, 0xb4, 0x16, 0x2d, 0x23,
+	0x59, 0x92, 0xa7, 0x62, 0x0f, 0xf8, 0x8d, 0xf0, 0x63, 0x43, 0x13, 0xaa, 0xa6, 0x22, 0x46, 0xfe,
+	0x94, 0x75, 0xd2, 0xe1, 0x6b, 0x69, 0xab, 0x30, 0xa0, 0x54, 0xec, 0x01, 0xfa, 0x0c, 0x40, 0x5a,
+	0x8b, 0xee, 0x83, 0xcf, 0x8e, 0x5b, 0x93, 0x06, 0xc4, 0xaf, 0x0a, 0x7d, 0x0e, 0x8f, 0x3a, 0xda,
+	0xee, 0x6e, 0xca, 0x66, 0xc3, 0x26, 0x21, 0x61, 0x1e, 0xb0, 0x77, 0x01, 0xf2, 0xba, 0x15, 0xd6,
+	0xeb, 0xca, 0xb1, 0x69, 0xb7, 0x89, 0x5d, 0xc4, 0xcf, 0xe0, 0x28, 0x36, 0x64, 0x69, 0x06, 0x89,
+	0x54, 0x2a, 0x78, 0xfe, 0xb5, 0x5d, 0x4f, 0xf1, 0xb7, 0x30, 0x1f, 0xec, 0xe6, 0xa0, 0x19, 0x72,
+	0xd0, 0x4c, 0x0e, 0xb3, 0xf8, 0x9e, 0xfe, 0x74, 0x77, 0x91, 0xe6, 0x57, 0x70, 0x7c, 0xb9, 0xd9,
+	0xf8, 0x9a, 0xfd, 0xf5, 0xf5, 0x4f, 0x83, 0xec, 0x9f, 0x06, 0x7d, 0x79, 0x20, 0xcb, 0x46, 0xc1,
+	0x20, 0x8d, 0x35, 0x07, 0x8c, 0x18, 0xa6, 0xf1, 0x6f, 0x04, 0x9e, 0x08, 0xbc, 0xbd, 0x47, 0xfd,
+	0x07, 0x36, 0x94, 0x65, 0x71, 0x75, 0xb6, 0xae, 0x5d, 0xd5, 0x96, 0x85, 0x6a, 0x6e, 0xce, 0x5d,
+	0x6b, 0x6a, 0xbd, 0x56, 0x95, 0xac, 0xf5, 0xe2, 0x62, 0x71, 0x31, 0x8c, 0xcf, 0xc3, 0x15, 0x94,
+	0xd3, 0xf0, 0xd7, 0x7a, 0xf1, 0x63, 0x00, 0xed, 0xea, 0x85, 0xaa, 0xc6, 0x04, 0x00, 0x00,
 }
@@ -65,6 +65,7 @@ func (block *Block) GetHeader(cfg *TuringchainConfig) *Header {
 	head.StateHash = block.StateHash
 	head.TxCount = int64(len(block.Txs))
 	head.Hash = block.Hash(cfg)
+	head.ConsensusProof = block.ConsensusProof
 	return head
 }
 
@@ -258,6 +259,48 @@ func (header *Header) Size() int {
 	return Size(header)
 }
 
+// LightHeaderCheck 共识对区块头的检查规则, 例如出块节点和难度, parent 是已经验证过的父区块头
+type LightHeaderCheck func(cfg *TuringchainConfig, parent, header *Header) error
+
+var lightHeaderChecks = make(map[string]LightHeaderCheck)
+
+// RegisterLightHeaderCheck 共识插件注册轻节点验证区块头的规则, name 为共识的名字
+func RegisterLightHeaderCheck(name string, check LightHeaderCheck) {
+	if check == nil {
+		panic("RegisterLightHeaderCheck: check is nil")
+	}
+	if _, dup := lightHeaderChecks[name]; dup {
+		panic("RegisterLightHeaderCheck: called twice for " + name)
+	}
+	lightHeaderChecks[name] = check
+}
+
+// GetLightHeaderCheck 获取共识注册的轻节点验证区块头的规则
+func GetLightHeaderCheck(name string) (LightHeaderCheck, bool) {
+	check, ok := lightHeaderChecks[name]
+	return check, ok
+}
+
+// CalcHash 通过区块头计算区块hash, 和对应区块的Hash 一致, 轻节点验证区块头时使用
+func (header *Header) CalcHash(cfg *TuringchainConfig) []byte {
+	head := &Header{}
+	head.Version = header.Version
+	head.ParentHash = header.ParentHash
+	head.TxHash = header.TxHash
+	head.BlockTime = header.BlockTime
+	head.Height = header.Height
+	if cfg.IsFork(header.Height, "ForkBlockHash") {
+		head.Difficulty = header.Difficulty
+		head.StateHash = header.StateHash
+		head.TxCount = header.TxCount
+	}
+	data, err := proto.Marshal(head)
+	if err != nil {
+		panic(err)
+	}
+	return common.Sha256(data)
+}
+
 // Size 获取paraTxDetail的Size
 func (paraTxDetail *ParaTxDetail) Size() int {
 	return Size(paraTxDetail)
@@ -31,6 +31,7 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 // 	 txCount : 区块上所有交易个数
 //	 difficulty :区块难度系数，
 //	 signature :交易签名
+//	 consensusProof :共识证明, 例如bft 的提交证明, 不参与区块hash 的计算
 type Header struct {
 	Version              int64      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	ParentHash           []byte     `protobuf:"bytes,2,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
@@ -42,6 +43,7 @@ type Header struct {
 	Hash                 []byte     `protobuf:"bytes,10,opt,name=hash,proto3" json:"hash,omitempty"`
 	Difficulty           uint32     `protobuf:"varint,11,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
 	Signature            *Signature `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	ConsensusProof       []byte     `protobuf:"bytes,12,opt,name=consensusProof,proto3" json:"consensusProof,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -142,6 +144,13 @@ func (m *Header) GetSignature() *Signature {
 	return nil
 }
 
+func (m *Header) GetConsensusProof() []byte {
+	if m != nil {
+		return m.ConsensusProof
+	}
+	return nil
+}
+
 //  参考Header解释
 // mainHash 平行链上使用的字段，代表这个区块的主链hash
 type Block struct {
@@ -156,6 +165,7 @@ type Block struct {
 	MainHeight           int64          `protobuf:"varint,13,opt,name=mainHeight,proto3" json:"mainHeight,omitempty"`
 	Signature            *Signature     `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
 	Txs                  []*Transaction `protobuf:"bytes,7,rep,name=txs,proto3" json:"txs,omitempty"`
+	ConsensusProof       []byte         `protobuf:"bytes,14,opt,name=consensusProof,proto3" json:"consensusProof,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -263,6 +273,13 @@ func (m *Block) GetTxs() []*Transaction {
 	return nil
 }
 
+func (m *Block) GetConsensusProof() []byte {
+	if m != nil {
+		return m.ConsensusProof
+	}
+	return nil
+}
+
 type Blocks struct {
 	Items                []*Block `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -697,10 +714,12 @@ func (m *BlockDetail) GetPrevStatusHash() []byte {
 }
 
 type Receipts struct {
-	Receipts             []*Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Receipts []*Receipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	//区块开始时执行器修改状态的收据, 不属于区块中的任何交易
+	BlockBegin           *Receipt `protobuf:"bytes,2,opt,name=blockBegin,proto3" json:"blockBegin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Receipts) Reset()         { *m = Receipts{} }
@@ -735,6 +754,13 @@ func (m *Receipts) GetReceipts() []*Receipt {
 	return nil
 }
 
+func (m *Receipts) GetBlockBegin() *Receipt {
+	if m != nil {
+		return m.BlockBegin
+	}
+	return nil
+}
+
 type ReceiptCheckTxList struct {
 	Errs                 []string `protobuf:"bytes,1,rep,name=errs,proto3" json:"errs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1210,7 +1236,9 @@ type ChainExecutor struct {
 	StateHash []byte `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
 	Param     []byte `protobuf:"bytes,4,opt,name=param,proto3" json:"param,omitempty"`
 	//扩展字段，用于额外的用途
-	Extra                []byte   `protobuf:"bytes,5,opt,name=extra,proto3" json:"extra,omitempty"`
+	Extra []byte `protobuf:"bytes,5,opt,name=extra,proto3" json:"extra,omitempty"`
+	//查询指定高度的状态, 0 表示最新高度, 不能和stateHash 同时指定
+	Height               int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1276,6 +1304,13 @@ func (m *ChainExecutor) GetExtra() []byte {
 	return nil
 }
 
+func (m *ChainExecutor) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 //  通过block hash记录block的操作类型及add/del：1/2
 type BlockSequence struct {
 	Hash                 []byte   `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
@@ -2899,118 +2934,120 @@ func init() {
 }
 
 var fileDescriptor_e9ac6287ce250c9a = []byte{
-	// 1800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5f, 0x8f, 0x1b, 0x49,
-	0x11, 0xd7, 0xcc, 0xd8, 0x5e, 0xbb, 0xbc, 0xde, 0xdb, 0x0c, 0x2b, 0xb0, 0x56, 0x70, 0xb7, 0xd7,
-	0xe4, 0x82, 0x09, 0xc1, 0x41, 0xbb, 0xa7, 0x5c, 0x14, 0x90, 0xe0, 0xe2, 0x04, 0xed, 0x2a, 0x77,
-	0xb9, 0x30, 0xbb, 0x09, 0x12, 0x6f, 0xb3, 0xe3, 0x5e, 0x7b, 0x58, 0xcf, 0x1f, 0x4f, 0xf7, 0x2c,
-	0xf6, 0x3d, 0xf1, 0x8c, 0xc4, 0x17, 0x41, 0x7c, 0x0c, 0x5e, 0x78, 0xb9, 0xaf, 0xc0, 0x57, 0x41,
-	0x55, 0xdd, 0x3d, 0xd3, 0xe3, 0x78, 0x43, 0x22, 0xc4, 0x03, 0x6f, 0x5d, 0xd5, 0x55, 0x5d, 0xbf,
-	0xaa, 0xae, 0xaa, 0xae, 0x19, 0xd8, 0xbf, 0x5c, 0x64, 0xd1, 0x75, 0x34, 0x0f, 0xe3, 0x74, 0x9c,
-	0x17, 0x99, 0xcc, 0xfc, 0xb6, 0x5c, 0xe7, 0x5c, 0x1c, 0xde, 0x91, 0x45, 0x98, 0x8a, 0x30, 0x92,
-	0x71, 0xa6, 0x77, 0x0e, 0x77, 0xa3, 0x2c, 0x49, 0x0c, 0xc5, 0xfe, 0xee, 0x42, 0xe7, 0x94, 0x87,
-	0x53, 0x5e, 0xf8, 0x43, 0xd8, 0xb9, 0xe1, 0x85, 0x88, 0xb3, 0x74, 0xe8, 0x1c, 0x39, 0x23, 0x2f,
-	0x30, 0xa4, 0xff, 0x31, 0x40, 0x1e, 0x16, 0x3c, 0x95, 0xa7, 0xa1, 0x98, 0x0f, 0xdd, 0x23, 0x67,
-	0xb4, 0x1b, 0x58, 0x1c, 0xff, 0xfb, 0xd0, 0x91, 0x2b, 0xda, 0xf3, 0x68, 0x4f, 0x53, 0xfe, 0x0f,
-	0xa1, 0x27, 0x64, 0x28, 0x39, 0x6d, 0xb5, 0x68, 0xab, 0x66, 0xa0, 0xd6, 0x9c, 0xc7, 0xb3, 0xb9,
-	0x1c, 0xb6, 0xc9, 0x9c, 0xa6, 0x50, 0x8b, 0xdc, 0xb9, 0x88, 0x13, 0x3e, 0xec, 0xd0, 0x56, 0xcd,
-	0x40, 0x94, 0x72, 0x35, 0xc9, 0xca, 0x54, 0x0e, 0x7b, 0x0a, 0xa5, 0x26, 0x7d, 0x1f, 0x5a, 0x73,
-	0x34, 0x04, 0x64, 0x88, 0xd6, 0x88, 0x7c, 0x1a, 0x5f, 0x5d, 0xc5, 0x51, 0xb9, 0x90, 0xeb, 0x61,
-	0xff, 0xc8, 0x19, 0x0d, 0x02, 0x8b, 0xe3, 0x8f, 0xa1, 0x27, 0xe2, 0x59, 0x1a, 0xca, 0xb2, 0xe0,
-	0xc3, 0xee, 0x91, 0x33, 0xea, 0x1f, 0xef, 0x8f, 0x29, 0x74, 0xe3, 0x73, 0xc3, 0x0f, 0x6a, 0x11,
-	0xf6, 0x2f, 0x17, 0xda, 0x4f, 0x11, 0xcb, 0xff, 0x49, 0xb4, 0xfe, 0x93, 0xff, 0x87, 0xd0, 0x4d,
-	0xc2, 0x38, 0x25, 0x93, 0xbb, 0x64, 0xb2, 0xa2, 0x51, 0x97, 0xd6, 0xca, 0xea, 0x80, 0x8e, 0xb6,
-	0x38, 0x1f, 0x1a, 0x3b, 0xff, 0x2e, 0x78, 0x72, 0x25, 0x86, 0x3b, 0x47, 0xde, 0xa8, 0x7f, 0xec,
-	0x6b, 0xc9, 0x8b, 0x3a, 0x3f, 0x03, 0xdc, 0x66, 0x0f, 0xa0, 0x43, 0x01, 0x16, 0x3e, 0x83, 0x76,
-	0x2c, 0x79, 0x22, 0x86, 0x0e, 0x69, 0xec, 0x6a, 0x0d, 0xda, 0x0d, 0xd4, 0x16, 0xcb, 0xa1, 0x4b,
-	0xf4, 0x39, 0x5f, 0xfa, 0xfb, 0xe0, 0xa5, 0x65, 0xa2, 0x6f, 0x03, 0x97, 0xfe, 0x3d, 0xf0, 0x04,
-	0x5f, 0xd2, 0x15, 0xf4, 0x8f, 0x0f, 0x6c, 0xfd, 0x73, 0xbe, 0x2c, 0x79, 0x1a, 0xf1, 0x00, 0x05,
-	0xfc, 0xfb, 0xd0, 0x99, 0x72, 0x19, 0xc6, 0x0b, 0xba, 0x91, 0x1a, 0x1c, 0x89, 0x3e, 0xa3, 0x9d,
-	0x40, 0x4b, 0xb0, 0x5f, 0x40, 0xcf, 0x9c, 0x20, 0xfc, 0x1f, 0x43, 0x4b, 0xf0, 0xa5, 0x41, 0xf8,
-	0xd1, 0x86, 0x85, 0x80, 0x36, 0xd9, 0x6f, 0x34, 0xc6, 0x57, 0xf1, 0x14, 0x31, 0xe6, 0xf1, 0x94,
-	0x30, 0xf6, 0x02, 0x5c, 0xa2, 0x97, 0x74, 0x5d, 0x1a, 0xe5, 0x86, 0x97, 0xb4, 0xc5, 0x1e, 0xc3,
-	0xae, 0x05, 0x45, 0xf8, 0xa3, 0x66, 0x64, 0xb6, 0xc1, 0xd5, 0xf1, 0x19, 0xc3, 0x8e, 0xaa, 0x6e,
-	0xc4, 0xda, 0x50, 0x1a, 0x68, 0x25, 0xb5, 0x6d, 0xe4, 0x4f, 0x01, 0xb4, 0xfc, 0x76, 0xb4, 0x23,
-	0xd8, 0x99, 0xab, 0x7d, 0x8d, 0x77, 0xaf, 0x71, 0x8c, 0x08, 0xcc, 0x36, 0x9b, 0xc3, 0x80, 0xf0,
-	0x7c, 0x73, 0xc3, 0x8b, 0x9b, 0x98, 0xff, 0xc9, 0xff, 0x14, 0x5a, 0xb8, 0x47, 0xa7, 0xbd, 0x65,
-	0x9e, 0xb6, 0xec, 0xda, 0x76, 0x9b, 0xb5, 0x7d, 0x08, 0x5d, 0x55, 0x25, 0x5c, 0x0c, 0xbd, 0x23,
-	0x0f, 0xf3, 0xd4, 0xd0, 0xec, 0x6f, 0x0e, 0xf4, 0x2d, 0xd7, 0xeb, 0x88, 0x3a, 0xb7, 0x46, 0xd4,
-	0x1f, 0x43, 0xb7, 0xe0, 0x11, 0x8f, 0x73, 0x89, 0x8e, 0xd8, 0x41, 0x0c, 0x14, 0xfb, 0x59, 0x28,
-	0xc3, 0xa0, 0x92, 0xf1, 0x3f, 0x01, 0xf7, 0xc5, 0x1b, 0xb2, 0x5c, 0x5f, 0xf3, 0x0b, 0xbe, 0x7e,
-	0x13, 0x2e, 0x4a, 0x1e, 0xb8, 0x2f, 0xde, 0xf8, 0xf7, 0x60, 0x2f, 0x2f, 0xf8, 0xcd, 0xb9, 0x0c,
-	0x65, 0x29, 0xac, 0x0a, 0xde, 0xe0, 0xb2, 0x47, 0xd0, 0x0d, 0xcc, 0xa1, 0xf7, 0x2d, 0x10, 0xea,
-	0x52, 0xf6, 0x9a, 0x20, 0x6a, 0x00, 0x6c, 0x04, 0xbe, 0x66, 0x4e, 0xe6, 0x3c, 0xba, 0xbe, 0x58,
-	0x7d, 0x15, 0x0b, 0x6a, 0x79, 0xbc, 0x28, 0x94, 0x76, 0x2f, 0xa0, 0x35, 0x5b, 0x43, 0x7f, 0x82,
-	0x0f, 0x81, 0x32, 0xea, 0xdf, 0x85, 0x41, 0x54, 0x16, 0xd4, 0x7c, 0x54, 0x21, 0xab, 0xfa, 0x68,
-	0x32, 0xfd, 0x23, 0xe8, 0x27, 0x3c, 0xc9, 0xb3, 0x6c, 0x71, 0x1e, 0x7f, 0xcb, 0x75, 0xf4, 0x6d,
-	0x96, 0xcf, 0x60, 0x37, 0x11, 0xb3, 0xdf, 0x95, 0xbc, 0xe4, 0x24, 0xe2, 0x91, 0x48, 0x83, 0xc7,
-	0x42, 0xe8, 0x05, 0x7c, 0xa9, 0xcb, 0xf7, 0x00, 0xda, 0x42, 0x86, 0x85, 0x31, 0xa8, 0x08, 0x4c,
-	0x29, 0x9e, 0x4e, 0xb5, 0x01, 0x5c, 0xe2, 0xd5, 0xc6, 0xe2, 0x59, 0x5d, 0x7e, 0xdd, 0xa0, 0xa2,
-	0x4d, 0x02, 0xb6, 0xc8, 0x3d, 0x5c, 0xb2, 0x4f, 0xa1, 0xff, 0xb5, 0x85, 0xca, 0x87, 0x96, 0x40,
-	0x34, 0xca, 0x06, 0xad, 0xd9, 0x7d, 0xd8, 0x0f, 0x78, 0xbe, 0x58, 0x13, 0x0e, 0xed, 0x5f, 0xdd,
-	0x3d, 0x1d, 0xbb, 0x7b, 0xb2, 0x7f, 0x3a, 0xba, 0x9c, 0x9f, 0x66, 0xd3, 0xb5, 0xe9, 0x50, 0xce,
-	0x3b, 0x3b, 0xd4, 0x07, 0xe7, 0x8e, 0xdd, 0x63, 0xbd, 0x77, 0xf6, 0xd8, 0xd6, 0x5b, 0x3d, 0xd6,
-	0xbc, 0x69, 0x6d, 0xeb, 0x4d, 0xab, 0x7d, 0xe9, 0x34, 0x7c, 0xf9, 0xa3, 0xee, 0x12, 0x1a, 0x45,
-	0x03, 0xa7, 0xf3, 0x1e, 0x38, 0x8d, 0x2d, 0x77, 0xab, 0x2d, 0xaf, 0x61, 0xeb, 0x01, 0xc0, 0x99,
-	0x98, 0x84, 0xe5, 0x6c, 0x2e, 0x5f, 0xe7, 0xe8, 0xc5, 0x99, 0x88, 0x88, 0x2a, 0x73, 0x8a, 0x70,
-	0x37, 0xb0, 0x38, 0xec, 0x31, 0xec, 0x9d, 0x89, 0x97, 0x32, 0x9f, 0x50, 0x63, 0x5c, 0xa7, 0x11,
-	0x96, 0x4b, 0x2c, 0x52, 0x99, 0x47, 0x94, 0x2b, 0xeb, 0x34, 0xd2, 0x5a, 0x1b, 0x5c, 0xf6, 0x57,
-	0x07, 0x06, 0x94, 0xcd, 0xcf, 0x57, 0x3c, 0x2a, 0x65, 0x56, 0x20, 0xa2, 0x69, 0x11, 0xdf, 0xf0,
-	0x42, 0xb7, 0x25, 0x4d, 0x61, 0x94, 0xaf, 0xca, 0x34, 0x7a, 0x19, 0x26, 0x2a, 0x7d, 0x7b, 0x41,
-	0x45, 0x37, 0x5f, 0x56, 0x6f, 0xf3, 0x65, 0x3d, 0x80, 0x76, 0x1e, 0x16, 0x61, 0xa2, 0x2b, 0x56,
-	0x11, 0xc8, 0xe5, 0x2b, 0x59, 0x84, 0x3a, 0xf4, 0x8a, 0x60, 0x5f, 0xe8, 0xae, 0x66, 0xde, 0x0f,
-	0x0c, 0x1a, 0x9d, 0xea, 0xa8, 0xa0, 0xd1, 0x81, 0x3e, 0xb4, 0x2e, 0xd6, 0xb9, 0xa9, 0x22, 0x5a,
-	0xb3, 0x5f, 0xc1, 0x5e, 0x43, 0x11, 0xab, 0xbf, 0xd1, 0x8f, 0xb7, 0x3f, 0x4f, 0xba, 0x2d, 0xff,
-	0xd9, 0x81, 0x83, 0x57, 0x61, 0x11, 0x52, 0x28, 0xec, 0x5e, 0xf7, 0x39, 0xf4, 0xa9, 0xa1, 0xe9,
-	0xe7, 0xcb, 0xb9, 0xf5, 0xf9, 0xb2, 0xc5, 0x30, 0x56, 0x42, 0x5b, 0xd0, 0x20, 0x2b, 0x1a, 0xe3,
-	0x1b, 0x0b, 0xbc, 0x23, 0x5d, 0x8c, 0x9a, 0x62, 0x4f, 0x60, 0x80, 0x08, 0x2e, 0x56, 0xe6, 0x11,
-	0xfa, 0x69, 0x13, 0xff, 0xf7, 0xb4, 0x51, 0x5b, 0xc8, 0xc0, 0xff, 0x87, 0x03, 0xbb, 0x36, 0x1f,
-	0x23, 0x84, 0xd2, 0xa6, 0x6c, 0x71, 0xed, 0x7f, 0x86, 0xa9, 0x86, 0x8f, 0x81, 0x7e, 0x59, 0x36,
-	0x5e, 0x08, 0xbd, 0xe9, 0xff, 0x1c, 0x7a, 0xd2, 0x60, 0xd8, 0x68, 0xc8, 0x95, 0xd9, 0x5a, 0x02,
-	0xaf, 0x3e, 0x9a, 0xc7, 0x8b, 0xa9, 0x3d, 0x54, 0x55, 0x0c, 0xbc, 0xe4, 0x38, 0x9d, 0xf2, 0x15,
-	0x5d, 0xf2, 0x20, 0x50, 0x04, 0x86, 0x20, 0x2f, 0xb2, 0xec, 0x4a, 0x0c, 0x3b, 0xf4, 0xd4, 0x68,
-	0x8a, 0xfd, 0xc5, 0x81, 0x6e, 0xe5, 0x42, 0xa5, 0xea, 0xd8, 0xaa, 0x0c, 0x5c, 0xb9, 0xd2, 0x0e,
-	0x6c, 0x6b, 0x20, 0xae, 0x5c, 0xf9, 0x0f, 0x60, 0x47, 0xd7, 0xdc, 0xc6, 0xb8, 0x61, 0x97, 0xa5,
-	0x11, 0xb1, 0xc0, 0xb4, 0x1a, 0x60, 0xae, 0xb0, 0xcb, 0x2d, 0x55, 0x54, 0x9f, 0xae, 0x2f, 0x62,
-	0xb9, 0xe0, 0xef, 0xdd, 0x72, 0x0f, 0xa0, 0x2d, 0x51, 0x81, 0xec, 0xf7, 0x02, 0x45, 0x90, 0x47,
-	0xe2, 0x9c, 0x2f, 0x29, 0x4c, 0xdd, 0x40, 0x11, 0xec, 0x06, 0xe0, 0xb7, 0xf1, 0x82, 0xeb, 0x6f,
-	0x84, 0x23, 0xe8, 0xd3, 0xa1, 0x8d, 0xb7, 0xc4, 0x66, 0x59, 0xf5, 0xe9, 0x36, 0xea, 0x73, 0xbb,
-	0x4d, 0x7c, 0xf1, 0xb9, 0x90, 0x2f, 0xb9, 0xd4, 0x56, 0x0d, 0x89, 0x0f, 0xe5, 0xf3, 0x74, 0xaa,
-	0x66, 0xed, 0x5b, 0xba, 0xf7, 0xb6, 0x8e, 0xc5, 0x16, 0xd0, 0x53, 0x58, 0xff, 0xbb, 0x91, 0xb0,
-	0xce, 0x46, 0xef, 0x1d, 0xd9, 0xc8, 0x8e, 0xcd, 0xbc, 0x44, 0xe3, 0xe0, 0xdd, 0xc6, 0x38, 0xb8,
-	0xdf, 0x50, 0xa9, 0xe7, 0xc1, 0xef, 0x1c, 0x54, 0x42, 0x07, 0xf0, 0xf6, 0x6e, 0x75, 0xae, 0x0a,
-	0x98, 0x6b, 0x07, 0xcc, 0xb8, 0xec, 0x59, 0x4d, 0xfa, 0xdd, 0x39, 0xfe, 0x31, 0x00, 0xdd, 0xcf,
-	0x59, 0x95, 0xe8, 0xed, 0xc0, 0xe2, 0x60, 0x2b, 0xae, 0x84, 0x95, 0x4c, 0x87, 0x32, 0x7a, 0x83,
-	0x6b, 0x0f, 0x67, 0x3b, 0x74, 0x88, 0x21, 0xd9, 0x23, 0xe8, 0xd7, 0xfe, 0x08, 0xff, 0x27, 0xcd,
-	0xc6, 0x70, 0xa7, 0x0a, 0x83, 0x11, 0x31, 0x6d, 0xe1, 0x5b, 0x80, 0x09, 0xda, 0xa0, 0xae, 0x56,
-	0xfb, 0xeb, 0xd8, 0xfe, 0x36, 0xd1, 0xbb, 0x6f, 0xa1, 0x6f, 0xf8, 0xee, 0x6d, 0xfa, 0x6e, 0x61,
-	0x6e, 0x35, 0x31, 0x4b, 0x2a, 0x1f, 0x85, 0xc9, 0x94, 0xcf, 0x87, 0xdd, 0xc4, 0x01, 0xb4, 0x23,
-	0x3a, 0xd9, 0xa3, 0x93, 0x15, 0x81, 0x78, 0xa6, 0x71, 0xc1, 0xa9, 0xda, 0xb5, 0xcd, 0x9a, 0xc1,
-	0x02, 0x9c, 0xe2, 0xf2, 0xc5, 0xba, 0x69, 0x77, 0xbb, 0xe7, 0xf7, 0x4c, 0x18, 0xdd, 0x46, 0x36,
-	0x51, 0xae, 0x9e, 0xa5, 0x57, 0x99, 0x89, 0xe2, 0x17, 0x7a, 0x82, 0x41, 0xde, 0x07, 0x55, 0xca,
-	0xaf, 0xe1, 0x8e, 0xd5, 0x41, 0x4e, 0x2b, 0x5f, 0xeb, 0xcb, 0xf3, 0xb4, 0x8d, 0xed, 0x11, 0x60,
-	0xa7, 0xd0, 0x9d, 0x24, 0xb9, 0x2a, 0xd1, 0xf7, 0x19, 0xba, 0x87, 0xb0, 0x13, 0x25, 0xb9, 0xf5,
-	0x55, 0x6c, 0x48, 0xf6, 0x39, 0x40, 0x35, 0x85, 0x89, 0xda, 0x73, 0xe7, 0x6d, 0xcf, 0x51, 0xc2,
-	0x78, 0xfe, 0x08, 0x76, 0x27, 0xf3, 0x32, 0xc5, 0x81, 0x27, 0x2b, 0xa6, 0x4a, 0x2f, 0xbd, 0xca,
-	0x36, 0xf5, 0x48, 0x46, 0x47, 0x0c, 0xb7, 0xd9, 0x85, 0xd6, 0x43, 0xde, 0xd7, 0x62, 0xa6, 0x72,
-	0xa8, 0x4c, 0xaf, 0xad, 0x87, 0xbc, 0x66, 0xd4, 0x4d, 0xd5, 0xdd, 0xd2, 0x54, 0xbd, 0xaa, 0xa9,
-	0xb2, 0x04, 0x7a, 0xd5, 0xa9, 0xf8, 0xc2, 0xd2, 0x09, 0x2f, 0xab, 0xee, 0x53, 0xd1, 0x4d, 0x73,
-	0xee, 0xad, 0xe6, 0xbc, 0x2d, 0xe6, 0x5a, 0xb5, 0xb9, 0x19, 0x7c, 0x14, 0xf0, 0x65, 0xc3, 0xff,
-	0xff, 0xcd, 0xc4, 0xfd, 0x9d, 0x0b, 0xfb, 0xaf, 0x4a, 0x31, 0x3f, 0x2f, 0x2f, 0x45, 0x54, 0xc4,
-	0x97, 0x3c, 0xe0, 0x4b, 0xcc, 0xa7, 0x14, 0x27, 0x2d, 0x95, 0xb1, 0xb4, 0x46, 0xd5, 0xd7, 0xc1,
-	0x57, 0x3a, 0x45, 0x70, 0x89, 0xd9, 0xc8, 0xd3, 0x28, 0x9b, 0x9a, 0xa6, 0xaf, 0x29, 0xfc, 0x96,
-	0x58, 0x84, 0x42, 0x9a, 0x8e, 0xab, 0xdd, 0x6a, 0xf0, 0xb0, 0xf0, 0x91, 0x3e, 0xb5, 0xff, 0x79,
-	0x58, 0x1c, 0xfc, 0xae, 0x41, 0x4a, 0x0d, 0xf9, 0x18, 0xc9, 0x0e, 0x99, 0x68, 0x32, 0xab, 0x41,
-	0x43, 0x75, 0x2c, 0x35, 0x68, 0x7c, 0x09, 0xdd, 0x28, 0x4b, 0x65, 0x11, 0x46, 0x72, 0xd8, 0xa5,
-	0x4c, 0xf9, 0xcc, 0xcc, 0x2e, 0x1b, 0x6e, 0x8e, 0x27, 0x5a, 0xee, 0x79, 0x2a, 0x8b, 0x75, 0x50,
-	0xa9, 0x1d, 0xfe, 0x12, 0x06, 0x8d, 0x2d, 0xf4, 0xfd, 0x9a, 0xaf, 0xcd, 0x97, 0xf2, 0x35, 0x5f,
-	0xe3, 0x65, 0xdc, 0xe0, 0xd7, 0x21, 0xc5, 0xa3, 0x1b, 0x28, 0xe2, 0x89, 0xfb, 0xd8, 0x61, 0xaf,
-	0x61, 0x0f, 0x0d, 0xfd, 0x3e, 0x96, 0x73, 0xfd, 0x8d, 0xf6, 0x33, 0x68, 0xe5, 0xa5, 0xce, 0xbd,
-	0xfe, 0xf1, 0x0f, 0x6e, 0x41, 0x13, 0x90, 0x10, 0x06, 0x55, 0x90, 0x9a, 0xee, 0x86, 0x9a, 0x62,
-	0x5f, 0xaa, 0x63, 0x2b, 0x0d, 0xe1, 0x3f, 0x84, 0x0e, 0x6a, 0x70, 0x53, 0x10, 0xb7, 0x1e, 0xac,
-	0xc5, 0xd8, 0x13, 0xdd, 0x9e, 0xaa, 0x4d, 0x94, 0xc4, 0x18, 0xc6, 0xe2, 0x9b, 0x6b, 0x3d, 0xa1,
-	0xd3, 0x1a, 0xfd, 0x4d, 0xc4, 0xcc, 0xdc, 0x75, 0x22, 0x66, 0x4f, 0x3f, 0xf9, 0xc3, 0x8f, 0x66,
-	0xb1, 0x9c, 0x97, 0x97, 0xe3, 0x28, 0x4b, 0x1e, 0x9e, 0x9c, 0x44, 0xe9, 0x43, 0xfa, 0x1f, 0x79,
-	0x72, 0xf2, 0x90, 0xac, 0x5e, 0x76, 0xe8, 0x87, 0xe3, 0xc9, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xd5, 0x6a, 0x77, 0xb7, 0xac, 0x14, 0x00, 0x00,
+	// 1838 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5f, 0x6f, 0xdb, 0xc8,
+	0x11, 0x07, 0x49, 0x49, 0x96, 0x46, 0x92, 0xcf, 0xc7, 0x1a, 0x2d, 0x61, 0x14, 0x57, 0xdf, 0x36,
+	0x97, 0xba, 0x69, 0xaa, 0x04, 0xee, 0x21, 0x17, 0xa4, 0x05, 0xda, 0xb3, 0x92, 0xc2, 0x46, 0xee,
+	0x72, 0x2e, 0xed, 0xa4, 0x40, 0xdf, 0x68, 0x6a, 0x2d, 0xb1, 0x96, 0x48, 0x8a, 0xbb, 0x74, 0xa5,
+	0x7b, 0xea, 0x5b, 0x81, 0x7e, 0x8f, 0xbe, 0xf4, 0x73, 0xf4, 0xa5, 0x2f, 0xf7, 0xd4, 0x0f, 0x54,
+	0xcc, 0xec, 0x2e, 0xb9, 0x54, 0xe4, 0x34, 0x41, 0xd1, 0x87, 0xbe, 0xed, 0xfc, 0xd9, 0x9d, 0xdf,
+	0xcc, 0xce, 0xcc, 0x0e, 0x09, 0x7b, 0x57, 0xf3, 0x2c, 0xbe, 0x89, 0x67, 0x51, 0x92, 0x8e, 0xf2,
+	0x22, 0x93, 0x99, 0xdf, 0x96, 0xeb, 0x9c, 0x8b, 0x83, 0x8f, 0x65, 0x11, 0xa5, 0x22, 0x8a, 0x65,
+	0x92, 0x69, 0xc9, 0xc1, 0x20, 0xce, 0x16, 0x0b, 0x43, 0xb1, 0x7f, 0xb9, 0xd0, 0x39, 0xe5, 0xd1,
+	0x84, 0x17, 0x7e, 0x00, 0x3b, 0xb7, 0xbc, 0x10, 0x49, 0x96, 0x06, 0xce, 0xa1, 0x73, 0xe4, 0x85,
+	0x86, 0xf4, 0x3f, 0x01, 0xc8, 0xa3, 0x82, 0xa7, 0xf2, 0x34, 0x12, 0xb3, 0xc0, 0x3d, 0x74, 0x8e,
+	0x06, 0xa1, 0xc5, 0xf1, 0xbf, 0x0f, 0x1d, 0xb9, 0x22, 0x99, 0x47, 0x32, 0x4d, 0xf9, 0x3f, 0x84,
+	0x9e, 0x90, 0x91, 0xe4, 0x24, 0x6a, 0x91, 0xa8, 0x66, 0xe0, 0xae, 0x19, 0x4f, 0xa6, 0x33, 0x19,
+	0xb4, 0xc9, 0x9c, 0xa6, 0x70, 0x17, 0xb9, 0x73, 0x99, 0x2c, 0x78, 0xd0, 0x21, 0x51, 0xcd, 0x40,
+	0x94, 0x72, 0x35, 0xce, 0xca, 0x54, 0x06, 0x3d, 0x85, 0x52, 0x93, 0xbe, 0x0f, 0xad, 0x19, 0x1a,
+	0x02, 0x32, 0x44, 0x6b, 0x44, 0x3e, 0x49, 0xae, 0xaf, 0x93, 0xb8, 0x9c, 0xcb, 0x75, 0xd0, 0x3f,
+	0x74, 0x8e, 0x86, 0xa1, 0xc5, 0xf1, 0x47, 0xd0, 0x13, 0xc9, 0x34, 0x8d, 0x64, 0x59, 0xf0, 0xa0,
+	0x7b, 0xe8, 0x1c, 0xf5, 0x8f, 0xf7, 0x46, 0x14, 0xba, 0xd1, 0x85, 0xe1, 0x87, 0xb5, 0x8a, 0x7f,
+	0x1f, 0x76, 0xe3, 0x2c, 0x15, 0x3c, 0x15, 0xa5, 0x38, 0x2f, 0xb2, 0xec, 0x3a, 0x18, 0x90, 0xb5,
+	0x0d, 0x2e, 0xfb, 0x8b, 0x07, 0xed, 0x13, 0xc4, 0xfc, 0x7f, 0x12, 0xd5, 0xff, 0x14, 0xa7, 0x03,
+	0xe8, 0x2e, 0xa2, 0x24, 0x25, 0x93, 0xca, 0xe3, 0x8a, 0xc6, 0xbd, 0xb4, 0x56, 0x56, 0x87, 0x74,
+	0xb4, 0xc5, 0xf9, 0xe0, 0x18, 0xdf, 0x03, 0x4f, 0xae, 0x44, 0xb0, 0x73, 0xe8, 0x1d, 0xf5, 0x8f,
+	0x7d, 0xad, 0x79, 0x59, 0xe7, 0x71, 0x88, 0xe2, 0x2d, 0x37, 0xb1, 0xbb, 0xf5, 0x26, 0x1e, 0x42,
+	0x87, 0x2e, 0x42, 0xf8, 0x0c, 0xda, 0x89, 0xe4, 0x0b, 0x11, 0x38, 0x74, 0xf2, 0x40, 0x9f, 0x4c,
+	0xd2, 0x50, 0x89, 0x58, 0x0e, 0x5d, 0xa2, 0x2f, 0xf8, 0xd2, 0xdf, 0x03, 0x2f, 0x2d, 0x17, 0xfa,
+	0xd6, 0x70, 0xe9, 0xdf, 0x07, 0x4f, 0xf0, 0x25, 0x5d, 0x55, 0xff, 0x78, 0xdf, 0xde, 0x7f, 0xc1,
+	0x97, 0x25, 0x4f, 0x63, 0x1e, 0xa2, 0x82, 0xff, 0x00, 0x3a, 0x13, 0x2e, 0xa3, 0x64, 0x4e, 0x37,
+	0x57, 0x3b, 0x41, 0xaa, 0xcf, 0x49, 0x12, 0x6a, 0x0d, 0xf6, 0x18, 0x7a, 0xe6, 0x04, 0xe1, 0xff,
+	0x18, 0x5a, 0x82, 0x2f, 0x0d, 0xc2, 0x8f, 0x36, 0x2c, 0x84, 0x24, 0x64, 0xbf, 0xd1, 0x18, 0xcf,
+	0x93, 0x09, 0x62, 0xcc, 0x93, 0x09, 0x61, 0xec, 0x85, 0xb8, 0x44, 0x2f, 0xe9, 0x5a, 0x35, 0xca,
+	0x0d, 0x2f, 0x49, 0xc4, 0x9e, 0xc2, 0xc0, 0x82, 0x22, 0xfc, 0xa3, 0x66, 0x64, 0xb6, 0xc1, 0xd5,
+	0xf1, 0x19, 0xc1, 0x8e, 0xea, 0x16, 0x88, 0xb5, 0xb1, 0x69, 0xa8, 0x37, 0x29, 0xb1, 0xd1, 0x3f,
+	0x05, 0xd0, 0xfa, 0xdb, 0xd1, 0x1e, 0xc1, 0xce, 0x4c, 0xc9, 0x35, 0xde, 0xdd, 0xc6, 0x31, 0x22,
+	0x34, 0x62, 0x36, 0x83, 0x21, 0xe1, 0xf9, 0xe6, 0x96, 0x17, 0xb7, 0x09, 0xff, 0x93, 0xff, 0x29,
+	0xb4, 0x50, 0x46, 0xa7, 0xbd, 0x65, 0x9e, 0x44, 0x76, 0xaf, 0x70, 0x9b, 0xbd, 0xe2, 0x00, 0xba,
+	0xaa, 0x9a, 0xb8, 0x08, 0xbc, 0x43, 0x0f, 0xf3, 0xd9, 0xd0, 0xec, 0xef, 0x0e, 0xf4, 0x2d, 0xd7,
+	0xeb, 0x88, 0x3a, 0x77, 0x46, 0xd4, 0x1f, 0x41, 0xb7, 0xe0, 0x31, 0x4f, 0x72, 0x89, 0x8e, 0xd8,
+	0x41, 0x0c, 0x15, 0xfb, 0x79, 0x24, 0xa3, 0xb0, 0xd2, 0xf1, 0x7f, 0x04, 0xee, 0xcb, 0x37, 0x81,
+	0xd7, 0xb8, 0xe6, 0x97, 0x7c, 0xfd, 0x26, 0x9a, 0x97, 0x3c, 0x74, 0x5f, 0xbe, 0xc1, 0xf4, 0xce,
+	0x0b, 0x7e, 0x7b, 0x21, 0x23, 0x59, 0x0a, 0xab, 0xd2, 0x37, 0xb8, 0xec, 0x1a, 0xba, 0xa1, 0x39,
+	0xf4, 0x81, 0x05, 0x42, 0x5d, 0xca, 0x6e, 0x13, 0x84, 0x05, 0x60, 0x04, 0x40, 0xc8, 0x4f, 0xf8,
+	0x34, 0x49, 0x37, 0x62, 0x6f, 0xb4, 0x2d, 0x0d, 0x76, 0x04, 0xbe, 0x66, 0x8f, 0x67, 0x3c, 0xbe,
+	0xb9, 0x5c, 0x7d, 0x95, 0x08, 0x6a, 0xb9, 0xbc, 0x28, 0x94, 0xb5, 0x5e, 0x48, 0x6b, 0xb6, 0x86,
+	0xfe, 0x18, 0x1f, 0x22, 0x05, 0xd2, 0xbf, 0x07, 0xc3, 0xb8, 0x2c, 0xa8, 0xa9, 0xa9, 0x06, 0xa1,
+	0xea, 0xa9, 0xc9, 0xf4, 0x0f, 0xa1, 0xbf, 0xe0, 0x8b, 0x3c, 0xcb, 0xe6, 0x17, 0xc9, 0xb7, 0x5c,
+	0xdf, 0x96, 0xcd, 0xf2, 0x19, 0x0c, 0x16, 0x62, 0xfa, 0xbb, 0x92, 0x97, 0x9c, 0x54, 0x3c, 0x52,
+	0x69, 0xf0, 0x58, 0x04, 0xbd, 0x90, 0x2f, 0x75, 0xb9, 0xef, 0x43, 0x5b, 0xc8, 0xa8, 0x30, 0x06,
+	0x15, 0x81, 0x29, 0xc8, 0xd3, 0x89, 0x36, 0x80, 0x4b, 0x4c, 0x85, 0x44, 0x3c, 0xaf, 0xcb, 0xb5,
+	0x1b, 0x56, 0xb4, 0x49, 0xd8, 0x16, 0xb9, 0x87, 0x4b, 0xf6, 0x29, 0xf4, 0xbf, 0xb6, 0x50, 0xf9,
+	0xd0, 0x12, 0x88, 0x46, 0xd9, 0xa0, 0x35, 0x7b, 0x00, 0x7b, 0x21, 0xcf, 0xe7, 0x6b, 0xc2, 0xa1,
+	0xfd, 0xab, 0xbb, 0xb2, 0x63, 0x77, 0x65, 0xf6, 0x4f, 0x47, 0x97, 0xff, 0x49, 0x36, 0x59, 0x9b,
+	0xce, 0xe7, 0xbc, 0xbb, 0xf3, 0x7d, 0x68, 0xae, 0xd9, 0xbd, 0xdb, 0x7b, 0x67, 0xef, 0x6e, 0xbd,
+	0xd5, 0xbb, 0xcd, 0x9b, 0xda, 0xb6, 0xde, 0xd4, 0xda, 0x97, 0x4e, 0xc3, 0x97, 0x3f, 0xea, 0xae,
+	0xa2, 0x51, 0x34, 0x70, 0x3a, 0xef, 0x81, 0xd3, 0xd8, 0x72, 0xb7, 0xda, 0xf2, 0x1a, 0xb6, 0x1e,
+	0x02, 0x9c, 0x89, 0x71, 0x54, 0x4e, 0x67, 0xf2, 0x75, 0x8e, 0x5e, 0x9c, 0x89, 0x98, 0xa8, 0x32,
+	0xa7, 0x08, 0x77, 0x43, 0x8b, 0xc3, 0x9e, 0xc2, 0xee, 0x99, 0x78, 0x25, 0xf3, 0x31, 0x35, 0xd2,
+	0x75, 0x1a, 0x63, 0x79, 0x25, 0x22, 0x95, 0x79, 0x8c, 0x1c, 0xb1, 0x4e, 0x63, 0xbd, 0x6b, 0x83,
+	0xcb, 0xfe, 0xe6, 0xc0, 0x90, 0xb2, 0xf9, 0xc5, 0x8a, 0xc7, 0xa5, 0xcc, 0x0a, 0x44, 0x34, 0x29,
+	0x92, 0x5b, 0x5e, 0xe8, 0x36, 0xa6, 0x29, 0x8c, 0xf2, 0x75, 0x99, 0xc6, 0xaf, 0xa2, 0x85, 0x4a,
+	0xdf, 0x5e, 0x58, 0xd1, 0xcd, 0x17, 0xdb, 0xdb, 0x7c, 0xb1, 0xf7, 0xa1, 0x9d, 0x47, 0x45, 0xb4,
+	0xd0, 0x15, 0xae, 0x08, 0xe4, 0xf2, 0x95, 0x2c, 0x22, 0x1d, 0x7a, 0x45, 0xdc, 0x19, 0xfb, 0x2f,
+	0x60, 0xd8, 0x78, 0x87, 0x30, 0x98, 0x64, 0xcd, 0x51, 0xc1, 0x24, 0x43, 0x3e, 0xb4, 0x2e, 0xd7,
+	0xb9, 0xa9, 0x2e, 0x5a, 0xb3, 0x5f, 0xc1, 0x6e, 0x63, 0x23, 0x76, 0x91, 0x46, 0x5f, 0xdf, 0xfe,
+	0xcc, 0xe9, 0xf6, 0xfe, 0x67, 0x07, 0xf6, 0xcf, 0xa3, 0x22, 0xa2, 0x10, 0xd9, 0x3d, 0xf3, 0x73,
+	0xe8, 0x53, 0xf3, 0xd0, 0xcf, 0xa0, 0x73, 0xe7, 0x33, 0x68, 0xab, 0x61, 0x0c, 0x85, 0xb6, 0xa0,
+	0x41, 0x56, 0x34, 0x7a, 0x9e, 0x08, 0xbc, 0x3b, 0x5d, 0xa4, 0x9a, 0x62, 0xcf, 0x60, 0x88, 0x08,
+	0x2e, 0x57, 0xe6, 0x31, 0xfb, 0x69, 0x13, 0xff, 0xf7, 0xb4, 0x51, 0x5b, 0xc9, 0xc0, 0xff, 0x87,
+	0x03, 0x03, 0x9b, 0x8f, 0x11, 0x42, 0x6d, 0x53, 0xce, 0xb8, 0xf6, 0x3f, 0xc3, 0x90, 0xe3, 0xa3,
+	0x12, 0xb8, 0xdb, 0x5e, 0x1a, 0x2d, 0xf4, 0x7f, 0x0e, 0x3d, 0x69, 0x30, 0x6c, 0x34, 0xf6, 0xca,
+	0x6c, 0xad, 0x81, 0x29, 0x11, 0xcf, 0x92, 0xf9, 0xc4, 0x1e, 0xe2, 0x2a, 0x06, 0x5e, 0x7e, 0x92,
+	0x4e, 0xf8, 0x8a, 0x2e, 0x7f, 0x18, 0x2a, 0x02, 0x43, 0x90, 0xe3, 0x4c, 0x23, 0x82, 0x0e, 0x3d,
+	0x59, 0x9a, 0x62, 0x7f, 0x75, 0xa0, 0x5b, 0xb9, 0x50, 0x6d, 0x75, 0xec, 0xad, 0x0c, 0x5c, 0xb9,
+	0x0a, 0xdc, 0xc6, 0x35, 0xd8, 0x8d, 0xc5, 0x95, 0x2b, 0xff, 0x21, 0xec, 0xe8, 0x5a, 0xdc, 0x18,
+	0x5b, 0xec, 0x72, 0x35, 0x2a, 0x16, 0x98, 0x56, 0x03, 0xcc, 0x35, 0x76, 0xbf, 0xa5, 0x8a, 0xea,
+	0xc9, 0xfa, 0x32, 0x91, 0x73, 0xfe, 0xde, 0xad, 0x78, 0x1f, 0xda, 0x12, 0x37, 0x90, 0xfd, 0x5e,
+	0xa8, 0x08, 0xf2, 0x48, 0x5c, 0xf0, 0x25, 0x85, 0xa9, 0x1b, 0x2a, 0x82, 0xdd, 0x02, 0xfc, 0x36,
+	0x99, 0x73, 0xfd, 0xed, 0x72, 0x08, 0x7d, 0x3a, 0xb4, 0xf1, 0xc6, 0xd8, 0x2c, 0xab, 0x6e, 0xdd,
+	0x46, 0xdd, 0x6e, 0xb7, 0x89, 0x93, 0x03, 0x17, 0xf2, 0x15, 0x97, 0xda, 0xaa, 0x21, 0xd9, 0x13,
+	0xe8, 0xbe, 0x48, 0x27, 0x6a, 0xb6, 0xbf, 0xa3, 0xab, 0x6f, 0xeb, 0x64, 0x6c, 0x0e, 0x3d, 0x85,
+	0xf5, 0xbf, 0x1b, 0x2d, 0xeb, 0x6c, 0xf4, 0xde, 0x91, 0x8d, 0xec, 0xd8, 0xcc, 0x5d, 0x34, 0x56,
+	0xde, 0x6b, 0x8c, 0x95, 0x7b, 0x8d, 0x2d, 0xf5, 0x5c, 0xf9, 0x9d, 0x83, 0x9b, 0xd0, 0x01, 0xbc,
+	0xbd, 0x3b, 0x9d, 0xab, 0x02, 0xe6, 0xda, 0x01, 0x33, 0x2e, 0x7b, 0x56, 0xf3, 0x7e, 0x77, 0x8e,
+	0x7f, 0x02, 0x40, 0xf7, 0x73, 0x56, 0x25, 0x7a, 0x3b, 0xb4, 0x38, 0x34, 0xe0, 0x1b, 0x65, 0xa5,
+	0xd3, 0xa1, 0x8c, 0xde, 0xe0, 0xda, 0x43, 0xde, 0x0e, 0x1d, 0x62, 0x48, 0xf6, 0x04, 0xfa, 0xb5,
+	0x3f, 0xc2, 0xff, 0x49, 0xb3, 0x31, 0x7c, 0x5c, 0x85, 0xc1, 0xa8, 0x98, 0xb6, 0xf0, 0x2d, 0xc0,
+	0x18, 0x6d, 0x50, 0x57, 0xab, 0xfd, 0x75, 0x6c, 0x7f, 0x9b, 0xe8, 0xdd, 0xb7, 0xd0, 0x37, 0x7c,
+	0xf7, 0x36, 0x7d, 0xb7, 0x30, 0xb7, 0x9a, 0x98, 0x25, 0x95, 0x8f, 0xc2, 0x64, 0xca, 0xe7, 0xc3,
+	0x6e, 0x62, 0x1f, 0xda, 0x31, 0x9d, 0xec, 0xd1, 0xc9, 0x8a, 0x40, 0x3c, 0x93, 0xa4, 0xe0, 0x54,
+	0xed, 0xda, 0x66, 0xcd, 0x60, 0x21, 0x4e, 0x77, 0xf9, 0x7c, 0xdd, 0xb4, 0xbb, 0xdd, 0xf3, 0xfb,
+	0x26, 0x8c, 0x6e, 0x23, 0x9b, 0x28, 0x57, 0xcf, 0xd2, 0xeb, 0xcc, 0x44, 0xf1, 0x0b, 0xe8, 0x55,
+	0xbc, 0x0f, 0xaa, 0x94, 0x5f, 0xc3, 0xc7, 0x56, 0x07, 0x39, 0xad, 0x7c, 0xad, 0x2f, 0xcf, 0xd3,
+	0x36, 0xb6, 0x47, 0x80, 0x9d, 0x42, 0x77, 0xbc, 0xc8, 0x55, 0x89, 0xbe, 0xcf, 0xf0, 0x1e, 0xc0,
+	0x4e, 0xbc, 0xc8, 0xad, 0xaf, 0x70, 0x43, 0xb2, 0xcf, 0x01, 0xaa, 0xe9, 0x4c, 0xf8, 0xf7, 0x6d,
+	0x0c, 0x1b, 0x9e, 0xa3, 0x86, 0xf1, 0xfc, 0x09, 0x0c, 0xc6, 0xb3, 0x32, 0xc5, 0x41, 0x28, 0x2b,
+	0x26, 0x6a, 0x5f, 0x7a, 0x9d, 0x6d, 0xee, 0x23, 0x1d, 0x1d, 0x31, 0x14, 0xb3, 0x4b, 0x18, 0x54,
+	0xbc, 0xaf, 0xc5, 0x54, 0xe5, 0x50, 0x99, 0xde, 0x58, 0x0f, 0x79, 0xcd, 0xa8, 0x9b, 0xaa, 0xbb,
+	0xa5, 0xa9, 0x7a, 0x55, 0x53, 0x65, 0x0b, 0xe8, 0x55, 0xa7, 0xe2, 0x0b, 0x4b, 0x27, 0xbc, 0xaa,
+	0xba, 0x4f, 0x45, 0x37, 0xcd, 0xb9, 0x77, 0x9a, 0xf3, 0xb6, 0x98, 0x6b, 0xd5, 0xe6, 0xa6, 0xf0,
+	0x51, 0xc8, 0x97, 0x0d, 0xff, 0xff, 0x37, 0x93, 0xf8, 0x77, 0x2e, 0xec, 0x9d, 0x97, 0x62, 0x76,
+	0x51, 0x5e, 0x89, 0xb8, 0x48, 0xae, 0x78, 0xc8, 0x97, 0x98, 0x4f, 0x29, 0x4e, 0x60, 0x2a, 0x63,
+	0x69, 0x8d, 0x5b, 0x5f, 0x87, 0x5f, 0xe9, 0x14, 0xc1, 0x25, 0x66, 0x23, 0x4f, 0xe3, 0x6c, 0x62,
+	0x9a, 0xbe, 0xa6, 0xf0, 0x1b, 0x63, 0x1e, 0x09, 0x69, 0x3a, 0xae, 0x76, 0xab, 0xc1, 0xc3, 0xc2,
+	0x47, 0xfa, 0xd4, 0xfe, 0xc7, 0x62, 0x71, 0xf0, 0x7b, 0x07, 0x29, 0x35, 0xfc, 0x63, 0x24, 0x3b,
+	0x64, 0xa2, 0xc9, 0xac, 0x06, 0x0d, 0xd5, 0xb1, 0x68, 0xed, 0x7f, 0x09, 0xdd, 0x38, 0x4b, 0x65,
+	0x11, 0xc5, 0x32, 0xe8, 0x52, 0xa6, 0x7c, 0x66, 0x66, 0x97, 0x0d, 0x37, 0x47, 0x63, 0xad, 0xf7,
+	0x22, 0x95, 0xc5, 0x3a, 0xac, 0xb6, 0x1d, 0xfc, 0x12, 0x86, 0x0d, 0x11, 0xfa, 0x7e, 0xc3, 0xd7,
+	0xe6, 0x8b, 0xfb, 0x86, 0xaf, 0xf1, 0x32, 0x6e, 0xf1, 0x2b, 0x93, 0xe2, 0xd1, 0x0d, 0x15, 0xf1,
+	0xcc, 0x7d, 0xea, 0xb0, 0xd7, 0xb0, 0x8b, 0x86, 0x7e, 0x9f, 0xc8, 0x99, 0xfe, 0x76, 0xfb, 0x19,
+	0xb4, 0xf2, 0x52, 0xe7, 0x5e, 0xff, 0xf8, 0x07, 0x77, 0xa0, 0x09, 0x49, 0x09, 0x83, 0x2a, 0x68,
+	0x9b, 0xee, 0x86, 0x9a, 0x62, 0x5f, 0xc2, 0x6e, 0x63, 0x87, 0xf0, 0x1f, 0x41, 0x07, 0x77, 0x70,
+	0x53, 0x10, 0x77, 0x1e, 0xac, 0xd5, 0xd8, 0x33, 0xdd, 0x9e, 0x2a, 0xe1, 0x79, 0xa9, 0x62, 0x98,
+	0x88, 0x6f, 0x6e, 0xf4, 0xe4, 0x4e, 0x6b, 0xf4, 0x77, 0x21, 0xa6, 0xe6, 0xae, 0x17, 0x62, 0x7a,
+	0x32, 0xfa, 0xc3, 0xc3, 0x69, 0x22, 0x67, 0xe5, 0xd5, 0x28, 0xce, 0x16, 0x8f, 0x64, 0x59, 0x24,
+	0xe9, 0x94, 0x7e, 0x94, 0x1e, 0x3f, 0x3e, 0x7e, 0x6c, 0xd3, 0x8f, 0x08, 0xc4, 0x55, 0x87, 0xfe,
+	0x8b, 0xfe, 0xe2, 0xdf, 0x03, 0x00, 0xfe, 0xbe, 0xbe, 0x50, 0x53, 0x15, 0x00, 0x00,
 }
@@ -28,6 +28,8 @@ type Config struct {
 	CoinSymbol     string         `json:"coinSymbol,omitempty"`
 	EnableParaFork bool           `json:"enableParaFork,omitempty"`
 	Metrics        *Metrics       `json:"metrics,omitempty"`
+	Trace          *Trace         `json:"trace,omitempty"`
+	Queue          *Queue         `json:"queue,omitempty"`
 	ChainID        int32          `json:"chainID,omitempty"`
 	AddrVer        byte           `json:"addrVer,omitempty"`
 	Crypto         *crypto.Config `json:"crypto,omitempty"`
@@ -106,6 +108,14 @@ type Mempool struct {
 	MaxTxFee int64 `json:"maxTxFee,omitempty"`
 	// 目前execCheck效率较低，支持关闭交易execCheck，提升性能
 	DisableExecCheck bool `json:"disableExecCheck,omitempty"`
+	// 替换同一账户相同nonce 的交易时, 手续费最少需要提高的百分比, 默认10
+	ReplaceFeeBump int64 `json:"replaceFeeBump,omitempty"`
+	// 是否开启交易日志, 节点重启之后恢复mempool 中未打包的交易
+	EnableJournal bool `json:"enableJournal,omitempty"`
+	// 交易日志数据库路径, 默认datadir/mempool
+	JournalDbPath string `json:"journalDbPath,omitempty"`
+	// 交易日志数据库类型, 默认leveldb
+	JournalDriver string `json:"journalDriver,omitempty"`
 }
 
 // Consensus 配置
@@ -139,6 +149,8 @@ type Wallet struct {
 	// 钱包发送交易签名方式
 	SignType string `json:"signType,omitempty"`
 	CoinType string `json:"coinType,omitempty"`
+	// 外部签名器名称, 为空时使用钱包数据库中的私钥, 签名器配置在[wallet.sub.签名器名称]
+	Signer string `json:"signer,omitempty"`
 }
 
 // Store 配置
@@ -216,6 +228,12 @@ type BlockChain struct {
 	DisableBlockBroadcast bool `json:"disableBlockBroadcast,omitempty"`
 	//关闭本地和ntp server的时钟偏移检查
 	DisableClockDriftCheck bool `json:"disableClockDriftCheck,omitempty"`
+	//新节点从其他节点下载此高度的状态快照, 之后只同步剩余的区块, 0 表示从创世区块开始同步
+	SnapshotHeight int64 `json:"snapshotHeight,omitempty"`
+	//快照高度的区块hash, 由用户从可信的来源获取, 用于验证下载的快照
+	SnapshotHash string `json:"snapshotHash,omitempty"`
+	//轻节点模式, 只同步和验证区块头, 交易和状态在查询时从全节点获取证明并验证
+	LightMode bool `json:"lightMode,omitempty"`
 }
 
 // P2P 配置
@@ -264,6 +282,8 @@ type RPC struct {
 	JrpcUserName string `json:"jrpcUserName,omitempty"`
 	//basic auth 用户密码
 	JrpcUserPasswd string `json:"jrpcUserPasswd,omitempty"`
+	// websocket 允许的跨域来源, 如 "https://example.com", 默认只允许同源, "*" 允许所有来源
+	WsOriginWhitelist []string `json:"wsOriginWhitelist,omitempty"`
 }
 
 // Exec 配置
@@ -276,6 +296,12 @@ type Exec struct {
 	Alias            []string `json:"alias,omitempty"`
 	// 是否保存token交易信息
 	SaveTokenTxList bool `json:"saveTokenTxList,omitempty"`
+	// 是否开启交易并行执行
+	EnableParallel bool `json:"enableParallel,omitempty"`
+	// 并行执行的协程数, 默认为cpu核数
+	ParallelWorkers int32 `json:"parallelWorkers,omitempty"`
+	// 是否开启交易回执日志索引, 开启后必须从0高度同步
+	EnableLogIndex bool `json:"enableLogIndex,omitempty"`
 }
 
 // Pprof 配置
@@ -301,3 +327,45 @@ type Metrics struct {
 	Password      string `json:"password,omitempty"`
 	Namespace     string `json:"namespace,omitempty"`
 }
+
+// Queue 消息队列配置
+type Queue struct {
+	// 队列满时的处理策略, block 阻塞等待, drop 丢弃低优先级消息, error 立即返回错误
+	Policy string `json:"policy,omitempty"`
+	// 单独设置topic 的处理策略, 格式为topic:policy
+	TopicPolicy []string `json:"topicPolicy,omitempty"`
+	// 消息积压并且超过这个时间(毫秒)没有交给模块处理时报告慢消费者
+	SlowConsumerTime int64 `json:"slowConsumerTime,omitempty"`
+	// 远程模块接入配置
+	Remote *QueueRemote `json:"remote,omitempty"`
+}
+
+// QueueRemote 在其他进程中运行的模块通过grpc 接入节点的消息队列
+type QueueRemote struct {
+	// 节点监听远程模块连接的地址, 为空表示不开启
+	ListenAddr string `json:"listenAddr,omitempty"`
+	// 远程模块连接的节点地址
+	NodeAddr string `json:"nodeAddr,omitempty"`
+	// 远程模块认证使用的token, 为空时只允许本机连接
+	Token     string `json:"token,omitempty"`
+	EnableTLS bool   `json:"enableTLS,omitempty"`
+	// 节点需要证书和私钥, 远程模块只需要证书
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	// 在其他进程中运行的模块, 节点不在本地加载
+	Modules []string `json:"modules,omitempty"`
+}
+
+// Trace 调用链跟踪配置
+type Trace struct {
+	Enable bool `json:"enable,omitempty"`
+	// 导出方式, 支持file 和otlp
+	Exporter string `json:"exporter,omitempty"`
+	// file 模式下保存span 的文件
+	File string `json:"file,omitempty"`
+	// otlp 模式下collector 的http 地址
+	Endpoint    string `json:"endpoint,omitempty"`
+	ServiceName string `json:"serviceName,omitempty"`
+	// 新建调用链的采样比例, 0到1之间
+	SampleRate float64 `json:"sampleRate,omitempty"`
+}
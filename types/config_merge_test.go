@@ -218,12 +218,19 @@ ForkLocalDBAccess=1
 ForkBase58AddressCheck=1800000
 ForkTicketFundAddrV1=-1
 ForkRootHash=1
+ForkTxNonce=-1
 [fork.sub.coins]
 Enable=0
+ForkTransferMulti=-1
+ForkTimeLock=-1
 
 [fork.sub.manage]
 Enable=0
 ForkManageExec=100000
+ForkManageProposal=-1
+
+[fork.sub.multisig]
+Enable=-1
 
 [fork.sub.store-kvmvccmavl]
 ForkKvmvccmavl=1
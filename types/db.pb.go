@@ -203,6 +203,246 @@ func (m *MAVLProof) GetRootHash() []byte {
 	return nil
 }
 
+//多key 证明中的节点, ty: 1 没有展开的子树 2 叶子节点 3 inner 节点
+type MAVLMultiProofNode struct {
+	Ty                   int32    `protobuf:"varint,1,opt,name=ty,proto3" json:"ty,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Key                  []byte   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Height               int32    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Size                 int32    `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MAVLMultiProofNode) Reset()         { *m = MAVLMultiProofNode{} }
+func (m *MAVLMultiProofNode) String() string { return proto.CompactTextString(m) }
+func (*MAVLMultiProofNode) ProtoMessage()    {}
+func (*MAVLMultiProofNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{3}
+}
+
+func (m *MAVLMultiProofNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MAVLMultiProofNode.Unmarshal(m, b)
+}
+func (m *MAVLMultiProofNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MAVLMultiProofNode.Marshal(b, m, deterministic)
+}
+func (m *MAVLMultiProofNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MAVLMultiProofNode.Merge(m, src)
+}
+func (m *MAVLMultiProofNode) XXX_Size() int {
+	return xxx_messageInfo_MAVLMultiProofNode.Size(m)
+}
+func (m *MAVLMultiProofNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MAVLMultiProofNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MAVLMultiProofNode proto.InternalMessageInfo
+
+func (m *MAVLMultiProofNode) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *MAVLMultiProofNode) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *MAVLMultiProofNode) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *MAVLMultiProofNode) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MAVLMultiProofNode) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MAVLMultiProofNode) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+//多key 证明, 节点按照后序遍历的顺序排列
+type MAVLMultiProof struct {
+	Nodes                []*MAVLMultiProofNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MAVLMultiProof) Reset()         { *m = MAVLMultiProof{} }
+func (m *MAVLMultiProof) String() string { return proto.CompactTextString(m) }
+func (*MAVLMultiProof) ProtoMessage()    {}
+func (*MAVLMultiProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{4}
+}
+
+func (m *MAVLMultiProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MAVLMultiProof.Unmarshal(m, b)
+}
+func (m *MAVLMultiProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MAVLMultiProof.Marshal(b, m, deterministic)
+}
+func (m *MAVLMultiProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MAVLMultiProof.Merge(m, src)
+}
+func (m *MAVLMultiProof) XXX_Size() int {
+	return xxx_messageInfo_MAVLMultiProof.Size(m)
+}
+func (m *MAVLMultiProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MAVLMultiProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MAVLMultiProof proto.InternalMessageInfo
+
+func (m *MAVLMultiProof) GetNodes() []*MAVLMultiProofNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+//stateHash 和height 都没有指定时使用最新的状态
+type ReqStateProof struct {
+	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Keys                 [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStateProof) Reset()         { *m = ReqStateProof{} }
+func (m *ReqStateProof) String() string { return proto.CompactTextString(m) }
+func (*ReqStateProof) ProtoMessage()    {}
+func (*ReqStateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{5}
+}
+
+func (m *ReqStateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStateProof.Unmarshal(m, b)
+}
+func (m *ReqStateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStateProof.Marshal(b, m, deterministic)
+}
+func (m *ReqStateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStateProof.Merge(m, src)
+}
+func (m *ReqStateProof) XXX_Size() int {
+	return xxx_messageInfo_ReqStateProof.Size(m)
+}
+func (m *ReqStateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStateProof proto.InternalMessageInfo
+
+func (m *ReqStateProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *ReqStateProof) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ReqStateProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+//values 和keys 一一对应, key 不存在时value 为空, 以proof 的验证结果为准
+type StateProof struct {
+	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Keys                 [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Values               [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Proof                []byte   `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{6}
+}
+
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProof.Unmarshal(m, b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProof.Marshal(b, m, deterministic)
+}
+func (m *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(m, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return xxx_messageInfo_StateProof.Size(m)
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *StateProof) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *StateProof) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *StateProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 type StoreNode struct {
 	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -219,7 +459,7 @@ func (m *StoreNode) Reset()         { *m = StoreNode{} }
 func (m *StoreNode) String() string { return proto.CompactTextString(m) }
 func (*StoreNode) ProtoMessage()    {}
 func (*StoreNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{3}
+	return fileDescriptor_8817812184a13374, []int{7}
 }
 
 func (m *StoreNode) XXX_Unmarshal(b []byte) error {
@@ -294,7 +534,7 @@ func (m *LocalDBSet) Reset()         { *m = LocalDBSet{} }
 func (m *LocalDBSet) String() string { return proto.CompactTextString(m) }
 func (*LocalDBSet) ProtoMessage()    {}
 func (*LocalDBSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{4}
+	return fileDescriptor_8817812184a13374, []int{8}
 }
 
 func (m *LocalDBSet) XXX_Unmarshal(b []byte) error {
@@ -344,7 +584,7 @@ func (m *LocalDBList) Reset()         { *m = LocalDBList{} }
 func (m *LocalDBList) String() string { return proto.CompactTextString(m) }
 func (*LocalDBList) ProtoMessage()    {}
 func (*LocalDBList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{5}
+	return fileDescriptor_8817812184a13374, []int{9}
 }
 
 func (m *LocalDBList) XXX_Unmarshal(b []byte) error {
@@ -412,7 +652,7 @@ func (m *LocalDBGet) Reset()         { *m = LocalDBGet{} }
 func (m *LocalDBGet) String() string { return proto.CompactTextString(m) }
 func (*LocalDBGet) ProtoMessage()    {}
 func (*LocalDBGet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{6}
+	return fileDescriptor_8817812184a13374, []int{10}
 }
 
 func (m *LocalDBGet) XXX_Unmarshal(b []byte) error {
@@ -458,7 +698,7 @@ func (m *LocalReplyValue) Reset()         { *m = LocalReplyValue{} }
 func (m *LocalReplyValue) String() string { return proto.CompactTextString(m) }
 func (*LocalReplyValue) ProtoMessage()    {}
 func (*LocalReplyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{7}
+	return fileDescriptor_8817812184a13374, []int{11}
 }
 
 func (m *LocalReplyValue) XXX_Unmarshal(b []byte) error {
@@ -499,7 +739,7 @@ func (m *StoreSet) Reset()         { *m = StoreSet{} }
 func (m *StoreSet) String() string { return proto.CompactTextString(m) }
 func (*StoreSet) ProtoMessage()    {}
 func (*StoreSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{8}
+	return fileDescriptor_8817812184a13374, []int{12}
 }
 
 func (m *StoreSet) XXX_Unmarshal(b []byte) error {
@@ -553,7 +793,7 @@ func (m *StoreDel) Reset()         { *m = StoreDel{} }
 func (m *StoreDel) String() string { return proto.CompactTextString(m) }
 func (*StoreDel) ProtoMessage()    {}
 func (*StoreDel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{9}
+	return fileDescriptor_8817812184a13374, []int{13}
 }
 
 func (m *StoreDel) XXX_Unmarshal(b []byte) error {
@@ -601,7 +841,7 @@ func (m *StoreSetWithSync) Reset()         { *m = StoreSetWithSync{} }
 func (m *StoreSetWithSync) String() string { return proto.CompactTextString(m) }
 func (*StoreSetWithSync) ProtoMessage()    {}
 func (*StoreSetWithSync) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{10}
+	return fileDescriptor_8817812184a13374, []int{14}
 }
 
 func (m *StoreSetWithSync) XXX_Unmarshal(b []byte) error {
@@ -655,7 +895,7 @@ func (m *StoreGet) Reset()         { *m = StoreGet{} }
 func (m *StoreGet) String() string { return proto.CompactTextString(m) }
 func (*StoreGet) ProtoMessage()    {}
 func (*StoreGet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{11}
+	return fileDescriptor_8817812184a13374, []int{15}
 }
 
 func (m *StoreGet) XXX_Unmarshal(b []byte) error {
@@ -690,6 +930,54 @@ func (m *StoreGet) GetKeys() [][]byte {
 	return nil
 }
 
+//检查状态是否存在, height 大于0 时同时检查是否已经被裁剪
+type StoreCheckState struct {
+	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StoreCheckState) Reset()         { *m = StoreCheckState{} }
+func (m *StoreCheckState) String() string { return proto.CompactTextString(m) }
+func (*StoreCheckState) ProtoMessage()    {}
+func (*StoreCheckState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{16}
+}
+
+func (m *StoreCheckState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreCheckState.Unmarshal(m, b)
+}
+func (m *StoreCheckState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreCheckState.Marshal(b, m, deterministic)
+}
+func (m *StoreCheckState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreCheckState.Merge(m, src)
+}
+func (m *StoreCheckState) XXX_Size() int {
+	return xxx_messageInfo_StoreCheckState.Size(m)
+}
+func (m *StoreCheckState) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreCheckState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreCheckState proto.InternalMessageInfo
+
+func (m *StoreCheckState) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *StoreCheckState) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type StoreReplyValue struct {
 	Values               [][]byte `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -701,7 +989,7 @@ func (m *StoreReplyValue) Reset()         { *m = StoreReplyValue{} }
 func (m *StoreReplyValue) String() string { return proto.CompactTextString(m) }
 func (*StoreReplyValue) ProtoMessage()    {}
 func (*StoreReplyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{12}
+	return fileDescriptor_8817812184a13374, []int{17}
 }
 
 func (m *StoreReplyValue) XXX_Unmarshal(b []byte) error {
@@ -745,7 +1033,7 @@ func (m *StoreList) Reset()         { *m = StoreList{} }
 func (m *StoreList) String() string { return proto.CompactTextString(m) }
 func (*StoreList) ProtoMessage()    {}
 func (*StoreList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{13}
+	return fileDescriptor_8817812184a13374, []int{18}
 }
 
 func (m *StoreList) XXX_Unmarshal(b []byte) error {
@@ -827,7 +1115,7 @@ func (m *StoreListReply) Reset()         { *m = StoreListReply{} }
 func (m *StoreListReply) String() string { return proto.CompactTextString(m) }
 func (*StoreListReply) ProtoMessage()    {}
 func (*StoreListReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{14}
+	return fileDescriptor_8817812184a13374, []int{19}
 }
 
 func (m *StoreListReply) XXX_Unmarshal(b []byte) error {
@@ -923,7 +1211,7 @@ func (m *PruneData) Reset()         { *m = PruneData{} }
 func (m *PruneData) String() string { return proto.CompactTextString(m) }
 func (*PruneData) ProtoMessage()    {}
 func (*PruneData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{15}
+	return fileDescriptor_8817812184a13374, []int{20}
 }
 
 func (m *PruneData) XXX_Unmarshal(b []byte) error {
@@ -963,7 +1251,7 @@ func (m *StoreValuePool) Reset()         { *m = StoreValuePool{} }
 func (m *StoreValuePool) String() string { return proto.CompactTextString(m) }
 func (*StoreValuePool) ProtoMessage()    {}
 func (*StoreValuePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8817812184a13374, []int{16}
+	return fileDescriptor_8817812184a13374, []int{21}
 }
 
 func (m *StoreValuePool) XXX_Unmarshal(b []byte) error {
@@ -991,10 +1279,152 @@ func (m *StoreValuePool) GetValues() [][]byte {
 	return nil
 }
 
+//按照状态根获取mavl 快照分片, depth 小于0 时根据树的大小计算, index 小于0 时只返回分片个数
+type ReqSnapshotChunk struct {
+	StateHash            []byte   `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Depth                int32    `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	Index                int32    `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqSnapshotChunk) Reset()         { *m = ReqSnapshotChunk{} }
+func (m *ReqSnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*ReqSnapshotChunk) ProtoMessage()    {}
+func (*ReqSnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{22}
+}
+
+func (m *ReqSnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSnapshotChunk.Unmarshal(m, b)
+}
+func (m *ReqSnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSnapshotChunk.Marshal(b, m, deterministic)
+}
+func (m *ReqSnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSnapshotChunk.Merge(m, src)
+}
+func (m *ReqSnapshotChunk) XXX_Size() int {
+	return xxx_messageInfo_ReqSnapshotChunk.Size(m)
+}
+func (m *ReqSnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSnapshotChunk proto.InternalMessageInfo
+
+func (m *ReqSnapshotChunk) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *ReqSnapshotChunk) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *ReqSnapshotChunk) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// mavl 状态快照分片, 包含深度为depth 的一棵子树的全部节点以及子树到状态根的路径
+type StateSnapshotChunk struct {
+	StateHash []byte `protobuf:"bytes,1,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Depth     int32  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	Index     int32  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Total     int32  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	//子树的节点, 按照后序遍历的顺序
+	Nodes []*StoreNode `protobuf:"bytes,5,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	//子树根节点的祖先节点, 从父节点到根节点
+	Proof                []*StoreNode `protobuf:"bytes,6,rep,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *StateSnapshotChunk) Reset()         { *m = StateSnapshotChunk{} }
+func (m *StateSnapshotChunk) String() string { return proto.CompactTextString(m) }
+func (*StateSnapshotChunk) ProtoMessage()    {}
+func (*StateSnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8817812184a13374, []int{23}
+}
+
+func (m *StateSnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateSnapshotChunk.Unmarshal(m, b)
+}
+func (m *StateSnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateSnapshotChunk.Marshal(b, m, deterministic)
+}
+func (m *StateSnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateSnapshotChunk.Merge(m, src)
+}
+func (m *StateSnapshotChunk) XXX_Size() int {
+	return xxx_messageInfo_StateSnapshotChunk.Size(m)
+}
+func (m *StateSnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateSnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateSnapshotChunk proto.InternalMessageInfo
+
+func (m *StateSnapshotChunk) GetStateHash() []byte {
+	if m != nil {
+		return m.StateHash
+	}
+	return nil
+}
+
+func (m *StateSnapshotChunk) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *StateSnapshotChunk) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *StateSnapshotChunk) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *StateSnapshotChunk) GetNodes() []*StoreNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *StateSnapshotChunk) GetProof() []*StoreNode {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LeafNode)(nil), "types.LeafNode")
 	proto.RegisterType((*InnerNode)(nil), "types.InnerNode")
 	proto.RegisterType((*MAVLProof)(nil), "types.MAVLProof")
+	proto.RegisterType((*MAVLMultiProofNode)(nil), "types.MAVLMultiProofNode")
+	proto.RegisterType((*MAVLMultiProof)(nil), "types.MAVLMultiProof")
+	proto.RegisterType((*ReqStateProof)(nil), "types.ReqStateProof")
+	proto.RegisterType((*StateProof)(nil), "types.StateProof")
 	proto.RegisterType((*StoreNode)(nil), "types.StoreNode")
 	proto.RegisterType((*LocalDBSet)(nil), "types.LocalDBSet")
 	proto.RegisterType((*LocalDBList)(nil), "types.LocalDBList")
@@ -1004,11 +1434,14 @@ func init() {
 	proto.RegisterType((*StoreDel)(nil), "types.StoreDel")
 	proto.RegisterType((*StoreSetWithSync)(nil), "types.StoreSetWithSync")
 	proto.RegisterType((*StoreGet)(nil), "types.StoreGet")
+	proto.RegisterType((*StoreCheckState)(nil), "types.StoreCheckState")
 	proto.RegisterType((*StoreReplyValue)(nil), "types.StoreReplyValue")
 	proto.RegisterType((*StoreList)(nil), "types.StoreList")
 	proto.RegisterType((*StoreListReply)(nil), "types.StoreListReply")
 	proto.RegisterType((*PruneData)(nil), "types.PruneData")
 	proto.RegisterType((*StoreValuePool)(nil), "types.StoreValuePool")
+	proto.RegisterType((*ReqSnapshotChunk)(nil), "types.ReqSnapshotChunk")
+	proto.RegisterType((*StateSnapshotChunk)(nil), "types.StateSnapshotChunk")
 }
 
 func init() {
@@ -1016,46 +1449,58 @@ func init() {
 }
 
 var fileDescriptor_8817812184a13374 = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0x45, 0x92, 0x9d, 0x48, 0x93, 0xd0, 0x18, 0x11, 0x8a, 0x08, 0x29, 0x49, 0x75, 0x72, 0x29,
-	0x38, 0xa5, 0xee, 0xb1, 0x87, 0x26, 0x04, 0xd2, 0x62, 0xb7, 0x04, 0x05, 0x5c, 0xe8, 0xa1, 0xb0,
-	0x91, 0xc6, 0x91, 0x88, 0xbd, 0xeb, 0x4a, 0xab, 0x12, 0xf5, 0xd2, 0x8f, 0xe8, 0xa9, 0xbf, 0xd5,
-	0x2f, 0x2a, 0x3b, 0xbb, 0xb2, 0x64, 0x50, 0x93, 0xe6, 0x36, 0x6f, 0xbd, 0x7a, 0xef, 0xcd, 0x9b,
-	0x59, 0x0c, 0x6e, 0x72, 0x3d, 0x5a, 0xe5, 0x42, 0x0a, 0xbf, 0x2f, 0xab, 0x15, 0x16, 0x07, 0xbb,
-	0xb1, 0x58, 0x2e, 0x05, 0xd7, 0x87, 0xe1, 0x57, 0x70, 0xa7, 0xc8, 0xe6, 0x9f, 0x44, 0x82, 0xfe,
-	0x00, 0x9c, 0x5b, 0xac, 0x02, 0xeb, 0xd8, 0x1a, 0xee, 0x46, 0xaa, 0xf4, 0xf7, 0xa1, 0xff, 0x9d,
-	0x2d, 0x4a, 0x0c, 0x6c, 0x3a, 0xd3, 0xc0, 0x7f, 0x0a, 0x5b, 0x29, 0x66, 0x37, 0xa9, 0x0c, 0x9c,
-	0x63, 0x6b, 0xd8, 0x8f, 0x0c, 0xf2, 0x7d, 0xe8, 0x15, 0xd9, 0x0f, 0x0c, 0x7a, 0x74, 0x4a, 0x75,
-	0xf8, 0x0d, 0xbc, 0x0f, 0x9c, 0x63, 0x4e, 0x02, 0x07, 0xe0, 0x2e, 0x70, 0x2e, 0xdf, 0xb3, 0x22,
-	0x35, 0x2a, 0x6b, 0xec, 0x1f, 0x82, 0x97, 0x2b, 0x16, 0xfa, 0x51, 0xcb, 0x35, 0x07, 0x8f, 0x92,
-	0x2c, 0xc1, 0xfb, 0x78, 0x3a, 0x9b, 0x5e, 0xe6, 0x42, 0xcc, 0xb5, 0x24, 0x9b, 0x6f, 0x4a, 0x6a,
-	0xec, 0xbf, 0x02, 0xc8, 0x6a, 0x6f, 0x45, 0x60, 0x1f, 0x3b, 0xc3, 0x9d, 0xd7, 0x83, 0x11, 0xa5,
-	0x34, 0x5a, 0x9b, 0x8e, 0x5a, 0x77, 0x14, 0x5b, 0x2e, 0x84, 0xf6, 0xe8, 0x68, 0xb6, 0x1a, 0x87,
-	0xbf, 0x2d, 0xf0, 0xae, 0xa4, 0xc8, 0xf1, 0x51, 0x59, 0xb6, 0x23, 0x71, 0xee, 0x8b, 0xa4, 0xf7,
-	0xef, 0x48, 0xfa, 0x9d, 0x91, 0x6c, 0xb5, 0x22, 0x39, 0x05, 0x98, 0x8a, 0x98, 0x2d, 0xce, 0xcf,
-	0xae, 0x50, 0xfa, 0x47, 0x60, 0x4f, 0x66, 0xa6, 0xdf, 0x3d, 0xd3, 0xef, 0x04, 0xab, 0x99, 0x32,
-	0x14, 0xd9, 0x93, 0x99, 0xa2, 0x90, 0x77, 0x59, 0x42, 0xc4, 0x4e, 0x44, 0x75, 0xf8, 0x13, 0x76,
-	0x0c, 0xc5, 0x34, 0x2b, 0xa4, 0x52, 0x5f, 0xe5, 0x38, 0xcf, 0xee, 0x4c, 0x8b, 0x06, 0xd5, 0x7d,
-	0xdb, 0x4d, 0xdf, 0x87, 0xe0, 0x25, 0x59, 0x8e, 0xb1, 0xcc, 0x04, 0x37, 0xd3, 0x6b, 0x0e, 0x54,
-	0x2a, 0xb1, 0x28, 0xb9, 0x34, 0x13, 0xd4, 0xa0, 0xd3, 0xc0, 0x9b, 0x75, 0x0f, 0x17, 0x48, 0x37,
-	0x6e, 0xb1, 0xd2, 0x53, 0xdb, 0x8d, 0xa8, 0xee, 0xfc, 0xea, 0x05, 0xec, 0xd1, 0x57, 0x11, 0xae,
-	0x16, 0xba, 0x43, 0x65, 0x9d, 0xb2, 0xaf, 0x3f, 0x36, 0x28, 0x64, 0xe0, 0xd2, 0xfc, 0x54, 0x44,
-	0x87, 0xe0, 0x15, 0x92, 0x49, 0x6c, 0xed, 0x4d, 0x73, 0xf0, 0x70, 0x80, 0x9b, 0xeb, 0xea, 0xd4,
-	0xb3, 0x09, 0xdf, 0x19, 0x89, 0x73, 0x5c, 0x3c, 0x20, 0xd1, 0x30, 0xd8, 0x1b, 0x0c, 0x4b, 0x18,
-	0xd4, 0x26, 0x3f, 0x67, 0x32, 0xbd, 0xaa, 0x78, 0xec, 0xbf, 0x04, 0xb7, 0x50, 0x67, 0x05, 0x4a,
-	0x22, 0x6a, 0x4c, 0xd5, 0x57, 0xa3, 0xf5, 0x05, 0x5a, 0x8f, 0x8a, 0xc7, 0x44, 0xeb, 0x46, 0x54,
-	0xfb, 0x01, 0x6c, 0x97, 0xab, 0x9b, 0x9c, 0x25, 0x48, 0x7e, 0xdd, 0xa8, 0x86, 0xe1, 0x5b, 0x63,
-	0xf8, 0xe2, 0xc1, 0x4c, 0x3a, 0x06, 0xa2, 0xc2, 0xa7, 0xaf, 0xff, 0x23, 0xfc, 0x5f, 0xf5, 0xeb,
-	0xa1, 0xed, 0xba, 0x5f, 0x6a, 0x1f, 0xfa, 0x85, 0x64, 0xb9, 0xac, 0x5f, 0x12, 0x01, 0xb5, 0x79,
-	0xc8, 0x13, 0xf3, 0x88, 0x54, 0xa9, 0xb4, 0x8a, 0x72, 0xae, 0x76, 0x54, 0x3f, 0x1e, 0x83, 0x9a,
-	0x9d, 0xd3, 0x8b, 0xd2, 0xec, 0xdc, 0x52, 0x24, 0xfa, 0xdd, 0x38, 0x11, 0xd5, 0xe1, 0x1f, 0x0b,
-	0x9e, 0xac, 0x5d, 0x51, 0x17, 0x8d, 0xb8, 0xd5, 0x21, 0x6e, 0x77, 0x89, 0x3b, 0xdd, 0xe2, 0xbd,
-	0xb6, 0xf8, 0x00, 0x1c, 0x5e, 0x2e, 0x8d, 0x21, 0x55, 0x76, 0xd9, 0x51, 0x73, 0xe2, 0x78, 0x27,
-	0x27, 0x58, 0x05, 0xdb, 0x44, 0x5a, 0xc3, 0x75, 0xfa, 0x6e, 0xeb, 0x39, 0x34, 0x51, 0x7b, 0x1b,
-	0x51, 0x3f, 0x07, 0xef, 0x32, 0x2f, 0x39, 0x9e, 0x33, 0xc9, 0x94, 0x9d, 0x94, 0x15, 0x69, 0x11,
-	0x58, 0x74, 0x47, 0x83, 0x70, 0x68, 0xda, 0xa6, 0x99, 0x5d, 0x0a, 0xb1, 0x68, 0x91, 0x59, 0x6d,
-	0xb2, 0xb3, 0xa3, 0x2f, 0xcf, 0x6e, 0x32, 0x99, 0x96, 0xd7, 0xa3, 0x58, 0x2c, 0x4f, 0xc6, 0xe3,
-	0x98, 0x9f, 0xc4, 0x29, 0xcb, 0xf8, 0x78, 0x7c, 0x42, 0x2b, 0x78, 0xbd, 0x45, 0xff, 0x33, 0xe3,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xe6, 0x2e, 0x76, 0x88, 0x06, 0x00, 0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x96, 0xed, 0x38, 0x6b, 0x9f, 0x0d, 0x6d, 0x64, 0xad, 0x90, 0x59, 0x55, 0xa2, 0xf8, 0x02,
+	0x65, 0x05, 0x4a, 0xab, 0xc2, 0x25, 0x17, 0xec, 0x6e, 0xa5, 0x82, 0x92, 0x45, 0xd5, 0x44, 0x0a,
+	0x02, 0x21, 0x24, 0xd7, 0x9e, 0xc4, 0x56, 0x9c, 0x19, 0xaf, 0x3d, 0x46, 0x31, 0x37, 0xbc, 0x00,
+	0x77, 0x5c, 0xf1, 0x3e, 0x3c, 0x01, 0x4f, 0x84, 0xe6, 0xcc, 0x38, 0x76, 0xc0, 0x6d, 0xb6, 0x20,
+	0xee, 0xe6, 0x3b, 0x19, 0x7f, 0xdf, 0x77, 0x7e, 0x66, 0x26, 0xe0, 0xc4, 0x77, 0xd3, 0xbc, 0xe0,
+	0x82, 0x7b, 0xb6, 0xa8, 0x73, 0x5a, 0x3e, 0x1f, 0x45, 0x7c, 0xbb, 0xe5, 0x4c, 0x05, 0x83, 0x1f,
+	0xc1, 0x99, 0xd3, 0x70, 0xf5, 0x0d, 0x8f, 0xa9, 0x37, 0x06, 0x6b, 0x43, 0x6b, 0xdf, 0x38, 0x37,
+	0x26, 0x23, 0x22, 0x97, 0xde, 0x33, 0xb0, 0x7f, 0x0a, 0xb3, 0x8a, 0xfa, 0x26, 0xc6, 0x14, 0xf0,
+	0xde, 0x87, 0x61, 0x42, 0xd3, 0x75, 0x22, 0x7c, 0xeb, 0xdc, 0x98, 0xd8, 0x44, 0x23, 0xcf, 0x83,
+	0x41, 0x99, 0xfe, 0x4c, 0xfd, 0x01, 0x46, 0x71, 0x1d, 0xbc, 0x05, 0xf7, 0x6b, 0xc6, 0x68, 0x81,
+	0x02, 0xcf, 0xc1, 0xc9, 0xe8, 0x4a, 0x7c, 0x15, 0x96, 0x89, 0x56, 0xd9, 0x63, 0xef, 0x0c, 0xdc,
+	0x42, 0xb2, 0xe0, 0x8f, 0x4a, 0xae, 0x0d, 0x3c, 0x4a, 0xb2, 0x02, 0xf7, 0xcd, 0xcb, 0xe5, 0xfc,
+	0xb6, 0xe0, 0x7c, 0xa5, 0x24, 0xc3, 0xd5, 0xa1, 0xa4, 0xc2, 0xde, 0x25, 0x40, 0xda, 0x78, 0x2b,
+	0x7d, 0xf3, 0xdc, 0x9a, 0x3c, 0xbd, 0x1a, 0x4f, 0xb1, 0x4a, 0xd3, 0xbd, 0x69, 0xd2, 0xd9, 0x23,
+	0xd9, 0x0a, 0xce, 0x95, 0x47, 0x4b, 0xb1, 0x35, 0x38, 0xf8, 0xd5, 0x00, 0x4f, 0xea, 0xbe, 0xa9,
+	0x32, 0x91, 0xa2, 0x38, 0xe6, 0x7c, 0x02, 0xa6, 0x50, 0x35, 0xb5, 0x89, 0x29, 0x6a, 0xe9, 0x38,
+	0x69, 0x53, 0xc4, 0x75, 0x53, 0x78, 0xab, 0xa7, 0xf0, 0x83, 0xfe, 0xc2, 0xdb, 0xbd, 0x55, 0x18,
+	0x76, 0xaa, 0xf0, 0x12, 0x4e, 0x0e, 0xdd, 0x78, 0x17, 0x60, 0x33, 0xcc, 0xd4, 0xc0, 0x4c, 0x3f,
+	0xd0, 0x99, 0xfe, 0xd3, 0x33, 0x51, 0xfb, 0x82, 0xef, 0xe0, 0x3d, 0x42, 0xdf, 0x2e, 0x44, 0x28,
+	0xa8, 0x62, 0x38, 0x03, 0xb7, 0x94, 0xa8, 0x53, 0xcd, 0x36, 0x20, 0x5d, 0x6c, 0x68, 0xad, 0x0a,
+	0x39, 0x22, 0xb8, 0xfe, 0x5b, 0xdf, 0xac, 0xc6, 0x71, 0x90, 0x01, 0xfc, 0x57, 0x5e, 0x2c, 0x49,
+	0xe9, 0x5b, 0x18, 0xd5, 0x48, 0xd6, 0x2d, 0x97, 0x94, 0x4d, 0xdd, 0x10, 0x04, 0xbf, 0x1b, 0xe0,
+	0x2e, 0x04, 0x2f, 0xe8, 0xa3, 0xc6, 0xbc, 0x3b, 0xad, 0xd6, 0x43, 0xd3, 0x3a, 0xb8, 0x7f, 0x5a,
+	0xdf, 0xa5, 0x4f, 0x30, 0xe7, 0x51, 0x98, 0x5d, 0xbf, 0x5a, 0x50, 0xe1, 0x7d, 0x08, 0xe6, 0x6c,
+	0xa9, 0x47, 0xf1, 0x54, 0x37, 0x68, 0x46, 0xeb, 0xa5, 0x34, 0x44, 0xcc, 0xd9, 0x52, 0x52, 0x88,
+	0x5d, 0x1a, 0x23, 0xb1, 0x45, 0x70, 0x1d, 0xfc, 0x02, 0x4f, 0x35, 0xc5, 0x3c, 0x2d, 0x85, 0x54,
+	0xcf, 0x0b, 0xba, 0x4a, 0x77, 0x3a, 0x45, 0x8d, 0x9a, 0xbc, 0xcd, 0x36, 0xef, 0x33, 0x70, 0xe3,
+	0xb4, 0xa0, 0x91, 0x48, 0x39, 0xd3, 0x07, 0xab, 0x0d, 0xc8, 0xaa, 0x44, 0xbc, 0x62, 0x42, 0x1f,
+	0x2e, 0x05, 0x7a, 0x0d, 0x7c, 0xbe, 0xcf, 0xe1, 0x86, 0x8a, 0xde, 0x7e, 0xf5, 0x7d, 0xf5, 0x02,
+	0x4e, 0xf1, 0x2b, 0x42, 0xf3, 0x4c, 0x65, 0xd8, 0x69, 0xab, 0xd9, 0x6d, 0x6b, 0x10, 0x82, 0x83,
+	0xfd, 0x93, 0x25, 0x7a, 0x78, 0x58, 0x8e, 0x16, 0xf0, 0xbe, 0x89, 0xfc, 0x52, 0x4b, 0x5c, 0xd3,
+	0xec, 0x88, 0x44, 0xcb, 0x60, 0x1e, 0x30, 0x6c, 0x61, 0xdc, 0x98, 0xfc, 0x36, 0x15, 0xc9, 0xa2,
+	0x66, 0x91, 0xf7, 0x09, 0x38, 0xa5, 0x8c, 0x95, 0x54, 0x20, 0x51, 0x6b, 0xaa, 0xd9, 0x4a, 0xf6,
+	0x1b, 0x70, 0x3c, 0x6a, 0x16, 0x21, 0xad, 0x43, 0x70, 0xed, 0xf9, 0xf0, 0xa4, 0xca, 0xd7, 0x45,
+	0x18, 0x53, 0xf4, 0xeb, 0x90, 0x06, 0x06, 0x5f, 0x68, 0xc3, 0x37, 0x47, 0x6b, 0xd2, 0xd3, 0x90,
+	0xe0, 0x06, 0x4e, 0xf1, 0xeb, 0xd7, 0x09, 0x8d, 0x36, 0x78, 0x14, 0xff, 0x65, 0xd6, 0x2f, 0x34,
+	0xd1, 0x3b, 0x74, 0xf1, 0xb7, 0xe6, 0x18, 0xe2, 0x98, 0x3e, 0x2c, 0xf7, 0x0c, 0xec, 0x52, 0x84,
+	0x85, 0x68, 0x8e, 0x24, 0x02, 0x39, 0xc2, 0x94, 0xc5, 0xcd, 0x45, 0x49, 0x59, 0x2c, 0xb5, 0xca,
+	0x6a, 0x25, 0x87, 0x5d, 0x9d, 0x42, 0x8d, 0xda, 0xe1, 0x55, 0x13, 0xd7, 0x0e, 0xef, 0x96, 0xc7,
+	0xea, 0x00, 0x5a, 0x04, 0xd7, 0xc1, 0x9f, 0x06, 0x9c, 0xec, 0x5d, 0x61, 0x16, 0xad, 0xb8, 0xd1,
+	0x23, 0x6e, 0xf6, 0x89, 0x5b, 0xfd, 0xe2, 0x83, 0xae, 0xf8, 0x18, 0x2c, 0x56, 0x6d, 0xb5, 0x21,
+	0xb9, 0xec, 0xb3, 0x23, 0x1b, 0xce, 0xe8, 0x4e, 0xcc, 0x68, 0xed, 0x3f, 0x41, 0xd2, 0x06, 0xee,
+	0xdb, 0xe8, 0xf4, 0xde, 0x83, 0xee, 0x41, 0xa9, 0x3f, 0x02, 0xf7, 0xb6, 0xa8, 0x18, 0xbd, 0x0e,
+	0x45, 0x28, 0xed, 0xc8, 0x67, 0x46, 0x5d, 0xfc, 0x23, 0xa2, 0x40, 0x30, 0xd1, 0x69, 0x63, 0xcf,
+	0x6e, 0x39, 0xcf, 0x3a, 0x64, 0xc6, 0x01, 0xd9, 0x0f, 0x30, 0x96, 0xef, 0x00, 0x0b, 0xf3, 0x32,
+	0xe1, 0xe2, 0x75, 0x52, 0xb1, 0xcd, 0xf1, 0xee, 0xc5, 0x34, 0x17, 0xea, 0x95, 0xb3, 0x89, 0x02,
+	0x32, 0x9a, 0xb2, 0x98, 0xee, 0xf4, 0x55, 0xa3, 0x40, 0xf0, 0x87, 0x01, 0x1e, 0x0e, 0xe0, 0xff,
+	0x24, 0x20, 0xa3, 0x82, 0x8b, 0x30, 0x6b, 0xee, 0x31, 0x04, 0xde, 0xc7, 0xcd, 0x6b, 0x68, 0x1f,
+	0xbc, 0xfb, 0xfb, 0x67, 0x42, 0x3f, 0x82, 0x72, 0x9f, 0x7a, 0x51, 0x86, 0xf7, 0xed, 0xc3, 0x9f,
+	0x5f, 0x4d, 0xbf, 0xff, 0x74, 0x9d, 0x8a, 0xa4, 0xba, 0x9b, 0x46, 0x7c, 0x7b, 0x21, 0xaa, 0x22,
+	0x65, 0xeb, 0x28, 0x09, 0x53, 0x76, 0x75, 0x79, 0x75, 0xd9, 0xc5, 0x17, 0x48, 0x70, 0x37, 0xc4,
+	0xff, 0x5f, 0x9f, 0xfd, 0x35, 0x00, 0x6b, 0xa0, 0x91, 0xf7, 0xa0, 0x09, 0x00, 0x00,
 }
@@ -70,6 +70,9 @@ var (
 	ErrManyTx                     = errors.New("ErrManyTx")
 	ErrDupTx                      = errors.New("ErrDupTx")
 	ErrMemFull                    = errors.New("ErrMemFull")
+	ErrReplaceTxFeeTooLow         = errors.New("ErrReplaceTxFeeTooLow")
+	ErrTxNonce                    = errors.New("ErrTxNonce")
+	ErrTxNonceTooLow              = errors.New("ErrTxNonceTooLow")
 	ErrNoBalance                  = errors.New("ErrNoBalance")
 	ErrBalanceLessThanTenTimesFee = errors.New("ErrBalanceLessThanTenTimesFee")
 	ErrTxExpire                   = errors.New("ErrTxExpire")
@@ -113,6 +116,8 @@ var (
 	ErrLabelHasUsed         = errors.New("ErrLabelHasUsed")
 	ErrPrivkeyExist         = errors.New("ErrPrivkeyExist")
 	ErrPrivkey              = errors.New("ErrPrivkey")
+	ErrWatchOnlyAccount     = errors.New("ErrWatchOnlyAccount")
+	ErrAddrExist            = errors.New("ErrAddrExist")
 	ErrInsufficientBalance  = errors.New("ErrInsufficientBalance")
 	ErrInsufficientTokenBal = errors.New("ErrInsufficientTokenBalance")
 	ErrInsuffSellOrder      = errors.New("ErrInsufficientSellOrder2buy")
@@ -204,4 +209,14 @@ var (
 	ErrPushNotSubscribed  = errors.New("ErrPushNotSubscribed")
 	ErrTxChainID          = errors.New("ErrTxChainID")
 	ErrTimeout            = errors.New("ErrTimeout")
+	ErrLogFilterNotFound  = errors.New("ErrLogFilterNotFound")
+	ErrTooManyLogFilter   = errors.New("ErrTooManyLogFilter")
+	ErrStateNotFound      = errors.New("ErrStateNotFound")
+	ErrStatePruned        = errors.New("ErrStatePruned")
+	ErrPartialTxSigner    = errors.New("ErrPartialTxSigner")
+	ErrPartialTxMismatch  = errors.New("ErrPartialTxMismatch")
+	ErrPartialTxNotSigned = errors.New("ErrPartialTxNotSigned")
+	ErrNoMatchedSigner    = errors.New("ErrNoMatchedSigner")
+	ErrSignerNotExist     = errors.New("ErrSignerNotExist")
+	ErrPrivKeyNotExport   = errors.New("ErrPrivKeyNotExport")
 )
@@ -132,6 +132,15 @@ const (
 
 	EventReExecBlock  = 142
 	EventTxListByHash = 143
+
+	//store 状态快照
+	EventStoreGetSnapshotChunk    = 144
+	EventStoreImportSnapshotChunk = 145
+	EventStoreCheckSnapshot       = 146
+	//store 历史状态检查
+	EventStoreCheckState = 147
+	//store 多key 状态证明
+	EventStoreGetStateProof = 148
 	//exec
 	EventBlockChainQuery = 212
 	EventConsensusQuery  = 213
@@ -185,6 +194,14 @@ const (
 	EventCheckTxsExist = 357
 	//delete para blocks
 	EventDeleteParaBlocks = 358
+	//从其他节点获取状态快照
+	EventFetchStateSnapshot = 359
+	//轻节点从其他节点获取区块头, 交易证明和状态证明
+	EventFetchLightHeaders = 360
+	EventFetchTxProof      = 361
+	EventFetchStateProof   = 362
+	//远程模块连接上节点的消息队列, 需要补上断开期间错过的消息
+	EventRemoteConnected = 363
 )
 
 var eventName = map[int]string{
@@ -343,4 +360,14 @@ var eventName = map[int]string{
 	EventNetProtocols:               "EventNetProtocols",
 	EventCheckTxsExist:              "EventCheckTxsExist",
 	EventDeleteParaBlocks:           "EventDeleteParaBlocks",
+	EventStoreGetSnapshotChunk:      "EventStoreGetSnapshotChunk",
+	EventStoreImportSnapshotChunk:   "EventStoreImportSnapshotChunk",
+	EventStoreCheckSnapshot:         "EventStoreCheckSnapshot",
+	EventStoreCheckState:            "EventStoreCheckState",
+	EventStoreGetStateProof:         "EventStoreGetStateProof",
+	EventFetchStateSnapshot:         "EventFetchStateSnapshot",
+	EventFetchLightHeaders:          "EventFetchLightHeaders",
+	EventFetchTxProof:               "EventFetchTxProof",
+	EventFetchStateProof:            "EventFetchStateProof",
+	EventRemoteConnected:            "EventRemoteConnected",
 }
@@ -472,6 +472,11 @@ type Amounter interface {
 	GetAmount() int64
 }
 
+//ToAddrsGetter 一笔交易转账给多个地址时, 执行器类型实现这个接口返回所有的收款地址, 用于建立地址的交易索引
+type ToAddrsGetter interface {
+	GetToAddrs(tx *Transaction) []string
+}
+
 //Amount 获取tx交易中的转账金额
 func (base *ExecTypeBase) Amount(tx *Transaction) (int64, error) {
 	_, v, err := base.child.DecodePayloadValue(tx)
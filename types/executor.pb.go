@@ -793,6 +793,233 @@ func (m *AuthorityCfg) GetSignType() string {
 	return ""
 }
 
+// 交易回执中的一条日志, 由logindex 插件按照执行器, 日志类型和地址建立索引
+type LogItem struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TxIndex              int32    `protobuf:"varint,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	LogIndex             int32    `protobuf:"varint,3,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	TxHash               []byte   `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Execer               string   `protobuf:"bytes,5,opt,name=execer,proto3" json:"execer,omitempty"`
+	Ty                   int32    `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
+	Log                  []byte   `protobuf:"bytes,7,opt,name=log,proto3" json:"log,omitempty"`
+	BlockTime            int64    `protobuf:"varint,8,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	Addrs                []string `protobuf:"bytes,9,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogItem) Reset()         { *m = LogItem{} }
+func (m *LogItem) String() string { return proto.CompactTextString(m) }
+func (*LogItem) ProtoMessage()    {}
+func (*LogItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{13}
+}
+
+func (m *LogItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogItem.Unmarshal(m, b)
+}
+func (m *LogItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogItem.Marshal(b, m, deterministic)
+}
+func (m *LogItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogItem.Merge(m, src)
+}
+func (m *LogItem) XXX_Size() int {
+	return xxx_messageInfo_LogItem.Size(m)
+}
+func (m *LogItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogItem proto.InternalMessageInfo
+
+func (m *LogItem) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LogItem) GetTxIndex() int32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *LogItem) GetLogIndex() int32 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *LogItem) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *LogItem) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *LogItem) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *LogItem) GetLog() []byte {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+func (m *LogItem) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *LogItem) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+type ReqGetLogs struct {
+	FromHeight int64 `protobuf:"varint,1,opt,name=fromHeight,proto3" json:"fromHeight,omitempty"`
+	// 小于0 表示到最新的高度, 0 表示只查询到创世区块
+	ToHeight int64 `protobuf:"varint,2,opt,name=toHeight,proto3" json:"toHeight,omitempty"`
+	// 为空表示所有执行器
+	Execer string `protobuf:"bytes,3,opt,name=execer,proto3" json:"execer,omitempty"`
+	// 指定执行器时, 可以只查询部分类型的日志
+	LogTypes []int32 `protobuf:"varint,4,rep,packed,name=logTypes,proto3" json:"logTypes,omitempty"`
+	// 日志中包含的地址, 满足任意一个即可
+	Addrs []string `protobuf:"bytes,5,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	// 最多返回的日志个数, 0 表示使用默认值
+	Count                int32    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqGetLogs) Reset()         { *m = ReqGetLogs{} }
+func (m *ReqGetLogs) String() string { return proto.CompactTextString(m) }
+func (*ReqGetLogs) ProtoMessage()    {}
+func (*ReqGetLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{14}
+}
+
+func (m *ReqGetLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqGetLogs.Unmarshal(m, b)
+}
+func (m *ReqGetLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqGetLogs.Marshal(b, m, deterministic)
+}
+func (m *ReqGetLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqGetLogs.Merge(m, src)
+}
+func (m *ReqGetLogs) XXX_Size() int {
+	return xxx_messageInfo_ReqGetLogs.Size(m)
+}
+func (m *ReqGetLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqGetLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqGetLogs proto.InternalMessageInfo
+
+func (m *ReqGetLogs) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *ReqGetLogs) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *ReqGetLogs) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *ReqGetLogs) GetLogTypes() []int32 {
+	if m != nil {
+		return m.LogTypes
+	}
+	return nil
+}
+
+func (m *ReqGetLogs) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *ReqGetLogs) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyLogs struct {
+	Logs                 []*LogItem `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ReplyLogs) Reset()         { *m = ReplyLogs{} }
+func (m *ReplyLogs) String() string { return proto.CompactTextString(m) }
+func (*ReplyLogs) ProtoMessage()    {}
+func (*ReplyLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12d1cdcda51e000f, []int{15}
+}
+
+func (m *ReplyLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyLogs.Unmarshal(m, b)
+}
+func (m *ReplyLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyLogs.Marshal(b, m, deterministic)
+}
+func (m *ReplyLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyLogs.Merge(m, src)
+}
+func (m *ReplyLogs) XXX_Size() int {
+	return xxx_messageInfo_ReplyLogs.Size(m)
+}
+func (m *ReplyLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyLogs proto.InternalMessageInfo
+
+func (m *ReplyLogs) GetLogs() []*LogItem {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Genesis)(nil), "types.Genesis")
 	proto.RegisterType((*ExecTxList)(nil), "types.ExecTxList")
@@ -807,6 +1034,9 @@ func init() {
 	proto.RegisterType((*ReplyConfig)(nil), "types.ReplyConfig")
 	proto.RegisterType((*HistoryCertStore)(nil), "types.HistoryCertStore")
 	proto.RegisterType((*AuthorityCfg)(nil), "types.AuthorityCfg")
+	proto.RegisterType((*LogItem)(nil), "types.LogItem")
+	proto.RegisterType((*ReqGetLogs)(nil), "types.ReqGetLogs")
+	proto.RegisterType((*ReplyLogs)(nil), "types.ReplyLogs")
 }
 
 func init() {
@@ -814,50 +1044,61 @@ func init() {
 }
 
 var fileDescriptor_12d1cdcda51e000f = []byte{
-	// 713 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xe1, 0x6a, 0xdb, 0x48,
-	0x10, 0x3e, 0x49, 0x76, 0x1c, 0x8f, 0x7d, 0x21, 0xd9, 0x3b, 0x0e, 0x11, 0xee, 0x12, 0xa3, 0xe4,
-	0x72, 0x86, 0x3b, 0x1c, 0xb0, 0xb9, 0x07, 0x48, 0x4c, 0x69, 0x02, 0x4d, 0x69, 0x15, 0xf7, 0x4f,
-	0x7e, 0x14, 0xd6, 0xeb, 0xb1, 0xb5, 0xc4, 0xde, 0x15, 0xab, 0x51, 0xb0, 0xde, 0xa6, 0xcf, 0x52,
-	0xfa, 0x60, 0x65, 0xd7, 0xb2, 0x25, 0x9a, 0xb4, 0xd0, 0x7f, 0x9a, 0xef, 0xfb, 0x34, 0x3b, 0xdf,
-	0xec, 0xce, 0xc0, 0x01, 0xae, 0x51, 0xe4, 0xa4, 0xcd, 0x20, 0x35, 0x9a, 0x34, 0x6b, 0x52, 0x91,
-	0x62, 0x76, 0x7c, 0x44, 0x86, 0xab, 0x8c, 0x0b, 0x92, 0x5a, 0x6d, 0x98, 0xe8, 0x14, 0x5a, 0xaf,
-	0x51, 0x61, 0x26, 0x33, 0xf6, 0x3b, 0x34, 0x65, 0x66, 0x72, 0x15, 0x7a, 0x3d, 0xaf, 0xbf, 0x1f,
-	0x6f, 0x82, 0xe8, 0x93, 0x0f, 0xf0, 0x6a, 0x8d, 0x62, 0xb2, 0x7e, 0x23, 0x33, 0x62, 0x7f, 0x42,
-	0x3b, 0x23, 0x4e, 0x78, 0xc3, 0xb3, 0xc4, 0x09, 0xbb, 0x71, 0x05, 0xb0, 0x13, 0x80, 0x94, 0x1b,
-	0x54, 0xe4, 0xe8, 0x96, 0xa3, 0x6b, 0x08, 0x3b, 0x86, 0xfd, 0x15, 0x97, 0xca, 0xb1, 0xfb, 0x8e,
-	0xdd, 0xc5, 0xf6, 0x5f, 0xf7, 0x8d, 0x72, 0x91, 0x50, 0xd8, 0xee, 0x79, 0xfd, 0x20, 0xae, 0x21,
-	0xf6, 0xe4, 0xe9, 0x52, 0x8b, 0xc7, 0x89, 0x5c, 0x61, 0x18, 0x38, 0xba, 0x02, 0xd8, 0x1f, 0xb0,
-	0x97, 0x6c, 0xfe, 0x6c, 0x38, 0xaa, 0x8c, 0x6c, 0xd6, 0x99, 0x9c, 0xcf, 0xa5, 0xc8, 0x97, 0x54,
-	0x84, 0xcd, 0x9e, 0xd7, 0x6f, 0xc4, 0x35, 0xc4, 0x66, 0x95, 0xd9, 0x1d, 0xae, 0x52, 0xad, 0x97,
-	0xe1, 0x9e, 0x33, 0x5e, 0x01, 0xec, 0x1c, 0x02, 0x5a, 0x67, 0xa1, 0xdf, 0x0b, 0xfa, 0x9d, 0x21,
-	0x1b, 0xb8, 0x2e, 0x0e, 0x26, 0x55, 0x13, 0x63, 0x4b, 0x47, 0x1f, 0xa0, 0xf9, 0x3e, 0x47, 0x53,
-	0xd8, 0x22, 0x6c, 0xe3, 0xd1, 0x94, 0x9d, 0x29, 0x23, 0x6b, 0x7b, 0x9e, 0x2b, 0xf1, 0x96, 0xaf,
-	0x30, 0xf4, 0x7b, 0x5e, 0xbf, 0x1d, 0xef, 0x62, 0x16, 0x42, 0x2b, 0xe5, 0xc5, 0x52, 0xf3, 0x99,
-	0x33, 0xd5, 0x8d, 0xb7, 0x61, 0xf4, 0x11, 0x60, 0x6c, 0x90, 0x13, 0x4e, 0xd6, 0xb7, 0xea, 0xbb,
-	0xb9, 0x4f, 0x00, 0x36, 0xb5, 0xd4, 0xb2, 0xd7, 0x90, 0x1f, 0xe4, 0x3f, 0x83, 0xce, 0x95, 0x31,
-	0xbc, 0x18, 0x6b, 0x35, 0x97, 0x0b, 0x7b, 0xfd, 0x4f, 0x7c, 0x99, 0xdb, 0xde, 0x06, 0xfd, 0x76,
-	0xbc, 0x09, 0xa2, 0x73, 0xe8, 0xde, 0x93, 0x91, 0x6a, 0xf1, 0x5c, 0xe5, 0x55, 0xaa, 0x33, 0xe8,
-	0xdc, 0x2a, 0x1a, 0x0d, 0x5f, 0x12, 0x35, 0xb7, 0xa2, 0x2f, 0x1e, 0xc0, 0x46, 0x70, 0x4b, 0xb8,
-	0x62, 0x87, 0x10, 0x3c, 0x62, 0xe1, 0xdc, 0xb4, 0x63, 0xfb, 0xc9, 0x18, 0x34, 0xf8, 0x6c, 0x66,
-	0x4a, 0x13, 0xee, 0x9b, 0x5d, 0x40, 0xc0, 0x8d, 0x71, 0x89, 0xaa, 0x1b, 0xa8, 0x95, 0x7d, 0xf3,
-	0x4b, 0x6c, 0x05, 0xec, 0x1f, 0x08, 0x32, 0x32, 0xee, 0xf2, 0x3b, 0xc3, 0xdf, 0x4a, 0x5d, 0xbd,
-	0x72, 0x2b, 0xcc, 0xc8, 0x25, 0x94, 0x8a, 0xdc, 0x4b, 0xa8, 0x12, 0xd6, 0x8a, 0xb7, 0x3a, 0xa9,
-	0x88, 0x1d, 0x80, 0x3f, 0x29, 0xc2, 0x8e, 0x33, 0xe0, 0x4f, 0x8a, 0xeb, 0x56, 0xe9, 0x29, 0x7a,
-	0x80, 0xee, 0x9d, 0x9e, 0xc9, 0xf9, 0xb6, 0x6f, 0xcf, 0x7d, 0xec, 0xec, 0xfb, 0xb5, 0x1e, 0xd9,
-	0x84, 0x3a, 0x2d, 0xdb, 0xe6, 0xeb, 0x74, 0xe7, 0xb6, 0x51, 0xb9, 0x8d, 0x04, 0xfc, 0x1a, 0xa3,
-	0x40, 0x99, 0x52, 0x99, 0xfc, 0x6f, 0x68, 0xa4, 0x06, 0x9f, 0x5c, 0xf6, 0xce, 0xf0, 0xa8, 0x2c,
-	0xb7, 0xea, 0x62, 0xec, 0x68, 0xf6, 0x2f, 0xb4, 0x44, 0x6e, 0xec, 0x98, 0xb9, 0x33, 0x5f, 0x54,
-	0x6e, 0x15, 0xd1, 0xff, 0xd0, 0x89, 0x31, 0x5d, 0xfe, 0x64, 0xfd, 0xd1, 0x67, 0x0f, 0x0e, 0x6f,
-	0x64, 0x46, 0xda, 0x14, 0x63, 0x34, 0x74, 0x4f, 0xda, 0xa0, 0x1d, 0x1f, 0xa3, 0x35, 0x09, 0x34,
-	0x94, 0x85, 0x5e, 0x2f, 0xb0, 0xeb, 0x60, 0x07, 0xb0, 0xff, 0xe0, 0x48, 0x2a, 0x42, 0xb3, 0xc2,
-	0x99, 0xe4, 0x84, 0x63, 0xa7, 0xf2, 0x9d, 0xea, 0x39, 0xc1, 0x2e, 0xe0, 0xc0, 0xe0, 0x93, 0x16,
-	0xdc, 0xbe, 0x5d, 0xbb, 0x6c, 0xdc, 0x4b, 0xec, 0xc6, 0xdf, 0xa0, 0xf6, 0x4c, 0x91, 0x1b, 0xbb,
-	0x15, 0x28, 0x29, 0xa7, 0xbd, 0x02, 0x2c, 0xab, 0xd6, 0x54, 0x6e, 0x91, 0xe6, 0x86, 0xdd, 0x01,
-	0xd1, 0x14, 0xba, 0x57, 0x39, 0x25, 0xda, 0x48, 0x2a, 0xc6, 0xf3, 0x85, 0x9b, 0x2a, 0xc5, 0xa7,
-	0x4b, 0x2c, 0x97, 0x5e, 0x19, 0xd9, 0xa9, 0x12, 0xa6, 0x48, 0x49, 0xbf, 0xe3, 0x94, 0x6c, 0xa7,
-	0xaa, 0x42, 0xec, 0x44, 0x67, 0x72, 0xa1, 0x26, 0x45, 0xba, 0x9d, 0x84, 0x5d, 0x7c, 0x7d, 0xfa,
-	0xf0, 0xd7, 0x42, 0x52, 0x92, 0x4f, 0x07, 0x42, 0xaf, 0x2e, 0x47, 0x23, 0xa1, 0x2e, 0x45, 0xc2,
-	0xa5, 0x1a, 0x8d, 0x2e, 0xdd, 0xa5, 0x4c, 0xf7, 0xdc, 0xea, 0x1d, 0x7d, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0xac, 0xc8, 0xee, 0x44, 0xa6, 0x05, 0x00, 0x00,
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xe1, 0x8e, 0xdb, 0x44,
+	0x10, 0xc6, 0x76, 0x7c, 0xb9, 0x4c, 0xc2, 0xa9, 0x67, 0x2a, 0x64, 0x55, 0xa8, 0x44, 0x6e, 0x29,
+	0x91, 0xa8, 0xee, 0xaa, 0x54, 0x3c, 0x40, 0x1b, 0xa1, 0xde, 0x49, 0x57, 0x04, 0xdb, 0xf0, 0xa7,
+	0x3f, 0x90, 0x36, 0xce, 0xc6, 0x59, 0xd5, 0xd9, 0x35, 0xbb, 0xe3, 0x53, 0xfc, 0x36, 0xfc, 0xe4,
+	0x39, 0x10, 0xaf, 0xc1, 0xbb, 0xa0, 0x59, 0x6f, 0x62, 0x5f, 0x8f, 0x22, 0xf1, 0x6f, 0xbf, 0xf9,
+	0xc6, 0x93, 0xf9, 0x66, 0x77, 0xbe, 0xc0, 0x99, 0xd8, 0x8b, 0xbc, 0x46, 0x6d, 0x2e, 0x2a, 0xa3,
+	0x51, 0x27, 0x31, 0x36, 0x95, 0xb0, 0x8f, 0xce, 0xd1, 0x70, 0x65, 0x79, 0x8e, 0x52, 0xab, 0x96,
+	0xc9, 0xbe, 0x86, 0xe1, 0x1b, 0xa1, 0x84, 0x95, 0x36, 0x79, 0x08, 0xb1, 0xb4, 0xa6, 0x56, 0x69,
+	0x30, 0x0d, 0x66, 0xa7, 0xac, 0x05, 0xd9, 0xef, 0x21, 0xc0, 0x0f, 0x7b, 0x91, 0x2f, 0xf7, 0x37,
+	0xd2, 0x62, 0xf2, 0x15, 0x8c, 0x2c, 0x72, 0x14, 0x57, 0xdc, 0x6e, 0x5d, 0xe2, 0x84, 0x75, 0x81,
+	0xe4, 0x31, 0x40, 0xc5, 0x8d, 0x50, 0xe8, 0xe8, 0xa1, 0xa3, 0x7b, 0x91, 0xe4, 0x11, 0x9c, 0xee,
+	0xb8, 0x54, 0x8e, 0x3d, 0x75, 0xec, 0x11, 0xd3, 0xb7, 0xee, 0x2c, 0x64, 0xb1, 0xc5, 0x74, 0x34,
+	0x0d, 0x66, 0x11, 0xeb, 0x45, 0xe8, 0x97, 0x57, 0xa5, 0xce, 0x3f, 0x2c, 0xe5, 0x4e, 0xa4, 0x91,
+	0xa3, 0xbb, 0x40, 0xf2, 0x25, 0x9c, 0x6c, 0xdb, 0x2f, 0x07, 0x8e, 0xf2, 0x88, 0xaa, 0xae, 0xe5,
+	0x66, 0x23, 0xf3, 0xba, 0xc4, 0x26, 0x8d, 0xa7, 0xc1, 0x6c, 0xc0, 0x7a, 0x11, 0xaa, 0x2a, 0xed,
+	0x5b, 0xb1, 0xab, 0xb4, 0x2e, 0xd3, 0x13, 0x27, 0xbc, 0x0b, 0x24, 0x4f, 0x21, 0xc2, 0xbd, 0x4d,
+	0xc3, 0x69, 0x34, 0x1b, 0xcf, 0x93, 0x0b, 0x37, 0xc5, 0x8b, 0x65, 0x37, 0x44, 0x46, 0x74, 0xf6,
+	0x0b, 0xc4, 0x3f, 0xd7, 0xc2, 0x34, 0xd4, 0x04, 0x0d, 0x5e, 0x18, 0x3f, 0x19, 0x8f, 0x48, 0xf6,
+	0xa6, 0x56, 0xf9, 0x8f, 0x7c, 0x27, 0xd2, 0x70, 0x1a, 0xcc, 0x46, 0xec, 0x88, 0x93, 0x14, 0x86,
+	0x15, 0x6f, 0x4a, 0xcd, 0xd7, 0x4e, 0xd4, 0x84, 0x1d, 0x60, 0xf6, 0x2b, 0xc0, 0xc2, 0x08, 0x8e,
+	0x62, 0xb9, 0xbf, 0x56, 0x9f, 0xac, 0xfd, 0x18, 0xa0, 0xed, 0xa5, 0x57, 0xbd, 0x17, 0xf9, 0x8f,
+	0xfa, 0x4f, 0x60, 0xfc, 0xca, 0x18, 0xde, 0x2c, 0xb4, 0xda, 0xc8, 0x82, 0xae, 0xff, 0x96, 0x97,
+	0x35, 0xcd, 0x36, 0x9a, 0x8d, 0x58, 0x0b, 0xb2, 0xa7, 0x30, 0x79, 0x87, 0x46, 0xaa, 0xe2, 0x7e,
+	0x56, 0xd0, 0x65, 0x3d, 0x81, 0xf1, 0xb5, 0xc2, 0x97, 0xf3, 0x7f, 0x4b, 0x8a, 0x0f, 0x49, 0x7f,
+	0x05, 0x00, 0x6d, 0xc2, 0x35, 0x8a, 0x5d, 0xf2, 0x00, 0xa2, 0x0f, 0xa2, 0x71, 0x6a, 0x46, 0x8c,
+	0x8e, 0x49, 0x02, 0x03, 0xbe, 0x5e, 0x1b, 0x2f, 0xc2, 0x9d, 0x93, 0x67, 0x10, 0x71, 0x63, 0x5c,
+	0xa1, 0xee, 0x06, 0x7a, 0x6d, 0x5f, 0x7d, 0xc6, 0x28, 0x21, 0xf9, 0x16, 0x22, 0x8b, 0xc6, 0x5d,
+	0xfe, 0x78, 0xfe, 0x85, 0xcf, 0xeb, 0x77, 0x4e, 0x89, 0x16, 0x5d, 0x41, 0xa9, 0x30, 0x8d, 0xef,
+	0x14, 0xec, 0x35, 0x4f, 0x79, 0x52, 0x61, 0x72, 0x06, 0xe1, 0xb2, 0x49, 0xc7, 0x4e, 0x40, 0xb8,
+	0x6c, 0x5e, 0x0f, 0xbd, 0xa6, 0xec, 0x3d, 0x4c, 0xde, 0xea, 0xb5, 0xdc, 0x1c, 0xe6, 0x76, 0x5f,
+	0xc7, 0x51, 0x7e, 0xd8, 0x9b, 0x11, 0x15, 0xd4, 0x95, 0x1f, 0x5b, 0xa8, 0xab, 0xa3, 0xda, 0x41,
+	0xa7, 0x36, 0xcb, 0xe1, 0x73, 0x26, 0x72, 0x21, 0x2b, 0xf4, 0xc5, 0xbf, 0x81, 0x41, 0x65, 0xc4,
+	0xad, 0xab, 0x3e, 0x9e, 0x9f, 0xfb, 0x76, 0xbb, 0x29, 0x32, 0x47, 0x27, 0xdf, 0xc1, 0x30, 0xaf,
+	0x0d, 0xad, 0x59, 0x1a, 0x7e, 0x2a, 0xf3, 0x90, 0x91, 0x7d, 0x0f, 0x63, 0x26, 0xaa, 0xf2, 0x7f,
+	0xf6, 0x9f, 0xfd, 0x19, 0xc0, 0x83, 0x2b, 0x69, 0x51, 0x9b, 0x66, 0x21, 0x0c, 0xbe, 0x43, 0x6d,
+	0x04, 0xad, 0x8f, 0xd1, 0x1a, 0x73, 0x61, 0xd0, 0xa6, 0xc1, 0x34, 0x22, 0x3b, 0x38, 0x06, 0x92,
+	0xe7, 0x70, 0x2e, 0x15, 0x0a, 0xb3, 0x13, 0x6b, 0xc9, 0x51, 0x2c, 0x5c, 0x56, 0xe8, 0xb2, 0xee,
+	0x13, 0xc9, 0x33, 0x38, 0x33, 0xe2, 0x56, 0xe7, 0x9c, 0xde, 0x2e, 0x99, 0x8d, 0x7b, 0x89, 0x13,
+	0xf6, 0x51, 0x94, 0x7e, 0x33, 0xaf, 0x0d, 0xb9, 0x02, 0x6e, 0xfd, 0xb6, 0x77, 0x01, 0x62, 0xd5,
+	0x1e, 0xbd, 0x8b, 0xc4, 0x2d, 0x7b, 0x0c, 0x64, 0x2b, 0x98, 0xbc, 0xaa, 0x71, 0xab, 0x8d, 0xc4,
+	0x66, 0xb1, 0x29, 0xdc, 0x56, 0x29, 0xbe, 0x2a, 0x85, 0x37, 0x3d, 0x8f, 0x68, 0xab, 0x72, 0xd3,
+	0x54, 0xa8, 0x7f, 0xe2, 0xb8, 0x3d, 0x6c, 0x55, 0x17, 0xa1, 0x8d, 0xb6, 0xb2, 0x50, 0xcb, 0xa6,
+	0x3a, 0x6c, 0xc2, 0x11, 0x67, 0x7f, 0x07, 0x30, 0xbc, 0xd1, 0xed, 0x23, 0xef, 0x6c, 0x29, 0xb8,
+	0x63, 0x4b, 0x29, 0x0c, 0x71, 0x7f, 0xad, 0xd6, 0x62, 0xef, 0x8a, 0xc7, 0xec, 0x00, 0xa9, 0x72,
+	0xa9, 0x8b, 0x96, 0x6a, 0xd7, 0xe7, 0x88, 0xa9, 0x1a, 0xee, 0x9d, 0x79, 0x0e, 0x5a, 0x0f, 0x68,
+	0x51, 0xcf, 0x1b, 0x62, 0xd7, 0x8b, 0x47, 0xf4, 0xe4, 0xb0, 0x71, 0xae, 0x16, 0xb3, 0x10, 0x1b,
+	0xba, 0xea, 0x52, 0x17, 0xde, 0x97, 0xe9, 0x78, 0xd7, 0x54, 0x4f, 0x3f, 0x36, 0xd5, 0x87, 0x10,
+	0xd3, 0xb3, 0xb4, 0xe9, 0xa8, 0xb5, 0x04, 0x07, 0xb2, 0x3f, 0x02, 0x00, 0x26, 0x7e, 0x7b, 0x23,
+	0xf0, 0x46, 0x17, 0x96, 0x46, 0xb5, 0x31, 0x7a, 0x77, 0xd5, 0x97, 0xd9, 0x8b, 0x90, 0x20, 0xd4,
+	0x9e, 0x0d, 0x1d, 0x7b, 0xc4, 0xbd, 0xc6, 0xa3, 0x3b, 0x8d, 0xb7, 0x43, 0xa0, 0x69, 0xda, 0x74,
+	0x30, 0x8d, 0xfc, 0x10, 0x1c, 0xee, 0x9a, 0x8a, 0x7b, 0x4d, 0x51, 0x34, 0xd7, 0xb5, 0x42, 0xaf,
+	0xb6, 0x05, 0xd9, 0x25, 0x8c, 0xdc, 0x53, 0x77, 0x8d, 0x66, 0x30, 0x28, 0x75, 0xd1, 0x3e, 0xd3,
+	0xf1, 0xfc, 0xcc, 0x6f, 0x88, 0xbf, 0x29, 0xe6, 0xb8, 0xd7, 0x17, 0xef, 0x9f, 0x17, 0x12, 0xb7,
+	0xf5, 0xea, 0x22, 0xd7, 0xbb, 0x4b, 0xac, 0xc9, 0x3f, 0xf2, 0x2d, 0x97, 0x6a, 0xfe, 0x62, 0xfe,
+	0xa2, 0x8f, 0x2f, 0xdd, 0xd7, 0xab, 0x13, 0xf7, 0x2f, 0xfa, 0xf2, 0x9f, 0x01, 0x00, 0xc5, 0xee,
+	0x72, 0x1d, 0x71, 0x07, 0x00, 0x00,
 }
@@ -139,12 +139,15 @@ func (f *Forks) SetTestNetFork() {
 	f.SetFork("ForkCacheDriver", 2580000)
 	f.SetFork("ForkTicketFundAddrV1", 3350000)
 	f.SetFork("ForkRootHash", 4500000)
+	//开启之后交易nonce 按照账户顺序递增, 默认不开启
+	f.SetFork("ForkTxNonce", MaxHeight)
 }
 
 func (f *Forks) setLocalFork() {
 	f.SetAllFork(0)
 	f.ReplaceFork("ForkBlockHash", 1)
 	f.ReplaceFork("ForkRootHash", 1)
+	f.ReplaceFork("ForkTxNonce", MaxHeight)
 }
 
 //paraName not used currently
@@ -152,6 +155,7 @@ func (f *Forks) setForkForParaZero() {
 	f.SetAllFork(0)
 	f.ReplaceFork("ForkBlockHash", 1)
 	f.ReplaceFork("ForkRootHash", 1)
+	f.ReplaceFork("ForkTxNonce", MaxHeight)
 }
 
 // IsFork 是否系统 fork高度
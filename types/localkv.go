@@ -22,6 +22,8 @@ var (
 	ConsensusParaTxsPrefix = []byte("LODBP:Consensus:Para:")            //存贮para共识模块从主链拉取的平行链交易
 	FlagReduceLocaldb      = []byte("FLAG:ReduceLocaldb")               // 精简版localdb标记
 	ReduceLocaldbHeight    = append(FlagReduceLocaldb, []byte(":H")...) // 精简版localdb高度
+	FlagLogIndex           = []byte("FLAG:LogIndex")                    // 交易回执日志索引标记
+	LogIndexPrefix         = []byte("LogIndex:")
 )
 
 // GetLocalDBKeyList 获取localdb的key列表
@@ -117,3 +119,33 @@ func CalcConsensusParaTxsKey(key []byte) []byte {
 func CheckConsensusParaTxsKey(key []byte) bool {
 	return bytes.HasPrefix(key, ConsensusParaTxsPrefix)
 }
+
+//LogIndexStr 日志在区块中的位置, 格式为 height*100000+index:logIndex, 按照字符串排序即为日志的先后顺序
+func LogIndexStr(height int64, txIndex, logIndex int32) string {
+	return fmt.Sprintf("%018d:%04d", height*MaxTxsPerBlock+int64(txIndex), logIndex)
+}
+
+//LogHeightStr 指定高度第一条日志之前的位置, 用于按照高度查询日志
+func LogHeightStr(height int64) string {
+	return fmt.Sprintf("%018d", height*MaxTxsPerBlock)
+}
+
+//CalcLogKey 保存日志内容, key=LogIndex:log:logindex
+func CalcLogKey(logindex string) []byte {
+	return []byte(fmt.Sprintf("%slog:%s", LogIndexPrefix, logindex))
+}
+
+//CalcLogExecKey 执行器下所有日志的索引, key=LogIndex:exec:execer:logindex
+func CalcLogExecKey(execer string, logindex string) []byte {
+	return []byte(fmt.Sprintf("%sexec:%s:%s", LogIndexPrefix, execer, logindex))
+}
+
+//CalcLogTypeKey 执行器下某种类型日志的索引, key=LogIndex:ty:execer:ty:logindex
+func CalcLogTypeKey(execer string, ty int32, logindex string) []byte {
+	return []byte(fmt.Sprintf("%sty:%s:%d:%s", LogIndexPrefix, execer, ty, logindex))
+}
+
+//CalcLogAddrKey 日志中包含的地址的索引, key=LogIndex:addr:addr:logindex
+func CalcLogAddrKey(addr string, logindex string) []byte {
+	return []byte(fmt.Sprintf("%saddr:%s:%s", LogIndexPrefix, addr, logindex))
+}
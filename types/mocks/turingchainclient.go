@@ -827,6 +827,36 @@ func (_m *TuringchainClient) GetLastMemPool(ctx context.Context, in *types.ReqNi
 	return r0, r1
 }
 
+// GetLogs provides a mock function with given fields: ctx, in, opts
+func (_m *TuringchainClient) GetLogs(ctx context.Context, in *types.ReqGetLogs, opts ...grpc.CallOption) (*types.ReplyLogs, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.ReplyLogs
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqGetLogs, ...grpc.CallOption) *types.ReplyLogs); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ReplyLogs)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqGetLogs, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMemPool provides a mock function with given fields: ctx, in, opts
 func (_m *TuringchainClient) GetMemPool(ctx context.Context, in *types.ReqGetMempool, opts ...grpc.CallOption) (*types.ReplyTxList, error) {
 	_va := make([]interface{}, len(opts))
@@ -977,6 +1007,36 @@ func (_m *TuringchainClient) GetProperFee(ctx context.Context, in *types.ReqProp
 	return r0, r1
 }
 
+// GetQueueStats provides a mock function with given fields: ctx, in, opts
+func (_m *TuringchainClient) GetQueueStats(ctx context.Context, in *types.ReqString, opts ...grpc.CallOption) (*types.QueueStats, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueueStats
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqString, ...grpc.CallOption) *types.QueueStats); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueueStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqString, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSeed provides a mock function with given fields: ctx, in, opts
 func (_m *TuringchainClient) GetSeed(ctx context.Context, in *types.GetSeedByPw, opts ...grpc.CallOption) (*types.ReplySeed, error) {
 	_va := make([]interface{}, len(opts))
@@ -1067,6 +1127,36 @@ func (_m *TuringchainClient) GetServerTime(ctx context.Context, in *types.ReqNil
 	return r0, r1
 }
 
+// GetStateProof provides a mock function with given fields: ctx, in, opts
+func (_m *TuringchainClient) GetStateProof(ctx context.Context, in *types.ReqStateProof, opts ...grpc.CallOption) (*types.StateProof, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.StateProof
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqStateProof, ...grpc.CallOption) *types.StateProof); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StateProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqStateProof, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransactionByAddr provides a mock function with given fields: ctx, in, opts
 func (_m *TuringchainClient) GetTransactionByAddr(ctx context.Context, in *types.ReqAddr, opts ...grpc.CallOption) (*types.ReplyTxInfos, error) {
 	_va := make([]interface{}, len(opts))
@@ -1787,6 +1877,36 @@ func (_m *TuringchainClient) SignRawTx(ctx context.Context, in *types.ReqSignRaw
 	return r0, r1
 }
 
+// Subscribe provides a mock function with given fields: ctx, in, opts
+func (_m *TuringchainClient) Subscribe(ctx context.Context, in *types.ReqSubscribe, opts ...grpc.CallOption) (types.Turingchain_SubscribeClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 types.Turingchain_SubscribeClient
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ReqSubscribe, ...grpc.CallOption) types.Turingchain_SubscribeClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.Turingchain_SubscribeClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ReqSubscribe, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnLock provides a mock function with given fields: ctx, in, opts
 func (_m *TuringchainClient) UnLock(ctx context.Context, in *types.WalletUnLock, opts ...grpc.CallOption) (*types.Reply, error) {
 	_va := make([]interface{}, len(opts))
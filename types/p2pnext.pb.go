@@ -2365,6 +2365,126 @@ func (m *ProtocolInfo) GetRatetotal() string {
 	return ""
 }
 
+//请求快照高度的状态快照, index 小于0 时请求快照信息
+type ReqStateSnapshot struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Depth                int32    `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Index                int32    `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStateSnapshot) Reset()         { *m = ReqStateSnapshot{} }
+func (m *ReqStateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ReqStateSnapshot) ProtoMessage()    {}
+func (*ReqStateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d81e96199caf00d1, []int{43}
+}
+
+func (m *ReqStateSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStateSnapshot.Unmarshal(m, b)
+}
+func (m *ReqStateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStateSnapshot.Marshal(b, m, deterministic)
+}
+func (m *ReqStateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStateSnapshot.Merge(m, src)
+}
+func (m *ReqStateSnapshot) XXX_Size() int {
+	return xxx_messageInfo_ReqStateSnapshot.Size(m)
+}
+func (m *ReqStateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStateSnapshot proto.InternalMessageInfo
+
+func (m *ReqStateSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqStateSnapshot) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ReqStateSnapshot) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *ReqStateSnapshot) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+//状态快照信息, 包含快照高度的区块以及快照分片的个数
+type StateSnapshotManifest struct {
+	Block                *BlockDetail `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Depth                int32        `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	Total                int32        `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *StateSnapshotManifest) Reset()         { *m = StateSnapshotManifest{} }
+func (m *StateSnapshotManifest) String() string { return proto.CompactTextString(m) }
+func (*StateSnapshotManifest) ProtoMessage()    {}
+func (*StateSnapshotManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d81e96199caf00d1, []int{44}
+}
+
+func (m *StateSnapshotManifest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateSnapshotManifest.Unmarshal(m, b)
+}
+func (m *StateSnapshotManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateSnapshotManifest.Marshal(b, m, deterministic)
+}
+func (m *StateSnapshotManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateSnapshotManifest.Merge(m, src)
+}
+func (m *StateSnapshotManifest) XXX_Size() int {
+	return xxx_messageInfo_StateSnapshotManifest.Size(m)
+}
+func (m *StateSnapshotManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateSnapshotManifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateSnapshotManifest proto.InternalMessageInfo
+
+func (m *StateSnapshotManifest) GetBlock() *BlockDetail {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *StateSnapshotManifest) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *StateSnapshotManifest) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MessageComm)(nil), "types.MessageComm")
 	proto.RegisterType((*MessageUtil)(nil), "types.MessageUtil")
@@ -2409,6 +2529,8 @@ func init() {
 	proto.RegisterType((*RemoveTopicReply)(nil), "types.RemoveTopicReply")
 	proto.RegisterType((*NetProtocolInfos)(nil), "types.NetProtocolInfos")
 	proto.RegisterType((*ProtocolInfo)(nil), "types.ProtocolInfo")
+	proto.RegisterType((*ReqStateSnapshot)(nil), "types.ReqStateSnapshot")
+	proto.RegisterType((*StateSnapshotManifest)(nil), "types.StateSnapshotManifest")
 }
 
 func init() {
@@ -2416,92 +2538,97 @@ func init() {
 }
 
 var fileDescriptor_d81e96199caf00d1 = []byte{
-	// 1377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x73, 0xdb, 0x36,
-	0x13, 0xd6, 0x47, 0x64, 0x49, 0x2b, 0xd9, 0x96, 0x11, 0xbf, 0x1e, 0x4d, 0xe6, 0x6d, 0xeb, 0x61,
-	0x2f, 0x4a, 0xdb, 0x38, 0x09, 0x9d, 0x43, 0x93, 0xe6, 0x12, 0x39, 0x69, 0xe4, 0xb6, 0xf6, 0x68,
-	0xd0, 0x36, 0x87, 0xde, 0x68, 0x12, 0x91, 0x38, 0x16, 0x09, 0x9a, 0x00, 0x95, 0x28, 0xd3, 0x43,
-	0xff, 0x56, 0xff, 0x51, 0x7b, 0xec, 0x3f, 0xe8, 0x00, 0x04, 0x48, 0x90, 0x96, 0x33, 0x13, 0xd6,
-	0xba, 0x61, 0x81, 0x67, 0xf7, 0xd9, 0x2f, 0xac, 0x40, 0xc1, 0x76, 0x64, 0x47, 0x21, 0x79, 0xcf,
-	0x8f, 0xa2, 0x98, 0x72, 0x8a, 0x5a, 0x7c, 0x15, 0x11, 0x76, 0xaf, 0x1b, 0xd9, 0x51, 0xba, 0x73,
-	0x6f, 0x70, 0xb1, 0xa0, 0xee, 0xa5, 0x3b, 0x77, 0xfc, 0x30, 0xdd, 0xb1, 0xfe, 0xac, 0x43, 0xef,
-	0x8c, 0x30, 0xe6, 0xcc, 0xc8, 0x09, 0x0d, 0x02, 0x34, 0x84, 0xf6, 0x92, 0xc4, 0xcc, 0xa7, 0xe1,
-	0xb0, 0x7e, 0x58, 0x1f, 0x75, 0xb1, 0x16, 0xd1, 0xff, 0xa1, 0xcb, 0xfd, 0x80, 0x30, 0xee, 0x04,
-	0xd1, 0xb0, 0x71, 0x58, 0x1f, 0x35, 0x71, 0xbe, 0x81, 0x76, 0xa0, 0xe1, 0x7b, 0xc3, 0xa6, 0x54,
-	0x69, 0xf8, 0x1e, 0x3a, 0x80, 0xad, 0x19, 0x65, 0xcc, 0x8f, 0x86, 0x77, 0x0e, 0xeb, 0xa3, 0x0e,
-	0x56, 0x92, 0xd8, 0x0f, 0xa9, 0x47, 0x4e, 0xbd, 0x61, 0x4b, 0x62, 0x95, 0x84, 0x3e, 0x07, 0x10,
-	0xab, 0x69, 0x72, 0xf1, 0x23, 0x59, 0x0d, 0xb7, 0x0e, 0xeb, 0xa3, 0x3e, 0x36, 0x76, 0x10, 0x82,
-	0x3b, 0xcc, 0x9f, 0x85, 0xc3, 0xb6, 0x3c, 0x91, 0x6b, 0xeb, 0x9f, 0x46, 0xe6, 0xfb, 0xaf, 0xdc,
-	0x5f, 0xa0, 0xaf, 0x60, 0xcb, 0xa5, 0x41, 0xa0, 0x5c, 0xef, 0xd9, 0xe8, 0x48, 0x26, 0xe0, 0xc8,
-	0x88, 0x0f, 0x2b, 0x04, 0x7a, 0x04, 0x9d, 0x88, 0x90, 0xf8, 0x34, 0x7c, 0x4b, 0x65, 0x30, 0x39,
-	0x7a, 0x6a, 0x4f, 0xa7, 0xea, 0x64, 0x52, 0xc3, 0x19, 0x0a, 0x3d, 0xc8, 0x33, 0xd3, 0x94, 0x0a,
-	0x7b, 0xb9, 0xc2, 0x9b, 0xf4, 0x60, 0x52, 0xcb, 0xd3, 0x65, 0x03, 0xa8, 0xe5, 0x0b, 0xf7, 0x52,
-	0x26, 0xa1, 0x67, 0x0f, 0x0a, 0x1a, 0x2f, 0xdc, 0xcb, 0x49, 0x0d, 0x1b, 0x28, 0xf4, 0x04, 0x3a,
-	0xe4, 0x3d, 0x27, 0x71, 0xe8, 0x2c, 0x64, 0x7a, 0x7a, 0xf6, 0x41, 0xae, 0xf1, 0x4a, 0x9d, 0x68,
-	0xc7, 0x34, 0x12, 0x1d, 0x43, 0x77, 0x46, 0xb8, 0xac, 0x2c, 0x93, 0x99, 0xeb, 0xd9, 0x77, 0x73,
-	0xb5, 0xd7, 0x84, 0x8f, 0xe5, 0xd1, 0xa4, 0x86, 0x73, 0x1c, 0x7a, 0x00, 0x1d, 0x3f, 0x5c, 0x7a,
-	0x0e, 0x77, 0x98, 0xcc, 0x69, 0xcf, 0xde, 0x55, 0x3a, 0xa7, 0xe1, 0xf2, 0xa5, 0xd8, 0x16, 0x1c,
-	0x1a, 0x32, 0x6e, 0x43, 0x6b, 0xe9, 0x2c, 0x12, 0x62, 0xfd, 0x00, 0x48, 0xa5, 0x53, 0x27, 0x09,
-	0x93, 0x2b, 0xf4, 0x04, 0x7a, 0x41, 0xba, 0x2b, 0x54, 0x3f, 0x92, 0x7e, 0x13, 0x66, 0xad, 0xe0,
-	0xee, 0x35, 0x5b, 0x2c, 0xaa, 0x66, 0x0c, 0x7d, 0x03, 0x6d, 0x25, 0xde, 0x5c, 0x4f, 0xac, 0x21,
-	0xd6, 0x0a, 0xf6, 0x35, 0x75, 0x56, 0xbd, 0xca, 0x81, 0xa0, 0xaf, 0xcb, 0xdc, 0xd7, 0x5b, 0x23,
-	0xa7, 0xfe, 0x00, 0xff, 0x5b, 0x43, 0x5d, 0x39, 0xee, 0x4f, 0xe2, 0x8e, 0x60, 0x47, 0x73, 0xfb,
-	0xe1, 0xac, 0x7a, 0xc0, 0xa3, 0x32, 0xe9, 0x8e, 0x91, 0x6c, 0x61, 0x39, 0x63, 0xbc, 0x82, 0xdd,
-	0x02, 0x63, 0xe5, 0x38, 0x3f, 0x4a, 0x49, 0x4d, 0x4a, 0x96, 0x05, 0xf9, 0xc2, 0xf3, 0xe2, 0xcd,
-	0x54, 0xf5, 0x35, 0xe1, 0xd2, 0xf8, 0x9a, 0x38, 0x53, 0xd2, 0x8d, 0xc4, 0x59, 0xa4, 0x4c, 0x0a,
-	0x94, 0x3f, 0xf9, 0x8c, 0x6f, 0xe0, 0xea, 0x68, 0xd3, 0x39, 0xed, 0x59, 0xd6, 0xbf, 0x7a, 0x22,
-	0x9d, 0x13, 0x5e, 0x7d, 0x08, 0xfc, 0x51, 0x87, 0x83, 0x75, 0xf6, 0x2a, 0x27, 0xf0, 0x51, 0x39,
-	0x9a, 0x1b, 0x66, 0xa8, 0x79, 0x23, 0xf5, 0x1c, 0xca, 0x86, 0x65, 0xf5, 0xae, 0x79, 0x50, 0xa6,
-	0x5f, 0x37, 0x8b, 0x73, 0xee, 0x77, 0xd9, 0x20, 0x32, 0xb8, 0x2b, 0xc7, 0x7e, 0xbf, 0x4c, 0x5e,
-	0x1e, 0xea, 0x39, 0xf1, 0xef, 0x26, 0xf1, 0x19, 0x09, 0x22, 0x4a, 0x17, 0xd5, 0xa3, 0x3e, 0x2a,
-	0x13, 0xef, 0x17, 0xa2, 0xd6, 0xf6, 0x8d, 0xeb, 0xa2, 0xef, 0xa8, 0x9a, 0x51, 0xb7, 0x1d, 0xb0,
-	0x32, 0x6b, 0x04, 0xfc, 0x1e, 0x06, 0xca, 0xcc, 0x84, 0x38, 0x1e, 0x89, 0x37, 0x16, 0x6c, 0x6a,
-	0xde, 0x60, 0x5e, 0xc2, 0x5e, 0x89, 0x79, 0x23, 0xd3, 0xfe, 0x1a, 0x2f, 0xcb, 0x78, 0x55, 0xf9,
-	0x37, 0x30, 0xf0, 0xb5, 0xe5, 0x8c, 0x34, 0xce, 0x07, 0x3e, 0x21, 0xff, 0x65, 0x2a, 0xdd, 0x58,
-	0x5a, 0x6d, 0x37, 0xe7, 0xe4, 0x59, 0x37, 0x9d, 0x13, 0x2e, 0x1f, 0x6b, 0xb7, 0x3c, 0x08, 0xcf,
-	0xa9, 0xa7, 0x4d, 0x9b, 0x91, 0xee, 0x19, 0x91, 0x32, 0x4c, 0xa2, 0xc5, 0xea, 0x93, 0xde, 0xa0,
-	0x8f, 0x01, 0xa2, 0x4c, 0xb3, 0x5c, 0xcf, 0xec, 0x00, 0x1b, 0x20, 0x2b, 0xcc, 0x9a, 0x78, 0x1c,
-	0x53, 0xc7, 0x3b, 0x71, 0x18, 0xff, 0x24, 0xca, 0x1b, 0x5b, 0x37, 0x33, 0x57, 0xac, 0x26, 0x85,
-	0xbd, 0xa9, 0x3d, 0x2d, 0x74, 0x2f, 0xbb, 0x85, 0x6f, 0x84, 0xa6, 0xfc, 0x46, 0xd0, 0x6f, 0xfa,
-	0x96, 0xf1, 0xa6, 0xff, 0xbb, 0x01, 0x30, 0xb5, 0xa7, 0x98, 0x5c, 0x25, 0x84, 0x71, 0x64, 0x43,
-	0x7b, 0x9e, 0xb2, 0xaa, 0xe0, 0x86, 0x79, 0xbf, 0x17, 0xbd, 0xc2, 0x1a, 0x88, 0xc6, 0xb0, 0x1b,
-	0x93, 0xab, 0x93, 0x79, 0x12, 0x5e, 0x62, 0xe2, 0xd2, 0xd8, 0x63, 0xa5, 0x1f, 0x02, 0x5c, 0x3c,
-	0x9d, 0xd4, 0x70, 0x59, 0x01, 0x3d, 0x85, 0xbe, 0x2b, 0x64, 0x51, 0xf1, 0x33, 0x36, 0x53, 0x2f,
-	0x7e, 0x3d, 0xca, 0x4f, 0x8c, 0xa3, 0x49, 0x0d, 0x17, 0xa0, 0xe8, 0x39, 0x6c, 0x67, 0xb2, 0x68,
-	0x53, 0xf5, 0xf6, 0xdf, 0x2f, 0xeb, 0x8a, 0xb3, 0x49, 0x0d, 0x17, 0xc1, 0xe8, 0x11, 0x74, 0x63,
-	0x72, 0x95, 0xfe, 0x10, 0xa8, 0x6f, 0x80, 0x41, 0xee, 0x76, 0xfe, 0x92, 0xcf, 0x40, 0xe2, 0x25,
-	0x1f, 0x93, 0x2b, 0xd9, 0x2f, 0xea, 0xf5, 0xbf, 0x9b, 0x2b, 0xc8, 0x6d, 0xf1, 0x92, 0xd7, 0x90,
-	0x71, 0x17, 0xda, 0x71, 0x9a, 0x5c, 0xeb, 0x39, 0x74, 0x34, 0x04, 0xdd, 0x13, 0x56, 0xde, 0x92,
-	0x58, 0x7c, 0x7d, 0xd5, 0x65, 0x3d, 0x32, 0x19, 0xed, 0x43, 0xcb, 0xa5, 0x49, 0xc8, 0x65, 0x1a,
-	0x5b, 0x38, 0x15, 0x2c, 0x0b, 0x3a, 0x13, 0x87, 0xcd, 0xa5, 0xd7, 0x07, 0xb0, 0x35, 0x77, 0xd8,
-	0x9c, 0x88, 0x2a, 0x35, 0x47, 0x7d, 0xac, 0x24, 0xeb, 0x19, 0x6c, 0x17, 0xe2, 0x45, 0xf7, 0xa1,
-	0xe5, 0x73, 0x12, 0xa4, 0xb8, 0xf5, 0x09, 0xc5, 0x29, 0xc2, 0xfa, 0xab, 0x01, 0x3d, 0xd9, 0x09,
-	0x2c, 0xa2, 0x21, 0x23, 0x95, 0x5a, 0x61, 0x1f, 0x5a, 0x24, 0x8e, 0x69, 0x2c, 0x3d, 0xef, 0xe2,
-	0x54, 0x40, 0x8f, 0xa1, 0xe7, 0x2e, 0x28, 0x23, 0x71, 0x9a, 0xb4, 0xa6, 0x74, 0xc5, 0x9c, 0x2e,
-	0xf2, 0x9e, 0x9b, 0x18, 0x51, 0x16, 0xf9, 0xe1, 0x34, 0xa6, 0xde, 0xaa, 0x54, 0x96, 0xb1, 0xde,
-	0x17, 0x65, 0xc9, 0x40, 0xe8, 0x09, 0xf4, 0xa5, 0xa0, 0x7c, 0x52, 0xa5, 0xd1, 0x63, 0x53, 0xed,
-	0x8a, 0xe6, 0x31, 0x51, 0x59, 0xdf, 0xe9, 0xc6, 0x6d, 0x5f, 0xef, 0xbb, 0xbc, 0x6b, 0x0b, 0x50,
-	0xd1, 0x07, 0xf2, 0x5b, 0x5a, 0x7c, 0xd1, 0x76, 0x0a, 0x7d, 0x70, 0xae, 0xb6, 0x45, 0x1f, 0x68,
-	0xc8, 0x18, 0x44, 0xc1, 0xd3, 0xd4, 0x5a, 0xcf, 0xa0, 0xa3, 0x31, 0xa2, 0x94, 0x4e, 0xc8, 0xde,
-	0x91, 0x58, 0x66, 0xb9, 0x83, 0x95, 0x24, 0x4b, 0x4c, 0xfc, 0xd9, 0x9c, 0xab, 0x7b, 0xad, 0x24,
-	0xeb, 0x5b, 0xe8, 0xe8, 0x94, 0x89, 0x0b, 0x7e, 0xfa, 0x52, 0xb5, 0x4f, 0xe3, 0xf4, 0xa5, 0x18,
-	0x07, 0x67, 0xc9, 0x82, 0xfb, 0xe2, 0x11, 0x39, 0x6c, 0xc8, 0xce, 0xc8, 0x37, 0x84, 0xe6, 0xcf,
-	0xc9, 0xc5, 0x2f, 0x34, 0xf2, 0x5d, 0x51, 0x28, 0x2e, 0x16, 0x6a, 0xa0, 0xa4, 0x82, 0xe0, 0x0c,
-	0xa8, 0x97, 0x2c, 0x88, 0xaa, 0x9f, 0x92, 0xac, 0xa7, 0xb0, 0xad, 0x35, 0xd3, 0xa9, 0x7b, 0x00,
-	0x5b, 0x8c, 0x3b, 0x3c, 0x61, 0xda, 0xe9, 0x54, 0x42, 0x03, 0x68, 0x06, 0x6c, 0xa6, 0xb4, 0xc5,
-	0xd2, 0x7a, 0x0a, 0xbb, 0xd3, 0xe4, 0x62, 0xe1, 0xb3, 0xb9, 0x54, 0x17, 0x17, 0x76, 0x3d, 0xb7,
-	0xa1, 0xda, 0x4f, 0x55, 0xdf, 0xc0, 0x7e, 0x49, 0x35, 0x25, 0xbf, 0xd1, 0x77, 0xe5, 0x52, 0x63,
-	0x9d, 0x4b, 0xcd, 0xdc, 0xa5, 0x53, 0xe8, 0x4a, 0x83, 0xf2, 0x27, 0x68, 0xbd, 0x31, 0x04, 0x77,
-	0xde, 0xc6, 0x34, 0x50, 0x81, 0xc8, 0xb5, 0xd8, 0x13, 0xdf, 0xe6, 0xd2, 0x52, 0x1f, 0xcb, 0xb5,
-	0x35, 0x82, 0x9d, 0xef, 0x09, 0x77, 0x53, 0x07, 0xf5, 0xcd, 0x54, 0x29, 0xac, 0x17, 0x52, 0xf8,
-	0xa5, 0x22, 0xd5, 0x20, 0xc9, 0x93, 0x5e, 0xcb, 0x2e, 0x56, 0x92, 0xf5, 0x1d, 0xf4, 0x30, 0x09,
-	0xe8, 0x92, 0x54, 0x29, 0x12, 0x86, 0x81, 0xa1, 0x7c, 0x3b, 0xa9, 0x7a, 0x05, 0x83, 0x73, 0xc2,
-	0xa7, 0x31, 0xe5, 0xd4, 0xa5, 0xf2, 0x15, 0xcf, 0xd0, 0x63, 0xe8, 0xca, 0xbf, 0xb2, 0x7c, 0xd1,
-	0xf8, 0xc5, 0xb1, 0x62, 0x02, 0x71, 0x8e, 0xb2, 0x3e, 0x40, 0xdf, 0x3c, 0x12, 0xc3, 0x2f, 0x52,
-	0xb2, 0xf2, 0x2c, 0x93, 0x85, 0x73, 0xb1, 0xc3, 0x89, 0x1f, 0xea, 0xf0, 0x52, 0x49, 0xfc, 0x08,
-	0x8a, 0x15, 0x4d, 0xb8, 0x72, 0x50, 0x8b, 0xa2, 0xeb, 0xc5, 0x92, 0x53, 0xee, 0x2c, 0xe4, 0xf0,
-	0xef, 0xe2, 0x7c, 0x63, 0xfc, 0xc5, 0x6f, 0x9f, 0xcd, 0x7c, 0x3e, 0x4f, 0x2e, 0x8e, 0x5c, 0x1a,
-	0x3c, 0x3c, 0x3e, 0x76, 0xc3, 0x87, 0xf2, 0xff, 0xb8, 0xe3, 0xe3, 0x87, 0xd2, 0xe9, 0x8b, 0x2d,
-	0x49, 0x7d, 0xfc, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x97, 0x8a, 0xdc, 0xcd, 0x13, 0x00,
-	0x00,
+	// 1458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0xd6, 0xc3, 0x7a, 0x8d, 0xe4, 0xd7, 0xc6, 0x31, 0x88, 0xa0, 0x28, 0x0c, 0xf6, 0xa2, 0xb4,
+	0x89, 0xe3, 0x30, 0x39, 0x34, 0x69, 0x2e, 0x91, 0x9d, 0x46, 0x6e, 0x6b, 0x43, 0x58, 0xb7, 0x39,
+	0xf4, 0x46, 0x8b, 0x6b, 0x89, 0xb0, 0xc8, 0xa5, 0xb8, 0x4b, 0xc7, 0x0e, 0x7a, 0xe8, 0xdf, 0xea,
+	0x3f, 0x6a, 0x8f, 0xfd, 0x07, 0xc5, 0xbe, 0xf8, 0xb2, 0x5c, 0x20, 0xaa, 0xd5, 0xdb, 0xce, 0xec,
+	0xcc, 0x7c, 0xf3, 0xda, 0xe1, 0x2e, 0x61, 0x3d, 0x72, 0xa2, 0x90, 0x5c, 0xf3, 0xfd, 0x28, 0xa6,
+	0x9c, 0xa2, 0x06, 0xbf, 0x89, 0x08, 0x7b, 0xd4, 0x89, 0x9c, 0x48, 0x71, 0x1e, 0x6d, 0x9d, 0xcf,
+	0xe8, 0xf8, 0x72, 0x3c, 0x75, 0xfd, 0x50, 0x71, 0xec, 0x3f, 0xaa, 0xd0, 0x3d, 0x21, 0x8c, 0xb9,
+	0x13, 0x72, 0x48, 0x83, 0x00, 0x59, 0xd0, 0xba, 0x22, 0x31, 0xf3, 0x69, 0x68, 0x55, 0xf7, 0xaa,
+	0xfd, 0x0e, 0x36, 0x24, 0xfa, 0x02, 0x3a, 0xdc, 0x0f, 0x08, 0xe3, 0x6e, 0x10, 0x59, 0xb5, 0xbd,
+	0x6a, 0xbf, 0x8e, 0x33, 0x06, 0xda, 0x80, 0x9a, 0xef, 0x59, 0x75, 0xa9, 0x52, 0xf3, 0x3d, 0xb4,
+	0x0b, 0xcd, 0x09, 0x65, 0xcc, 0x8f, 0xac, 0xb5, 0xbd, 0x6a, 0xbf, 0x8d, 0x35, 0x25, 0xf8, 0x21,
+	0xf5, 0xc8, 0xb1, 0x67, 0x35, 0xa4, 0xac, 0xa6, 0xd0, 0x97, 0x00, 0x62, 0x35, 0x4a, 0xce, 0x7f,
+	0x24, 0x37, 0x56, 0x73, 0xaf, 0xda, 0xef, 0xe1, 0x1c, 0x07, 0x21, 0x58, 0x63, 0xfe, 0x24, 0xb4,
+	0x5a, 0x72, 0x47, 0xae, 0xed, 0xbf, 0x6b, 0xa9, 0xef, 0xbf, 0x70, 0x7f, 0x86, 0xbe, 0x86, 0xe6,
+	0x98, 0x06, 0x81, 0x76, 0xbd, 0xeb, 0xa0, 0x7d, 0x99, 0x80, 0xfd, 0x5c, 0x7c, 0x58, 0x4b, 0xa0,
+	0x03, 0x68, 0x47, 0x84, 0xc4, 0xc7, 0xe1, 0x05, 0xb5, 0x6a, 0x05, 0xe9, 0x91, 0x33, 0x1a, 0xe9,
+	0x9d, 0x61, 0x05, 0xa7, 0x52, 0xe8, 0x69, 0x96, 0x99, 0xba, 0x54, 0xd8, 0xce, 0x14, 0x3e, 0xa8,
+	0x8d, 0x61, 0x25, 0x4b, 0x97, 0x03, 0xa0, 0x97, 0x6f, 0xc7, 0x97, 0x32, 0x09, 0x5d, 0x67, 0xab,
+	0xa0, 0xf1, 0x76, 0x7c, 0x39, 0xac, 0xe0, 0x9c, 0x14, 0x7a, 0x09, 0x6d, 0x72, 0xcd, 0x49, 0x1c,
+	0xba, 0x33, 0x99, 0x9e, 0xae, 0xb3, 0x9b, 0x69, 0xbc, 0xd3, 0x3b, 0xc6, 0x31, 0x23, 0x89, 0x5e,
+	0x40, 0x67, 0x42, 0xb8, 0xac, 0x2c, 0x93, 0x99, 0xeb, 0x3a, 0x0f, 0x32, 0xb5, 0xf7, 0x84, 0x0f,
+	0xe4, 0xd6, 0xb0, 0x82, 0x33, 0x39, 0xf4, 0x14, 0xda, 0x7e, 0x78, 0xe5, 0xb9, 0xdc, 0x65, 0x32,
+	0xa7, 0x5d, 0x67, 0x53, 0xeb, 0x1c, 0x87, 0x57, 0x47, 0x82, 0x2d, 0x30, 0x8c, 0xc8, 0xa0, 0x05,
+	0x8d, 0x2b, 0x77, 0x96, 0x10, 0xfb, 0x07, 0x40, 0x3a, 0x9d, 0x26, 0x49, 0x98, 0xcc, 0xd1, 0x4b,
+	0xe8, 0x06, 0x8a, 0x2b, 0x54, 0xff, 0x25, 0xfd, 0x79, 0x31, 0xfb, 0x06, 0x1e, 0xdc, 0xb2, 0xc5,
+	0xa2, 0xe5, 0x8c, 0xa1, 0x27, 0xd0, 0xd2, 0xe4, 0xdd, 0xf5, 0xc4, 0x46, 0xc4, 0xbe, 0x81, 0x1d,
+	0x03, 0x9d, 0x56, 0x6f, 0xe9, 0x40, 0xd0, 0x37, 0x65, 0xec, 0xdb, 0xad, 0x91, 0x41, 0x7f, 0x82,
+	0x87, 0x0b, 0xa0, 0x59, 0xf4, 0x7f, 0x60, 0x47, 0xb0, 0x61, 0xb0, 0xfd, 0x70, 0xb2, 0x7c, 0xc0,
+	0xfd, 0x32, 0xe8, 0x46, 0x2e, 0xd9, 0xc2, 0x72, 0x8a, 0x38, 0x87, 0xcd, 0x02, 0x22, 0x8b, 0x56,
+	0x01, 0x49, 0xf3, 0x90, 0x2c, 0x0d, 0xf2, 0xad, 0xe7, 0xc5, 0xab, 0xa9, 0xea, 0x7b, 0xc2, 0xa5,
+	0xf1, 0x05, 0x71, 0x2a, 0xd0, 0x95, 0xc4, 0x59, 0x84, 0x4c, 0x0a, 0x90, 0x3f, 0xf9, 0x8c, 0xaf,
+	0xe0, 0xe8, 0x18, 0xd3, 0x19, 0xec, 0x49, 0xda, 0xbf, 0x66, 0x22, 0x9d, 0x12, 0xbe, 0xfc, 0x10,
+	0xf8, 0xbd, 0x0a, 0xbb, 0x8b, 0xec, 0x2d, 0x9d, 0xc0, 0x83, 0x72, 0x34, 0x77, 0xcc, 0xd0, 0xfc,
+	0x89, 0x34, 0x73, 0x28, 0x1d, 0x96, 0xcb, 0x77, 0xcd, 0xd3, 0x32, 0xfc, 0xa2, 0x59, 0x9c, 0x61,
+	0x7f, 0x4c, 0x07, 0x51, 0xb6, 0xb9, 0x7c, 0xec, 0x8f, 0xcb, 0xe0, 0xe5, 0xa1, 0x9e, 0x01, 0xff,
+	0x96, 0x07, 0x3e, 0x21, 0x41, 0x44, 0xe9, 0x6c, 0xf9, 0xa8, 0xf7, 0xcb, 0xc0, 0x3b, 0x85, 0xa8,
+	0x8d, 0xfd, 0xdc, 0x71, 0x31, 0x67, 0x54, 0xcf, 0xa8, 0xfb, 0x0e, 0x58, 0x9b, 0xcd, 0x05, 0x7c,
+	0x0d, 0x5b, 0xda, 0xcc, 0x90, 0xb8, 0x1e, 0x89, 0x57, 0x16, 0xac, 0x32, 0x9f, 0x43, 0xbe, 0x82,
+	0xed, 0x12, 0xf2, 0x4a, 0xa6, 0xfd, 0x2d, 0x5c, 0x96, 0xe2, 0xea, 0xf2, 0xaf, 0x60, 0xe0, 0x1b,
+	0xcb, 0x29, 0x68, 0x9c, 0x0d, 0x7c, 0x42, 0xfe, 0xcb, 0x54, 0xba, 0xb3, 0xb4, 0xc6, 0x6e, 0x86,
+	0xc9, 0xd3, 0x6e, 0x3a, 0x25, 0x5c, 0x5e, 0xd6, 0xee, 0x79, 0x10, 0x9e, 0x52, 0xcf, 0x98, 0xce,
+	0x47, 0xba, 0x9d, 0x8b, 0x94, 0x61, 0x12, 0xcd, 0x6e, 0x3e, 0xeb, 0x0e, 0xfa, 0x1c, 0x20, 0x4a,
+	0x35, 0xcb, 0xf5, 0x4c, 0x37, 0x70, 0x4e, 0xc8, 0x0e, 0xd3, 0x26, 0x1e, 0xc4, 0xd4, 0xf5, 0x0e,
+	0x5d, 0xc6, 0x3f, 0x0b, 0xf2, 0xce, 0xd6, 0x4d, 0xcd, 0x15, 0xab, 0x49, 0x61, 0x7b, 0xe4, 0x8c,
+	0x0a, 0xdd, 0xcb, 0xee, 0xe1, 0x8d, 0x50, 0x97, 0x6f, 0x04, 0x73, 0xa7, 0x6f, 0xe4, 0xee, 0xf4,
+	0x7f, 0xd5, 0x00, 0x46, 0xce, 0x08, 0x93, 0x79, 0x42, 0x18, 0x47, 0x0e, 0xb4, 0xa6, 0x0a, 0x55,
+	0x07, 0x67, 0x65, 0xfd, 0x5e, 0xf4, 0x0a, 0x1b, 0x41, 0x34, 0x80, 0xcd, 0x98, 0xcc, 0x0f, 0xa7,
+	0x49, 0x78, 0x89, 0xc9, 0x98, 0xc6, 0x1e, 0x2b, 0x7d, 0x08, 0x70, 0x71, 0x77, 0x58, 0xc1, 0x65,
+	0x05, 0xf4, 0x0a, 0x7a, 0x63, 0x41, 0x8b, 0x8a, 0x9f, 0xb0, 0x89, 0x55, 0x2f, 0x8c, 0xf2, 0xc3,
+	0xdc, 0xd6, 0xb0, 0x82, 0x0b, 0xa2, 0xe8, 0x0d, 0xac, 0xa7, 0xb4, 0x68, 0x53, 0x6b, 0xad, 0x90,
+	0xe8, 0xc3, 0xfc, 0xde, 0xb0, 0x82, 0x8b, 0xc2, 0xe8, 0x00, 0x3a, 0x31, 0x99, 0xab, 0x0f, 0x81,
+	0xd5, 0x28, 0xbc, 0x1a, 0x30, 0x99, 0x67, 0x37, 0xf9, 0x54, 0x48, 0xdc, 0xe4, 0x63, 0x32, 0x97,
+	0xfd, 0x62, 0x35, 0x0b, 0x07, 0x05, 0x6b, 0xb6, 0xb8, 0xc9, 0x1b, 0x91, 0x41, 0x07, 0x5a, 0xb1,
+	0x4a, 0xae, 0xfd, 0x06, 0xda, 0x46, 0x04, 0x3d, 0x12, 0x56, 0x2e, 0x48, 0x2c, 0x5e, 0x5f, 0x55,
+	0x59, 0x8f, 0x94, 0x46, 0x3b, 0xd0, 0x18, 0xd3, 0x24, 0xe4, 0x32, 0x8d, 0x0d, 0xac, 0x08, 0xdb,
+	0x86, 0xf6, 0xd0, 0x65, 0x53, 0xe9, 0xf5, 0x2e, 0x34, 0xa7, 0x2e, 0x9b, 0x12, 0x51, 0xa5, 0x7a,
+	0xbf, 0x87, 0x35, 0x65, 0xbf, 0x86, 0xf5, 0x42, 0xbc, 0xe8, 0x31, 0x34, 0x7c, 0x4e, 0x02, 0x25,
+	0xb7, 0x38, 0xa1, 0x58, 0x49, 0xd8, 0x7f, 0xd6, 0xa0, 0x2b, 0x3b, 0x81, 0x45, 0x34, 0x64, 0x64,
+	0xa9, 0x56, 0xd8, 0x81, 0x06, 0x89, 0x63, 0x1a, 0x4b, 0xcf, 0x3b, 0x58, 0x11, 0xe8, 0x39, 0x74,
+	0xc7, 0x33, 0xca, 0x48, 0xac, 0x92, 0x56, 0xdf, 0xab, 0xe7, 0x92, 0x96, 0xbe, 0x15, 0xf2, 0x32,
+	0xa2, 0x2c, 0xf2, 0xe1, 0x34, 0xa0, 0xde, 0x4d, 0xa9, 0x2c, 0x03, 0xc3, 0x17, 0x65, 0x49, 0x85,
+	0xd0, 0x4b, 0xe8, 0x49, 0x42, 0xfb, 0x64, 0x35, 0x0b, 0x63, 0x53, 0x73, 0x45, 0xf3, 0xe4, 0xa5,
+	0xd2, 0xbe, 0x33, 0x8d, 0xdb, 0xba, 0xdd, 0x77, 0x59, 0xd7, 0x16, 0x44, 0x45, 0x1f, 0xc8, 0xb7,
+	0xb4, 0x78, 0xd1, 0xb6, 0x0b, 0x7d, 0x70, 0xaa, 0xd9, 0xa2, 0x0f, 0x8c, 0xc8, 0x00, 0x44, 0xc1,
+	0x55, 0x6a, 0xed, 0xd7, 0xd0, 0x36, 0x32, 0xa2, 0x94, 0x6e, 0xc8, 0x3e, 0x92, 0x58, 0x66, 0xb9,
+	0x8d, 0x35, 0x25, 0x4b, 0x4c, 0xfc, 0xc9, 0x94, 0xeb, 0x73, 0xad, 0x29, 0xfb, 0x5b, 0x68, 0x9b,
+	0x94, 0x89, 0x03, 0x7e, 0x7c, 0xa4, 0xdb, 0xa7, 0x76, 0x7c, 0x24, 0xc6, 0xc1, 0x49, 0x32, 0xe3,
+	0xbe, 0xb8, 0x44, 0x5a, 0x35, 0xd9, 0x19, 0x19, 0x43, 0x68, 0x9e, 0x25, 0xe7, 0x3f, 0xd3, 0xc8,
+	0x1f, 0x8b, 0x42, 0x71, 0xb1, 0xd0, 0x03, 0x45, 0x11, 0x02, 0x33, 0xa0, 0x5e, 0x32, 0x23, 0xba,
+	0x7e, 0x9a, 0xb2, 0x5f, 0xc1, 0xba, 0xd1, 0x54, 0x53, 0x77, 0x17, 0x9a, 0x8c, 0xbb, 0x3c, 0x61,
+	0xc6, 0x69, 0x45, 0xa1, 0x2d, 0xa8, 0x07, 0x6c, 0xa2, 0xb5, 0xc5, 0xd2, 0x7e, 0x05, 0x9b, 0xa3,
+	0xe4, 0x7c, 0xe6, 0xb3, 0xa9, 0x54, 0x17, 0x07, 0x76, 0x31, 0x76, 0x4e, 0xb5, 0xa7, 0x54, 0x3f,
+	0xc0, 0x4e, 0x49, 0x55, 0x81, 0xdf, 0xe9, 0xbb, 0x76, 0xa9, 0xb6, 0xc8, 0xa5, 0x7a, 0xe6, 0xd2,
+	0x31, 0x74, 0xa4, 0x41, 0xf9, 0x09, 0x5a, 0x6c, 0x0c, 0xc1, 0xda, 0x45, 0x4c, 0x03, 0x1d, 0x88,
+	0x5c, 0x0b, 0x9e, 0x78, 0x9b, 0x4b, 0x4b, 0x3d, 0x2c, 0xd7, 0x76, 0x1f, 0x36, 0xbe, 0x27, 0x7c,
+	0xac, 0x1c, 0x34, 0x27, 0x53, 0xa7, 0xb0, 0x5a, 0x48, 0xe1, 0x57, 0xd0, 0x29, 0x08, 0x49, 0x1c,
+	0x75, 0x2c, 0x3b, 0x58, 0x53, 0xf6, 0x77, 0xd0, 0xc5, 0x24, 0xa0, 0x57, 0x64, 0x99, 0x22, 0x61,
+	0xd8, 0xca, 0x29, 0xdf, 0x4f, 0xaa, 0xde, 0xc1, 0xd6, 0x29, 0xe1, 0x23, 0xf1, 0xe7, 0x6a, 0x4c,
+	0xe5, 0x2d, 0x9e, 0xa1, 0xe7, 0xd0, 0x91, 0xbf, 0xb2, 0x7c, 0xd1, 0xf8, 0xc5, 0xb1, 0x92, 0x17,
+	0xc4, 0x99, 0x94, 0xfd, 0x09, 0x7a, 0xf9, 0x2d, 0x31, 0xfc, 0x22, 0x4d, 0x6b, 0xcf, 0x52, 0x5a,
+	0x38, 0x17, 0xbb, 0x9c, 0xf8, 0xa1, 0x09, 0x4f, 0x51, 0xe2, 0x23, 0x28, 0x56, 0x34, 0xe1, 0xda,
+	0x41, 0x43, 0x8a, 0xae, 0x17, 0x4b, 0x4e, 0xb9, 0x3b, 0x93, 0xc3, 0xbf, 0x83, 0x33, 0x86, 0xcd,
+	0x45, 0x5a, 0xe6, 0x67, 0xdc, 0xe5, 0xe4, 0x2c, 0x74, 0x23, 0x36, 0xa5, 0x3c, 0x77, 0xb6, 0xaa,
+	0xf9, 0xb3, 0x25, 0x2c, 0xa9, 0xe9, 0xe0, 0xb2, 0xa9, 0xee, 0xc4, 0x8c, 0x21, 0x92, 0xe9, 0x91,
+	0x88, 0x4f, 0x25, 0x7e, 0x03, 0x2b, 0x42, 0x70, 0xfd, 0xd0, 0x23, 0xd7, 0x12, 0xb9, 0x81, 0x15,
+	0x61, 0x07, 0xf0, 0xb0, 0x00, 0x79, 0xe2, 0x86, 0xfe, 0x05, 0x61, 0x1c, 0xf5, 0xa1, 0x21, 0x2d,
+	0x96, 0xee, 0x0e, 0x72, 0xa8, 0x1d, 0x11, 0xee, 0xfa, 0x33, 0xac, 0x04, 0x32, 0xb8, 0x5a, 0x09,
+	0x4e, 0x05, 0xaa, 0x9d, 0x90, 0xc4, 0x60, 0xff, 0xd7, 0x27, 0x13, 0x9f, 0x4f, 0x93, 0xf3, 0xfd,
+	0x31, 0x0d, 0x9e, 0xf1, 0x24, 0xf6, 0xc3, 0x89, 0xfc, 0xeb, 0xe8, 0x1c, 0x38, 0x07, 0x79, 0xfa,
+	0x99, 0x84, 0x3b, 0x6f, 0xca, 0x74, 0xbf, 0xf8, 0x67, 0x00, 0xe1, 0x99, 0x8f, 0xe0, 0xc1, 0x14,
+	0x00, 0x00,
 }
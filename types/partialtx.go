@@ -0,0 +1,195 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/common/address"
+	"github.com/turingchain2020/turingchain/common/crypto"
+)
+
+//NewPartiallySignedTx 根据单笔交易或者交易组创建部分签名交易, signers 按顺序指定每笔交易的签名地址
+//交易中已经存在并且和签名地址匹配的签名会被保留, 创建之后交易内容不能再修改(fee, expire 需要提前设置好)
+func NewPartiallySignedTx(tx *Transaction, signers []string) (*PartiallySignedTx, error) {
+	group, err := tx.GetTxGroup()
+	if err != nil {
+		return nil, err
+	}
+	txs := []*Transaction{tx}
+	if group != nil {
+		txs = group.Txs
+	}
+	if len(signers) != len(txs) {
+		return nil, ErrPartialTxSigner
+	}
+	ptx := &PartiallySignedTx{}
+	for i := range txs {
+		if err := address.CheckAddress(signers[i]); err != nil {
+			return nil, ErrInvalidAddress
+		}
+		copytx := txs[i].Clone()
+		copytx.Signature = nil
+		ptx.Txs = append(ptx.Txs, copytx)
+		ptx.Signers = append(ptx.Signers, &PartialSigner{Addr: signers[i]})
+	}
+	for i := range txs {
+		if sign := txs[i].GetSignature(); sign != nil {
+			//忽略不匹配的签名, 交易内容有变化时原来的签名已经无效
+			_ = ptx.AddSignature(i, sign)
+		}
+	}
+	return ptx, nil
+}
+
+//DecodePartiallySignedTx 解析hex 编码的部分签名交易, 并检查交易组结构和已有签名
+func DecodePartiallySignedTx(data string) (*PartiallySignedTx, error) {
+	b, err := common.FromHex(data)
+	if err != nil {
+		return nil, err
+	}
+	var ptx PartiallySignedTx
+	err = Decode(b, &ptx)
+	if err != nil {
+		return nil, err
+	}
+	err = ptx.Check()
+	if err != nil {
+		return nil, err
+	}
+	return &ptx, nil
+}
+
+//Hex 部分签名交易的hex 编码, 用于在签名方之间传递
+func (ptx *PartiallySignedTx) Hex() string {
+	return hex.EncodeToString(Encode(ptx))
+}
+
+//Check 检查交易和签名地址数量一致, 交易组结构完整, 并且已有的签名都有效
+func (ptx *PartiallySignedTx) Check() error {
+	if len(ptx.Txs) == 0 || len(ptx.Txs) != len(ptx.Signers) {
+		return ErrPartialTxSigner
+	}
+	if len(ptx.Txs) > 1 {
+		group := &Transactions{}
+		for _, tx := range ptx.Txs {
+			if tx.GroupCount != int32(len(ptx.Txs)) {
+				return ErrTxGroupCount
+			}
+			group.Txs = append(group.Txs, tx.Clone())
+		}
+		group.RebuiltGroup()
+		for i, tx := range group.Txs {
+			if !bytes.Equal(tx.Header, ptx.Txs[i].Header) || !bytes.Equal(tx.Next, ptx.Txs[i].Next) {
+				return ErrTxGroupHeader
+			}
+		}
+	} else if _, err := ptx.Txs[0].GetTxGroup(); err != nil {
+		return err
+	}
+	for i, signer := range ptx.Signers {
+		if ptx.Txs[i].Signature != nil {
+			return ErrPartialTxSigner
+		}
+		if signer.Signature != nil && !ptx.checkSignature(i, signer.Signature) {
+			return ErrSign
+		}
+	}
+	return nil
+}
+
+func (ptx *PartiallySignedTx) checkSignature(index int, sign *Signature) bool {
+	if address.PubKeyToAddr(sign.GetPubkey()) != ptx.Signers[index].Addr {
+		return false
+	}
+	tx := ptx.Txs[index]
+	return CheckSign(Encode(tx), string(tx.Execer), sign)
+}
+
+//AddSignature 为第index 笔交易添加签名, 签名必须有效并且属于指定的签名地址
+func (ptx *PartiallySignedTx) AddSignature(index int, sign *Signature) error {
+	if index < 0 || index >= len(ptx.Signers) {
+		return ErrIndex
+	}
+	if !ptx.checkSignature(index, sign) {
+		return ErrSign
+	}
+	ptx.Signers[index].Signature = sign
+	return nil
+}
+
+//Sign 用私钥对所有签名地址匹配并且还没有签名的交易签名, 返回签名的交易数量
+func (ptx *PartiallySignedTx) Sign(ty int32, priv crypto.PrivKey) int {
+	addr := address.PubKeyToAddr(priv.PubKey().Bytes())
+	count := 0
+	for i, signer := range ptx.Signers {
+		if signer.Addr != addr || signer.Signature != nil {
+			continue
+		}
+		tx := ptx.Txs[i].Clone()
+		tx.Sign(ty, priv)
+		signer.Signature = tx.Signature
+		count++
+	}
+	return count
+}
+
+//Combine 合并其他签名方返回的同一部分签名交易中的签名
+func (ptx *PartiallySignedTx) Combine(other *PartiallySignedTx) error {
+	if len(ptx.Txs) != len(other.Txs) || len(ptx.Signers) != len(other.Signers) {
+		return ErrPartialTxMismatch
+	}
+	for i := range ptx.Txs {
+		if !bytes.Equal(Encode(ptx.Txs[i]), Encode(other.Txs[i])) || ptx.Signers[i].Addr != other.Signers[i].Addr {
+			return ErrPartialTxMismatch
+		}
+	}
+	for i, signer := range other.Signers {
+		if signer.Signature == nil || ptx.Signers[i].Signature != nil {
+			continue
+		}
+		err := ptx.AddSignature(i, signer.Signature)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Missing 还缺少签名的交易序号
+func (ptx *PartiallySignedTx) Missing() []int {
+	var missing []int
+	for i, signer := range ptx.Signers {
+		if signer.Signature == nil {
+			missing = append(missing, i)
+		}
+	}
+	return missing
+}
+
+//Hash 最终交易的hash, 交易组为第一笔交易的hash
+func (ptx *PartiallySignedTx) Hash() []byte {
+	return ptx.Txs[0].Hash()
+}
+
+//Finalize 所有签名收集完成后生成可以发送的交易
+func (ptx *PartiallySignedTx) Finalize() (*Transaction, error) {
+	if len(ptx.Missing()) > 0 {
+		return nil, ErrPartialTxNotSigned
+	}
+	var txs []*Transaction
+	for i, tx := range ptx.Txs {
+		copytx := tx.Clone()
+		copytx.Signature = ptx.Signers[i].Signature
+		txs = append(txs, copytx)
+	}
+	if len(txs) == 1 {
+		return txs[0], nil
+	}
+	group := &Transactions{Txs: txs}
+	return group.Tx(), nil
+}
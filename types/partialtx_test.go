@@ -0,0 +1,81 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common/address"
+	"github.com/turingchain2020/turingchain/common/crypto"
+	_ "github.com/turingchain2020/turingchain/system/crypto/init"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genPartialTxKey(t *testing.T) (crypto.PrivKey, string) {
+	cr, err := crypto.New(GetSignName("", SECP256K1))
+	require.Nil(t, err)
+	priv, err := cr.GenKey()
+	require.Nil(t, err)
+	return priv, address.PubKeyToAddr(priv.PubKey().Bytes())
+}
+
+func TestPartiallySignedTx(t *testing.T) {
+	cfg := NewTuringchainConfig(GetDefaultCfgstring())
+	priv1, addr1 := genPartialTxKey(t)
+	priv2, addr2 := genPartialTxKey(t)
+	tx1 := &Transaction{Execer: []byte("coins"), Payload: []byte("1"), Fee: 100000, Nonce: 1, To: addr2}
+	tx2 := &Transaction{Execer: []byte("coins"), Payload: []byte("2"), Fee: 100000, Nonce: 2, To: addr1}
+	group, err := CreateTxGroup([]*Transaction{tx1, tx2}, cfg.GetMinTxFeeRate())
+	require.Nil(t, err)
+
+	_, err = NewPartiallySignedTx(group.Tx(), []string{addr1})
+	assert.Equal(t, ErrPartialTxSigner, err)
+	ptx, err := NewPartiallySignedTx(group.Tx(), []string{addr1, addr2})
+	require.Nil(t, err)
+	assert.Equal(t, []int{0, 1}, ptx.Missing())
+	_, err = ptx.Finalize()
+	assert.Equal(t, ErrPartialTxNotSigned, err)
+
+	//两个签名方分别签名
+	ptx1, err := DecodePartiallySignedTx(ptx.Hex())
+	require.Nil(t, err)
+	assert.Equal(t, 1, ptx1.Sign(SECP256K1, priv1))
+	assert.Equal(t, 0, ptx1.Sign(SECP256K1, priv1))
+	ptx2, err := DecodePartiallySignedTx(ptx.Hex())
+	require.Nil(t, err)
+	assert.Equal(t, 1, ptx2.Sign(SECP256K1, priv2))
+	//签名不属于指定的签名地址
+	assert.Equal(t, ErrSign, ptx2.AddSignature(0, ptx2.Signers[1].Signature))
+
+	ptx1, err = DecodePartiallySignedTx(ptx1.Hex())
+	require.Nil(t, err)
+	require.Nil(t, ptx1.Combine(ptx2))
+	assert.Nil(t, ptx1.Missing())
+	tx, err := ptx1.Finalize()
+	require.Nil(t, err)
+	assert.Equal(t, group.Tx().Hash(), tx.Hash())
+	final, err := tx.GetTxGroup()
+	require.Nil(t, err)
+	assert.True(t, final.CheckSign())
+	assert.Nil(t, final.Check(cfg, 0, cfg.GetMinTxFeeRate(), cfg.GetMaxTxFee()))
+	assert.Equal(t, addr2, final.Txs[1].From())
+
+	//已经签名的交易创建时保留签名
+	ptx3, err := NewPartiallySignedTx(tx, []string{addr1, addr2})
+	require.Nil(t, err)
+	assert.Nil(t, ptx3.Missing())
+
+	//不同的交易不能合并
+	tx3 := &Transaction{Execer: []byte("coins"), Payload: []byte("3"), Fee: 100000, Nonce: 3, To: addr1}
+	other, err := NewPartiallySignedTx(tx3, []string{addr1})
+	require.Nil(t, err)
+	assert.Equal(t, ErrPartialTxMismatch, ptx.Combine(other))
+
+	//交易被篡改之后签名无效
+	ptx1.Txs[1].Fee = 1
+	_, err = DecodePartiallySignedTx(ptx1.Hex())
+	assert.NotNil(t, err)
+}
@@ -1032,6 +1032,279 @@ func (m *ClientReply) GetResult() *Result {
 	return nil
 }
 
+// BftProposal 提议者在height, round 提议的区块
+type BftProposal struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round                int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Block                *Block   `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BftProposal) Reset()         { *m = BftProposal{} }
+func (m *BftProposal) String() string { return proto.CompactTextString(m) }
+func (*BftProposal) ProtoMessage()    {}
+func (*BftProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc19f28ccff0670, []int{16}
+}
+
+func (m *BftProposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BftProposal.Unmarshal(m, b)
+}
+func (m *BftProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BftProposal.Marshal(b, m, deterministic)
+}
+func (m *BftProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BftProposal.Merge(m, src)
+}
+func (m *BftProposal) XXX_Size() int {
+	return xxx_messageInfo_BftProposal.Size(m)
+}
+func (m *BftProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BftProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BftProposal proto.InternalMessageInfo
+
+func (m *BftProposal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BftProposal) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BftProposal) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+// BftVote prevote 或者 precommit 投票, blockHash 为空表示投nil
+type BftVote struct {
+	Type                 int32    `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round                int32    `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,4,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BftVote) Reset()         { *m = BftVote{} }
+func (m *BftVote) String() string { return proto.CompactTextString(m) }
+func (*BftVote) ProtoMessage()    {}
+func (*BftVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc19f28ccff0670, []int{17}
+}
+
+func (m *BftVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BftVote.Unmarshal(m, b)
+}
+func (m *BftVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BftVote.Marshal(b, m, deterministic)
+}
+func (m *BftVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BftVote.Merge(m, src)
+}
+func (m *BftVote) XXX_Size() int {
+	return xxx_messageInfo_BftVote.Size(m)
+}
+func (m *BftVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_BftVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BftVote proto.InternalMessageInfo
+
+func (m *BftVote) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *BftVote) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BftVote) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BftVote) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+// BftMessage 共识节点之间传输的消息, signature 是对 proposal 或 vote 序列化后的签名
+type BftMessage struct {
+	// Types that are valid to be assigned to Value:
+	//	*BftMessage_Proposal
+	//	*BftMessage_Vote
+	Value                isBftMessage_Value `protobuf_oneof:"value"`
+	Signature            *Signature         `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *BftMessage) Reset()         { *m = BftMessage{} }
+func (m *BftMessage) String() string { return proto.CompactTextString(m) }
+func (*BftMessage) ProtoMessage()    {}
+func (*BftMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc19f28ccff0670, []int{18}
+}
+
+func (m *BftMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BftMessage.Unmarshal(m, b)
+}
+func (m *BftMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BftMessage.Marshal(b, m, deterministic)
+}
+func (m *BftMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BftMessage.Merge(m, src)
+}
+func (m *BftMessage) XXX_Size() int {
+	return xxx_messageInfo_BftMessage.Size(m)
+}
+func (m *BftMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BftMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BftMessage proto.InternalMessageInfo
+
+type isBftMessage_Value interface {
+	isBftMessage_Value()
+}
+
+type BftMessage_Proposal struct {
+	Proposal *BftProposal `protobuf:"bytes,1,opt,name=proposal,proto3,oneof"`
+}
+
+type BftMessage_Vote struct {
+	Vote *BftVote `protobuf:"bytes,2,opt,name=vote,proto3,oneof"`
+}
+
+func (*BftMessage_Proposal) isBftMessage_Value() {}
+
+func (*BftMessage_Vote) isBftMessage_Value() {}
+
+func (m *BftMessage) GetValue() isBftMessage_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *BftMessage) GetProposal() *BftProposal {
+	if x, ok := m.GetValue().(*BftMessage_Proposal); ok {
+		return x.Proposal
+	}
+	return nil
+}
+
+func (m *BftMessage) GetVote() *BftVote {
+	if x, ok := m.GetValue().(*BftMessage_Vote); ok {
+		return x.Vote
+	}
+	return nil
+}
+
+func (m *BftMessage) GetSignature() *Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BftMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BftMessage_Proposal)(nil),
+		(*BftMessage_Vote)(nil),
+	}
+}
+
+// BftCommit 区块的提交证明, 包含超过2/3验证节点的precommit
+type BftCommit struct {
+	Height               int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round                int32         `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	BlockHash            []byte        `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Precommits           []*BftMessage `protobuf:"bytes,4,rep,name=precommits,proto3" json:"precommits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BftCommit) Reset()         { *m = BftCommit{} }
+func (m *BftCommit) String() string { return proto.CompactTextString(m) }
+func (*BftCommit) ProtoMessage()    {}
+func (*BftCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cc19f28ccff0670, []int{19}
+}
+
+func (m *BftCommit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BftCommit.Unmarshal(m, b)
+}
+func (m *BftCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BftCommit.Marshal(b, m, deterministic)
+}
+func (m *BftCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BftCommit.Merge(m, src)
+}
+func (m *BftCommit) XXX_Size() int {
+	return xxx_messageInfo_BftCommit.Size(m)
+}
+func (m *BftCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BftCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BftCommit proto.InternalMessageInfo
+
+func (m *BftCommit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BftCommit) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BftCommit) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BftCommit) GetPrecommits() []*BftMessage {
+	if m != nil {
+		return m.Precommits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Operation)(nil), "types.Operation")
 	proto.RegisterType((*Checkpoint)(nil), "types.Checkpoint")
@@ -1049,6 +1322,10 @@ func init() {
 	proto.RegisterType((*RequestAck)(nil), "types.RequestAck")
 	proto.RegisterType((*RequestNewView)(nil), "types.RequestNewView")
 	proto.RegisterType((*ClientReply)(nil), "types.ClientReply")
+	proto.RegisterType((*BftProposal)(nil), "types.BftProposal")
+	proto.RegisterType((*BftVote)(nil), "types.BftVote")
+	proto.RegisterType((*BftMessage)(nil), "types.BftMessage")
+	proto.RegisterType((*BftCommit)(nil), "types.BftCommit")
 }
 
 func init() {
@@ -1056,48 +1333,60 @@ func init() {
 }
 
 var fileDescriptor_6cc19f28ccff0670 = []byte{
-	// 677 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5f, 0x6b, 0xdb, 0x30,
-	0x10, 0xaf, 0xe3, 0xc4, 0x69, 0x2e, 0x4d, 0x69, 0xc5, 0x36, 0x44, 0xd9, 0x58, 0x30, 0x14, 0xfa,
-	0x50, 0x12, 0x56, 0xbf, 0x0d, 0x06, 0x5b, 0xcb, 0x46, 0x9f, 0xd6, 0xa2, 0xc1, 0x60, 0x7b, 0x73,
-	0x34, 0x2d, 0x11, 0x89, 0xff, 0xcc, 0x92, 0x1b, 0xfa, 0x4d, 0xf6, 0xba, 0xcf, 0xb0, 0x2f, 0xb4,
-	0x8f, 0x32, 0x24, 0x2b, 0x96, 0xb5, 0xba, 0x65, 0xed, 0x60, 0x90, 0x07, 0x4b, 0x77, 0x3f, 0xdd,
-	0xe9, 0xee, 0x77, 0x3f, 0x05, 0x20, 0x9f, 0x7d, 0x95, 0x93, 0xbc, 0xc8, 0x64, 0x86, 0x7a, 0xf2,
-	0x3a, 0x67, 0xe2, 0x60, 0x6f, 0xb6, 0xca, 0xe8, 0x92, 0x2e, 0x62, 0x9e, 0x56, 0x86, 0x70, 0x0a,
-	0x83, 0x8b, 0x9c, 0x15, 0xb1, 0xe4, 0x59, 0x8a, 0x42, 0xe8, 0x5d, 0xc5, 0xab, 0x92, 0x61, 0x6f,
-	0xec, 0x1d, 0x0d, 0x4f, 0x76, 0x26, 0x1a, 0x35, 0x39, 0x55, 0x20, 0x52, 0x99, 0xc2, 0xd7, 0x00,
-	0x67, 0x0b, 0x46, 0x97, 0x79, 0xc6, 0x53, 0x89, 0x0e, 0x60, 0x5b, 0xb0, 0x6f, 0x25, 0x4b, 0x69,
-	0x05, 0x1a, 0x91, 0x7a, 0x8d, 0x9e, 0x40, 0xf0, 0x85, 0xcf, 0x99, 0x90, 0xb8, 0x33, 0xf6, 0x8e,
-	0x76, 0x88, 0x59, 0x85, 0x17, 0xd0, 0x7b, 0x9b, 0xca, 0xe2, 0xfa, 0x21, 0x60, 0x84, 0xa0, 0x7b,
-	0xc5, 0xd9, 0x1a, 0xfb, 0xda, 0x5f, 0x7f, 0x87, 0xef, 0x00, 0x3e, 0x72, 0xb6, 0x3e, 0x5b, 0xc4,
-	0xe9, 0x9c, 0xa1, 0x31, 0x0c, 0xd5, 0x2e, 0xd5, 0xab, 0xc2, 0x1c, 0xdc, 0xdc, 0xba, 0x35, 0xb1,
-	0x57, 0xd0, 0xff, 0x50, 0x26, 0x49, 0xfc, 0xb0, 0xd4, 0xc2, 0x63, 0x08, 0x08, 0x13, 0xe5, 0x4a,
-	0xfe, 0x55, 0x1d, 0x7f, 0xfa, 0xd0, 0x27, 0xea, 0x48, 0x21, 0xd1, 0x04, 0x02, 0xba, 0xe2, 0x2c,
-	0x95, 0x06, 0xf0, 0xc8, 0x00, 0x8c, 0xfd, 0x4c, 0xdb, 0xce, 0xb7, 0x88, 0xf1, 0x42, 0x2f, 0x01,
-	0xf2, 0x82, 0xa9, 0x5f, 0x5c, 0x30, 0x9d, 0xc5, 0xf0, 0x04, 0xbb, 0x98, 0xcb, 0x82, 0x5d, 0x56,
-	0xf6, 0xf3, 0x2d, 0xd2, 0xf0, 0x46, 0x2f, 0xa0, 0xbf, 0x01, 0xfa, 0x1a, 0xf8, 0xf8, 0x06, 0xd0,
-	0xa0, 0x36, 0x7e, 0x3a, 0xbd, 0x2c, 0x49, 0xb8, 0xc4, 0xdd, 0xd6, 0xf4, 0xb4, 0x4d, 0xa7, 0xa7,
-	0xbf, 0x54, 0x7a, 0xb4, 0xa6, 0x08, 0xee, 0xb5, 0xa5, 0x67, 0x29, 0xa4, 0xd2, 0xb3, 0xde, 0x0a,
-	0x6b, 0x5b, 0x85, 0x83, 0x36, 0xac, 0xed, 0xb5, 0xc2, 0x5a, 0x6f, 0x74, 0x08, 0x7e, 0x4c, 0x97,
-	0xb8, 0xaf, 0x41, 0xfb, 0x2e, 0xe8, 0x0d, 0x5d, 0x9e, 0x6f, 0x11, 0x65, 0x57, 0x15, 0x48, 0xd9,
-	0x5a, 0xb3, 0x68, 0xbb, 0xad, 0x02, 0xef, 0xd9, 0x5a, 0x85, 0x50, 0x15, 0x30, 0x7e, 0xa7, 0x7d,
-	0xd3, 0xd0, 0x70, 0x0e, 0x23, 0xa7, 0x29, 0x68, 0x0c, 0x9d, 0x2c, 0x37, 0x6d, 0xdb, 0x33, 0xe7,
-	0xd4, 0x03, 0x45, 0x3a, 0x59, 0x8e, 0x9e, 0xc2, 0x40, 0xf2, 0x84, 0x09, 0x19, 0x27, 0xb9, 0xee,
-	0xd5, 0x80, 0xd8, 0x0d, 0x45, 0x26, 0xd3, 0x7a, 0x5f, 0x9b, 0xcc, 0x2a, 0x2c, 0x61, 0xff, 0x46,
-	0x27, 0x6b, 0xf2, 0x7b, 0x96, 0xfc, 0x0e, 0x53, 0x3b, 0xb7, 0x32, 0xd5, 0x77, 0x86, 0x08, 0x43,
-	0xbf, 0x60, 0xf9, 0x8a, 0xd3, 0x58, 0x77, 0x74, 0x44, 0x36, 0xcb, 0xb0, 0x80, 0x5d, 0x97, 0x07,
-	0xff, 0x21, 0xe6, 0x27, 0x5b, 0xd3, 0x8a, 0x3f, 0xf7, 0x0d, 0xd9, 0x38, 0xda, 0x77, 0x8f, 0x8e,
-	0xeb, 0x2a, 0xfe, 0x9b, 0x66, 0xdd, 0x11, 0xe2, 0x97, 0x57, 0xc7, 0x68, 0x88, 0xd0, 0x7d, 0xaf,
-	0x10, 0xc1, 0xd0, 0x0e, 0x81, 0xc0, 0xfe, 0xd8, 0x6f, 0x50, 0xd8, 0xe6, 0x4e, 0x9a, 0x5e, 0x4a,
-	0x66, 0xd4, 0x88, 0x0a, 0xdc, 0xd5, 0xee, 0x1b, 0x99, 0xd1, 0xe2, 0x4a, 0x2a, 0x13, 0x3a, 0x82,
-	0x6d, 0x33, 0xfc, 0x02, 0xf7, 0x5a, 0xdc, 0x6a, 0x6b, 0xf3, 0x8a, 0x81, 0x7b, 0x45, 0x09, 0x60,
-	0xa7, 0xa8, 0xf5, 0x6a, 0x0d, 0x6c, 0xc7, 0xc1, 0xfe, 0xa9, 0xc6, 0xfe, 0x5d, 0x6a, 0xdc, 0x75,
-	0xe4, 0xf4, 0x87, 0x57, 0x73, 0xd1, 0x4c, 0x64, 0x6b, 0xe8, 0xa8, 0x19, 0x40, 0xe0, 0x8e, 0x53,
-	0x39, 0xdb, 0x91, 0x66, 0x4c, 0x81, 0x8e, 0x61, 0x20, 0xb4, 0xd2, 0x73, 0xb6, 0xa9, 0xde, 0xae,
-	0x81, 0x98, 0x17, 0x80, 0x58, 0x87, 0xe6, 0xed, 0x7a, 0x6e, 0x65, 0xbe, 0x7b, 0x30, 0xac, 0x84,
-	0x80, 0xb0, 0x7c, 0x75, 0xdd, 0x9a, 0xe0, 0x83, 0xe6, 0xff, 0xf6, 0x71, 0x41, 0x87, 0x10, 0x14,
-	0xfa, 0x99, 0x31, 0xca, 0x3a, 0xaa, 0xd5, 0x4b, 0x6d, 0x12, 0x63, 0x3c, 0x7d, 0xfe, 0xf9, 0xd9,
-	0x9c, 0xcb, 0x45, 0x39, 0x9b, 0xd0, 0x2c, 0x99, 0x46, 0x11, 0x4d, 0xa7, 0xfa, 0xdd, 0x8f, 0xa2,
-	0xa9, 0xf6, 0x9f, 0x05, 0xfa, 0x0f, 0x40, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x03, 0x07, 0x21,
-	0x7a, 0x27, 0x08, 0x00, 0x00,
+	// 874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6b, 0xeb, 0x46,
+	0x10, 0xb6, 0x2c, 0xcb, 0x8e, 0xc7, 0xcf, 0xe6, 0x79, 0x79, 0x2d, 0x22, 0xbc, 0x83, 0x11, 0x7d,
+	0x90, 0x43, 0x70, 0x12, 0xe7, 0x56, 0x28, 0xb4, 0x0e, 0x2d, 0xbe, 0xb4, 0x09, 0x1b, 0x08, 0xb4,
+	0x97, 0xb2, 0x56, 0xd6, 0xf2, 0x62, 0x5b, 0x52, 0xb5, 0xab, 0x98, 0xfc, 0x85, 0xfe, 0x82, 0x5e,
+	0x7a, 0xe8, 0x6f, 0xe8, 0x1f, 0xea, 0x4f, 0x29, 0xbb, 0x5a, 0x69, 0x57, 0x89, 0x5c, 0x1a, 0x1f,
+	0x1e, 0xf8, 0xe0, 0xdd, 0x99, 0x6f, 0x66, 0x76, 0xe6, 0x9b, 0x19, 0x01, 0xa4, 0xcb, 0x95, 0x98,
+	0xa6, 0x59, 0x22, 0x12, 0xe4, 0x89, 0xe7, 0x94, 0xf2, 0xd3, 0xf7, 0xcb, 0x6d, 0x12, 0x6e, 0xc2,
+	0x35, 0x61, 0x71, 0x21, 0x38, 0x1d, 0x8b, 0x8c, 0xc4, 0x9c, 0x84, 0x82, 0x25, 0xfa, 0x2a, 0xb8,
+	0x80, 0xfe, 0x6d, 0x4a, 0x33, 0x22, 0xaf, 0x50, 0x00, 0xde, 0x13, 0xd9, 0xe6, 0xd4, 0x77, 0x26,
+	0xce, 0xd9, 0x60, 0xf6, 0x6e, 0xaa, 0x0c, 0x4d, 0xe7, 0xd2, 0x0e, 0x2e, 0x44, 0xc1, 0xb7, 0x00,
+	0x37, 0x6b, 0x1a, 0x6e, 0xd2, 0x84, 0xc5, 0x02, 0x9d, 0xc2, 0x09, 0xa7, 0xbf, 0xe5, 0x34, 0x0e,
+	0x0b, 0xd0, 0x10, 0x57, 0x67, 0xf4, 0x25, 0x74, 0x1f, 0x59, 0x44, 0xb9, 0xf0, 0xdb, 0x13, 0xe7,
+	0xec, 0x1d, 0xd6, 0xa7, 0xe0, 0x16, 0xbc, 0xef, 0x63, 0x91, 0x3d, 0x1f, 0x03, 0x46, 0x08, 0x3a,
+	0x4f, 0x8c, 0xee, 0x7d, 0x57, 0xe9, 0xab, 0xff, 0xc1, 0x0f, 0x00, 0x0f, 0x8c, 0xee, 0x6f, 0xd6,
+	0x24, 0x8e, 0x28, 0x9a, 0xc0, 0x40, 0xde, 0x86, 0xea, 0x94, 0x69, 0xc3, 0xf6, 0xd5, 0xc1, 0xc0,
+	0xbe, 0x81, 0xde, 0x7d, 0xbe, 0xdb, 0x91, 0xe3, 0x42, 0x0b, 0xce, 0xa1, 0x8b, 0x29, 0xcf, 0xb7,
+	0xe2, 0x7f, 0xe5, 0xf1, 0x6f, 0x17, 0x7a, 0x58, 0x9a, 0xe4, 0x02, 0x4d, 0xa1, 0x1b, 0x6e, 0x19,
+	0x8d, 0x85, 0x06, 0x7c, 0xd0, 0x00, 0x2d, 0xbf, 0x51, 0xb2, 0x45, 0x0b, 0x6b, 0x2d, 0xf4, 0x35,
+	0x40, 0x9a, 0x51, 0xf9, 0x23, 0x19, 0x55, 0x51, 0x0c, 0x66, 0x7e, 0x1d, 0x73, 0x97, 0xd1, 0xbb,
+	0x42, 0xbe, 0x68, 0x61, 0x4b, 0x1b, 0x5d, 0x41, 0xaf, 0x04, 0xba, 0x0a, 0xf8, 0xc5, 0x2b, 0xa0,
+	0x46, 0x95, 0x7a, 0x2a, 0xbc, 0x64, 0xb7, 0x63, 0xc2, 0xef, 0x34, 0x86, 0xa7, 0x64, 0x2a, 0x3c,
+	0xf5, 0x4f, 0x86, 0x17, 0x56, 0x14, 0xf1, 0xbd, 0xa6, 0xf0, 0x0c, 0x85, 0x64, 0x78, 0x46, 0x5b,
+	0x62, 0x4d, 0xa9, 0xfc, 0x6e, 0x13, 0xd6, 0xd4, 0x5a, 0x62, 0x8d, 0x36, 0xfa, 0x04, 0x2e, 0x09,
+	0x37, 0x7e, 0x4f, 0x81, 0xc6, 0x75, 0xd0, 0x77, 0xe1, 0x66, 0xd1, 0xc2, 0x52, 0x2e, 0x33, 0x10,
+	0xd3, 0xbd, 0x62, 0xd1, 0x49, 0x53, 0x06, 0x7e, 0xa2, 0x7b, 0xe9, 0x42, 0x66, 0x40, 0xeb, 0xcd,
+	0x7b, 0xba, 0xa0, 0x41, 0x04, 0xc3, 0x5a, 0x51, 0xd0, 0x04, 0xda, 0x49, 0xaa, 0xcb, 0xf6, 0x5e,
+	0xdb, 0xa9, 0x1a, 0x0a, 0xb7, 0x93, 0x14, 0x7d, 0x84, 0xbe, 0x60, 0x3b, 0xca, 0x05, 0xd9, 0xa5,
+	0xaa, 0x56, 0x7d, 0x6c, 0x2e, 0x24, 0x99, 0x74, 0xe9, 0x5d, 0x25, 0xd2, 0xa7, 0x20, 0x87, 0xf1,
+	0xab, 0x4a, 0x56, 0xe4, 0x77, 0x0c, 0xf9, 0x6b, 0x4c, 0x6d, 0x1f, 0x64, 0xaa, 0x5b, 0x6b, 0x22,
+	0x1f, 0x7a, 0x19, 0x4d, 0xb7, 0x2c, 0x24, 0xaa, 0xa2, 0x43, 0x5c, 0x1e, 0x83, 0x0c, 0x46, 0x75,
+	0x1e, 0x7c, 0x06, 0x9f, 0x3f, 0x9b, 0x9c, 0x16, 0xfc, 0x79, 0xab, 0x4b, 0xcb, 0xb4, 0x5b, 0x37,
+	0x4d, 0x60, 0xfc, 0x8a, 0x70, 0x47, 0x8d, 0x9d, 0xc3, 0x2e, 0xfe, 0x71, 0x2a, 0x1f, 0xd6, 0x10,
+	0x7a, 0xeb, 0x13, 0xae, 0x61, 0x60, 0x9a, 0x80, 0xfb, 0xee, 0xc4, 0xb5, 0x28, 0x6c, 0x62, 0xc7,
+	0xb6, 0x96, 0x1c, 0x33, 0xb2, 0x45, 0xb9, 0xdf, 0x99, 0xb8, 0xd6, 0x98, 0x51, 0xc3, 0x15, 0x17,
+	0x22, 0x74, 0x06, 0x27, 0xba, 0xf9, 0xb9, 0xef, 0x35, 0xa8, 0x55, 0x52, 0xfb, 0x89, 0xdd, 0xfa,
+	0x13, 0x05, 0x80, 0xe9, 0xa2, 0xc6, 0xa7, 0x59, 0xd8, 0x76, 0x0d, 0xfb, 0x72, 0x1a, 0xbb, 0xff,
+	0x35, 0x8d, 0x3b, 0xb5, 0x71, 0xfa, 0x97, 0x03, 0xa3, 0x7a, 0x47, 0x36, 0xba, 0xbe, 0xb6, 0x1d,
+	0x70, 0xbf, 0x5d, 0xcb, 0x9c, 0xa9, 0x88, 0xed, 0x93, 0xa3, 0x73, 0xe8, 0x73, 0x35, 0xe9, 0x19,
+	0x2d, 0xb3, 0x37, 0xd2, 0x10, 0xbd, 0x01, 0xb0, 0x51, 0xb0, 0x5f, 0xe7, 0xd5, 0x33, 0xf3, 0x87,
+	0x03, 0x83, 0x62, 0x10, 0x60, 0x9a, 0x6e, 0x9f, 0x1b, 0x03, 0x3c, 0xaa, 0xff, 0x0f, 0xb7, 0x0b,
+	0xfa, 0x04, 0xdd, 0x4c, 0xad, 0x19, 0x3d, 0x59, 0x87, 0xd5, 0xf4, 0x92, 0x97, 0x58, 0x0b, 0x83,
+	0x5f, 0x61, 0x30, 0x5f, 0x89, 0xbb, 0x2c, 0x49, 0x13, 0x4e, 0xb6, 0xd2, 0xcf, 0x9a, 0xb2, 0x68,
+	0x5d, 0xac, 0x18, 0x17, 0xeb, 0x13, 0xfa, 0x00, 0x5e, 0x96, 0xe4, 0xf1, 0xa3, 0x8a, 0xcc, 0xc3,
+	0xc5, 0x41, 0x32, 0x4b, 0x7d, 0x3c, 0xe8, 0x15, 0xf1, 0x62, 0x81, 0x29, 0x51, 0xc0, 0xa0, 0x37,
+	0x5f, 0x89, 0x87, 0x44, 0x28, 0xb6, 0x4b, 0x05, 0x65, 0xda, 0xc3, 0xea, 0xbf, 0xe5, 0xb0, 0xdd,
+	0xec, 0xd0, 0xb5, 0x1d, 0x7e, 0x84, 0xbe, 0xb2, 0xba, 0x20, 0x7c, 0xad, 0x79, 0x60, 0x2e, 0x82,
+	0x3f, 0x1d, 0x80, 0xf9, 0x4a, 0xfc, 0x48, 0x39, 0x27, 0x11, 0x45, 0x97, 0x92, 0xd3, 0xc5, 0xbb,
+	0xf4, 0xe4, 0x45, 0x65, 0x80, 0xe6, 0xc5, 0x8b, 0x16, 0xae, 0xb4, 0xd0, 0x57, 0xd0, 0x79, 0x4a,
+	0x44, 0xb9, 0x2a, 0x47, 0x46, 0x5b, 0x86, 0xbf, 0x68, 0x61, 0x25, 0x45, 0x53, 0xe8, 0x73, 0x16,
+	0xc5, 0x44, 0xe4, 0xd5, 0x72, 0x2c, 0x47, 0xfa, 0x7d, 0x79, 0x8f, 0x8d, 0x8a, 0xd9, 0x0a, 0xbf,
+	0x3b, 0xd0, 0x9f, 0xaf, 0xca, 0xf1, 0xf5, 0xb6, 0x54, 0xd7, 0x5e, 0xee, 0xbe, 0x78, 0x39, 0xba,
+	0x52, 0x9b, 0xbe, 0xd8, 0xab, 0x25, 0x53, 0xc7, 0x26, 0x7c, 0x9d, 0x11, 0x6c, 0x29, 0xcd, 0xa7,
+	0xbf, 0x9c, 0x47, 0x4c, 0xac, 0xf3, 0xe5, 0x34, 0x4c, 0x76, 0x17, 0x22, 0xcf, 0x58, 0x1c, 0xa9,
+	0x8f, 0xc0, 0xd9, 0xe5, 0xec, 0xd2, 0x3e, 0x5f, 0x28, 0x33, 0xcb, 0xae, 0xfa, 0x10, 0xbc, 0xfe,
+	0x77, 0x00, 0x78, 0x84, 0x46, 0x81, 0x42, 0x0a, 0x00, 0x00,
 }
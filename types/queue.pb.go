@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: queue.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+//远程模块和节点的消息队列之间传递的消息
+type QueueFrame struct {
+	//帧类型: 订阅, 消息, 应答, 查询统计
+	Kind int32 `protobuf:"varint,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	//发送方的消息id, 应答帧为请求消息的id
+	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Topic     string `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	Ty        int64  `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
+	WaitReply bool   `protobuf:"varint,5,opt,name=waitReply,proto3" json:"waitReply,omitempty"`
+	//消息数据的protobuf 类型名称, 为空表示没有数据
+	DataType string `protobuf:"bytes,6,opt,name=dataType,proto3" json:"dataType,omitempty"`
+	Data     []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	//消息数据是错误
+	Err string `protobuf:"bytes,8,opt,name=err,proto3" json:"err,omitempty"`
+	//W3C traceparent 格式的跟踪上下文
+	TraceParent          string   `protobuf:"bytes,9,opt,name=traceParent,proto3" json:"traceParent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueueFrame) Reset()         { *m = QueueFrame{} }
+func (m *QueueFrame) String() string { return proto.CompactTextString(m) }
+func (*QueueFrame) ProtoMessage()    {}
+func (*QueueFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96e4d7d76a734cd8, []int{0}
+}
+
+func (m *QueueFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueFrame.Unmarshal(m, b)
+}
+func (m *QueueFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueFrame.Marshal(b, m, deterministic)
+}
+func (m *QueueFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueFrame.Merge(m, src)
+}
+func (m *QueueFrame) XXX_Size() int {
+	return xxx_messageInfo_QueueFrame.Size(m)
+}
+func (m *QueueFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueFrame proto.InternalMessageInfo
+
+func (m *QueueFrame) GetKind() int32 {
+	if m != nil {
+		return m.Kind
+	}
+	return 0
+}
+
+func (m *QueueFrame) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueueFrame) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *QueueFrame) GetTy() int64 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *QueueFrame) GetWaitReply() bool {
+	if m != nil {
+		return m.WaitReply
+	}
+	return false
+}
+
+func (m *QueueFrame) GetDataType() string {
+	if m != nil {
+		return m.DataType
+	}
+	return ""
+}
+
+func (m *QueueFrame) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *QueueFrame) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *QueueFrame) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueueFrame)(nil), "types.QueueFrame")
+}
+
+func init() {
+	proto.RegisterFile("queue.proto", fileDescriptor_96e4d7d76a734cd8)
+}
+
+var fileDescriptor_96e4d7d76a734cd8 = []byte{
+	// 263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x4b, 0xc3, 0x30,
+	0x18, 0xc5, 0xcd, 0xba, 0x6e, 0x6d, 0x26, 0xa2, 0x1f, 0x1e, 0xc2, 0xf0, 0x10, 0x76, 0xca, 0x41,
+	0xba, 0x52, 0xf1, 0x1f, 0x50, 0xf1, 0xac, 0xc1, 0x93, 0xb7, 0xac, 0xfd, 0xd8, 0x82, 0x36, 0xa9,
+	0xf1, 0x2b, 0xd2, 0xff, 0xd4, 0x3f, 0x47, 0x9a, 0x89, 0x1b, 0x78, 0x7b, 0xef, 0x97, 0xc7, 0x23,
+	0xef, 0xe3, 0x8b, 0x8f, 0x1e, 0x7b, 0x2c, 0xba, 0xe0, 0xc9, 0x43, 0x4a, 0x43, 0x87, 0x9f, 0xab,
+	0x6f, 0xc6, 0xf9, 0xf3, 0x88, 0x1f, 0x83, 0x69, 0x11, 0x80, 0x4f, 0xdf, 0xac, 0x6b, 0x04, 0x93,
+	0x4c, 0xa5, 0x3a, 0x6a, 0x38, 0xe3, 0x13, 0xdb, 0x88, 0x89, 0x64, 0x2a, 0xd1, 0x13, 0xdb, 0xc0,
+	0x25, 0x4f, 0xc9, 0x77, 0xb6, 0x16, 0x89, 0x64, 0x2a, 0xd7, 0x7b, 0x33, 0xa6, 0x68, 0x10, 0xd3,
+	0x7d, 0x8a, 0x06, 0xb8, 0xe2, 0xf9, 0x97, 0xb1, 0xa4, 0xb1, 0x7b, 0x1f, 0x44, 0x2a, 0x99, 0xca,
+	0xf4, 0x01, 0xc0, 0x92, 0x67, 0x8d, 0x21, 0xf3, 0x32, 0x74, 0x28, 0x66, 0xb1, 0xe6, 0xcf, 0x8f,
+	0x7f, 0x18, 0xb5, 0x98, 0x4b, 0xa6, 0x4e, 0x75, 0xd4, 0x70, 0xce, 0x13, 0x0c, 0x41, 0x64, 0x31,
+	0x3a, 0x4a, 0x90, 0x7c, 0x41, 0xc1, 0xd4, 0xf8, 0x64, 0x02, 0x3a, 0x12, 0x79, 0x7c, 0x39, 0x46,
+	0xd5, 0xc3, 0xef, 0x60, 0x8d, 0xad, 0x27, 0x84, 0x5b, 0x3e, 0xbf, 0xf7, 0xce, 0x61, 0x4d, 0x70,
+	0x51, 0xc4, 0xf1, 0xc5, 0x61, 0xf8, 0xf2, 0x3f, 0x5a, 0x9d, 0x28, 0x56, 0xb2, 0xbb, 0xe2, 0xf5,
+	0x7a, 0x6b, 0x69, 0xd7, 0x6f, 0x8a, 0xda, 0xb7, 0x6b, 0xea, 0x83, 0x75, 0xdb, 0x7a, 0x67, 0xac,
+	0xab, 0xca, 0xaa, 0x3c, 0xf6, 0xeb, 0x58, 0xb0, 0x99, 0xc5, 0xf3, 0xde, 0xfc, 0x0c, 0x00, 0x81,
+	0xf9, 0x77, 0x23, 0x6d, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// QueueRemoteClient is the client API for QueueRemote service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueueRemoteClient interface {
+	Connect(ctx context.Context, opts ...grpc.CallOption) (QueueRemote_ConnectClient, error)
+}
+
+type queueRemoteClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueueRemoteClient(cc grpc.ClientConnInterface) QueueRemoteClient {
+	return &queueRemoteClient{cc}
+}
+
+func (c *queueRemoteClient) Connect(ctx context.Context, opts ...grpc.CallOption) (QueueRemote_ConnectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_QueueRemote_serviceDesc.Streams[0], "/types.queueRemote/Connect", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queueRemoteConnectClient{stream}
+	return x, nil
+}
+
+type QueueRemote_ConnectClient interface {
+	Send(*QueueFrame) error
+	Recv() (*QueueFrame, error)
+	grpc.ClientStream
+}
+
+type queueRemoteConnectClient struct {
+	grpc.ClientStream
+}
+
+func (x *queueRemoteConnectClient) Send(m *QueueFrame) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *queueRemoteConnectClient) Recv() (*QueueFrame, error) {
+	m := new(QueueFrame)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueueRemoteServer is the server API for QueueRemote service.
+type QueueRemoteServer interface {
+	Connect(QueueRemote_ConnectServer) error
+}
+
+// UnimplementedQueueRemoteServer can be embedded to have forward compatible implementations.
+type UnimplementedQueueRemoteServer struct {
+}
+
+func (*UnimplementedQueueRemoteServer) Connect(srv QueueRemote_ConnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+
+func RegisterQueueRemoteServer(s *grpc.Server, srv QueueRemoteServer) {
+	s.RegisterService(&_QueueRemote_serviceDesc, srv)
+}
+
+func _QueueRemote_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(QueueRemoteServer).Connect(&queueRemoteConnectServer{stream})
+}
+
+type QueueRemote_ConnectServer interface {
+	Send(*QueueFrame) error
+	Recv() (*QueueFrame, error)
+	grpc.ServerStream
+}
+
+type queueRemoteConnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *queueRemoteConnectServer) Send(m *QueueFrame) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *queueRemoteConnectServer) Recv() (*QueueFrame, error) {
+	m := new(QueueFrame)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _QueueRemote_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "types.queueRemote",
+	HandlerType: (*QueueRemoteServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       _QueueRemote_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "queue.proto",
+}
@@ -64,8 +64,211 @@ func (m *ServerTime) GetCurrentTimestamp() int64 {
 	return 0
 }
 
+// 订阅区块链的推送数据, 通过websocket 或者grpc stream 推送
+type ReqSubscribe struct {
+	// 0:区块 1:区块头 2:交易回执 3:交易执行结果 4:mempool中待打包的交易
+	Type int32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	// 从指定的sequence 开始推送, 断线重连时传入最后收到的seqNum+1, 小于0时从最新的区块开始
+	StartSequence int64 `protobuf:"varint,2,opt,name=startSequence,proto3" json:"startSequence,omitempty"`
+	// 订阅交易回执时, 指定需要推送的合约
+	Contract             map[string]bool `protobuf:"bytes,3,rep,name=contract,proto3" json:"contract,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReqSubscribe) Reset()         { *m = ReqSubscribe{} }
+func (m *ReqSubscribe) String() string { return proto.CompactTextString(m) }
+func (*ReqSubscribe) ProtoMessage()    {}
+func (*ReqSubscribe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{1}
+}
+
+func (m *ReqSubscribe) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSubscribe.Unmarshal(m, b)
+}
+func (m *ReqSubscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSubscribe.Marshal(b, m, deterministic)
+}
+func (m *ReqSubscribe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSubscribe.Merge(m, src)
+}
+func (m *ReqSubscribe) XXX_Size() int {
+	return xxx_messageInfo_ReqSubscribe.Size(m)
+}
+func (m *ReqSubscribe) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSubscribe.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSubscribe proto.InternalMessageInfo
+
+func (m *ReqSubscribe) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *ReqSubscribe) GetStartSequence() int64 {
+	if m != nil {
+		return m.StartSequence
+	}
+	return 0
+}
+
+func (m *ReqSubscribe) GetContract() map[string]bool {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+type SubscribeEvent struct {
+	Type   int32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	SeqNum int64 `protobuf:"varint,2,opt,name=seqNum,proto3" json:"seqNum,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//	*SubscribeEvent_Block
+	//	*SubscribeEvent_Header
+	//	*SubscribeEvent_TxReceipt
+	//	*SubscribeEvent_TxResult
+	//	*SubscribeEvent_Tx
+	Value                isSubscribeEvent_Value `protobuf_oneof:"value"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SubscribeEvent) Reset()         { *m = SubscribeEvent{} }
+func (m *SubscribeEvent) String() string { return proto.CompactTextString(m) }
+func (*SubscribeEvent) ProtoMessage()    {}
+func (*SubscribeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{2}
+}
+
+func (m *SubscribeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeEvent.Unmarshal(m, b)
+}
+func (m *SubscribeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeEvent.Marshal(b, m, deterministic)
+}
+func (m *SubscribeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeEvent.Merge(m, src)
+}
+func (m *SubscribeEvent) XXX_Size() int {
+	return xxx_messageInfo_SubscribeEvent.Size(m)
+}
+func (m *SubscribeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeEvent proto.InternalMessageInfo
+
+func (m *SubscribeEvent) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *SubscribeEvent) GetSeqNum() int64 {
+	if m != nil {
+		return m.SeqNum
+	}
+	return 0
+}
+
+type isSubscribeEvent_Value interface {
+	isSubscribeEvent_Value()
+}
+
+type SubscribeEvent_Block struct {
+	Block *BlockSeq `protobuf:"bytes,3,opt,name=block,proto3,oneof"`
+}
+
+type SubscribeEvent_Header struct {
+	Header *HeaderSeq `protobuf:"bytes,4,opt,name=header,proto3,oneof"`
+}
+
+type SubscribeEvent_TxReceipt struct {
+	TxReceipt *TxReceipts4SubscribePerBlk `protobuf:"bytes,5,opt,name=txReceipt,proto3,oneof"`
+}
+
+type SubscribeEvent_TxResult struct {
+	TxResult *TxResultPerBlock `protobuf:"bytes,6,opt,name=txResult,proto3,oneof"`
+}
+
+type SubscribeEvent_Tx struct {
+	Tx *Transaction `protobuf:"bytes,7,opt,name=tx,proto3,oneof"`
+}
+
+func (*SubscribeEvent_Block) isSubscribeEvent_Value() {}
+
+func (*SubscribeEvent_Header) isSubscribeEvent_Value() {}
+
+func (*SubscribeEvent_TxReceipt) isSubscribeEvent_Value() {}
+
+func (*SubscribeEvent_TxResult) isSubscribeEvent_Value() {}
+
+func (*SubscribeEvent_Tx) isSubscribeEvent_Value() {}
+
+func (m *SubscribeEvent) GetValue() isSubscribeEvent_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *SubscribeEvent) GetBlock() *BlockSeq {
+	if x, ok := m.GetValue().(*SubscribeEvent_Block); ok {
+		return x.Block
+	}
+	return nil
+}
+
+func (m *SubscribeEvent) GetHeader() *HeaderSeq {
+	if x, ok := m.GetValue().(*SubscribeEvent_Header); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (m *SubscribeEvent) GetTxReceipt() *TxReceipts4SubscribePerBlk {
+	if x, ok := m.GetValue().(*SubscribeEvent_TxReceipt); ok {
+		return x.TxReceipt
+	}
+	return nil
+}
+
+func (m *SubscribeEvent) GetTxResult() *TxResultPerBlock {
+	if x, ok := m.GetValue().(*SubscribeEvent_TxResult); ok {
+		return x.TxResult
+	}
+	return nil
+}
+
+func (m *SubscribeEvent) GetTx() *Transaction {
+	if x, ok := m.GetValue().(*SubscribeEvent_Tx); ok {
+		return x.Tx
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SubscribeEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SubscribeEvent_Block)(nil),
+		(*SubscribeEvent_Header)(nil),
+		(*SubscribeEvent_TxReceipt)(nil),
+		(*SubscribeEvent_TxResult)(nil),
+		(*SubscribeEvent_Tx)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterType((*ServerTime)(nil), "types.serverTime")
+	proto.RegisterType((*ReqSubscribe)(nil), "types.ReqSubscribe")
+	proto.RegisterMapType((map[string]bool)(nil), "types.ReqSubscribe.ContractEntry")
+	proto.RegisterType((*SubscribeEvent)(nil), "types.SubscribeEvent")
 }
 
 func init() {
@@ -73,86 +276,107 @@ func init() {
 }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 1256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xef, 0x6f, 0xdb, 0x36,
-	0x13, 0x56, 0xf1, 0xbe, 0x6b, 0x1a, 0xd6, 0x76, 0x1d, 0x26, 0xed, 0x5a, 0x6d, 0x45, 0x07, 0x01,
-	0xc3, 0x8a, 0x0d, 0x4d, 0x52, 0x7b, 0xcd, 0xda, 0xb5, 0x1b, 0x50, 0xa7, 0xb5, 0x63, 0xcc, 0xf5,
-	0x5c, 0xdb, 0xdd, 0x80, 0x7d, 0x63, 0xe4, 0x9b, 0x23, 0x54, 0x16, 0x65, 0x92, 0x8a, 0xed, 0x3f,
-	0x7d, 0xdf, 0x06, 0x92, 0xfa, 0x45, 0x49, 0x4e, 0xb2, 0x6f, 0xe6, 0x73, 0x7c, 0x4e, 0x77, 0xe4,
-	0x3d, 0x77, 0x26, 0xda, 0x65, 0xa1, 0x7b, 0x18, 0x32, 0x2a, 0x28, 0xfe, 0x42, 0x6c, 0x42, 0xe0,
-	0x76, 0xcd, 0xa5, 0x8b, 0x05, 0x0d, 0x34, 0x68, 0xef, 0x09, 0x46, 0x02, 0x4e, 0x5c, 0xe1, 0xa5,
-	0x50, 0xf3, 0xdc, 0xa7, 0xee, 0x67, 0xf7, 0x82, 0x78, 0x09, 0x52, 0x5b, 0x11, 0xdf, 0x07, 0x11,
-	0xaf, 0x76, 0xc3, 0x56, 0x18, 0xff, 0xac, 0x13, 0xd7, 0xa5, 0x51, 0x90, 0x58, 0x1a, 0xb0, 0x06,
-	0x37, 0x12, 0x94, 0xe9, 0xb5, 0xf3, 0x12, 0x21, 0x0e, 0xec, 0x12, 0xd8, 0xd4, 0x5b, 0x00, 0xfe,
-	0x1e, 0x35, 0xdd, 0x88, 0x31, 0x08, 0x84, 0x5c, 0x72, 0x41, 0x16, 0xe1, 0xc3, 0x5b, 0xdf, 0xdc,
-	0x7a, 0xfa, 0xbf, 0x71, 0x09, 0x6f, 0xfd, 0xf3, 0x15, 0xda, 0x51, 0x11, 0xb4, 0xdb, 0xf8, 0x19,
-	0xda, 0xed, 0x81, 0xe8, 0xc8, 0xa0, 0x38, 0x6e, 0x1e, 0xaa, 0x2c, 0x0e, 0xc7, 0xb0, 0xd4, 0x88,
-	0x5d, 0x4b, 0x91, 0xd0, 0xdf, 0x38, 0x16, 0x3e, 0x42, 0xf5, 0x1e, 0x88, 0x01, 0xe1, 0xe2, 0x0c,
-	0xc8, 0x0c, 0x18, 0xae, 0x67, 0x94, 0xa1, 0xe7, 0xdb, 0xc9, 0x52, 0x5b, 0x1d, 0x0b, 0xff, 0x8c,
-	0x0e, 0x4e, 0x19, 0x10, 0x01, 0x63, 0xb2, 0x9a, 0x66, 0xa7, 0x81, 0xef, 0xc5, 0x1b, 0xb5, 0x71,
-	0xba, 0xb6, 0x13, 0xe0, 0x53, 0xc0, 0xbd, 0x79, 0x30, 0x5d, 0x3b, 0x16, 0x7e, 0x87, 0x9a, 0x19,
-	0x77, 0xdd, 0x63, 0x34, 0x0a, 0xf1, 0x63, 0x93, 0x97, 0x79, 0x54, 0xe6, 0x2a, 0x2f, 0xbf, 0xa2,
-	0xe6, 0xc7, 0x08, 0xd8, 0x26, 0xff, 0xf5, 0x46, 0x16, 0xf5, 0x19, 0xe1, 0x17, 0xf6, 0xc3, 0x78,
-	0x9d, 0xdb, 0xf3, 0x0e, 0x04, 0xf1, 0x7c, 0xc7, 0xc2, 0xaf, 0xd0, 0xfe, 0x04, 0x82, 0x59, 0xce,
-	0x34, 0xd9, 0x04, 0x2e, 0xc6, 0x65, 0x4a, 0xe9, 0xb4, 0x5e, 0xa0, 0x7b, 0x05, 0xea, 0x8d, 0x68,
-	0xbf, 0xa0, 0x83, 0x1e, 0x88, 0xdc, 0x8e, 0xce, 0xe6, 0xed, 0x6c, 0xc6, 0xf2, 0x51, 0xcb, 0xb5,
-	0xbd, 0x9f, 0xe7, 0x4d, 0xd7, 0xfd, 0xe0, 0x6f, 0xca, 0x1d, 0x0b, 0xf7, 0xd0, 0x83, 0x22, 0x5d,
-	0x26, 0x09, 0xc6, 0xfd, 0x6a, 0xc4, 0x7e, 0xb4, 0x2d, 0x71, 0xe9, 0xe8, 0x25, 0x42, 0x3d, 0x10,
-	0x1f, 0x60, 0x31, 0xa2, 0xd4, 0xc7, 0x07, 0x19, 0x59, 0xa3, 0x21, 0xa5, 0xbe, 0x8d, 0xcd, 0x18,
-	0x06, 0x1e, 0x17, 0x2a, 0xf1, 0xbb, 0x3d, 0x10, 0x6f, 0x75, 0xfd, 0xf2, 0x62, 0x91, 0xdc, 0x8f,
-	0x97, 0x7f, 0xaa, 0xc2, 0x4f, 0x76, 0xa9, 0x62, 0x41, 0x19, 0xad, 0xf0, 0xc1, 0x18, 0xb5, 0x0f,
-	0xaa, 0xc8, 0x9a, 0x3b, 0x84, 0x55, 0x05, 0x37, 0x43, 0xb7, 0x72, 0xc7, 0xe8, 0xbe, 0x86, 0x72,
-	0xc7, 0x20, 0x33, 0xc1, 0x4f, 0x32, 0x37, 0x95, 0x1b, 0xec, 0x07, 0x86, 0xc7, 0xe9, 0x3a, 0x3b,
-	0xbc, 0x2e, 0xaa, 0xf7, 0x17, 0x21, 0x65, 0x62, 0xc4, 0xbc, 0xcb, 0xcf, 0xb0, 0x49, 0x2b, 0x37,
-	0xf5, 0x65, 0x98, 0xb7, 0xc6, 0xd6, 0x41, 0x75, 0x55, 0x43, 0x54, 0x5e, 0x39, 0x70, 0x5e, 0xf6,
-	0x63, 0x98, 0xed, 0x66, 0xfe, 0x42, 0xe4, 0x2d, 0x3b, 0x16, 0x6e, 0xa1, 0x3b, 0x13, 0x19, 0x5d,
-	0x17, 0x00, 0x3f, 0x28, 0xd3, 0x45, 0x17, 0xa0, 0x54, 0x84, 0xaf, 0xd1, 0xce, 0x44, 0x2a, 0xfd,
-	0xdc, 0xc7, 0x0f, 0x2b, 0x28, 0x03, 0x72, 0x0e, 0xfe, 0x15, 0x41, 0xd7, 0x3e, 0x00, 0x9b, 0x43,
-	0x87, 0xf8, 0x24, 0x70, 0x01, 0x7f, 0x5d, 0xf4, 0x90, 0xb7, 0x9a, 0x35, 0xa4, 0x0b, 0xd3, 0xb1,
-	0xf0, 0x09, 0xda, 0x9d, 0x80, 0x18, 0x11, 0xce, 0x57, 0x33, 0xfc, 0xa8, 0x22, 0x04, 0x6d, 0x2a,
-	0x05, 0xfe, 0x2d, 0xfa, 0xff, 0x80, 0xba, 0x9f, 0x8b, 0x45, 0x57, 0xdc, 0xf6, 0x0c, 0xdd, 0xfe,
-	0x14, 0xa8, 0x8d, 0xfb, 0x46, 0x12, 0x1a, 0xac, 0x90, 0x72, 0x23, 0x6e, 0x7c, 0x89, 0x1e, 0x0a,
-	0xfe, 0xab, 0x85, 0xf0, 0x06, 0xd5, 0x7a, 0x20, 0x46, 0x8c, 0x86, 0xc0, 0xe4, 0xe9, 0x67, 0x92,
-	0x5d, 0xa6, 0x60, 0xaa, 0x07, 0x45, 0x4d, 0x61, 0xc7, 0xc2, 0x3f, 0xa1, 0x7b, 0x3d, 0x10, 0x71,
-	0xc2, 0x82, 0x88, 0xa8, 0x24, 0x25, 0x33, 0x76, 0xbd, 0x47, 0x89, 0xa1, 0x99, 0x74, 0xf5, 0xdf,
-	0x2f, 0x81, 0x5d, 0x7a, 0xb0, 0x2a, 0xf5, 0xbc, 0xe4, 0xee, 0x8c, 0x5d, 0x4a, 0xf5, 0xf2, 0xa3,
-	0xb2, 0x9c, 0xaa, 0xa8, 0x46, 0xe3, 0xc9, 0x6f, 0x72, 0x2c, 0xfc, 0x5c, 0x25, 0xab, 0xfc, 0xc9,
-	0x2f, 0xe4, 0x63, 0xed, 0x07, 0xa2, 0xb2, 0x32, 0x9f, 0xa3, 0x9d, 0x1e, 0x04, 0x13, 0x80, 0x59,
-	0xda, 0x19, 0xe3, 0xf5, 0x80, 0x04, 0x73, 0x93, 0x22, 0xd1, 0x84, 0x22, 0x0a, 0x14, 0xb5, 0xee,
-	0x6c, 0x46, 0xab, 0x4a, 0xca, 0x11, 0xba, 0x33, 0x21, 0x97, 0xa0, 0x38, 0x49, 0xec, 0x09, 0xa0,
-	0x48, 0xc5, 0xdb, 0x6e, 0xa9, 0x46, 0x94, 0x54, 0xef, 0x5e, 0x6e, 0x2c, 0xc6, 0x25, 0x9b, 0xcc,
-	0x99, 0x5c, 0xf3, 0x6a, 0x21, 0xa4, 0xe6, 0xcc, 0xa9, 0x9c, 0xac, 0x69, 0x03, 0x52, 0xab, 0xf7,
-	0xf1, 0xe4, 0xae, 0xfa, 0x8e, 0xb4, 0xe9, 0xdb, 0xbb, 0x21, 0xe7, 0x04, 0x35, 0xf4, 0x77, 0x68,
-	0xc0, 0x21, 0xe0, 0x11, 0xbf, 0x21, 0xef, 0x15, 0xda, 0x2b, 0x0d, 0xcd, 0x34, 0xb5, 0x64, 0x0c,
-	0xf7, 0x83, 0xaa, 0x11, 0x7a, 0xac, 0x8a, 0xff, 0x0c, 0xd6, 0xd3, 0xb5, 0x9e, 0x25, 0xa5, 0x62,
-	0xaa, 0xa5, 0x73, 0x7f, 0xad, 0x18, 0x2f, 0xd0, 0xdd, 0x77, 0xd1, 0x22, 0x4c, 0x7a, 0x5f, 0x6e,
-	0xf0, 0x4c, 0x04, 0xf3, 0x82, 0xb9, 0x29, 0x17, 0x8d, 0xe9, 0xba, 0xcd, 0xd1, 0x78, 0xd7, 0xf3,
-	0x8d, 0x86, 0x95, 0xc7, 0x4b, 0xf9, 0xbd, 0x41, 0xd8, 0xe8, 0xa8, 0xff, 0x8d, 0x7d, 0x88, 0x76,
-	0xfe, 0x00, 0xc6, 0xe5, 0x99, 0x6c, 0x11, 0x76, 0x6c, 0x96, 0x53, 0xd6, 0xb1, 0xf0, 0x77, 0xe8,
-	0x76, 0x9f, 0xab, 0x3f, 0x02, 0xd7, 0xf4, 0x99, 0x13, 0x35, 0x0a, 0x47, 0x00, 0x4c, 0x32, 0xd3,
-	0xbb, 0x1a, 0xb5, 0x46, 0x31, 0x3c, 0x86, 0x65, 0x7a, 0xe6, 0x72, 0x1d, 0x77, 0x8e, 0x97, 0x68,
-	0x67, 0x08, 0x42, 0x71, 0xbe, 0x34, 0x38, 0x31, 0x2a, 0x69, 0x49, 0x68, 0x43, 0x3a, 0x83, 0x18,
-	0x56, 0xd5, 0xde, 0xe8, 0xf3, 0xa1, 0x08, 0x4f, 0xa5, 0x10, 0x6f, 0x12, 0xe2, 0xb1, 0x52, 0x7c,
-	0x97, 0x08, 0xe2, 0x77, 0x89, 0xe7, 0x47, 0x0c, 0xb6, 0x31, 0xfa, 0x81, 0x68, 0xb7, 0xd4, 0xf5,
-	0x1e, 0xc4, 0xdd, 0x50, 0xa9, 0x7d, 0x02, 0xcb, 0x08, 0xa4, 0x52, 0xb6, 0xd3, 0x4e, 0x7e, 0x74,
-	0x2c, 0xdc, 0x46, 0x7b, 0x4a, 0xaa, 0x7a, 0xf7, 0x35, 0xa5, 0x94, 0x90, 0x5e, 0x67, 0xbd, 0xec,
-	0x8a, 0x3f, 0x32, 0xfb, 0xf9, 0x6e, 0x96, 0x4d, 0xe1, 0x63, 0xf5, 0x7f, 0x35, 0x26, 0x4f, 0x60,
-	0x89, 0x0d, 0xef, 0xe9, 0xb9, 0x27, 0x59, 0x38, 0x16, 0xfe, 0x01, 0xa1, 0x53, 0x9f, 0x72, 0xf8,
-	0x18, 0x41, 0x04, 0xd7, 0x9d, 0x5c, 0x57, 0x25, 0xf4, 0xd6, 0xf7, 0xa5, 0xea, 0x92, 0x76, 0x91,
-	0x1b, 0x97, 0xa6, 0x25, 0x6d, 0xf4, 0x26, 0xac, 0xb4, 0xb9, 0x3b, 0xf1, 0xe6, 0x81, 0xfa, 0x9f,
-	0x9b, 0x9f, 0x11, 0x29, 0x68, 0xce, 0x88, 0x14, 0x76, 0x2c, 0xdc, 0x47, 0xb6, 0x16, 0xef, 0x90,
-	0xc6, 0xfe, 0xaa, 0xfe, 0x6e, 0x66, 0xc6, 0x2b, 0x5c, 0x9d, 0xa0, 0x9a, 0xea, 0x2c, 0x63, 0x12,
-	0xcc, 0x86, 0xd1, 0x02, 0x67, 0x1a, 0x5d, 0x4a, 0x48, 0xdd, 0x4e, 0x55, 0x13, 0x7f, 0xaa, 0x3a,
-	0x72, 0x97, 0x32, 0x63, 0xe8, 0xfe, 0x06, 0x9b, 0xd2, 0x5d, 0x76, 0x10, 0x2e, 0x06, 0xbb, 0xe6,
-	0x69, 0xc2, 0x79, 0x70, 0x7b, 0x94, 0xa7, 0xaa, 0x1e, 0x46, 0x84, 0x11, 0xd9, 0x8d, 0xa6, 0x9e,
-	0xf0, 0x21, 0x55, 0x88, 0x54, 0x79, 0xde, 0x90, 0x0e, 0x39, 0x8d, 0x66, 0x75, 0xd1, 0x47, 0x7b,
-	0x03, 0x4a, 0x66, 0x5b, 0xbd, 0x9c, 0x81, 0x37, 0xbf, 0x10, 0x89, 0x97, 0x47, 0x46, 0xd2, 0x79,
-	0x93, 0x63, 0xe1, 0xf7, 0xaa, 0x06, 0x12, 0x4f, 0xda, 0x9a, 0xaf, 0x01, 0xd3, 0xb2, 0x35, 0xa2,
-	0x63, 0x35, 0x72, 0xf4, 0xbb, 0xa9, 0xea, 0x25, 0xd6, 0x30, 0x5e, 0x56, 0x5c, 0xa9, 0xa9, 0xae,
-	0xd4, 0x94, 0xbe, 0x01, 0x0b, 0xc5, 0x9a, 0xf4, 0xf6, 0xec, 0x95, 0xe8, 0x58, 0x9d, 0x27, 0x7f,
-	0x3d, 0x9e, 0x7b, 0xe2, 0x22, 0x3a, 0x3f, 0x74, 0xe9, 0xe2, 0xa8, 0xdd, 0x76, 0x83, 0xa3, 0xf8,
-	0x29, 0x78, 0xa4, 0x76, 0x9f, 0xdf, 0x56, 0xaf, 0xcb, 0xf6, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xc7, 0xa5, 0x21, 0xc4, 0xdc, 0x0e, 0x00, 0x00,
+	// 1590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xeb, 0x72, 0xdb, 0xb6,
+	0x12, 0xa6, 0xed, 0xf8, 0x22, 0x58, 0xb2, 0x65, 0xf8, 0x12, 0x85, 0x73, 0x32, 0xc7, 0x87, 0x93,
+	0x33, 0xf1, 0xa4, 0x8d, 0xad, 0x28, 0x89, 0xeb, 0xdc, 0x3a, 0x63, 0x39, 0xb6, 0xac, 0xa9, 0xe3,
+	0x2a, 0x92, 0xd2, 0xce, 0xf4, 0x4f, 0x06, 0xa2, 0x36, 0x32, 0x27, 0x14, 0x49, 0x01, 0xa0, 0x2c,
+	0xbd, 0x5a, 0x7f, 0xf7, 0x41, 0xfa, 0x28, 0x1d, 0x80, 0x37, 0x90, 0xa2, 0x12, 0xf7, 0x9f, 0xf0,
+	0xed, 0x7e, 0xcb, 0xc5, 0x62, 0x2f, 0x80, 0x50, 0x81, 0x7a, 0xe6, 0xa1, 0x47, 0x5d, 0xee, 0xe2,
+	0x65, 0x3e, 0xf5, 0x80, 0xe9, 0x45, 0xd3, 0x1d, 0x0e, 0x5d, 0x27, 0x00, 0xf5, 0x2d, 0x4e, 0x89,
+	0xc3, 0x88, 0xc9, 0xad, 0x18, 0x2a, 0xf7, 0x6c, 0xd7, 0xfc, 0x6a, 0xde, 0x10, 0x2b, 0x42, 0x8a,
+	0xb7, 0xc4, 0xb6, 0x81, 0x87, 0xab, 0x82, 0x57, 0xf3, 0xc2, 0x9f, 0x25, 0x62, 0x9a, 0xae, 0xef,
+	0x44, 0x92, 0x0d, 0x98, 0x80, 0xe9, 0x73, 0x97, 0x86, 0xeb, 0x5d, 0xcf, 0x67, 0x37, 0x9f, 0xf9,
+	0xe4, 0x33, 0x05, 0x13, 0x2c, 0x2f, 0x52, 0x5b, 0xeb, 0xf7, 0xc2, 0x5f, 0x9b, 0x8c, 0x13, 0x6e,
+	0x31, 0x6e, 0x85, 0x3e, 0x1a, 0x27, 0x08, 0x31, 0xa0, 0x63, 0xa0, 0x5d, 0x6b, 0x08, 0xf8, 0x09,
+	0x2a, 0x9b, 0x3e, 0xa5, 0xe0, 0x70, 0xb1, 0x64, 0x9c, 0x0c, 0xbd, 0xca, 0xc2, 0xfe, 0xc2, 0xc1,
+	0x52, 0x7b, 0x06, 0x37, 0xfe, 0x5a, 0x40, 0xc5, 0x36, 0x8c, 0x3a, 0x7e, 0x8f, 0x99, 0xd4, 0xea,
+	0x01, 0xc6, 0xe8, 0x9e, 0xd8, 0xb0, 0x24, 0x2c, 0xb7, 0xe5, 0x6f, 0xfc, 0x08, 0x95, 0x18, 0x27,
+	0x94, 0x77, 0x60, 0xe4, 0x83, 0x63, 0x42, 0x65, 0x51, 0x5a, 0x4b, 0x83, 0xf8, 0x1d, 0x5a, 0x33,
+	0x5d, 0x87, 0x53, 0x62, 0xf2, 0xca, 0xd2, 0xfe, 0xd2, 0xc1, 0x7a, 0xed, 0x7f, 0x87, 0x32, 0x76,
+	0x87, 0xea, 0x07, 0x0e, 0xcf, 0x42, 0x9d, 0x73, 0x87, 0xd3, 0x69, 0x3b, 0xa6, 0xe8, 0x6f, 0x50,
+	0x29, 0x25, 0xc2, 0x65, 0xb4, 0xf4, 0x15, 0xa6, 0xd2, 0x91, 0x42, 0x5b, 0xfc, 0xc4, 0x3b, 0x68,
+	0x79, 0x4c, 0x6c, 0x3f, 0xf8, 0xfe, 0x5a, 0x3b, 0x58, 0xbc, 0x5e, 0x3c, 0x59, 0x30, 0xfe, 0x5c,
+	0x44, 0x1b, 0xf1, 0x27, 0xce, 0xc7, 0xe0, 0xf0, 0xdc, 0x8d, 0xec, 0xa1, 0x15, 0x06, 0xa3, 0x6b,
+	0x7f, 0x18, 0xee, 0x20, 0x5c, 0xe1, 0xc7, 0x68, 0x59, 0x9e, 0x5e, 0x65, 0x69, 0x7f, 0xe1, 0x60,
+	0xbd, 0xb6, 0x19, 0xfa, 0x5d, 0x17, 0x58, 0x07, 0x46, 0x97, 0x5a, 0x3b, 0x90, 0xe3, 0x27, 0x68,
+	0xe5, 0x06, 0x48, 0x1f, 0x68, 0xe5, 0x9e, 0xd4, 0x2c, 0x87, 0x9a, 0x97, 0x12, 0x0c, 0x54, 0x43,
+	0x0d, 0x7c, 0x8a, 0x0a, 0x7c, 0xd2, 0x0e, 0x8e, 0xb0, 0xb2, 0xbc, 0xbf, 0xa0, 0x04, 0xa4, 0x1b,
+	0xe1, 0xec, 0x45, 0xec, 0x75, 0x0b, 0x68, 0xdd, 0xfe, 0x7a, 0xa9, 0xb5, 0x13, 0x16, 0x7e, 0x89,
+	0xd6, 0xc4, 0x82, 0xf9, 0x36, 0xaf, 0xac, 0x48, 0x0b, 0xf7, 0x15, 0x0b, 0x02, 0x96, 0x2c, 0xd7,
+	0x14, 0xbc, 0x58, 0x15, 0x3f, 0x42, 0x8b, 0x7c, 0x52, 0x59, 0x95, 0x04, 0x1c, 0x11, 0x92, 0x84,
+	0xbd, 0xd4, 0xda, 0x8b, 0x7c, 0x52, 0x5f, 0x0d, 0xa3, 0x59, 0xfb, 0xfb, 0x21, 0x5a, 0xe7, 0x3e,
+	0xb5, 0x9c, 0x81, 0xcc, 0x5e, 0xfc, 0x14, 0x15, 0x1a, 0xc0, 0xa5, 0x59, 0x86, 0xcb, 0xc9, 0x19,
+	0x06, 0x88, 0x5e, 0x8c, 0x11, 0xcf, 0x9e, 0x1a, 0x1a, 0x3e, 0x42, 0xa5, 0x06, 0xf0, 0x2b, 0xc2,
+	0x78, 0x10, 0x05, 0x5c, 0x4a, 0x28, 0xd7, 0x96, 0xad, 0x97, 0x52, 0x31, 0x32, 0x34, 0xfc, 0x1a,
+	0xed, 0x9c, 0x51, 0x20, 0x1c, 0xda, 0xe4, 0x56, 0x71, 0x0b, 0x47, 0x61, 0x0f, 0x84, 0xdd, 0x89,
+	0x1e, 0x01, 0x9f, 0x1c, 0x66, 0x0d, 0x9c, 0xee, 0xc4, 0xd0, 0xf0, 0x7b, 0x54, 0x4e, 0xb8, 0x93,
+	0x06, 0x75, 0x7d, 0x0f, 0x3f, 0x4c, 0xf3, 0x12, 0x8b, 0x52, 0x9c, 0x67, 0xe5, 0x67, 0x54, 0xfe,
+	0xe8, 0x03, 0x9d, 0xaa, 0x5f, 0xdf, 0x48, 0xbc, 0xbe, 0x24, 0xec, 0x46, 0xaf, 0xcc, 0x06, 0xee,
+	0x3d, 0x70, 0x62, 0xd9, 0x86, 0x86, 0x5f, 0xa1, 0xed, 0x0e, 0x38, 0x7d, 0x45, 0xd4, 0x99, 0x3a,
+	0x26, 0xce, 0x89, 0xf5, 0x4c, 0xb4, 0x5e, 0xa2, 0xcd, 0x0c, 0xf5, 0x4e, 0xb4, 0x77, 0x68, 0xa7,
+	0x01, 0x5c, 0xd1, 0xa8, 0x4f, 0x4f, 0xfb, 0x7d, 0xaa, 0x7a, 0x2d, 0xd6, 0xfa, 0xb6, 0xca, 0xeb,
+	0x4e, 0x9a, 0xce, 0x17, 0x97, 0x19, 0x1a, 0x6e, 0xa0, 0xbd, 0x2c, 0x5d, 0x6c, 0x12, 0x52, 0xe7,
+	0x1b, 0x20, 0xfa, 0x83, 0x79, 0x1b, 0x17, 0x86, 0x4e, 0x10, 0x6a, 0x00, 0xff, 0x00, 0xc3, 0x96,
+	0xeb, 0xda, 0x78, 0x27, 0x21, 0x07, 0xa8, 0xe7, 0xba, 0xb6, 0x8e, 0xd3, 0x3e, 0x5c, 0x59, 0x8c,
+	0xcb, 0x8d, 0xaf, 0x37, 0x80, 0x9f, 0x06, 0x9d, 0x8f, 0x65, 0x93, 0x64, 0x37, 0x5c, 0xfe, 0x2e,
+	0x5b, 0x66, 0xa4, 0x25, 0x93, 0x05, 0x25, 0xb4, 0xcc, 0x07, 0x43, 0x54, 0xdf, 0xc9, 0x23, 0x07,
+	0xdc, 0x6b, 0xb8, 0xcd, 0xe1, 0x26, 0xe8, 0x5c, 0x6e, 0x1b, 0xed, 0x06, 0x90, 0x12, 0x06, 0xb1,
+	0x13, 0xfc, 0xdf, 0xc4, 0x4c, 0xae, 0x82, 0xbe, 0x97, 0xb2, 0xd8, 0x9d, 0x24, 0xc1, 0xbb, 0x40,
+	0xa5, 0xe6, 0xd0, 0x73, 0x29, 0x6f, 0x51, 0x6b, 0x2c, 0x1a, 0xda, 0xc3, 0xac, 0xad, 0x94, 0x78,
+	0xae, 0x6f, 0x75, 0x54, 0x92, 0x39, 0xe4, 0x8a, 0x23, 0x07, 0xc6, 0x66, 0xed, 0xa4, 0xc4, 0x7a,
+	0x59, 0x3d, 0x10, 0x71, 0xca, 0x86, 0x86, 0x6b, 0x68, 0xad, 0x23, 0xbc, 0xbb, 0x00, 0xc0, 0x7b,
+	0xb3, 0x74, 0x7e, 0x01, 0x30, 0x93, 0x84, 0x6f, 0xd0, 0x6a, 0x47, 0x54, 0x7a, 0xcf, 0xc6, 0x95,
+	0x1c, 0xca, 0x15, 0xe9, 0x81, 0xfd, 0x0d, 0xa7, 0x8b, 0x1f, 0x80, 0x0e, 0xa0, 0x4e, 0x6c, 0x22,
+	0xc6, 0xc5, 0x7f, 0xb2, 0x16, 0x54, 0xa9, 0x8e, 0xb3, 0x2e, 0x83, 0x08, 0xe0, 0x31, 0x2a, 0x74,
+	0x80, 0xb7, 0x08, 0x63, 0xb7, 0x7d, 0xfc, 0x20, 0xc7, 0x85, 0x40, 0x34, 0xe3, 0xf8, 0xff, 0xd1,
+	0xbd, 0x2b, 0xd1, 0xbe, 0x33, 0x49, 0x97, 0x55, 0x7b, 0x8a, 0x56, 0x3e, 0x39, 0x52, 0x71, 0x3b,
+	0xb5, 0x89, 0x00, 0xcc, 0x29, 0xe5, 0x8d, 0xb0, 0xf1, 0x45, 0xf5, 0x90, 0xb1, 0x9f, 0x5f, 0x08,
+	0x6f, 0x51, 0xb1, 0x01, 0xbc, 0x45, 0x5d, 0x0f, 0xa8, 0x88, 0x7e, 0x52, 0xb2, 0xa3, 0x18, 0xd4,
+	0x77, 0x55, 0x6a, 0x0c, 0x1b, 0x1a, 0xfe, 0x09, 0x6d, 0x36, 0x80, 0x87, 0x1b, 0xe6, 0x84, 0xfb,
+	0x33, 0xa5, 0x94, 0xf6, 0x3d, 0xd0, 0x91, 0xc5, 0x50, 0x8e, 0xba, 0xfa, 0xaf, 0x63, 0xa0, 0x63,
+	0x0b, 0x6e, 0x67, 0x7a, 0xde, 0x8e, 0x3a, 0xf8, 0x22, 0x2d, 0x59, 0xf5, 0xe2, 0xa3, 0x22, 0x9d,
+	0xf2, 0xa8, 0xa9, 0xc6, 0xa3, 0x2a, 0x19, 0x1a, 0x7e, 0x26, 0x37, 0x1b, 0x8c, 0x28, 0xc2, 0x6e,
+	0x54, 0x5f, 0x9b, 0x0e, 0xcf, 0xcd, 0xcc, 0x67, 0x68, 0xb5, 0x01, 0x4e, 0x07, 0xa0, 0x1f, 0x77,
+	0xc6, 0x70, 0x7d, 0x45, 0x9c, 0x41, 0x9a, 0x22, 0xd0, 0x88, 0xc2, 0x33, 0x14, 0xb9, 0xae, 0x4f,
+	0x5b, 0xb7, 0xb9, 0x94, 0x23, 0xb4, 0xd6, 0x21, 0x63, 0x90, 0x9c, 0xc8, 0xf7, 0x08, 0x90, 0xa4,
+	0xec, 0x69, 0xd7, 0x64, 0x23, 0x8a, 0xb2, 0x77, 0x4b, 0x19, 0x8b, 0x61, 0xca, 0x46, 0x73, 0x46,
+	0x69, 0x5e, 0x35, 0x84, 0xe4, 0x9c, 0x39, 0x93, 0x73, 0x35, 0x8a, 0xae, 0x5c, 0x9d, 0x87, 0x77,
+	0xbe, 0xbc, 0xef, 0x08, 0x59, 0x70, 0x7a, 0x77, 0xe4, 0x1c, 0xa3, 0x8d, 0xe0, 0x3b, 0xae, 0xc3,
+	0xc0, 0x61, 0x3e, 0xbb, 0x23, 0xef, 0x15, 0xda, 0x9a, 0x19, 0x9a, 0xf1, 0xd6, 0xa2, 0x31, 0xdc,
+	0x74, 0xf2, 0x46, 0x68, 0x55, 0x26, 0xff, 0x25, 0x4c, 0xba, 0x93, 0x60, 0x96, 0xcc, 0x24, 0x53,
+	0x31, 0x9e, 0xfb, 0x13, 0xc9, 0x78, 0x89, 0xd6, 0xdf, 0xfb, 0x43, 0x2f, 0xea, 0x7d, 0xca, 0xe0,
+	0xe9, 0x70, 0x71, 0xf9, 0x48, 0x97, 0x4b, 0x80, 0x05, 0x79, 0xab, 0xd0, 0xd8, 0x85, 0x65, 0xa7,
+	0x1a, 0x96, 0x8a, 0xcf, 0xec, 0xef, 0x2d, 0xc2, 0xa9, 0x8e, 0xfa, 0xef, 0xd8, 0x87, 0x68, 0xf5,
+	0x37, 0xa0, 0x4c, 0xc4, 0x64, 0x4e, 0x61, 0x87, 0x62, 0x31, 0x65, 0x0d, 0x0d, 0x3f, 0x46, 0x2b,
+	0x4d, 0x26, 0x2f, 0x02, 0xdf, 0xe9, 0x33, 0xc7, 0x72, 0x14, 0xb6, 0x00, 0xa8, 0x60, 0xc6, 0x67,
+	0xd5, 0xaa, 0xb5, 0x42, 0xb8, 0x0d, 0xa3, 0x38, 0xe6, 0x62, 0x1d, 0x76, 0x8e, 0x13, 0xb4, 0x7a,
+	0x0d, 0x5c, 0x72, 0xee, 0xa7, 0x38, 0x21, 0x2a, 0x68, 0x91, 0x6b, 0xd7, 0x6e, 0x1f, 0x42, 0x58,
+	0x66, 0xfb, 0x46, 0x93, 0x5d, 0x73, 0xef, 0x4c, 0xde, 0x68, 0xef, 0xe0, 0x62, 0x55, 0x56, 0xfc,
+	0x05, 0xe1, 0xc4, 0xbe, 0x20, 0x96, 0xed, 0x53, 0x98, 0xc7, 0x68, 0x3a, 0xfc, 0x79, 0x4d, 0x1e,
+	0xef, 0x4e, 0xd8, 0x0d, 0xa3, 0x6b, 0x73, 0xf0, 0x2c, 0x98, 0x4f, 0x3b, 0x7e, 0x61, 0x68, 0xf8,
+	0x39, 0xda, 0x92, 0xa5, 0x1a, 0x68, 0x7f, 0x27, 0x95, 0x22, 0xd2, 0x9b, 0xa4, 0x97, 0x7d, 0xe3,
+	0x22, 0xb3, 0xad, 0x76, 0xb3, 0x64, 0x0a, 0x57, 0xe5, 0x7d, 0x35, 0x24, 0x77, 0x60, 0x84, 0x53,
+	0xd6, 0xf5, 0xec, 0xe5, 0xdf, 0xd0, 0xf0, 0x0f, 0x08, 0x9d, 0xd9, 0x2e, 0x83, 0x8f, 0x3e, 0xf8,
+	0xf0, 0xfd, 0xc3, 0x15, 0xe6, 0xa5, 0xaa, 0xe8, 0xbd, 0x2c, 0x27, 0xd1, 0xa3, 0x0a, 0x4b, 0x94,
+	0xe4, 0xe5, 0x40, 0x04, 0xe2, 0xd4, 0xb6, 0x45, 0xb5, 0x46, 0x6d, 0x46, 0x19, 0xb3, 0x69, 0x49,
+	0x3c, 0x20, 0xd2, 0xb0, 0xac, 0xe9, 0x42, 0xc7, 0x1a, 0x38, 0xf2, 0x7e, 0xac, 0xce, 0x96, 0x18,
+	0x4c, 0xcf, 0x96, 0x18, 0x36, 0x34, 0xdc, 0x44, 0x7a, 0x50, 0xf4, 0xd7, 0x6e, 0x68, 0x2f, 0xef,
+	0x9a, 0x9a, 0x08, 0xbf, 0x61, 0xea, 0x18, 0x15, 0x65, 0x47, 0x6a, 0x13, 0xa7, 0x2f, 0x5e, 0x58,
+	0x49, 0x6d, 0x8f, 0x04, 0x24, 0x4f, 0x35, 0xaf, 0xf9, 0x1f, 0xc8, 0x4e, 0x7e, 0xe1, 0xd2, 0xd4,
+	0xb0, 0xfe, 0x05, 0xa6, 0x33, 0x39, 0x50, 0x47, 0x38, 0xeb, 0xec, 0x84, 0xc5, 0x1b, 0x56, 0xc1,
+	0xf9, 0x5e, 0x9e, 0xc9, 0x3c, 0x6a, 0x11, 0x4a, 0x44, 0x17, 0xeb, 0x5a, 0xdc, 0x06, 0x7c, 0x5f,
+	0xe9, 0x0e, 0xaa, 0x20, 0x1e, 0x8e, 0x01, 0x9a, 0xe4, 0x53, 0x13, 0x6d, 0x5d, 0xb9, 0xa4, 0x3f,
+	0xd7, 0xca, 0x25, 0x58, 0x83, 0x1b, 0x1e, 0x59, 0x79, 0x90, 0xda, 0xb4, 0x2a, 0x32, 0x34, 0x7c,
+	0x2e, 0x73, 0x20, 0xb2, 0x14, 0x48, 0xd5, 0x1c, 0x48, 0x4b, 0xe6, 0x7a, 0x54, 0x95, 0xa3, 0x2a,
+	0x78, 0x6f, 0xe5, 0xbd, 0xe0, 0x36, 0x52, 0x2f, 0x32, 0x26, 0xab, 0xb0, 0x24, 0xab, 0x30, 0xfe,
+	0x0f, 0x21, 0x93, 0xe4, 0x51, 0xc6, 0x26, 0xff, 0x32, 0xc8, 0x2a, 0x2c, 0x24, 0xff, 0x1b, 0x6c,
+	0xe7, 0xbc, 0xf5, 0xe3, 0xc0, 0xa7, 0x9f, 0xe6, 0x86, 0x56, 0x5d, 0xc0, 0x55, 0x79, 0xd0, 0x57,
+	0xee, 0x80, 0xa9, 0xb3, 0x34, 0x84, 0xd2, 0xa9, 0x21, 0x10, 0x39, 0x08, 0xa4, 0x8f, 0x9c, 0x70,
+	0x68, 0x51, 0xd7, 0xfd, 0xa2, 0x5e, 0xe8, 0x13, 0x34, 0x76, 0x35, 0x81, 0x0c, 0xad, 0x7e, 0xf8,
+	0xc7, 0x8f, 0x03, 0x8b, 0xdf, 0xf8, 0xbd, 0x43, 0xd3, 0x1d, 0x1e, 0x29, 0x8f, 0xdd, 0x5a, 0xb5,
+	0x56, 0x55, 0xd7, 0x47, 0x92, 0xdc, 0x5b, 0x91, 0xff, 0xab, 0x3c, 0xff, 0x67, 0x00, 0x3d, 0x2f,
+	0x2e, 0x7a, 0x08, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -266,6 +490,8 @@ type TuringchainClient interface {
 	GetBlockBySeq(ctx context.Context, in *Int64, opts ...grpc.CallOption) (*BlockSeq, error)
 	//关闭turingchain
 	CloseQueue(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*Reply, error)
+	//获取消息队列的统计, 可以指定topic
+	GetQueueStats(ctx context.Context, in *ReqString, opts ...grpc.CallOption) (*QueueStats, error)
 	//获取地址所以合约下的余额
 	GetAllExecBalance(ctx context.Context, in *ReqAllExecBalance, opts ...grpc.CallOption) (*AllExecBalance, error)
 	//签名交易
@@ -286,6 +512,12 @@ type TuringchainClient interface {
 	GetHeaders(ctx context.Context, in *ReqBlocks, opts ...grpc.CallOption) (*Headers, error)
 	// get server time
 	GetServerTime(ctx context.Context, in *ReqNil, opts ...grpc.CallOption) (*ServerTime, error)
+	// 订阅区块, 交易回执以及mempool 中的交易
+	Subscribe(ctx context.Context, in *ReqSubscribe, opts ...grpc.CallOption) (Turingchain_SubscribeClient, error)
+	// 查询交易回执中的日志, 需要开启日志索引
+	GetLogs(ctx context.Context, in *ReqGetLogs, opts ...grpc.CallOption) (*ReplyLogs, error)
+	// 查询多个key 的值和状态证明
+	GetStateProof(ctx context.Context, in *ReqStateProof, opts ...grpc.CallOption) (*StateProof, error)
 }
 
 type turingchainClient struct {
@@ -755,6 +987,15 @@ func (c *turingchainClient) CloseQueue(ctx context.Context, in *ReqNil, opts ...
 	return out, nil
 }
 
+func (c *turingchainClient) GetQueueStats(ctx context.Context, in *ReqString, opts ...grpc.CallOption) (*QueueStats, error) {
+	out := new(QueueStats)
+	err := c.cc.Invoke(ctx, "/types.turingchain/GetQueueStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *turingchainClient) GetAllExecBalance(ctx context.Context, in *ReqAllExecBalance, opts ...grpc.CallOption) (*AllExecBalance, error) {
 	out := new(AllExecBalance)
 	err := c.cc.Invoke(ctx, "/types.turingchain/GetAllExecBalance", in, out, opts...)
@@ -854,6 +1095,56 @@ func (c *turingchainClient) GetServerTime(ctx context.Context, in *ReqNil, opts
 	return out, nil
 }
 
+func (c *turingchainClient) Subscribe(ctx context.Context, in *ReqSubscribe, opts ...grpc.CallOption) (Turingchain_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Turingchain_serviceDesc.Streams[0], "/types.turingchain/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &turingchainSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Turingchain_SubscribeClient interface {
+	Recv() (*SubscribeEvent, error)
+	grpc.ClientStream
+}
+
+type turingchainSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *turingchainSubscribeClient) Recv() (*SubscribeEvent, error) {
+	m := new(SubscribeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *turingchainClient) GetLogs(ctx context.Context, in *ReqGetLogs, opts ...grpc.CallOption) (*ReplyLogs, error) {
+	out := new(ReplyLogs)
+	err := c.cc.Invoke(ctx, "/types.turingchain/GetLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *turingchainClient) GetStateProof(ctx context.Context, in *ReqStateProof, opts ...grpc.CallOption) (*StateProof, error) {
+	out := new(StateProof)
+	err := c.cc.Invoke(ctx, "/types.turingchain/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TuringchainServer is the server API for Turingchain service.
 type TuringchainServer interface {
 	// turingchain 对外提供服务的接口
@@ -955,6 +1246,8 @@ type TuringchainServer interface {
 	GetBlockBySeq(context.Context, *Int64) (*BlockSeq, error)
 	//关闭turingchain
 	CloseQueue(context.Context, *ReqNil) (*Reply, error)
+	//获取消息队列的统计, 可以指定topic
+	GetQueueStats(context.Context, *ReqString) (*QueueStats, error)
 	//获取地址所以合约下的余额
 	GetAllExecBalance(context.Context, *ReqAllExecBalance) (*AllExecBalance, error)
 	//签名交易
@@ -975,6 +1268,12 @@ type TuringchainServer interface {
 	GetHeaders(context.Context, *ReqBlocks) (*Headers, error)
 	// get server time
 	GetServerTime(context.Context, *ReqNil) (*ServerTime, error)
+	// 订阅区块, 交易回执以及mempool 中的交易
+	Subscribe(*ReqSubscribe, Turingchain_SubscribeServer) error
+	// 查询交易回执中的日志, 需要开启日志索引
+	GetLogs(context.Context, *ReqGetLogs) (*ReplyLogs, error)
+	// 查询多个key 的值和状态证明
+	GetStateProof(context.Context, *ReqStateProof) (*StateProof, error)
 }
 
 // UnimplementedTuringchainServer can be embedded to have forward compatible implementations.
@@ -1134,6 +1433,9 @@ func (*UnimplementedTuringchainServer) GetBlockBySeq(ctx context.Context, req *I
 func (*UnimplementedTuringchainServer) CloseQueue(ctx context.Context, req *ReqNil) (*Reply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseQueue not implemented")
 }
+func (*UnimplementedTuringchainServer) GetQueueStats(ctx context.Context, req *ReqString) (*QueueStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueStats not implemented")
+}
 func (*UnimplementedTuringchainServer) GetAllExecBalance(ctx context.Context, req *ReqAllExecBalance) (*AllExecBalance, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllExecBalance not implemented")
 }
@@ -1167,6 +1469,15 @@ func (*UnimplementedTuringchainServer) GetHeaders(ctx context.Context, req *ReqB
 func (*UnimplementedTuringchainServer) GetServerTime(ctx context.Context, req *ReqNil) (*ServerTime, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServerTime not implemented")
 }
+func (*UnimplementedTuringchainServer) Subscribe(req *ReqSubscribe, srv Turingchain_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedTuringchainServer) GetLogs(ctx context.Context, req *ReqGetLogs) (*ReplyLogs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
+}
+func (*UnimplementedTuringchainServer) GetStateProof(ctx context.Context, req *ReqStateProof) (*StateProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateProof not implemented")
+}
 
 func RegisterTuringchainServer(s *grpc.Server, srv TuringchainServer) {
 	s.RegisterService(&_Turingchain_serviceDesc, srv)
@@ -2090,6 +2401,24 @@ func _Turingchain_CloseQueue_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Turingchain_GetQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqString)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TuringchainServer).GetQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.turingchain/GetQueueStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TuringchainServer).GetQueueStats(ctx, req.(*ReqString))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Turingchain_GetAllExecBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqAllExecBalance)
 	if err := dec(in); err != nil {
@@ -2288,6 +2617,63 @@ func _Turingchain_GetServerTime_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Turingchain_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReqSubscribe)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TuringchainServer).Subscribe(m, &turingchainSubscribeServer{stream})
+}
+
+type Turingchain_SubscribeServer interface {
+	Send(*SubscribeEvent) error
+	grpc.ServerStream
+}
+
+type turingchainSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *turingchainSubscribeServer) Send(m *SubscribeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Turingchain_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqGetLogs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TuringchainServer).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.turingchain/GetLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TuringchainServer).GetLogs(ctx, req.(*ReqGetLogs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Turingchain_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqStateProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TuringchainServer).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.turingchain/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TuringchainServer).GetStateProof(ctx, req.(*ReqStateProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Turingchain_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.turingchain",
 	HandlerType: (*TuringchainServer)(nil),
@@ -2496,6 +2882,10 @@ var _Turingchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CloseQueue",
 			Handler:    _Turingchain_CloseQueue_Handler,
 		},
+		{
+			MethodName: "GetQueueStats",
+			Handler:    _Turingchain_GetQueueStats_Handler,
+		},
 		{
 			MethodName: "GetAllExecBalance",
 			Handler:    _Turingchain_GetAllExecBalance_Handler,
@@ -2540,7 +2930,21 @@ var _Turingchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetServerTime",
 			Handler:    _Turingchain_GetServerTime_Handler,
 		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _Turingchain_GetLogs_Handler,
+		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _Turingchain_GetStateProof_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Turingchain_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
 }
@@ -71,11 +71,13 @@ func (m *TotalFee) GetTxCount() int64 {
 
 //查询symbol代币总额
 type ReqGetTotalCoins struct {
-	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	StateHash            []byte   `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
-	StartKey             []byte   `protobuf:"bytes,3,opt,name=startKey,proto3" json:"startKey,omitempty"`
-	Count                int64    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	Execer               string   `protobuf:"bytes,5,opt,name=execer,proto3" json:"execer,omitempty"`
+	Symbol    string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	StateHash []byte `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	StartKey  []byte `protobuf:"bytes,3,opt,name=startKey,proto3" json:"startKey,omitempty"`
+	Count     int64  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Execer    string `protobuf:"bytes,5,opt,name=execer,proto3" json:"execer,omitempty"`
+	//查询指定高度的状态, 0 表示使用stateHash, 不能和stateHash 同时指定
+	Height               int64    `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -141,6 +143,13 @@ func (m *ReqGetTotalCoins) GetExecer() string {
 	return ""
 }
 
+func (m *ReqGetTotalCoins) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 //查询symbol代币总额应答
 type ReplyGetTotalCoins struct {
 	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -311,13 +320,15 @@ func (m *TotalAmount) GetTotal() int64 {
 
 //查询symbol在合约中的代币总额，如果execAddr为空，则为查询symbol在所有合约中的代币总额
 type ReqGetExecBalance struct {
-	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	StateHash            []byte   `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
-	Addr                 []byte   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	ExecAddr             []byte   `protobuf:"bytes,4,opt,name=execAddr,proto3" json:"execAddr,omitempty"`
-	Execer               string   `protobuf:"bytes,5,opt,name=execer,proto3" json:"execer,omitempty"`
-	Count                int64    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
-	NextKey              []byte   `protobuf:"bytes,7,opt,name=nextKey,proto3" json:"nextKey,omitempty"`
+	Symbol    string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	StateHash []byte `protobuf:"bytes,2,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Addr      []byte `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	ExecAddr  []byte `protobuf:"bytes,4,opt,name=execAddr,proto3" json:"execAddr,omitempty"`
+	Execer    string `protobuf:"bytes,5,opt,name=execer,proto3" json:"execer,omitempty"`
+	Count     int64  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	NextKey   []byte `protobuf:"bytes,7,opt,name=nextKey,proto3" json:"nextKey,omitempty"`
+	//查询指定高度的状态, 0 表示使用stateHash, 不能和stateHash 同时指定
+	Height               int64    `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -397,6 +408,13 @@ func (m *ReqGetExecBalance) GetNextKey() []byte {
 	return nil
 }
 
+func (m *ReqGetExecBalance) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type ExecBalanceItem struct {
 	ExecAddr             []byte   `protobuf:"bytes,1,opt,name=execAddr,proto3" json:"execAddr,omitempty"`
 	Frozen               int64    `protobuf:"varint,2,opt,name=frozen,proto3" json:"frozen,omitempty"`
@@ -524,6 +542,281 @@ func (m *ReplyGetExecBalance) GetItems() []*ExecBalanceItem {
 	return nil
 }
 
+//消息队列中一种消息的统计, 时间单位为微秒
+type QueueEventStats struct {
+	Event string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	//发送的消息数量
+	Count int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	//还在队列中等待处理的数量
+	Pending int64 `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	//从发送到模块接收的等待时间
+	AvgWait int64 `protobuf:"varint,4,opt,name=avgWait,proto3" json:"avgWait,omitempty"`
+	MaxWait int64 `protobuf:"varint,5,opt,name=maxWait,proto3" json:"maxWait,omitempty"`
+	//需要应答的消息从发送到收到应答的时间
+	ReplyCount           int64    `protobuf:"varint,6,opt,name=replyCount,proto3" json:"replyCount,omitempty"`
+	AvgReply             int64    `protobuf:"varint,7,opt,name=avgReply,proto3" json:"avgReply,omitempty"`
+	MaxReply             int64    `protobuf:"varint,8,opt,name=maxReply,proto3" json:"maxReply,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueueEventStats) Reset()         { *m = QueueEventStats{} }
+func (m *QueueEventStats) String() string { return proto.CompactTextString(m) }
+func (*QueueEventStats) ProtoMessage()    {}
+func (*QueueEventStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_405f6cee9ed2da7e, []int{8}
+}
+
+func (m *QueueEventStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueEventStats.Unmarshal(m, b)
+}
+func (m *QueueEventStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueEventStats.Marshal(b, m, deterministic)
+}
+func (m *QueueEventStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueEventStats.Merge(m, src)
+}
+func (m *QueueEventStats) XXX_Size() int {
+	return xxx_messageInfo_QueueEventStats.Size(m)
+}
+func (m *QueueEventStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueEventStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueEventStats proto.InternalMessageInfo
+
+func (m *QueueEventStats) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *QueueEventStats) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *QueueEventStats) GetPending() int64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *QueueEventStats) GetAvgWait() int64 {
+	if m != nil {
+		return m.AvgWait
+	}
+	return 0
+}
+
+func (m *QueueEventStats) GetMaxWait() int64 {
+	if m != nil {
+		return m.MaxWait
+	}
+	return 0
+}
+
+func (m *QueueEventStats) GetReplyCount() int64 {
+	if m != nil {
+		return m.ReplyCount
+	}
+	return 0
+}
+
+func (m *QueueEventStats) GetAvgReply() int64 {
+	if m != nil {
+		return m.AvgReply
+	}
+	return 0
+}
+
+func (m *QueueEventStats) GetMaxReply() int64 {
+	if m != nil {
+		return m.MaxReply
+	}
+	return 0
+}
+
+//消息队列中一个topic 的统计
+type QueueTopicStats struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	//队列满时的处理策略
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	//高优先级和低优先级队列中的消息数量
+	HighDepth int64 `protobuf:"varint,3,opt,name=highDepth,proto3" json:"highDepth,omitempty"`
+	LowDepth  int64 `protobuf:"varint,4,opt,name=lowDepth,proto3" json:"lowDepth,omitempty"`
+	//消息数量的最高值
+	HighWaterMark int64 `protobuf:"varint,5,opt,name=highWaterMark,proto3" json:"highWaterMark,omitempty"`
+	Sent          int64 `protobuf:"varint,6,opt,name=sent,proto3" json:"sent,omitempty"`
+	//按照策略丢弃和拒绝的消息数量
+	Dropped  int64 `protobuf:"varint,7,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	Rejected int64 `protobuf:"varint,8,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	//模块正在处理的消息类型
+	Current string `protobuf:"bytes,9,opt,name=current,proto3" json:"current,omitempty"`
+	//距离最近一次交给模块的时间, 毫秒
+	Idle int64 `protobuf:"varint,10,opt,name=idle,proto3" json:"idle,omitempty"`
+	//是否是慢消费者
+	Slow                 bool               `protobuf:"varint,11,opt,name=slow,proto3" json:"slow,omitempty"`
+	Events               []*QueueEventStats `protobuf:"bytes,12,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueueTopicStats) Reset()         { *m = QueueTopicStats{} }
+func (m *QueueTopicStats) String() string { return proto.CompactTextString(m) }
+func (*QueueTopicStats) ProtoMessage()    {}
+func (*QueueTopicStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_405f6cee9ed2da7e, []int{9}
+}
+
+func (m *QueueTopicStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueTopicStats.Unmarshal(m, b)
+}
+func (m *QueueTopicStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueTopicStats.Marshal(b, m, deterministic)
+}
+func (m *QueueTopicStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueTopicStats.Merge(m, src)
+}
+func (m *QueueTopicStats) XXX_Size() int {
+	return xxx_messageInfo_QueueTopicStats.Size(m)
+}
+func (m *QueueTopicStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueTopicStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueTopicStats proto.InternalMessageInfo
+
+func (m *QueueTopicStats) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *QueueTopicStats) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *QueueTopicStats) GetHighDepth() int64 {
+	if m != nil {
+		return m.HighDepth
+	}
+	return 0
+}
+
+func (m *QueueTopicStats) GetLowDepth() int64 {
+	if m != nil {
+		return m.LowDepth
+	}
+	return 0
+}
+
+func (m *QueueTopicStats) GetHighWaterMark() int64 {
+	if m != nil {
+		return m.HighWaterMark
+	}
+	return 0
+}
+
+func (m *QueueTopicStats) GetSent() int64 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *QueueTopicStats) GetDropped() int64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
+}
+
+func (m *QueueTopicStats) GetRejected() int64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *QueueTopicStats) GetCurrent() string {
+	if m != nil {
+		return m.Current
+	}
+	return ""
+}
+
+func (m *QueueTopicStats) GetIdle() int64 {
+	if m != nil {
+		return m.Idle
+	}
+	return 0
+}
+
+func (m *QueueTopicStats) GetSlow() bool {
+	if m != nil {
+		return m.Slow
+	}
+	return false
+}
+
+func (m *QueueTopicStats) GetEvents() []*QueueEventStats {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+//消息队列的统计
+type QueueStats struct {
+	Topics               []*QueueTopicStats `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueueStats) Reset()         { *m = QueueStats{} }
+func (m *QueueStats) String() string { return proto.CompactTextString(m) }
+func (*QueueStats) ProtoMessage()    {}
+func (*QueueStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_405f6cee9ed2da7e, []int{10}
+}
+
+func (m *QueueStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueStats.Unmarshal(m, b)
+}
+func (m *QueueStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueStats.Marshal(b, m, deterministic)
+}
+func (m *QueueStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueStats.Merge(m, src)
+}
+func (m *QueueStats) XXX_Size() int {
+	return xxx_messageInfo_QueueStats.Size(m)
+}
+func (m *QueueStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueStats proto.InternalMessageInfo
+
+func (m *QueueStats) GetTopics() []*QueueTopicStats {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TotalFee)(nil), "types.TotalFee")
 	proto.RegisterType((*ReqGetTotalCoins)(nil), "types.ReqGetTotalCoins")
@@ -533,6 +826,9 @@ func init() {
 	proto.RegisterType((*ReqGetExecBalance)(nil), "types.ReqGetExecBalance")
 	proto.RegisterType((*ExecBalanceItem)(nil), "types.ExecBalanceItem")
 	proto.RegisterType((*ReplyGetExecBalance)(nil), "types.ReplyGetExecBalance")
+	proto.RegisterType((*QueueEventStats)(nil), "types.QueueEventStats")
+	proto.RegisterType((*QueueTopicStats)(nil), "types.QueueTopicStats")
+	proto.RegisterType((*QueueStats)(nil), "types.QueueStats")
 }
 
 func init() {
@@ -540,34 +836,51 @@ func init() {
 }
 
 var fileDescriptor_405f6cee9ed2da7e = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xd5, 0xe2, 0x38, 0x6d, 0xa7, 0x95, 0x1a, 0x96, 0xa8, 0x58, 0x08, 0x44, 0x65, 0x2e, 0x3d,
-	0xa0, 0x44, 0xc2, 0x12, 0xf7, 0xa4, 0xa2, 0x50, 0x71, 0x33, 0x9c, 0x90, 0x38, 0x6c, 0x36, 0xd3,
-	0xc6, 0x52, 0xbc, 0x0e, 0xf6, 0x04, 0xc5, 0x7c, 0x06, 0xff, 0xc3, 0x81, 0x3f, 0x43, 0x3b, 0xbb,
-	0x76, 0xec, 0x40, 0x2f, 0xdc, 0xe6, 0x8d, 0xd7, 0xf3, 0xe6, 0xbd, 0xb7, 0x0b, 0xe7, 0x15, 0x29,
-	0xca, 0x2a, 0xca, 0xf4, 0x64, 0x53, 0x16, 0x54, 0xc8, 0x90, 0xea, 0x0d, 0x56, 0xf1, 0x5b, 0x38,
-	0xfe, 0x5c, 0x90, 0x5a, 0xdf, 0x20, 0xca, 0x11, 0x04, 0x77, 0x88, 0x91, 0xb8, 0x14, 0x57, 0x41,
-	0x6a, 0x4b, 0x19, 0xc1, 0x11, 0xed, 0xae, 0x8b, 0xad, 0xa1, 0xe8, 0x11, 0x77, 0x1b, 0x18, 0xff,
-	0x14, 0x30, 0x4a, 0xf1, 0xdb, 0x7b, 0x24, 0xfe, 0xfd, 0xba, 0xc8, 0x4c, 0x25, 0x2f, 0x60, 0x58,
-	0xd5, 0xf9, 0xa2, 0x58, 0xf3, 0x8c, 0x93, 0xd4, 0x23, 0xf9, 0x1c, 0x4e, 0x2c, 0x3d, 0x7e, 0x50,
-	0xd5, 0x8a, 0x07, 0x9d, 0xa5, 0xfb, 0x86, 0x7c, 0x06, 0xc7, 0x15, 0xa9, 0x92, 0x3e, 0x62, 0x1d,
-	0x05, 0xfc, 0xb1, 0xc5, 0x72, 0x0c, 0xa1, 0x66, 0xfa, 0x01, 0xd3, 0x3b, 0x60, 0x79, 0x70, 0x87,
-	0x1a, 0xcb, 0x28, 0x74, 0x3c, 0x0e, 0xc5, 0x06, 0x64, 0x8a, 0x9b, 0x75, 0xdd, 0xdf, 0xaa, 0x9d,
-	0x21, 0xba, 0x33, 0x46, 0x10, 0x98, 0x6d, 0xee, 0x65, 0xd9, 0xd2, 0x4e, 0x55, 0x39, 0x1f, 0x0c,
-	0xb8, 0xe9, 0x91, 0x35, 0xc1, 0xe0, 0x8e, 0xd7, 0x1b, 0xf0, 0x7a, 0x0d, 0x8c, 0xb7, 0xf0, 0xf4,
-	0x96, 0xb0, 0x54, 0x84, 0xa9, 0x32, 0xf7, 0x38, 0xaf, 0x3f, 0xb5, 0xa2, 0x7a, 0x92, 0xc5, 0xa1,
-	0xe4, 0x31, 0x84, 0x2c, 0xd1, 0x9b, 0xe1, 0x80, 0x5d, 0x09, 0xcd, 0xd2, 0x7b, 0x60, 0xcb, 0x7f,
-	0xcb, 0x8f, 0x5f, 0xc1, 0x29, 0xcb, 0x9b, 0xb9, 0xfd, 0xc6, 0x10, 0x92, 0x85, 0x8d, 0x3e, 0x06,
-	0xf1, 0x6f, 0x01, 0x8f, 0x5d, 0x40, 0xef, 0x76, 0xa8, 0xe7, 0x6a, 0xad, 0x8c, 0xc6, 0xff, 0x4c,
-	0x48, 0xc2, 0x40, 0x2d, 0x97, 0xa5, 0xdf, 0x8c, 0x6b, 0x9b, 0x9a, 0x75, 0x7d, 0x66, 0xfb, 0xce,
-	0x96, 0x16, 0x3f, 0x94, 0xcf, 0x5e, 0xce, 0xb0, 0x9b, 0x44, 0xc7, 0xdf, 0xa3, 0xbe, 0xbf, 0x5f,
-	0xe1, 0xbc, 0xb3, 0xfc, 0x2d, 0x61, 0xde, 0xa3, 0x15, 0x7f, 0xd3, 0xde, 0x95, 0xc5, 0x0f, 0x34,
-	0x3e, 0x55, 0x8f, 0x38, 0x58, 0x4d, 0xd9, 0x77, 0x6c, 0x83, 0x65, 0x14, 0xff, 0x12, 0xf0, 0xa4,
-	0xb9, 0x2f, 0x07, 0x26, 0xf9, 0x8b, 0x20, 0x7a, 0x17, 0x21, 0x86, 0x33, 0x57, 0xdd, 0x74, 0x59,
-	0x7a, 0xbd, 0xfd, 0x99, 0x59, 0x97, 0xb1, 0xd7, 0x7b, 0xf8, 0x42, 0xc9, 0xd7, 0x10, 0x66, 0x84,
-	0x79, 0x15, 0x85, 0x97, 0xc1, 0xd5, 0xe9, 0x9b, 0x8b, 0x09, 0x3f, 0xd2, 0xc9, 0x81, 0x09, 0xa9,
-	0x3b, 0x34, 0x7f, 0xf9, 0xe5, 0xc5, 0x7d, 0x46, 0xab, 0xed, 0x62, 0xa2, 0x8b, 0x7c, 0x9a, 0x24,
-	0xda, 0x4c, 0xf5, 0x4a, 0x65, 0x26, 0x49, 0xa6, 0xfc, 0xdf, 0x62, 0xc8, 0x4f, 0x3d, 0xf9, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0x5a, 0x92, 0xa1, 0xd5, 0xfd, 0x03, 0x00, 0x00,
+	// 728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdf, 0x4e, 0xd4, 0x5e,
+	0x10, 0x4e, 0x59, 0xba, 0xec, 0x0e, 0xfc, 0x02, 0xbf, 0x4a, 0xb0, 0x31, 0xc6, 0x90, 0xea, 0x05,
+	0x17, 0x64, 0x21, 0x98, 0x78, 0xe5, 0x0d, 0x20, 0x28, 0x31, 0x5e, 0x58, 0x49, 0x48, 0x4c, 0xbc,
+	0x38, 0xb4, 0x43, 0x7b, 0xb4, 0xff, 0x6c, 0x4f, 0x97, 0x5d, 0x1f, 0xc9, 0x77, 0xf0, 0x41, 0x8c,
+	0x97, 0xbe, 0x88, 0x99, 0x39, 0xa7, 0xdd, 0x76, 0x91, 0x1b, 0xef, 0xe6, 0x9b, 0x39, 0x3b, 0x33,
+	0xdf, 0xf7, 0x4d, 0xb3, 0xb0, 0x59, 0x29, 0xa1, 0x64, 0xa5, 0x64, 0x30, 0x29, 0xca, 0x5c, 0xe5,
+	0x8e, 0xad, 0xe6, 0x05, 0x56, 0xde, 0x0b, 0x18, 0x5d, 0xe6, 0x4a, 0x24, 0xe7, 0x88, 0xce, 0x16,
+	0x0c, 0x6e, 0x10, 0x5d, 0x6b, 0xd7, 0xda, 0x1b, 0xf8, 0x14, 0x3a, 0x2e, 0xac, 0xa9, 0xd9, 0x69,
+	0x5e, 0x67, 0xca, 0x5d, 0xe1, 0x6c, 0x03, 0xbd, 0xef, 0x16, 0x6c, 0xf9, 0xf8, 0xf5, 0x35, 0x2a,
+	0xfe, 0xf9, 0x69, 0x2e, 0xb3, 0xca, 0xd9, 0x81, 0x61, 0x35, 0x4f, 0xaf, 0xf3, 0x84, 0x7b, 0x8c,
+	0x7d, 0x83, 0x9c, 0xc7, 0x30, 0xa6, 0xf1, 0xf8, 0x46, 0x54, 0x31, 0x37, 0xda, 0xf0, 0x17, 0x09,
+	0xe7, 0x11, 0x8c, 0x2a, 0x25, 0x4a, 0xf5, 0x16, 0xe7, 0xee, 0x80, 0x8b, 0x2d, 0x76, 0xb6, 0xc1,
+	0x0e, 0x78, 0xfc, 0x2a, 0x8f, 0xd7, 0x80, 0xe6, 0xe0, 0x0c, 0x03, 0x2c, 0x5d, 0x5b, 0xcf, 0xd1,
+	0x88, 0xf2, 0x31, 0xca, 0x28, 0x56, 0xee, 0x90, 0x9f, 0x1b, 0xe4, 0x65, 0xe0, 0xf8, 0x58, 0x24,
+	0xf3, 0xfe, 0xb6, 0x6d, 0x6f, 0xab, 0xdb, 0x7b, 0x0b, 0x06, 0x59, 0x9d, 0x1a, 0xba, 0x14, 0x52,
+	0x57, 0x91, 0xf2, 0xc3, 0x81, 0xee, 0xaa, 0x11, 0x89, 0x93, 0xe1, 0x8c, 0xd7, 0x5e, 0xe5, 0xb5,
+	0x1b, 0xe8, 0xd5, 0xf0, 0xf0, 0x42, 0x61, 0x29, 0x14, 0xfa, 0x22, 0x8b, 0xf0, 0x64, 0xfe, 0xa1,
+	0x25, 0xdb, 0x93, 0xc2, 0x5a, 0x96, 0x62, 0x1b, 0x6c, 0xa6, 0x6e, 0x44, 0xd2, 0x80, 0x56, 0xc2,
+	0x2c, 0x34, 0xda, 0x50, 0xf8, 0x77, 0x59, 0xbc, 0xa7, 0xb0, 0xce, 0xf4, 0x8e, 0xf5, 0x7e, 0xdb,
+	0x60, 0x2b, 0x82, 0x0d, 0x3f, 0x06, 0xde, 0x4f, 0x0b, 0xfe, 0xd7, 0xc6, 0x9d, 0xcd, 0x30, 0x38,
+	0x11, 0x89, 0xc8, 0x02, 0xfc, 0x47, 0xe7, 0x1c, 0x58, 0x15, 0x61, 0x58, 0x9a, 0xcd, 0x38, 0x26,
+	0x37, 0xc9, 0x8d, 0x63, 0xca, 0x6b, 0x59, 0x5a, 0x7c, 0xaf, 0x6f, 0x2d, 0x9d, 0x61, 0xd7, 0x89,
+	0x8e, 0xbe, 0x6b, 0x3d, 0x7d, 0x3b, 0x3e, 0x8f, 0x7a, 0x3e, 0x7f, 0x82, 0xcd, 0x0e, 0xa9, 0x0b,
+	0x85, 0x69, 0x6f, 0x1d, 0xeb, 0xee, 0x3a, 0x37, 0x65, 0xfe, 0x0d, 0x33, 0xe3, 0xb6, 0x41, 0x94,
+	0x17, 0x81, 0x92, 0x53, 0x6c, 0x0d, 0x67, 0xe4, 0xfd, 0xb0, 0xe0, 0x41, 0x73, 0x47, 0x4b, 0xe2,
+	0x99, 0x03, 0xb1, 0x7a, 0x07, 0xe2, 0xc1, 0x86, 0x8e, 0xce, 0xbb, 0x53, 0x7a, 0xb9, 0xc5, 0x9b,
+	0xe3, 0xee, 0xc4, 0x5e, 0xee, 0xfe, 0x43, 0x73, 0xf6, 0xc1, 0x96, 0x0a, 0xd3, 0xca, 0xb5, 0x77,
+	0x07, 0x7b, 0xeb, 0x47, 0x3b, 0x13, 0xfe, 0xa8, 0x27, 0x4b, 0x22, 0xf8, 0xfa, 0x91, 0xf7, 0xdb,
+	0x82, 0xcd, 0xf7, 0x35, 0xd6, 0x78, 0x36, 0xc5, 0x4c, 0xd1, 0x4d, 0xf2, 0x47, 0x80, 0x53, 0x34,
+	0xab, 0x8f, 0x7d, 0x0d, 0x16, 0x86, 0xac, 0x2c, 0x19, 0x52, 0x60, 0x16, 0xca, 0x2c, 0x32, 0x6b,
+	0x36, 0x90, 0x2a, 0x62, 0x1a, 0x5d, 0x09, 0xd9, 0x5c, 0x64, 0x03, 0xa9, 0x92, 0x8a, 0x19, 0x57,
+	0x6c, 0x5d, 0x31, 0xd0, 0x79, 0x02, 0x50, 0x92, 0x98, 0xa7, 0x1d, 0xe7, 0x3b, 0x19, 0x72, 0x4e,
+	0x4c, 0x23, 0xd6, 0x9b, 0xfd, 0x1f, 0xf8, 0x2d, 0xa6, 0x5a, 0x2a, 0x66, 0xba, 0xa6, 0x4f, 0xa0,
+	0xc5, 0xde, 0xaf, 0x15, 0xc3, 0xf2, 0x32, 0x2f, 0x64, 0xd0, 0xb2, 0x54, 0x84, 0x1a, 0x96, 0x0c,
+	0xc8, 0xb7, 0x22, 0x4f, 0x64, 0x30, 0x67, 0x9a, 0x63, 0xdf, 0x20, 0x3a, 0xfa, 0x58, 0x46, 0xf1,
+	0x2b, 0x2c, 0x54, 0x6c, 0x98, 0x2e, 0x12, 0x34, 0x3b, 0xc9, 0x6f, 0x75, 0x51, 0x93, 0x6d, 0xb1,
+	0xf3, 0x0c, 0xfe, 0xa3, 0x87, 0x57, 0x42, 0x61, 0xf9, 0x4e, 0x94, 0x5f, 0x0c, 0xe7, 0x7e, 0x92,
+	0x3e, 0x9b, 0x0a, 0x5b, 0xce, 0x1c, 0x93, 0x4e, 0x61, 0x99, 0x17, 0x05, 0x86, 0x86, 0x6c, 0x03,
+	0x69, 0x5e, 0x89, 0x9f, 0x31, 0x50, 0x18, 0x36, 0x5c, 0x1b, 0x4c, 0xbf, 0x0a, 0xea, 0xb2, 0xa4,
+	0x66, 0x63, 0xa6, 0xd0, 0x40, 0x9a, 0x21, 0xc3, 0x04, 0x5d, 0xd0, 0x33, 0x28, 0xe6, 0xb9, 0x49,
+	0x7e, 0xeb, 0xae, 0xef, 0x5a, 0x7b, 0x23, 0x9f, 0x63, 0x67, 0x02, 0x43, 0xb6, 0xbc, 0x72, 0x37,
+	0x7a, 0x27, 0xb4, 0x74, 0x27, 0xbe, 0x79, 0xe5, 0xbd, 0x04, 0xe0, 0x92, 0xd6, 0x75, 0x02, 0x43,
+	0x96, 0xb2, 0x72, 0xad, 0xbb, 0xbf, 0x5e, 0xe8, 0xef, 0x9b, 0x57, 0x27, 0x93, 0x8f, 0xfb, 0x91,
+	0x54, 0x71, 0x7d, 0x3d, 0x09, 0xf2, 0xf4, 0x40, 0xd5, 0xa5, 0xcc, 0xa2, 0x20, 0x16, 0x32, 0x3b,
+	0x3a, 0x3c, 0x3a, 0xec, 0xe2, 0x03, 0xee, 0x73, 0x3d, 0xe4, 0xff, 0xaa, 0xe7, 0x7f, 0x06, 0x00,
+	0xb1, 0xe8, 0xc7, 0x7a, 0xbe, 0x06, 0x00, 0x00,
 }
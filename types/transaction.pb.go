@@ -870,6 +870,103 @@ func (m *Transactions) GetTxs() []*Transaction {
 	return nil
 }
 
+// PartiallySignedTx 部分签名交易, 交易内容确定之后在各个签名方之间传递, 签名收集完成后生成最终交易
+// txs 为单笔交易或者交易组中的交易(不含签名), signers 和txs 一一对应
+type PartiallySignedTx struct {
+	Txs                  []*Transaction   `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	Signers              []*PartialSigner `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PartiallySignedTx) Reset()         { *m = PartiallySignedTx{} }
+func (m *PartiallySignedTx) String() string { return proto.CompactTextString(m) }
+func (*PartiallySignedTx) ProtoMessage()    {}
+func (*PartiallySignedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{13}
+}
+
+func (m *PartiallySignedTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartiallySignedTx.Unmarshal(m, b)
+}
+func (m *PartiallySignedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartiallySignedTx.Marshal(b, m, deterministic)
+}
+func (m *PartiallySignedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartiallySignedTx.Merge(m, src)
+}
+func (m *PartiallySignedTx) XXX_Size() int {
+	return xxx_messageInfo_PartiallySignedTx.Size(m)
+}
+func (m *PartiallySignedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartiallySignedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartiallySignedTx proto.InternalMessageInfo
+
+func (m *PartiallySignedTx) GetTxs() []*Transaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *PartiallySignedTx) GetSigners() []*PartialSigner {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+// PartialSigner 交易需要的签名地址以及已经收集到的签名
+type PartialSigner struct {
+	Addr                 string     `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Signature            *Signature `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *PartialSigner) Reset()         { *m = PartialSigner{} }
+func (m *PartialSigner) String() string { return proto.CompactTextString(m) }
+func (*PartialSigner) ProtoMessage()    {}
+func (*PartialSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{14}
+}
+
+func (m *PartialSigner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartialSigner.Unmarshal(m, b)
+}
+func (m *PartialSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartialSigner.Marshal(b, m, deterministic)
+}
+func (m *PartialSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialSigner.Merge(m, src)
+}
+func (m *PartialSigner) XXX_Size() int {
+	return xxx_messageInfo_PartialSigner.Size(m)
+}
+func (m *PartialSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialSigner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialSigner proto.InternalMessageInfo
+
+func (m *PartialSigner) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *PartialSigner) GetSignature() *Signature {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 // 环签名类型时，签名字段存储的环签名信息
 type RingSignature struct {
 	Items                []*RingSignatureItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -882,7 +979,7 @@ func (m *RingSignature) Reset()         { *m = RingSignature{} }
 func (m *RingSignature) String() string { return proto.CompactTextString(m) }
 func (*RingSignature) ProtoMessage()    {}
 func (*RingSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{13}
+	return fileDescriptor_2cc4e03d2c28c490, []int{15}
 }
 
 func (m *RingSignature) XXX_Unmarshal(b []byte) error {
@@ -923,7 +1020,7 @@ func (m *RingSignatureItem) Reset()         { *m = RingSignatureItem{} }
 func (m *RingSignatureItem) String() string { return proto.CompactTextString(m) }
 func (*RingSignatureItem) ProtoMessage()    {}
 func (*RingSignatureItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{14}
+	return fileDescriptor_2cc4e03d2c28c490, []int{16}
 }
 
 func (m *RingSignatureItem) XXX_Unmarshal(b []byte) error {
@@ -982,7 +1079,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{15}
+	return fileDescriptor_2cc4e03d2c28c490, []int{17}
 }
 
 func (m *Signature) XXX_Unmarshal(b []byte) error {
@@ -1037,7 +1134,7 @@ func (m *AddrOverview) Reset()         { *m = AddrOverview{} }
 func (m *AddrOverview) String() string { return proto.CompactTextString(m) }
 func (*AddrOverview) ProtoMessage()    {}
 func (*AddrOverview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{16}
+	return fileDescriptor_2cc4e03d2c28c490, []int{18}
 }
 
 func (m *AddrOverview) XXX_Unmarshal(b []byte) error {
@@ -1096,7 +1193,7 @@ func (m *ReqAddr) Reset()         { *m = ReqAddr{} }
 func (m *ReqAddr) String() string { return proto.CompactTextString(m) }
 func (*ReqAddr) ProtoMessage()    {}
 func (*ReqAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{17}
+	return fileDescriptor_2cc4e03d2c28c490, []int{19}
 }
 
 func (m *ReqAddr) XXX_Unmarshal(b []byte) error {
@@ -1170,7 +1267,7 @@ func (m *HexTx) Reset()         { *m = HexTx{} }
 func (m *HexTx) String() string { return proto.CompactTextString(m) }
 func (*HexTx) ProtoMessage()    {}
 func (*HexTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{18}
+	return fileDescriptor_2cc4e03d2c28c490, []int{20}
 }
 
 func (m *HexTx) XXX_Unmarshal(b []byte) error {
@@ -1212,7 +1309,7 @@ func (m *ReplyTxInfo) Reset()         { *m = ReplyTxInfo{} }
 func (m *ReplyTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyTxInfo) ProtoMessage()    {}
 func (*ReplyTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{19}
+	return fileDescriptor_2cc4e03d2c28c490, []int{21}
 }
 
 func (m *ReplyTxInfo) XXX_Unmarshal(b []byte) error {
@@ -1272,7 +1369,7 @@ func (m *ReqTxList) Reset()         { *m = ReqTxList{} }
 func (m *ReqTxList) String() string { return proto.CompactTextString(m) }
 func (*ReqTxList) ProtoMessage()    {}
 func (*ReqTxList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{20}
+	return fileDescriptor_2cc4e03d2c28c490, []int{22}
 }
 
 func (m *ReqTxList) XXX_Unmarshal(b []byte) error {
@@ -1311,7 +1408,7 @@ func (m *ReplyTxList) Reset()         { *m = ReplyTxList{} }
 func (m *ReplyTxList) String() string { return proto.CompactTextString(m) }
 func (*ReplyTxList) ProtoMessage()    {}
 func (*ReplyTxList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{21}
+	return fileDescriptor_2cc4e03d2c28c490, []int{23}
 }
 
 func (m *ReplyTxList) XXX_Unmarshal(b []byte) error {
@@ -1339,6 +1436,54 @@ func (m *ReplyTxList) GetTxs() []*Transaction {
 	return nil
 }
 
+// mempool 交易日志, tx 不为空时表示加入交易, 否则表示删除hash 对应的交易
+type MempoolJournal struct {
+	Tx                   *Transaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Hash                 []byte       `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MempoolJournal) Reset()         { *m = MempoolJournal{} }
+func (m *MempoolJournal) String() string { return proto.CompactTextString(m) }
+func (*MempoolJournal) ProtoMessage()    {}
+func (*MempoolJournal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{24}
+}
+
+func (m *MempoolJournal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolJournal.Unmarshal(m, b)
+}
+func (m *MempoolJournal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolJournal.Marshal(b, m, deterministic)
+}
+func (m *MempoolJournal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolJournal.Merge(m, src)
+}
+func (m *MempoolJournal) XXX_Size() int {
+	return xxx_messageInfo_MempoolJournal.Size(m)
+}
+func (m *MempoolJournal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolJournal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolJournal proto.InternalMessageInfo
+
+func (m *MempoolJournal) GetTx() *Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *MempoolJournal) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type ReqGetMempool struct {
 	IsAll                bool     `protobuf:"varint,1,opt,name=isAll,proto3" json:"isAll,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1350,7 +1495,7 @@ func (m *ReqGetMempool) Reset()         { *m = ReqGetMempool{} }
 func (m *ReqGetMempool) String() string { return proto.CompactTextString(m) }
 func (*ReqGetMempool) ProtoMessage()    {}
 func (*ReqGetMempool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{22}
+	return fileDescriptor_2cc4e03d2c28c490, []int{25}
 }
 
 func (m *ReqGetMempool) XXX_Unmarshal(b []byte) error {
@@ -1390,7 +1535,7 @@ func (m *ReqProperFee) Reset()         { *m = ReqProperFee{} }
 func (m *ReqProperFee) String() string { return proto.CompactTextString(m) }
 func (*ReqProperFee) ProtoMessage()    {}
 func (*ReqProperFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{23}
+	return fileDescriptor_2cc4e03d2c28c490, []int{26}
 }
 
 func (m *ReqProperFee) XXX_Unmarshal(b []byte) error {
@@ -1436,7 +1581,7 @@ func (m *ReplyProperFee) Reset()         { *m = ReplyProperFee{} }
 func (m *ReplyProperFee) String() string { return proto.CompactTextString(m) }
 func (*ReplyProperFee) ProtoMessage()    {}
 func (*ReplyProperFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{24}
+	return fileDescriptor_2cc4e03d2c28c490, []int{27}
 }
 
 func (m *ReplyProperFee) XXX_Unmarshal(b []byte) error {
@@ -1477,7 +1622,7 @@ func (m *TxHashList) Reset()         { *m = TxHashList{} }
 func (m *TxHashList) String() string { return proto.CompactTextString(m) }
 func (*TxHashList) ProtoMessage()    {}
 func (*TxHashList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{25}
+	return fileDescriptor_2cc4e03d2c28c490, []int{28}
 }
 
 func (m *TxHashList) XXX_Unmarshal(b []byte) error {
@@ -1530,7 +1675,7 @@ func (m *ReplyTxInfos) Reset()         { *m = ReplyTxInfos{} }
 func (m *ReplyTxInfos) String() string { return proto.CompactTextString(m) }
 func (*ReplyTxInfos) ProtoMessage()    {}
 func (*ReplyTxInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{26}
+	return fileDescriptor_2cc4e03d2c28c490, []int{29}
 }
 
 func (m *ReplyTxInfos) XXX_Unmarshal(b []byte) error {
@@ -1570,7 +1715,7 @@ func (m *ReceiptLog) Reset()         { *m = ReceiptLog{} }
 func (m *ReceiptLog) String() string { return proto.CompactTextString(m) }
 func (*ReceiptLog) ProtoMessage()    {}
 func (*ReceiptLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{27}
+	return fileDescriptor_2cc4e03d2c28c490, []int{30}
 }
 
 func (m *ReceiptLog) XXX_Unmarshal(b []byte) error {
@@ -1621,7 +1766,7 @@ func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{28}
+	return fileDescriptor_2cc4e03d2c28c490, []int{31}
 }
 
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
@@ -1675,7 +1820,7 @@ func (m *ReceiptData) Reset()         { *m = ReceiptData{} }
 func (m *ReceiptData) String() string { return proto.CompactTextString(m) }
 func (*ReceiptData) ProtoMessage()    {}
 func (*ReceiptData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{29}
+	return fileDescriptor_2cc4e03d2c28c490, []int{32}
 }
 
 func (m *ReceiptData) XXX_Unmarshal(b []byte) error {
@@ -1726,7 +1871,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{30}
+	return fileDescriptor_2cc4e03d2c28c490, []int{33}
 }
 
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
@@ -1811,7 +1956,7 @@ func (m *TransactionDetail) Reset()         { *m = TransactionDetail{} }
 func (m *TransactionDetail) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetail) ProtoMessage()    {}
 func (*TransactionDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{31}
+	return fileDescriptor_2cc4e03d2c28c490, []int{34}
 }
 
 func (m *TransactionDetail) XXX_Unmarshal(b []byte) error {
@@ -1927,7 +2072,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{32}
+	return fileDescriptor_2cc4e03d2c28c490, []int{35}
 }
 
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
@@ -1966,7 +2111,7 @@ func (m *ReqAddrs) Reset()         { *m = ReqAddrs{} }
 func (m *ReqAddrs) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrs) ProtoMessage()    {}
 func (*ReqAddrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{33}
+	return fileDescriptor_2cc4e03d2c28c490, []int{36}
 }
 
 func (m *ReqAddrs) XXX_Unmarshal(b []byte) error {
@@ -2005,7 +2150,7 @@ func (m *ReqDecodeRawTransaction) Reset()         { *m = ReqDecodeRawTransaction
 func (m *ReqDecodeRawTransaction) String() string { return proto.CompactTextString(m) }
 func (*ReqDecodeRawTransaction) ProtoMessage()    {}
 func (*ReqDecodeRawTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{34}
+	return fileDescriptor_2cc4e03d2c28c490, []int{37}
 }
 
 func (m *ReqDecodeRawTransaction) XXX_Unmarshal(b []byte) error {
@@ -2045,7 +2190,7 @@ func (m *UserWrite) Reset()         { *m = UserWrite{} }
 func (m *UserWrite) String() string { return proto.CompactTextString(m) }
 func (*UserWrite) ProtoMessage()    {}
 func (*UserWrite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{35}
+	return fileDescriptor_2cc4e03d2c28c490, []int{38}
 }
 
 func (m *UserWrite) XXX_Unmarshal(b []byte) error {
@@ -2093,7 +2238,7 @@ func (m *UpgradeMeta) Reset()         { *m = UpgradeMeta{} }
 func (m *UpgradeMeta) String() string { return proto.CompactTextString(m) }
 func (*UpgradeMeta) ProtoMessage()    {}
 func (*UpgradeMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{36}
+	return fileDescriptor_2cc4e03d2c28c490, []int{39}
 }
 
 func (m *UpgradeMeta) XXX_Unmarshal(b []byte) error {
@@ -2148,7 +2293,7 @@ func (m *ReqTxHashList) Reset()         { *m = ReqTxHashList{} }
 func (m *ReqTxHashList) String() string { return proto.CompactTextString(m) }
 func (*ReqTxHashList) ProtoMessage()    {}
 func (*ReqTxHashList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{37}
+	return fileDescriptor_2cc4e03d2c28c490, []int{40}
 }
 
 func (m *ReqTxHashList) XXX_Unmarshal(b []byte) error {
@@ -2197,7 +2342,7 @@ func (m *TxProof) Reset()         { *m = TxProof{} }
 func (m *TxProof) String() string { return proto.CompactTextString(m) }
 func (*TxProof) ProtoMessage()    {}
 func (*TxProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{38}
+	return fileDescriptor_2cc4e03d2c28c490, []int{41}
 }
 
 func (m *TxProof) XXX_Unmarshal(b []byte) error {
@@ -2251,7 +2396,7 @@ func (m *ReqCheckTxsExist) Reset()         { *m = ReqCheckTxsExist{} }
 func (m *ReqCheckTxsExist) String() string { return proto.CompactTextString(m) }
 func (*ReqCheckTxsExist) ProtoMessage()    {}
 func (*ReqCheckTxsExist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{39}
+	return fileDescriptor_2cc4e03d2c28c490, []int{42}
 }
 
 func (m *ReqCheckTxsExist) XXX_Unmarshal(b []byte) error {
@@ -2293,7 +2438,7 @@ func (m *ReplyCheckTxsExist) Reset()         { *m = ReplyCheckTxsExist{} }
 func (m *ReplyCheckTxsExist) String() string { return proto.CompactTextString(m) }
 func (*ReplyCheckTxsExist) ProtoMessage()    {}
 func (*ReplyCheckTxsExist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2cc4e03d2c28c490, []int{40}
+	return fileDescriptor_2cc4e03d2c28c490, []int{43}
 }
 
 func (m *ReplyCheckTxsExist) XXX_Unmarshal(b []byte) error {
@@ -2342,6 +2487,8 @@ func init() {
 	proto.RegisterType((*NoBalanceTx)(nil), "types.NoBalanceTx")
 	proto.RegisterType((*Transaction)(nil), "types.Transaction")
 	proto.RegisterType((*Transactions)(nil), "types.Transactions")
+	proto.RegisterType((*PartiallySignedTx)(nil), "types.PartiallySignedTx")
+	proto.RegisterType((*PartialSigner)(nil), "types.PartialSigner")
 	proto.RegisterType((*RingSignature)(nil), "types.RingSignature")
 	proto.RegisterType((*RingSignatureItem)(nil), "types.RingSignatureItem")
 	proto.RegisterType((*Signature)(nil), "types.Signature")
@@ -2351,6 +2498,7 @@ func init() {
 	proto.RegisterType((*ReplyTxInfo)(nil), "types.ReplyTxInfo")
 	proto.RegisterType((*ReqTxList)(nil), "types.ReqTxList")
 	proto.RegisterType((*ReplyTxList)(nil), "types.ReplyTxList")
+	proto.RegisterType((*MempoolJournal)(nil), "types.MempoolJournal")
 	proto.RegisterType((*ReqGetMempool)(nil), "types.ReqGetMempool")
 	proto.RegisterType((*ReqProperFee)(nil), "types.ReqProperFee")
 	proto.RegisterType((*ReplyProperFee)(nil), "types.ReplyProperFee")
@@ -2377,100 +2525,104 @@ func init() {
 }
 
 var fileDescriptor_2cc4e03d2c28c490 = []byte{
-	// 1505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0x1b, 0x37,
-	0x17, 0x85, 0x34, 0x92, 0x2d, 0x5d, 0xc9, 0xfe, 0xec, 0x41, 0x90, 0x08, 0x46, 0x3e, 0xc7, 0x25,
-	0x12, 0x20, 0x08, 0x02, 0x19, 0xb0, 0xb3, 0x6b, 0x81, 0x26, 0xb1, 0x93, 0xd8, 0x70, 0x92, 0xa6,
-	0xb4, 0x92, 0x00, 0x6d, 0x37, 0xf4, 0xe8, 0x5a, 0x9a, 0x7a, 0x34, 0x94, 0x67, 0x28, 0x67, 0xd4,
-	0x07, 0xe8, 0xa6, 0xdd, 0xf5, 0x19, 0xfa, 0x3e, 0x7d, 0x8c, 0x3e, 0x46, 0xc1, 0x4b, 0x72, 0x86,
-	0xf2, 0x4f, 0x91, 0x45, 0x80, 0xee, 0x78, 0x2e, 0xa9, 0xfb, 0x7b, 0x78, 0x86, 0x82, 0x75, 0x95,
-	0x89, 0x34, 0x17, 0x91, 0x8a, 0x65, 0xda, 0x9f, 0x66, 0x52, 0xc9, 0xb0, 0xa9, 0xe6, 0x53, 0xcc,
-	0x37, 0xba, 0x91, 0x9c, 0x4c, 0x9c, 0x91, 0xbd, 0x81, 0x95, 0x67, 0x79, 0x8e, 0x2a, 0x7f, 0x85,
-	0x29, 0xe6, 0x71, 0x1e, 0xde, 0x86, 0x25, 0x31, 0x91, 0xb3, 0x54, 0xf5, 0xea, 0x5b, 0xb5, 0x87,
-	0x01, 0xb7, 0x28, 0xbc, 0x0f, 0x2b, 0x19, 0xaa, 0x59, 0x96, 0x3e, 0x1b, 0x0e, 0x33, 0xcc, 0xf3,
-	0x5e, 0xb0, 0x55, 0x7b, 0xd8, 0xe6, 0x8b, 0x46, 0xf6, 0x7b, 0x0d, 0x6e, 0x19, 0x7f, 0x03, 0x1d,
-	0xff, 0x14, 0xb3, 0x81, 0x7c, 0x51, 0x60, 0x14, 0xde, 0x85, 0x76, 0x24, 0xe3, 0x54, 0xc9, 0x33,
-	0x4c, 0x7b, 0x35, 0xfa, 0x69, 0x65, 0xb8, 0x31, 0x68, 0x08, 0x8d, 0x54, 0x2a, 0xa4, 0x58, 0x5d,
-	0x4e, 0xeb, 0x70, 0x03, 0x5a, 0x58, 0x60, 0xf4, 0x56, 0x4c, 0xb0, 0xd7, 0x20, 0x47, 0x25, 0x0e,
-	0x57, 0xa1, 0xae, 0x64, 0xaf, 0x49, 0xd6, 0xba, 0x92, 0xec, 0xd7, 0x1a, 0xac, 0x9a, 0x74, 0x3e,
-	0xc6, 0x6a, 0x3c, 0xcc, 0xc4, 0xa7, 0xff, 0x28, 0x91, 0x9f, 0x5d, 0x1e, 0xae, 0x2d, 0x5f, 0x30,
-	0x0f, 0x13, 0xab, 0x51, 0xc6, 0x3a, 0x82, 0x26, 0xc5, 0xd2, 0x87, 0x75, 0x42, 0xd6, 0x3b, 0xad,
-	0xb5, 0xe3, 0x7c, 0x3e, 0x39, 0x91, 0x09, 0x39, 0x6e, 0x73, 0x8b, 0xbc, 0x80, 0x81, 0x1f, 0x90,
-	0xfd, 0x5d, 0x83, 0xd6, 0x5e, 0x86, 0x42, 0xe1, 0xa0, 0xb0, 0x91, 0x6a, 0x2e, 0xd2, 0x8d, 0x59,
-	0xae, 0x41, 0x70, 0x8a, 0x68, 0x3d, 0xe9, 0x65, 0x99, 0x77, 0xc3, 0xcb, 0x7b, 0x13, 0x20, 0x2e,
-	0xe7, 0x42, 0xbd, 0x6a, 0x71, 0xcf, 0x12, 0xf6, 0x60, 0x39, 0xce, 0x07, 0xd4, 0x9f, 0x25, 0xda,
-	0x74, 0x30, 0xdc, 0x82, 0x0e, 0xb5, 0xe9, 0xd8, 0x54, 0xb2, 0x4c, 0x09, 0xf9, 0xa6, 0x85, 0xd9,
-	0xb4, 0x2e, 0xcd, 0xe6, 0x36, 0x2c, 0xe9, 0x35, 0x66, 0xbd, 0xb6, 0x69, 0x81, 0x41, 0x2c, 0x85,
-	0x2e, 0xc7, 0x8f, 0x59, 0xac, 0x90, 0x8b, 0x4f, 0xb6, 0xda, 0xa2, 0xac, 0xd6, 0x55, 0x1f, 0xf8,
-	0xd5, 0x63, 0x31, 0x8d, 0x33, 0x37, 0x7d, 0x8b, 0x5c, 0xf5, 0xcd, 0xaa, 0xfa, 0x5b, 0xd0, 0x8c,
-	0xd3, 0x21, 0x16, 0x54, 0x47, 0x93, 0x1b, 0xc0, 0x1e, 0xc1, 0x6d, 0xdb, 0xd9, 0xea, 0xaa, 0xbe,
-	0xca, 0xe4, 0x6c, 0xaa, 0x3d, 0xa8, 0x22, 0xef, 0xd5, 0xb6, 0x82, 0x87, 0x6d, 0xae, 0x97, 0x6c,
-	0x13, 0x5a, 0xef, 0xd3, 0x3c, 0x1e, 0xa5, 0x83, 0x42, 0xf7, 0x72, 0x28, 0x94, 0xa0, 0xcc, 0xba,
-	0x9c, 0xd6, 0x2c, 0x83, 0xee, 0x5b, 0xf9, 0x5c, 0x24, 0x22, 0x8d, 0x70, 0x50, 0xd0, 0x2d, 0x56,
-	0xc5, 0x01, 0x96, 0x4e, 0x2c, 0xd2, 0x3d, 0x9d, 0x8a, 0xb9, 0xbe, 0xad, 0x76, 0xfe, 0x0e, 0xd2,
-	0x4e, 0x16, 0x5f, 0x9c, 0xe1, 0xdc, 0x96, 0xe8, 0xe0, 0x4d, 0x75, 0x32, 0x09, 0x1d, 0x2f, 0xa6,
-	0x2e, 0x92, 0x82, 0xd8, 0x8e, 0x19, 0xf0, 0x45, 0x03, 0xfe, 0x59, 0x87, 0x8e, 0xd7, 0x2b, 0x6f,
-	0x90, 0xa6, 0x15, 0x16, 0xd9, 0x98, 0x89, 0x14, 0x43, 0x8a, 0xd9, 0xe5, 0x0e, 0x86, 0x7d, 0x68,
-	0xeb, 0x26, 0x0a, 0x35, 0xcb, 0x0c, 0x3d, 0x3b, 0x3b, 0x6b, 0x7d, 0x92, 0xc5, 0xfe, 0xb1, 0xb3,
-	0xf3, 0xea, 0x88, 0x1b, 0x65, 0xa3, 0x1a, 0x65, 0x95, 0x9b, 0x99, 0xaf, 0x1b, 0xfa, 0x2d, 0x68,
-	0xa6, 0x32, 0x8d, 0x90, 0x46, 0x1c, 0x70, 0x03, 0x2c, 0x65, 0x96, 0x4b, 0xca, 0x6c, 0x02, 0x8c,
-	0xf4, 0x84, 0xf7, 0xe8, 0xd2, 0xb4, 0x88, 0x0d, 0x9e, 0x45, 0x7b, 0x1f, 0xa3, 0x18, 0x5a, 0x6a,
-	0x76, 0xb9, 0x45, 0x74, 0x7d, 0xb0, 0x50, 0x3d, 0xb0, 0xd7, 0x07, 0x0b, 0xa5, 0xab, 0x8c, 0xc6,
-	0x22, 0x4e, 0x0f, 0xf7, 0x7b, 0x1d, 0x72, 0xe4, 0x20, 0x7b, 0x02, 0x5d, 0xaf, 0x4d, 0x79, 0x78,
-	0xbf, 0xa2, 0x53, 0x67, 0x27, 0xb4, 0xf5, 0x7a, 0x27, 0x0c, 0xc5, 0xbe, 0x85, 0x15, 0x1e, 0xa7,
-	0xa3, 0xb2, 0x0f, 0x61, 0x1f, 0x9a, 0xb1, 0xc2, 0x89, 0xfb, 0x61, 0xcf, 0xfe, 0x70, 0xe1, 0xd0,
-	0xa1, 0xc2, 0x09, 0x37, 0xc7, 0xd8, 0x21, 0xac, 0x5f, 0xd9, 0xd3, 0x15, 0x4d, 0x67, 0x27, 0x7a,
-	0xc8, 0xda, 0x4b, 0x97, 0x5b, 0xa4, 0xe5, 0xaf, 0x9a, 0x44, 0x9d, 0xb6, 0x2a, 0x03, 0xfb, 0x1e,
-	0xda, 0x55, 0x1e, 0xba, 0x89, 0x73, 0x1a, 0x71, 0x93, 0xd7, 0xd5, 0xdc, 0x73, 0x69, 0xa6, 0x7b,
-	0xad, 0x4b, 0x23, 0x90, 0x9e, 0xcb, 0x9f, 0xa0, 0xab, 0x69, 0xf7, 0xdd, 0x05, 0x66, 0x17, 0x31,
-	0x92, 0xba, 0x64, 0x18, 0xc5, 0x17, 0x96, 0x3d, 0x01, 0x77, 0x50, 0xef, 0x9c, 0x18, 0x56, 0x5b,
-	0x59, 0x73, 0x50, 0xef, 0xa8, 0x62, 0xcf, 0x53, 0x49, 0x07, 0xd9, 0x1f, 0x35, 0x58, 0xe6, 0x78,
-	0x4e, 0xc4, 0x0e, 0xa1, 0x21, 0x34, 0xdf, 0xad, 0xec, 0x0a, 0x6b, 0x3b, 0x4d, 0xc4, 0x88, 0x1c,
-	0x36, 0x39, 0xad, 0x35, 0x65, 0xa2, 0xd2, 0x57, 0x93, 0x1b, 0xa0, 0xab, 0x18, 0xc6, 0x19, 0xd2,
-	0x60, 0x88, 0x78, 0x4d, 0x5e, 0x19, 0x0c, 0x41, 0xe2, 0xd1, 0x58, 0x39, 0xfa, 0x19, 0xb4, 0xa8,
-	0x30, 0x81, 0x53, 0x98, 0x3b, 0xd0, 0x3c, 0xc0, 0xe2, 0xaa, 0x94, 0xb1, 0x19, 0x74, 0x38, 0x4e,
-	0x93, 0xf9, 0xa0, 0x38, 0x4c, 0x4f, 0xa5, 0xce, 0x6e, 0x2c, 0xf2, 0xb1, 0x53, 0x14, 0xbd, 0xf6,
-	0x22, 0xd5, 0xaf, 0x8f, 0x14, 0x78, 0x91, 0xc2, 0xfb, 0xb0, 0x24, 0xe8, 0xfb, 0xd6, 0x6b, 0x10,
-	0x59, 0xba, 0x96, 0x2c, 0xf4, 0x21, 0xe2, 0x76, 0x8f, 0x7d, 0x05, 0x6d, 0x8e, 0xe7, 0x83, 0xe2,
-	0x75, 0x9c, 0xab, 0xaa, 0x7c, 0xd3, 0x7e, 0x03, 0xd8, 0x6e, 0x99, 0x19, 0x1d, 0xfa, 0x3c, 0xea,
-	0x3e, 0x80, 0x15, 0x8e, 0xe7, 0xaf, 0x50, 0xbd, 0xc1, 0xc9, 0x54, 0xca, 0x84, 0x92, 0xcc, 0x9f,
-	0x25, 0x09, 0xf9, 0x6e, 0x71, 0x03, 0xd8, 0x53, 0x2d, 0xf0, 0xe7, 0xef, 0x32, 0x39, 0xc5, 0xec,
-	0x25, 0x2e, 0x8c, 0xd3, 0xb0, 0xcb, 0x41, 0x23, 0x9f, 0xc7, 0xf1, 0x2f, 0x68, 0x07, 0x66, 0x11,
-	0xeb, 0xc3, 0x2a, 0x65, 0x57, 0xf9, 0xb8, 0x0b, 0xed, 0xa9, 0x03, 0xb6, 0x92, 0xca, 0xc0, 0x38,
-	0xc0, 0xa0, 0x38, 0x10, 0xf9, 0x98, 0x8a, 0xd1, 0x2d, 0x15, 0xf9, 0x18, 0x73, 0x77, 0x17, 0x0c,
-	0xaa, 0x3a, 0x51, 0xf7, 0x3a, 0xe1, 0x29, 0x4d, 0xb0, 0x15, 0x54, 0x4a, 0xc3, 0xbe, 0xd1, 0x55,
-	0x94, 0xb3, 0xcb, 0xc3, 0xc7, 0xba, 0x0a, 0x5a, 0x5e, 0x6a, 0x93, 0x77, 0x8a, 0xbb, 0x23, 0xac,
-	0x0f, 0xc0, 0x31, 0xc2, 0x78, 0xaa, 0x5e, 0xcb, 0xd1, 0x95, 0xab, 0xb5, 0x06, 0x41, 0x22, 0x47,
-	0xf6, 0x5e, 0xe9, 0x25, 0x13, 0x9a, 0xd7, 0x74, 0xfe, 0xca, 0xe1, 0x7b, 0x50, 0x3f, 0xfa, 0x40,
-	0x77, 0xb7, 0xb3, 0xf3, 0x3f, 0x1b, 0xf3, 0x08, 0xe7, 0x1f, 0x44, 0x32, 0x43, 0x5e, 0x3f, 0xfa,
-	0x10, 0x3e, 0x80, 0x46, 0x22, 0x47, 0x39, 0xe5, 0xdf, 0xd9, 0x59, 0x2f, 0xd3, 0x72, 0xe1, 0x39,
-	0x6d, 0xb3, 0x7d, 0x3d, 0x72, 0xb2, 0xed, 0x0b, 0x25, 0xae, 0x84, 0xf9, 0x4c, 0x2f, 0x7f, 0xd5,
-	0xa0, 0x35, 0x28, 0x38, 0xe6, 0xb3, 0x44, 0x79, 0xe4, 0xad, 0x5d, 0x4f, 0xde, 0xba, 0xf7, 0x21,
-	0x0e, 0x19, 0xdd, 0x0e, 0xf3, 0x39, 0xb8, 0x8e, 0x63, 0xfa, 0xe3, 0xff, 0x04, 0x3a, 0x99, 0x09,
-	0x39, 0x14, 0xf6, 0x1d, 0xe3, 0x77, 0xba, 0x4c, 0x9f, 0xfb, 0xc7, 0x34, 0x3b, 0x4e, 0x12, 0x19,
-	0x9d, 0xa9, 0x78, 0xe2, 0x3e, 0x18, 0x95, 0x41, 0x7f, 0x0d, 0x4c, 0x04, 0x7a, 0xa6, 0x2c, 0xd1,
-	0xed, 0xf4, 0x2c, 0xec, 0xb7, 0x00, 0xd6, 0xbd, 0x3c, 0xf6, 0x51, 0x89, 0x38, 0xb1, 0xd9, 0xd6,
-	0xfe, 0x35, 0xdb, 0xc7, 0x24, 0x6e, 0x3a, 0x0d, 0xaa, 0xf4, 0xfa, 0x4c, 0xdd, 0x11, 0x12, 0xd4,
-	0x4c, 0xca, 0x53, 0xd3, 0x63, 0x2d, 0xa8, 0x84, 0xbc, 0x2e, 0x36, 0xae, 0xef, 0x62, 0xd3, 0x97,
-	0x80, 0x85, 0x5a, 0x97, 0x2e, 0xd7, 0x5a, 0x3d, 0x15, 0x97, 0x17, 0x9e, 0x8a, 0x1b, 0xd0, 0x3a,
-	0xcd, 0xe4, 0x84, 0x04, 0xd3, 0x3e, 0xd4, 0x1c, 0xbe, 0xd4, 0x9f, 0xf6, 0xe5, 0xfe, 0x78, 0xa2,
-	0x03, 0x37, 0x8b, 0x4e, 0xf8, 0x08, 0x5a, 0xaa, 0x78, 0x67, 0xea, 0xeb, 0xd0, 0xb9, 0x55, 0xd7,
-	0x35, 0x63, 0xe6, 0xe5, 0x3e, 0x65, 0x33, 0x4b, 0x12, 0x7d, 0x63, 0x7b, 0x5d, 0xba, 0x04, 0x25,
-	0x66, 0x4f, 0x21, 0xbc, 0x32, 0x0c, 0xed, 0xdd, 0x13, 0xa8, 0xde, 0xd5, 0x71, 0x98, 0x73, 0x46,
-	0xa6, 0xb6, 0xa0, 0x65, 0xbf, 0x11, 0x74, 0xe7, 0x75, 0x8d, 0xee, 0x7d, 0x66, 0x00, 0xdb, 0x86,
-	0x3b, 0x1c, 0xcf, 0xf7, 0x31, 0x92, 0x43, 0x7a, 0x84, 0x7a, 0x8f, 0x9d, 0x6b, 0x9f, 0x57, 0xec,
-	0x6b, 0x68, 0xbf, 0xcf, 0x31, 0xa3, 0x57, 0x2b, 0x1d, 0x91, 0xd3, 0x38, 0x2a, 0x8f, 0x68, 0x40,
-	0xef, 0x04, 0x99, 0x2a, 0xb4, 0xfa, 0xd2, 0xe6, 0x0e, 0xb2, 0x1f, 0xa1, 0xf3, 0x7e, 0x3a, 0xca,
-	0xc4, 0x10, 0xdf, 0xa0, 0x12, 0xba, 0xf8, 0x5c, 0x89, 0x4c, 0xc5, 0xe9, 0xc8, 0xea, 0x66, 0x89,
-	0xb5, 0x93, 0x0b, 0xcc, 0x72, 0xfd, 0x4d, 0xb2, 0x4e, 0x2c, 0xf4, 0x48, 0x12, 0xf8, 0x24, 0x61,
-	0x87, 0xa4, 0xc9, 0x37, 0xaa, 0x5f, 0xbb, 0x54, 0xbf, 0x2d, 0xe8, 0xc4, 0xf9, 0xf1, 0x58, 0x66,
-	0x8a, 0xda, 0x5e, 0xa7, 0xc8, 0xbe, 0x89, 0x1d, 0xc3, 0xb2, 0x1d, 0x95, 0x47, 0xd5, 0xda, 0x02,
-	0x55, 0x17, 0x2e, 0xf6, 0x8a, 0xa3, 0xe4, 0x06, 0xb4, 0x32, 0x29, 0x8d, 0x5f, 0xf3, 0x20, 0x28,
-	0x31, 0xeb, 0xc3, 0x1a, 0xc7, 0xf3, 0xbd, 0x31, 0x46, 0x67, 0x83, 0x22, 0x7f, 0x51, 0xe8, 0x14,
-	0x37, 0x34, 0x55, 0x0e, 0x7c, 0x89, 0x2e, 0x31, 0x1b, 0x40, 0x48, 0x82, 0xba, 0xf8, 0x8b, 0x4d,
-	0x00, 0xd4, 0x8b, 0x97, 0x89, 0x18, 0x99, 0xdf, 0xb4, 0xb8, 0x67, 0x29, 0xf7, 0xf7, 0x4a, 0x7d,
-	0x5f, 0xe1, 0x9e, 0xe5, 0xf9, 0xbd, 0x1f, 0xfe, 0x3f, 0x8a, 0xd5, 0x78, 0x76, 0xd2, 0x8f, 0xe4,
-	0x64, 0x7b, 0x77, 0x37, 0x4a, 0xb7, 0xe9, 0x15, 0xb7, 0xbb, 0xbb, 0x4d, 0x54, 0x3a, 0x59, 0xa2,
-	0xbf, 0xe9, 0xbb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x60, 0xa4, 0x2b, 0xa8, 0xd0, 0x0f, 0x00,
-	0x00,
+	// 1574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0x06, 0xb9, 0xa4, 0x48, 0x1e, 0x52, 0xaa, 0xb5, 0x30, 0x9c, 0x85, 0x10, 0xa8, 0xea, 0xc0,
+	0x01, 0x8c, 0xc0, 0xa0, 0x03, 0x25, 0x77, 0x2d, 0xd0, 0x38, 0x52, 0x62, 0xa9, 0x8a, 0x53, 0x77,
+	0x44, 0x3b, 0x40, 0xdb, 0x9b, 0xd1, 0xf2, 0x88, 0xdc, 0x6a, 0xb9, 0x43, 0xcd, 0x0e, 0x95, 0x65,
+	0x1f, 0xa0, 0x37, 0xed, 0x5d, 0x9f, 0xa1, 0xef, 0xd3, 0xc7, 0xe8, 0x63, 0x14, 0x73, 0x66, 0x66,
+	0x77, 0xa8, 0x9f, 0x56, 0x17, 0x06, 0x72, 0x37, 0xdf, 0x99, 0xc3, 0xf3, 0xf3, 0x9d, 0x9f, 0x1d,
+	0x10, 0x76, 0xb5, 0x12, 0x45, 0x29, 0x52, 0x9d, 0xc9, 0x62, 0xbc, 0x54, 0x52, 0xcb, 0xb8, 0xab,
+	0xd7, 0x4b, 0x2c, 0xf7, 0x46, 0xa9, 0x5c, 0x2c, 0xbc, 0x90, 0xbd, 0x85, 0xed, 0xd7, 0x65, 0x89,
+	0xba, 0x7c, 0x83, 0x05, 0x96, 0x59, 0x19, 0x3f, 0x83, 0x2d, 0xb1, 0x90, 0xab, 0x42, 0x27, 0xed,
+	0x83, 0xd6, 0x8b, 0x88, 0x3b, 0x14, 0x3f, 0x87, 0x6d, 0x85, 0x7a, 0xa5, 0x8a, 0xd7, 0xd3, 0xa9,
+	0xc2, 0xb2, 0x4c, 0xa2, 0x83, 0xd6, 0x8b, 0x01, 0xdf, 0x14, 0xb2, 0x7f, 0xb4, 0xe0, 0xa9, 0xb5,
+	0x37, 0x31, 0xfe, 0x2f, 0x51, 0x4d, 0xe4, 0xb7, 0x15, 0xa6, 0xf1, 0xa7, 0x30, 0x48, 0x65, 0x56,
+	0x68, 0x79, 0x85, 0x45, 0xd2, 0xa2, 0x9f, 0x36, 0x82, 0x07, 0x9d, 0xc6, 0xd0, 0x29, 0xa4, 0x46,
+	0xf2, 0x35, 0xe2, 0x74, 0x8e, 0xf7, 0xa0, 0x8f, 0x15, 0xa6, 0x3f, 0x88, 0x05, 0x26, 0x1d, 0x32,
+	0x54, 0xe3, 0x78, 0x07, 0xda, 0x5a, 0x26, 0x5d, 0x92, 0xb6, 0xb5, 0x64, 0x7f, 0x6b, 0xc1, 0x8e,
+	0x0d, 0xe7, 0xc7, 0x4c, 0xcf, 0xa7, 0x4a, 0xfc, 0xf4, 0x33, 0x05, 0xf2, 0x17, 0xd8, 0xd9, 0xa4,
+	0xe5, 0x23, 0xc6, 0x61, 0x7d, 0x75, 0x6a, 0x5f, 0x67, 0xd0, 0x25, 0x5f, 0x46, 0xd9, 0x04, 0xe4,
+	0xac, 0xd3, 0xd9, 0x18, 0x2e, 0xd7, 0x8b, 0x0b, 0x99, 0x93, 0xe1, 0x01, 0x77, 0x28, 0x70, 0x18,
+	0x85, 0x0e, 0xd9, 0x7f, 0x5a, 0xd0, 0x3f, 0x52, 0x28, 0x34, 0x4e, 0x2a, 0xe7, 0xa9, 0xe5, 0x3d,
+	0x3d, 0x18, 0xe5, 0x13, 0x88, 0x2e, 0x11, 0x9d, 0x25, 0x73, 0xac, 0xe3, 0xee, 0x04, 0x71, 0xef,
+	0x03, 0x64, 0x75, 0x5d, 0x88, 0xab, 0x3e, 0x0f, 0x24, 0x71, 0x02, 0xbd, 0xac, 0x9c, 0x10, 0x3f,
+	0x5b, 0x74, 0xe9, 0x61, 0x7c, 0x00, 0x43, 0xa2, 0xe9, 0xdc, 0x66, 0xd2, 0xa3, 0x80, 0x42, 0xd1,
+	0x46, 0x6d, 0xfa, 0xb7, 0x6a, 0xf3, 0x0c, 0xb6, 0xcc, 0x19, 0x55, 0x32, 0xb0, 0x14, 0x58, 0xc4,
+	0x0a, 0x18, 0x71, 0xfc, 0x51, 0x65, 0x1a, 0xb9, 0xf8, 0xc9, 0x65, 0x5b, 0xd5, 0xd9, 0xfa, 0xec,
+	0xa3, 0x30, 0x7b, 0xac, 0x96, 0x99, 0xf2, 0xd5, 0x77, 0xc8, 0x67, 0xdf, 0x6d, 0xb2, 0x7f, 0x0a,
+	0xdd, 0xac, 0x98, 0x62, 0x45, 0x79, 0x74, 0xb9, 0x05, 0xec, 0x73, 0x78, 0xe6, 0x98, 0x6d, 0x46,
+	0xf5, 0x8d, 0x92, 0xab, 0xa5, 0xb1, 0xa0, 0xab, 0x32, 0x69, 0x1d, 0x44, 0x2f, 0x06, 0xdc, 0x1c,
+	0xd9, 0x3e, 0xf4, 0xdf, 0x17, 0x65, 0x36, 0x2b, 0x26, 0x95, 0xe1, 0x72, 0x2a, 0xb4, 0xa0, 0xc8,
+	0x46, 0x9c, 0xce, 0x4c, 0xc1, 0xe8, 0x07, 0xf9, 0x8d, 0xc8, 0x45, 0x91, 0xe2, 0xa4, 0xa2, 0x29,
+	0xd6, 0xd5, 0x09, 0xd6, 0x46, 0x1c, 0x32, 0x9c, 0x2e, 0xc5, 0xda, 0x4c, 0xab, 0xab, 0xbf, 0x87,
+	0x74, 0xa3, 0xb2, 0x9b, 0x2b, 0x5c, 0xbb, 0x14, 0x3d, 0x7c, 0x28, 0x4f, 0x26, 0x61, 0x18, 0xf8,
+	0x34, 0x49, 0x92, 0x13, 0xc7, 0x98, 0x05, 0x1f, 0xd5, 0xe1, 0xbf, 0xda, 0x30, 0x0c, 0xb8, 0x0a,
+	0x0a, 0x69, 0xa9, 0x70, 0xc8, 0xf9, 0xcc, 0xa5, 0x98, 0x92, 0xcf, 0x11, 0xf7, 0x30, 0x1e, 0xc3,
+	0xc0, 0x90, 0x28, 0xf4, 0x4a, 0xd9, 0xf6, 0x1c, 0x1e, 0x3e, 0x19, 0xd3, 0x5a, 0x1c, 0x9f, 0x7b,
+	0x39, 0x6f, 0x54, 0x7c, 0x29, 0x3b, 0x4d, 0x29, 0x9b, 0xd8, 0x6c, 0x7d, 0x1d, 0x32, 0xd9, 0x17,
+	0xb2, 0x48, 0x91, 0x4a, 0x1c, 0x71, 0x0b, 0x5c, 0xcb, 0xf4, 0xea, 0x96, 0xd9, 0x07, 0x98, 0x99,
+	0x0a, 0x1f, 0xd1, 0xd0, 0xf4, 0xa9, 0x1b, 0x02, 0x89, 0xb1, 0x3e, 0x47, 0x31, 0x75, 0xad, 0x39,
+	0xe2, 0x0e, 0xd1, 0xf8, 0x60, 0xa5, 0x13, 0x70, 0xe3, 0x83, 0x95, 0x36, 0x59, 0xa6, 0x73, 0x91,
+	0x15, 0xa7, 0xc7, 0xc9, 0x90, 0x0c, 0x79, 0xc8, 0xbe, 0x82, 0x51, 0x40, 0x53, 0x19, 0x3f, 0x6f,
+	0xda, 0x69, 0x78, 0x18, 0xbb, 0x7c, 0x03, 0x0d, 0xdb, 0x62, 0x19, 0xec, 0xbe, 0x13, 0x4a, 0x67,
+	0x22, 0xcf, 0xd7, 0x86, 0x0c, 0x9c, 0x4e, 0xaa, 0xc7, 0xfd, 0x34, 0x1e, 0x43, 0xcf, 0x70, 0x86,
+	0xaa, 0x4c, 0xda, 0xa4, 0xf9, 0xd4, 0x69, 0x3a, 0x83, 0x64, 0x4e, 0x71, 0xaf, 0xc4, 0xce, 0x61,
+	0x7b, 0xe3, 0xc6, 0xe4, 0x27, 0xa6, 0x53, 0x5b, 0xc7, 0x01, 0xa7, 0xf3, 0x66, 0xad, 0xda, 0xff,
+	0xb7, 0x56, 0xec, 0xb7, 0xb0, 0xcd, 0xb3, 0x62, 0x56, 0xdf, 0xc5, 0x63, 0xe8, 0x66, 0x1a, 0x17,
+	0x3e, 0xfa, 0xc4, 0xfd, 0x78, 0x43, 0xe9, 0x54, 0xe3, 0x82, 0x5b, 0x35, 0x76, 0x0a, 0xbb, 0x77,
+	0xee, 0x4c, 0x45, 0x96, 0xab, 0x0b, 0xd3, 0xa4, 0xc6, 0xca, 0x88, 0x3b, 0x64, 0xd6, 0x77, 0x18,
+	0x9d, 0xb9, 0x0a, 0x62, 0xf9, 0x03, 0x0c, 0x9a, 0x38, 0x4c, 0x13, 0xac, 0x29, 0xb5, 0x2e, 0x6f,
+	0xeb, 0x75, 0x60, 0xd2, 0x76, 0xe7, 0xbd, 0x26, 0xed, 0x82, 0x0f, 0x4c, 0xfe, 0x19, 0x46, 0x66,
+	0x6c, 0x7e, 0x7f, 0x83, 0xea, 0x26, 0x43, 0xda, 0x8e, 0x0a, 0xd3, 0xec, 0xc6, 0x75, 0x7f, 0xc4,
+	0x3d, 0x34, 0x37, 0x17, 0x76, 0x2a, 0xdd, 0x5a, 0xf6, 0xd0, 0xdc, 0xe8, 0xea, 0x28, 0xd8, 0xf2,
+	0x1e, 0xb2, 0x7f, 0xb6, 0xa0, 0xc7, 0xf1, 0x9a, 0x06, 0xf3, 0xbe, 0x62, 0xc4, 0xd0, 0xb9, 0xcc,
+	0xc5, 0x8c, 0x0c, 0x76, 0x39, 0x9d, 0x4d, 0xcb, 0xa7, 0xb5, 0xad, 0x2e, 0xb7, 0xc0, 0x64, 0x31,
+	0xcd, 0x14, 0x52, 0x77, 0xd0, 0xe0, 0x74, 0x79, 0x23, 0xb0, 0x0d, 0x9e, 0xcd, 0xe6, 0xda, 0x8f,
+	0x8f, 0x45, 0x9b, 0x1b, 0x32, 0xf2, 0x1b, 0xf2, 0x13, 0xe8, 0x9e, 0x60, 0x75, 0x77, 0x15, 0xb3,
+	0x15, 0x0c, 0x39, 0x2e, 0xf3, 0xf5, 0xa4, 0x3a, 0x2d, 0x2e, 0xa5, 0x89, 0x6e, 0x2e, 0xca, 0xb9,
+	0xdf, 0x88, 0xe6, 0x1c, 0x78, 0x6a, 0xdf, 0xef, 0x29, 0x0a, 0x3c, 0xc5, 0xcf, 0x61, 0x4b, 0xd0,
+	0xf7, 0x39, 0xe9, 0x50, 0xb3, 0x8c, 0x5c, 0xb3, 0xd0, 0x87, 0x94, 0xbb, 0x3b, 0xf6, 0x2b, 0x18,
+	0x70, 0xbc, 0x9e, 0x54, 0xdf, 0x67, 0xa5, 0x6e, 0xd2, 0xb7, 0xf4, 0x5b, 0xc0, 0xbe, 0xac, 0x23,
+	0x23, 0xa5, 0xc7, 0x8d, 0xde, 0x09, 0xec, 0xbc, 0xc5, 0xc5, 0x52, 0xca, 0xfc, 0x77, 0x72, 0xa5,
+	0x0a, 0x91, 0xc7, 0xac, 0x4e, 0xf8, 0xfe, 0x9f, 0x99, 0xef, 0x91, 0xcf, 0xba, 0xdd, 0x64, 0xcd,
+	0x3e, 0x83, 0x6d, 0x8e, 0xd7, 0x6f, 0x50, 0x3b, 0x7b, 0x94, 0x6e, 0xf9, 0x3a, 0xcf, 0xc9, 0x56,
+	0x9f, 0x5b, 0xc0, 0xbe, 0x36, 0x9f, 0xba, 0xeb, 0x77, 0x4a, 0x2e, 0x51, 0x7d, 0x87, 0x1b, 0x8d,
+	0x61, 0xfb, 0xd4, 0x43, 0xfb, 0x21, 0x39, 0xcf, 0xfe, 0x8a, 0xae, 0xf4, 0x0e, 0xb1, 0x31, 0xec,
+	0x50, 0x9e, 0x8d, 0x8d, 0x4f, 0x61, 0xb0, 0xf4, 0xc0, 0x71, 0xd2, 0x08, 0x18, 0x07, 0x98, 0x54,
+	0x27, 0xa2, 0x9c, 0x13, 0x2d, 0xa6, 0x38, 0xa2, 0x9c, 0x63, 0xe9, 0xa7, 0xca, 0xa2, 0x86, 0xd3,
+	0x76, 0xc0, 0x69, 0xb0, 0x73, 0xa3, 0x83, 0xa8, 0xd9, 0xb9, 0xec, 0x37, 0x30, 0x72, 0x5c, 0x9b,
+	0x2e, 0x28, 0xe3, 0x97, 0x26, 0x0b, 0x3a, 0xde, 0x22, 0x3c, 0xd0, 0xe2, 0x5e, 0x85, 0x8d, 0x01,
+	0x38, 0xa6, 0x98, 0x2d, 0xf5, 0xf7, 0x72, 0x76, 0x67, 0x48, 0x9f, 0x40, 0x94, 0xcb, 0x99, 0xe3,
+	0xd6, 0x1c, 0x99, 0x80, 0x9e, 0xd3, 0xbf, 0xa3, 0xfc, 0x4b, 0x68, 0x9f, 0x7d, 0x70, 0xab, 0xef,
+	0x17, 0xce, 0xe7, 0x19, 0xae, 0x3f, 0x88, 0x7c, 0x85, 0xbc, 0x7d, 0xf6, 0x21, 0xfe, 0x0c, 0x3a,
+	0xb9, 0x9c, 0x95, 0x14, 0xff, 0xf0, 0x70, 0xb7, 0x0e, 0xcb, 0xbb, 0xe7, 0x74, 0xcd, 0x8e, 0x61,
+	0xe8, 0x64, 0xc7, 0x42, 0x8b, 0x3b, 0x6e, 0x1e, 0x69, 0xe5, 0xdf, 0x2d, 0xe8, 0x4f, 0x2a, 0x8e,
+	0xe5, 0x2a, 0xd7, 0xc1, 0x18, 0xb4, 0xee, 0x1f, 0x83, 0x76, 0xf0, 0x24, 0x71, 0x6d, 0x17, 0xfd,
+	0xcf, 0xb6, 0xfb, 0x0a, 0x86, 0xca, 0xba, 0x9c, 0x0a, 0xf7, 0xa2, 0x0b, 0x99, 0xae, 0xc3, 0xe7,
+	0xa1, 0x9a, 0xe9, 0x8e, 0x8b, 0x5c, 0xa6, 0x57, 0x3a, 0x5b, 0xf8, 0x4f, 0x67, 0x23, 0x30, 0xdf,
+	0x45, 0xeb, 0x81, 0x1e, 0x6c, 0x5b, 0x34, 0xe7, 0x81, 0x84, 0xfd, 0x3d, 0x82, 0xdd, 0x20, 0x8e,
+	0x63, 0xd4, 0x22, 0x7b, 0xdc, 0x90, 0xbc, 0x84, 0x9e, 0x0b, 0x23, 0x69, 0x6f, 0x28, 0x86, 0x91,
+	0x7a, 0x15, 0x5a, 0xcd, 0x4a, 0xca, 0x4b, 0xcb, 0xf1, 0x88, 0x3b, 0x14, 0xb0, 0xd8, 0xb9, 0x9f,
+	0xc5, 0x6e, 0xb8, 0x4c, 0x36, 0x72, 0xdd, 0xba, 0x9d, 0x6b, 0xf3, 0x68, 0xee, 0x6d, 0x3c, 0x9a,
+	0xf7, 0xa0, 0x7f, 0xa9, 0xe4, 0x82, 0x56, 0xaf, 0x7b, 0xb2, 0x7a, 0x7c, 0x8b, 0x9f, 0xc1, 0x6d,
+	0x7e, 0x82, 0xf5, 0x05, 0x0f, 0xaf, 0xaf, 0xf8, 0x73, 0xe8, 0xeb, 0xea, 0x9d, 0xcd, 0x6f, 0x48,
+	0x7a, 0x3b, 0x9e, 0x35, 0x2b, 0xe6, 0xf5, 0x3d, 0x45, 0xb3, 0xca, 0x73, 0x33, 0xb1, 0xc9, 0x88,
+	0x86, 0xa0, 0xc6, 0xec, 0x6b, 0x88, 0xef, 0x14, 0xc3, 0x58, 0x0f, 0x56, 0x5d, 0x72, 0xb7, 0x1c,
+	0x56, 0xcf, 0x2e, 0xbc, 0x03, 0xe8, 0xbb, 0xaf, 0x0d, 0xcd, 0xbc, 0xc9, 0xd1, 0xbf, 0x54, 0x2d,
+	0x60, 0xaf, 0xe0, 0x13, 0x8e, 0xd7, 0xc7, 0x98, 0xca, 0x29, 0x3d, 0xc7, 0x1b, 0x3b, 0xf7, 0x3f,
+	0x34, 0xd9, 0xaf, 0x61, 0xf0, 0xbe, 0x44, 0x45, 0xef, 0x77, 0x52, 0x91, 0xcb, 0x2c, 0xad, 0x55,
+	0x0c, 0xa0, 0x17, 0x93, 0x2c, 0x34, 0xba, 0xfd, 0x32, 0xe0, 0x1e, 0xb2, 0x3f, 0xc1, 0xf0, 0xfd,
+	0x72, 0xa6, 0xc4, 0x14, 0xdf, 0xa2, 0x16, 0x26, 0xf9, 0x52, 0x9b, 0x07, 0x4a, 0x31, 0x73, 0x7b,
+	0xb3, 0xc6, 0xc6, 0xc8, 0x0d, 0xaa, 0xd2, 0x7c, 0xdd, 0x9c, 0x11, 0x07, 0x83, 0x26, 0x89, 0xc2,
+	0x26, 0x61, 0xa7, 0xb4, 0x93, 0x1f, 0xdc, 0x7e, 0x83, 0x7a, 0xfb, 0x1d, 0xc0, 0x30, 0x2b, 0xcf,
+	0xe7, 0x52, 0xe9, 0x13, 0xbf, 0xd7, 0xfb, 0x3c, 0x14, 0xb1, 0x73, 0xe8, 0xb9, 0x52, 0x05, 0xad,
+	0xda, 0xda, 0x68, 0xd5, 0x8d, 0xc1, 0xde, 0xf6, 0x2d, 0xb9, 0x07, 0x7d, 0x25, 0xa5, 0xb5, 0x6b,
+	0x9f, 0x16, 0x35, 0x66, 0x63, 0x78, 0xc2, 0xf1, 0xfa, 0x68, 0x8e, 0xe9, 0xd5, 0xa4, 0x2a, 0xbf,
+	0xad, 0x4c, 0x88, 0x7b, 0xa6, 0x55, 0x4e, 0xc2, 0x15, 0x5d, 0x63, 0x36, 0x81, 0x98, 0x16, 0xea,
+	0xe6, 0x2f, 0xf6, 0x01, 0xd0, 0x1c, 0xbe, 0xcb, 0xc5, 0xcc, 0xfe, 0xa6, 0xcf, 0x03, 0x49, 0x7d,
+	0x7f, 0x54, 0xef, 0xf7, 0x6d, 0x1e, 0x48, 0xbe, 0x19, 0xff, 0xf1, 0xe5, 0x2c, 0xd3, 0xf3, 0xd5,
+	0xc5, 0x38, 0x95, 0x8b, 0x57, 0x7a, 0xa5, 0xb2, 0x62, 0x46, 0x0f, 0xda, 0xc3, 0x2f, 0x0e, 0xbf,
+	0x08, 0xf1, 0x2b, 0xea, 0xac, 0x8b, 0x2d, 0xfa, 0xff, 0xe2, 0xcb, 0xff, 0x0e, 0x00, 0x58, 0x6f,
+	0x41, 0x61, 0xe9, 0x10, 0x00, 0x00,
 }
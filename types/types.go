@@ -548,17 +548,18 @@ func (b *Block) Clone() *Block {
 		return nil
 	}
 	return &Block{
-		Version:    b.Version,
-		ParentHash: b.ParentHash,
-		TxHash:     b.TxHash,
-		StateHash:  b.StateHash,
-		Height:     b.Height,
-		BlockTime:  b.BlockTime,
-		Difficulty: b.Difficulty,
-		MainHash:   b.MainHash,
-		MainHeight: b.MainHeight,
-		Signature:  b.Signature.Clone(),
-		Txs:        cloneTxs(b.Txs),
+		Version:        b.Version,
+		ParentHash:     b.ParentHash,
+		TxHash:         b.TxHash,
+		StateHash:      b.StateHash,
+		Height:         b.Height,
+		BlockTime:      b.BlockTime,
+		Difficulty:     b.Difficulty,
+		MainHash:       b.MainHash,
+		MainHeight:     b.MainHeight,
+		Signature:      b.Signature.Clone(),
+		Txs:            cloneTxs(b.Txs),
+		ConsensusProof: b.ConsensusProof,
 	}
 }
 
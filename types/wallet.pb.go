@@ -188,10 +188,14 @@ func (m *WalletTxDetails) GetTxDetails() []*WalletTxDetail {
 //	 addr :账户地址
 //	 timeStamp :创建账户时的时标
 type WalletAccountStore struct {
-	Privkey              string   `protobuf:"bytes,1,opt,name=privkey,proto3" json:"privkey,omitempty"`
-	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	TimeStamp            string   `protobuf:"bytes,4,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	Privkey   string `protobuf:"bytes,1,opt,name=privkey,proto3" json:"privkey,omitempty"`
+	Label     string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Addr      string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	TimeStamp string `protobuf:"bytes,4,opt,name=timeStamp,proto3" json:"timeStamp,omitempty"`
+	// 只能查看余额和交易, 没有私钥
+	WatchOnly bool `protobuf:"varint,5,opt,name=watchOnly,proto3" json:"watchOnly,omitempty"`
+	// 私钥保存在外部签名器中, 记录签名器名称
+	Signer               string   `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -250,6 +254,20 @@ func (m *WalletAccountStore) GetTimeStamp() string {
 	return ""
 }
 
+func (m *WalletAccountStore) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
+func (m *WalletAccountStore) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
 //钱包模块通过一个随机值对钱包密码加密
 // 	 pwHash : 对钱包密码和一个随机值组合进行哈希计算
 //	 randstr :对钱包密码加密的一个随机值
@@ -410,6 +428,7 @@ func (m *WalletAccounts) GetWallets() []*WalletAccount {
 type WalletAccount struct {
 	Acc                  *Account `protobuf:"bytes,1,opt,name=acc,proto3" json:"acc,omitempty"`
 	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	WatchOnly            bool     `protobuf:"varint,3,opt,name=watchOnly,proto3" json:"watchOnly,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -454,6 +473,13 @@ func (m *WalletAccount) GetLabel() string {
 	return ""
 }
 
+func (m *WalletAccount) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
 //钱包解锁
 // 	 passwd : 钱包密码
 //	 timeout :钱包解锁时间，0，一直解锁，非0值，超时之后继续锁定
@@ -915,6 +941,123 @@ func (m *ReqWalletImportPrivkey) GetLabel() string {
 	return ""
 }
 
+//导入只读地址
+// 	 addr : 只能查看余额和交易的地址
+//	 label :地址对应的标签
+type ReqWalletImportWatchOnly struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqWalletImportWatchOnly) Reset()         { *m = ReqWalletImportWatchOnly{} }
+func (m *ReqWalletImportWatchOnly) String() string { return proto.CompactTextString(m) }
+func (*ReqWalletImportWatchOnly) ProtoMessage()    {}
+func (*ReqWalletImportWatchOnly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{17}
+}
+
+func (m *ReqWalletImportWatchOnly) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqWalletImportWatchOnly.Unmarshal(m, b)
+}
+func (m *ReqWalletImportWatchOnly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqWalletImportWatchOnly.Marshal(b, m, deterministic)
+}
+func (m *ReqWalletImportWatchOnly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqWalletImportWatchOnly.Merge(m, src)
+}
+func (m *ReqWalletImportWatchOnly) XXX_Size() int {
+	return xxx_messageInfo_ReqWalletImportWatchOnly.Size(m)
+}
+func (m *ReqWalletImportWatchOnly) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqWalletImportWatchOnly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqWalletImportWatchOnly proto.InternalMessageInfo
+
+func (m *ReqWalletImportWatchOnly) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqWalletImportWatchOnly) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+//导入扩展公钥, 生成count 个只读地址, 标签为label-index
+// 	 xpub : 其他钱包export_xpub 导出的扩展公钥
+//	 start :开始的地址索引
+//	 count :生成的地址数量
+type ReqWalletImportXpub struct {
+	Xpub                 string   `protobuf:"bytes,1,opt,name=xpub,proto3" json:"xpub,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Start                int32    `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqWalletImportXpub) Reset()         { *m = ReqWalletImportXpub{} }
+func (m *ReqWalletImportXpub) String() string { return proto.CompactTextString(m) }
+func (*ReqWalletImportXpub) ProtoMessage()    {}
+func (*ReqWalletImportXpub) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{18}
+}
+
+func (m *ReqWalletImportXpub) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqWalletImportXpub.Unmarshal(m, b)
+}
+func (m *ReqWalletImportXpub) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqWalletImportXpub.Marshal(b, m, deterministic)
+}
+func (m *ReqWalletImportXpub) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqWalletImportXpub.Merge(m, src)
+}
+func (m *ReqWalletImportXpub) XXX_Size() int {
+	return xxx_messageInfo_ReqWalletImportXpub.Size(m)
+}
+func (m *ReqWalletImportXpub) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqWalletImportXpub.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqWalletImportXpub proto.InternalMessageInfo
+
+func (m *ReqWalletImportXpub) GetXpub() string {
+	if m != nil {
+		return m.Xpub
+	}
+	return ""
+}
+
+func (m *ReqWalletImportXpub) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ReqWalletImportXpub) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ReqWalletImportXpub) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 //发送交易
 // 	 from : 打出地址
 //	 to :接受地址
@@ -936,7 +1079,7 @@ func (m *ReqWalletSendToAddress) Reset()         { *m = ReqWalletSendToAddress{}
 func (m *ReqWalletSendToAddress) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletSendToAddress) ProtoMessage()    {}
 func (*ReqWalletSendToAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{17}
+	return fileDescriptor_b88fd140af4deb6f, []int{19}
 }
 
 func (m *ReqWalletSendToAddress) XXX_Unmarshal(b []byte) error {
@@ -1010,7 +1153,7 @@ func (m *ReqWalletSetFee) Reset()         { *m = ReqWalletSetFee{} }
 func (m *ReqWalletSetFee) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletSetFee) ProtoMessage()    {}
 func (*ReqWalletSetFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{18}
+	return fileDescriptor_b88fd140af4deb6f, []int{20}
 }
 
 func (m *ReqWalletSetFee) XXX_Unmarshal(b []byte) error {
@@ -1050,7 +1193,7 @@ func (m *ReqWalletSetLabel) Reset()         { *m = ReqWalletSetLabel{} }
 func (m *ReqWalletSetLabel) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletSetLabel) ProtoMessage()    {}
 func (*ReqWalletSetLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{19}
+	return fileDescriptor_b88fd140af4deb6f, []int{21}
 }
 
 func (m *ReqWalletSetLabel) XXX_Unmarshal(b []byte) error {
@@ -1096,7 +1239,7 @@ func (m *ReqWalletMergeBalance) Reset()         { *m = ReqWalletMergeBalance{} }
 func (m *ReqWalletMergeBalance) String() string { return proto.CompactTextString(m) }
 func (*ReqWalletMergeBalance) ProtoMessage()    {}
 func (*ReqWalletMergeBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{20}
+	return fileDescriptor_b88fd140af4deb6f, []int{22}
 }
 
 func (m *ReqWalletMergeBalance) XXX_Unmarshal(b []byte) error {
@@ -1141,7 +1284,7 @@ func (m *ReqTokenPreCreate) Reset()         { *m = ReqTokenPreCreate{} }
 func (m *ReqTokenPreCreate) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenPreCreate) ProtoMessage()    {}
 func (*ReqTokenPreCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{21}
+	return fileDescriptor_b88fd140af4deb6f, []int{23}
 }
 
 func (m *ReqTokenPreCreate) XXX_Unmarshal(b []byte) error {
@@ -1224,7 +1367,7 @@ func (m *ReqTokenFinishCreate) Reset()         { *m = ReqTokenFinishCreate{} }
 func (m *ReqTokenFinishCreate) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenFinishCreate) ProtoMessage()    {}
 func (*ReqTokenFinishCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{22}
+	return fileDescriptor_b88fd140af4deb6f, []int{24}
 }
 
 func (m *ReqTokenFinishCreate) XXX_Unmarshal(b []byte) error {
@@ -1279,7 +1422,7 @@ func (m *ReqTokenRevokeCreate) Reset()         { *m = ReqTokenRevokeCreate{} }
 func (m *ReqTokenRevokeCreate) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenRevokeCreate) ProtoMessage()    {}
 func (*ReqTokenRevokeCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{23}
+	return fileDescriptor_b88fd140af4deb6f, []int{25}
 }
 
 func (m *ReqTokenRevokeCreate) XXX_Unmarshal(b []byte) error {
@@ -1335,7 +1478,7 @@ func (m *ReqModifyConfig) Reset()         { *m = ReqModifyConfig{} }
 func (m *ReqModifyConfig) String() string { return proto.CompactTextString(m) }
 func (*ReqModifyConfig) ProtoMessage()    {}
 func (*ReqModifyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{24}
+	return fileDescriptor_b88fd140af4deb6f, []int{26}
 }
 
 func (m *ReqModifyConfig) XXX_Unmarshal(b []byte) error {
@@ -1407,7 +1550,7 @@ func (m *ReqSignRawTx) Reset()         { *m = ReqSignRawTx{} }
 func (m *ReqSignRawTx) String() string { return proto.CompactTextString(m) }
 func (*ReqSignRawTx) ProtoMessage()    {}
 func (*ReqSignRawTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{25}
+	return fileDescriptor_b88fd140af4deb6f, []int{27}
 }
 
 func (m *ReqSignRawTx) XXX_Unmarshal(b []byte) error {
@@ -1495,7 +1638,7 @@ func (m *ReplySignRawTx) Reset()         { *m = ReplySignRawTx{} }
 func (m *ReplySignRawTx) String() string { return proto.CompactTextString(m) }
 func (*ReplySignRawTx) ProtoMessage()    {}
 func (*ReplySignRawTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{26}
+	return fileDescriptor_b88fd140af4deb6f, []int{28}
 }
 
 func (m *ReplySignRawTx) XXX_Unmarshal(b []byte) error {
@@ -1536,7 +1679,7 @@ func (m *ReportErrEvent) Reset()         { *m = ReportErrEvent{} }
 func (m *ReportErrEvent) String() string { return proto.CompactTextString(m) }
 func (*ReportErrEvent) ProtoMessage()    {}
 func (*ReportErrEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{27}
+	return fileDescriptor_b88fd140af4deb6f, []int{29}
 }
 
 func (m *ReportErrEvent) XXX_Unmarshal(b []byte) error {
@@ -1589,7 +1732,7 @@ func (m *Int32) Reset()         { *m = Int32{} }
 func (m *Int32) String() string { return proto.CompactTextString(m) }
 func (*Int32) ProtoMessage()    {}
 func (*Int32) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{28}
+	return fileDescriptor_b88fd140af4deb6f, []int{30}
 }
 
 func (m *Int32) XXX_Unmarshal(b []byte) error {
@@ -1628,7 +1771,7 @@ func (m *ReqAccountList) Reset()         { *m = ReqAccountList{} }
 func (m *ReqAccountList) String() string { return proto.CompactTextString(m) }
 func (*ReqAccountList) ProtoMessage()    {}
 func (*ReqAccountList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{29}
+	return fileDescriptor_b88fd140af4deb6f, []int{31}
 }
 
 func (m *ReqAccountList) XXX_Unmarshal(b []byte) error {
@@ -1668,7 +1811,7 @@ func (m *ReqPrivkeysFile) Reset()         { *m = ReqPrivkeysFile{} }
 func (m *ReqPrivkeysFile) String() string { return proto.CompactTextString(m) }
 func (*ReqPrivkeysFile) ProtoMessage()    {}
 func (*ReqPrivkeysFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b88fd140af4deb6f, []int{30}
+	return fileDescriptor_b88fd140af4deb6f, []int{32}
 }
 
 func (m *ReqPrivkeysFile) XXX_Unmarshal(b []byte) error {
@@ -1703,6 +1846,276 @@ func (m *ReqPrivkeysFile) GetPasswd() string {
 	return ""
 }
 
+// ReqCreatePartialTx 创建部分签名交易, signers 按交易组中的顺序指定每笔交易的签名地址
+type ReqCreatePartialTx struct {
+	TxHex                string   `protobuf:"bytes,1,opt,name=txHex,proto3" json:"txHex,omitempty"`
+	Signers              []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	Expire               string   `protobuf:"bytes,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	Fee                  int64    `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCreatePartialTx) Reset()         { *m = ReqCreatePartialTx{} }
+func (m *ReqCreatePartialTx) String() string { return proto.CompactTextString(m) }
+func (*ReqCreatePartialTx) ProtoMessage()    {}
+func (*ReqCreatePartialTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{33}
+}
+
+func (m *ReqCreatePartialTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCreatePartialTx.Unmarshal(m, b)
+}
+func (m *ReqCreatePartialTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCreatePartialTx.Marshal(b, m, deterministic)
+}
+func (m *ReqCreatePartialTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCreatePartialTx.Merge(m, src)
+}
+func (m *ReqCreatePartialTx) XXX_Size() int {
+	return xxx_messageInfo_ReqCreatePartialTx.Size(m)
+}
+func (m *ReqCreatePartialTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCreatePartialTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCreatePartialTx proto.InternalMessageInfo
+
+func (m *ReqCreatePartialTx) GetTxHex() string {
+	if m != nil {
+		return m.TxHex
+	}
+	return ""
+}
+
+func (m *ReqCreatePartialTx) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *ReqCreatePartialTx) GetExpire() string {
+	if m != nil {
+		return m.Expire
+	}
+	return ""
+}
+
+func (m *ReqCreatePartialTx) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+// ReqSignPartialTx 对部分签名交易签名, addr 和privkey 都为空时使用钱包中所有匹配的地址签名
+type ReqSignPartialTx struct {
+	Data                 string   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Privkey              string   `protobuf:"bytes,3,opt,name=privkey,proto3" json:"privkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqSignPartialTx) Reset()         { *m = ReqSignPartialTx{} }
+func (m *ReqSignPartialTx) String() string { return proto.CompactTextString(m) }
+func (*ReqSignPartialTx) ProtoMessage()    {}
+func (*ReqSignPartialTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{34}
+}
+
+func (m *ReqSignPartialTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqSignPartialTx.Unmarshal(m, b)
+}
+func (m *ReqSignPartialTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqSignPartialTx.Marshal(b, m, deterministic)
+}
+func (m *ReqSignPartialTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqSignPartialTx.Merge(m, src)
+}
+func (m *ReqSignPartialTx) XXX_Size() int {
+	return xxx_messageInfo_ReqSignPartialTx.Size(m)
+}
+func (m *ReqSignPartialTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqSignPartialTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqSignPartialTx proto.InternalMessageInfo
+
+func (m *ReqSignPartialTx) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ReqSignPartialTx) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqSignPartialTx) GetPrivkey() string {
+	if m != nil {
+		return m.Privkey
+	}
+	return ""
+}
+
+// ReqCombinePartialTx 合并多个签名方返回的同一部分签名交易
+type ReqCombinePartialTx struct {
+	Datas                []string `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCombinePartialTx) Reset()         { *m = ReqCombinePartialTx{} }
+func (m *ReqCombinePartialTx) String() string { return proto.CompactTextString(m) }
+func (*ReqCombinePartialTx) ProtoMessage()    {}
+func (*ReqCombinePartialTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{35}
+}
+
+func (m *ReqCombinePartialTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCombinePartialTx.Unmarshal(m, b)
+}
+func (m *ReqCombinePartialTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCombinePartialTx.Marshal(b, m, deterministic)
+}
+func (m *ReqCombinePartialTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCombinePartialTx.Merge(m, src)
+}
+func (m *ReqCombinePartialTx) XXX_Size() int {
+	return xxx_messageInfo_ReqCombinePartialTx.Size(m)
+}
+func (m *ReqCombinePartialTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCombinePartialTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCombinePartialTx proto.InternalMessageInfo
+
+func (m *ReqCombinePartialTx) GetDatas() []string {
+	if m != nil {
+		return m.Datas
+	}
+	return nil
+}
+
+type PartialSignerStatus struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Signed               bool     `protobuf:"varint,3,opt,name=signed,proto3" json:"signed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartialSignerStatus) Reset()         { *m = PartialSignerStatus{} }
+func (m *PartialSignerStatus) String() string { return proto.CompactTextString(m) }
+func (*PartialSignerStatus) ProtoMessage()    {}
+func (*PartialSignerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{36}
+}
+
+func (m *PartialSignerStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartialSignerStatus.Unmarshal(m, b)
+}
+func (m *PartialSignerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartialSignerStatus.Marshal(b, m, deterministic)
+}
+func (m *PartialSignerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialSignerStatus.Merge(m, src)
+}
+func (m *PartialSignerStatus) XXX_Size() int {
+	return xxx_messageInfo_PartialSignerStatus.Size(m)
+}
+func (m *PartialSignerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialSignerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialSignerStatus proto.InternalMessageInfo
+
+func (m *PartialSignerStatus) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PartialSignerStatus) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *PartialSignerStatus) GetSigned() bool {
+	if m != nil {
+		return m.Signed
+	}
+	return false
+}
+
+type ReplyPartialTxStatus struct {
+	TxHash               string                 `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Signers              []*PartialSignerStatus `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+	Complete             bool                   `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ReplyPartialTxStatus) Reset()         { *m = ReplyPartialTxStatus{} }
+func (m *ReplyPartialTxStatus) String() string { return proto.CompactTextString(m) }
+func (*ReplyPartialTxStatus) ProtoMessage()    {}
+func (*ReplyPartialTxStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b88fd140af4deb6f, []int{37}
+}
+
+func (m *ReplyPartialTxStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyPartialTxStatus.Unmarshal(m, b)
+}
+func (m *ReplyPartialTxStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyPartialTxStatus.Marshal(b, m, deterministic)
+}
+func (m *ReplyPartialTxStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyPartialTxStatus.Merge(m, src)
+}
+func (m *ReplyPartialTxStatus) XXX_Size() int {
+	return xxx_messageInfo_ReplyPartialTxStatus.Size(m)
+}
+func (m *ReplyPartialTxStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyPartialTxStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyPartialTxStatus proto.InternalMessageInfo
+
+func (m *ReplyPartialTxStatus) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ReplyPartialTxStatus) GetSigners() []*PartialSignerStatus {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *ReplyPartialTxStatus) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*WalletTxDetail)(nil), "types.WalletTxDetail")
 	proto.RegisterType((*WalletTxDetails)(nil), "types.WalletTxDetails")
@@ -1721,6 +2134,8 @@ func init() {
 	proto.RegisterType((*ReqGetAccount)(nil), "types.ReqGetAccount")
 	proto.RegisterType((*ReqWalletTransactionList)(nil), "types.ReqWalletTransactionList")
 	proto.RegisterType((*ReqWalletImportPrivkey)(nil), "types.ReqWalletImportPrivkey")
+	proto.RegisterType((*ReqWalletImportWatchOnly)(nil), "types.ReqWalletImportWatchOnly")
+	proto.RegisterType((*ReqWalletImportXpub)(nil), "types.ReqWalletImportXpub")
 	proto.RegisterType((*ReqWalletSendToAddress)(nil), "types.ReqWalletSendToAddress")
 	proto.RegisterType((*ReqWalletSetFee)(nil), "types.ReqWalletSetFee")
 	proto.RegisterType((*ReqWalletSetLabel)(nil), "types.ReqWalletSetLabel")
@@ -1735,6 +2150,11 @@ func init() {
 	proto.RegisterType((*Int32)(nil), "types.Int32")
 	proto.RegisterType((*ReqAccountList)(nil), "types.ReqAccountList")
 	proto.RegisterType((*ReqPrivkeysFile)(nil), "types.ReqPrivkeysFile")
+	proto.RegisterType((*ReqCreatePartialTx)(nil), "types.ReqCreatePartialTx")
+	proto.RegisterType((*ReqSignPartialTx)(nil), "types.ReqSignPartialTx")
+	proto.RegisterType((*ReqCombinePartialTx)(nil), "types.ReqCombinePartialTx")
+	proto.RegisterType((*PartialSignerStatus)(nil), "types.PartialSignerStatus")
+	proto.RegisterType((*ReplyPartialTxStatus)(nil), "types.ReplyPartialTxStatus")
 }
 
 func init() {
@@ -1742,83 +2162,97 @@ func init() {
 }
 
 var fileDescriptor_b88fd140af4deb6f = []byte{
-	// 1234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5d, 0x6e, 0x1b, 0x37,
-	0x10, 0xc6, 0x4a, 0x96, 0x6d, 0xd1, 0xb2, 0x93, 0x10, 0x49, 0x20, 0xa4, 0x4d, 0xa3, 0xb0, 0x48,
-	0xea, 0x02, 0x85, 0x03, 0x44, 0x2f, 0x45, 0x81, 0x02, 0x71, 0x7e, 0x1c, 0x07, 0x70, 0x52, 0x83,
-	0x52, 0x51, 0xa0, 0x2f, 0x05, 0xbd, 0x3b, 0x92, 0x08, 0xaf, 0xc8, 0x35, 0x97, 0xb2, 0xa4, 0x9b,
-	0xf4, 0x00, 0x3d, 0x42, 0xcf, 0xd0, 0xf7, 0xde, 0xa8, 0xe0, 0x90, 0xd4, 0xee, 0xba, 0xce, 0x43,
-	0xd1, 0x37, 0x7e, 0xc3, 0xe1, 0xfc, 0x7c, 0xc3, 0x19, 0x92, 0xf4, 0x96, 0x22, 0xcf, 0xc1, 0x1e,
-	0x15, 0x46, 0x5b, 0x4d, 0x3b, 0x76, 0x5d, 0x40, 0xf9, 0xe8, 0x9e, 0x35, 0x42, 0x95, 0x22, 0xb5,
-	0x52, 0x2b, 0xbf, 0xf3, 0x68, 0x5f, 0xa4, 0xa9, 0x5e, 0xa8, 0xa0, 0xc8, 0xfe, 0x6c, 0x91, 0x83,
-	0x5f, 0xf0, 0xe4, 0x78, 0xf5, 0x16, 0xac, 0x90, 0x39, 0x65, 0xa4, 0x65, 0x57, 0xfd, 0x64, 0x90,
-	0x1c, 0xee, 0xbd, 0xa4, 0x47, 0x68, 0xe8, 0x68, 0x5c, 0xd9, 0xe1, 0x2d, 0xbb, 0xa2, 0xdf, 0x91,
-	0x1d, 0x03, 0x29, 0xc8, 0xc2, 0xf6, 0x5b, 0x0d, 0x45, 0xee, 0xa5, 0x6f, 0x85, 0x15, 0x3c, 0xaa,
-	0xd0, 0x87, 0x64, 0x7b, 0x06, 0x72, 0x3a, 0xb3, 0xfd, 0xf6, 0x20, 0x39, 0x6c, 0xf3, 0x80, 0xe8,
-	0x7d, 0xd2, 0x91, 0x2a, 0x83, 0x55, 0x7f, 0x0b, 0xc5, 0x1e, 0xd0, 0x2f, 0x49, 0xf7, 0x22, 0xd7,
-	0xe9, 0xa5, 0x95, 0x73, 0xe8, 0x77, 0x70, 0xa7, 0x12, 0x38, 0x5b, 0x62, 0xee, 0x12, 0xe8, 0x6f,
-	0x7b, 0x5b, 0x1e, 0xd1, 0x47, 0x64, 0x77, 0x62, 0xf4, 0x5c, 0x64, 0x99, 0xe9, 0xef, 0x0c, 0x92,
-	0xc3, 0x2e, 0xdf, 0x60, 0x77, 0xc6, 0xae, 0x66, 0xa2, 0x9c, 0xf5, 0x77, 0x07, 0xc9, 0x61, 0x8f,
-	0x07, 0x44, 0xbf, 0x22, 0xc4, 0xe7, 0xf4, 0x49, 0xcc, 0xa1, 0xdf, 0xc5, 0x53, 0x35, 0x09, 0xed,
-	0x93, 0x9d, 0x42, 0xac, 0x73, 0x2d, 0xb2, 0x3e, 0xc1, 0x83, 0x11, 0xb2, 0x13, 0x72, 0xa7, 0xc9,
-	0x5a, 0x49, 0x87, 0xa4, 0x6b, 0x23, 0xe8, 0x27, 0x83, 0xf6, 0xe1, 0xde, 0xcb, 0x07, 0x81, 0x94,
-	0xa6, 0x2a, 0xaf, 0xf4, 0xd8, 0x35, 0xa1, 0x7e, 0xf3, 0xd8, 0x57, 0x65, 0x64, 0xb5, 0xf1, 0x7e,
-	0x8d, 0xbc, 0xbe, 0x84, 0x35, 0x96, 0xa1, 0xcb, 0x23, 0x74, 0x8c, 0xe5, 0xe2, 0x02, 0x72, 0x64,
-	0xbd, 0xcb, 0x3d, 0xa0, 0x94, 0x6c, 0x61, 0xde, 0x6d, 0x14, 0xe2, 0xda, 0xb1, 0xe8, 0xf8, 0x1a,
-	0x59, 0x31, 0x2f, 0x90, 0xdf, 0x2e, 0xaf, 0x04, 0xec, 0x15, 0xe9, 0x79, 0xbf, 0xe7, 0xcb, 0x53,
-	0xc7, 0xc4, 0x43, 0xb2, 0x5d, 0xe0, 0x0a, 0x1d, 0xf6, 0x78, 0x40, 0x2e, 0x12, 0x23, 0x54, 0x56,
-	0x5a, 0x13, 0x3c, 0x46, 0xc8, 0x7e, 0x4f, 0xa2, 0x89, 0x91, 0x15, 0x76, 0x51, 0x52, 0x46, 0x7a,
-	0xb2, 0xf4, 0x92, 0x33, 0x9d, 0x5e, 0xa2, 0xa1, 0x5d, 0xde, 0x90, 0x79, 0x9d, 0xe3, 0x85, 0xd5,
-	0x1f, 0xa5, 0x92, 0x6a, 0x8a, 0x36, 0x51, 0xa7, 0x92, 0xb9, 0xc0, 0x65, 0x79, 0x2a, 0xca, 0x11,
-	0x40, 0x86, 0x19, 0xed, 0xf2, 0x4a, 0xe0, 0x2d, 0x8c, 0x65, 0x7a, 0x19, 0xbc, 0x6c, 0x45, 0x0b,
-	0x95, 0x8c, 0xbd, 0x8a, 0x57, 0x3a, 0x90, 0x5a, 0xd2, 0x23, 0xb2, 0xe3, 0xdb, 0x23, 0x56, 0xe6,
-	0x7e, 0xa3, 0x32, 0x41, 0x8f, 0x47, 0x25, 0xf6, 0x9e, 0xec, 0x37, 0x76, 0xe8, 0x80, 0xb4, 0x45,
-	0x9a, 0x86, 0xa6, 0x38, 0x08, 0x87, 0xe3, 0x31, 0xb7, 0x75, 0x7b, 0x65, 0xd8, 0x2c, 0x92, 0xf4,
-	0xb3, 0x42, 0x02, 0x1c, 0xcf, 0xa2, 0x2c, 0x97, 0x59, 0x28, 0x6c, 0x40, 0x8e, 0x67, 0x57, 0x1c,
-	0xbd, 0xf0, 0xfd, 0xd4, 0xe6, 0x11, 0xd2, 0xe7, 0xe4, 0xc0, 0x47, 0xf5, 0x93, 0xf1, 0x29, 0x06,
-	0x4e, 0x6e, 0x48, 0xd9, 0x53, 0xb2, 0xf7, 0x1e, 0x94, 0xe3, 0xe8, 0x4c, 0xa8, 0xa9, 0xbb, 0x12,
-	0xb9, 0x50, 0x53, 0x74, 0xd3, 0xe1, 0xb8, 0x66, 0xcf, 0x9c, 0x8a, 0x75, 0x2a, 0xaf, 0xd7, 0xe7,
-	0xcb, 0xcf, 0xc5, 0xc2, 0x7e, 0x20, 0xbd, 0x91, 0xb8, 0x86, 0x8d, 0x1e, 0x25, 0x5b, 0xa5, 0xab,
-	0x85, 0xd7, 0xc2, 0x75, 0xed, 0x6c, 0xab, 0x71, 0xf6, 0x09, 0xe9, 0x72, 0x28, 0xf2, 0x35, 0xd6,
-	0xea, 0x96, 0x83, 0xec, 0x94, 0x50, 0x0e, 0x57, 0xe1, 0xe2, 0x80, 0x3d, 0xdf, 0xa4, 0xaf, 0xf3,
-	0xcc, 0x81, 0x78, 0xe1, 0x03, 0x74, 0x3b, 0x0a, 0x96, 0xb8, 0x13, 0x2e, 0x60, 0x80, 0xec, 0x19,
-	0xd9, 0xe7, 0x70, 0xf5, 0x09, 0x96, 0xb1, 0x46, 0x9b, 0x0a, 0x24, 0xf5, 0x0a, 0x78, 0xb5, 0xf7,
-	0x55, 0x29, 0x6f, 0x57, 0x9b, 0x90, 0xfe, 0x26, 0xae, 0xda, 0xb0, 0x3b, 0x93, 0x25, 0x8e, 0x2f,
-	0x37, 0x4a, 0xc6, 0xab, 0xd8, 0x1c, 0x1e, 0x39, 0x4b, 0x68, 0x12, 0x23, 0xeb, 0x70, 0x0f, 0xdc,
-	0xfd, 0xcd, 0xa4, 0x01, 0x3c, 0x8e, 0xb5, 0xea, 0xf0, 0x4a, 0xc0, 0x4e, 0xc9, 0xc3, 0x8d, 0x9f,
-	0x0f, 0xf3, 0x42, 0x1b, 0x7b, 0x1e, 0x5a, 0xfb, 0x3f, 0x36, 0x3d, 0xfb, 0x23, 0xa9, 0x99, 0x1a,
-	0x81, 0xca, 0xc6, 0xfa, 0x38, 0xcb, 0x0c, 0x94, 0xa5, 0x23, 0xde, 0x85, 0x18, 0x89, 0x77, 0x6b,
-	0x7a, 0x40, 0x5a, 0x56, 0x07, 0x0b, 0x2d, 0xab, 0x6b, 0x73, 0xb4, 0xdd, 0x98, 0xa3, 0x94, 0x6c,
-	0x29, 0x6d, 0x21, 0x8c, 0x0c, 0x5c, 0xbb, 0xd0, 0x64, 0x39, 0xd6, 0x97, 0xa0, 0x70, 0x1e, 0xef,
-	0xf2, 0x08, 0xe9, 0x80, 0xec, 0x59, 0xb7, 0x18, 0xad, 0xe7, 0x17, 0x3a, 0xc7, 0x91, 0xdc, 0xe5,
-	0x75, 0x11, 0xfb, 0x96, 0xdc, 0xa9, 0x17, 0xfc, 0x04, 0xea, 0x23, 0x3c, 0xa9, 0xbb, 0x66, 0x3f,
-	0x92, 0x7b, 0x75, 0xd5, 0xb3, 0xc6, 0x6c, 0x4b, 0x6a, 0xb3, 0xed, 0x76, 0x42, 0xbe, 0x21, 0x0f,
-	0x36, 0xc7, 0x3f, 0x82, 0x99, 0xc2, 0x6b, 0x91, 0x0b, 0x95, 0x42, 0x48, 0x3d, 0x89, 0xa9, 0xb3,
-	0xbf, 0x13, 0x74, 0x84, 0x19, 0x9c, 0x1b, 0x78, 0x63, 0x40, 0x58, 0xa0, 0x4f, 0x49, 0x2f, 0x75,
-	0x2b, 0x6d, 0x7e, 0xab, 0x39, 0xdc, 0x0b, 0x32, 0x47, 0x2d, 0x72, 0xe3, 0x5e, 0x8a, 0x56, 0xe0,
-	0x46, 0xf8, 0xf7, 0xa8, 0xf4, 0xc9, 0xfb, 0xe9, 0x1b, 0x10, 0x0e, 0x2a, 0x65, 0x8d, 0xce, 0x16,
-	0xfe, 0x26, 0x78, 0x3e, 0x1b, 0x32, 0xfa, 0x98, 0x10, 0xbd, 0x54, 0x10, 0x1c, 0x76, 0xfc, 0x90,
-	0x46, 0xc9, 0x71, 0x48, 0xd3, 0x6a, 0x2b, 0xf2, 0xf0, 0xd2, 0x79, 0xe0, 0xa4, 0x85, 0x91, 0x29,
-	0xe0, 0x2b, 0xd7, 0xe6, 0x1e, 0x30, 0x43, 0xee, 0xc7, 0x94, 0x4e, 0xa4, 0x92, 0xe5, 0x2c, 0x64,
-	0xf5, 0x35, 0xd9, 0x9f, 0x20, 0x86, 0x46, 0x5a, 0xbd, 0x28, 0x3c, 0x0e, 0xef, 0x63, 0xc8, 0xa1,
-	0xd5, 0xc8, 0xa1, 0x19, 0x5f, 0xfb, 0x46, 0x7c, 0xac, 0xa8, 0x7c, 0x72, 0xb8, 0xd6, 0x97, 0x35,
-	0x26, 0x0d, 0xe2, 0x26, 0x93, 0x41, 0xf6, 0x7f, 0x3c, 0x02, 0x5e, 0xa6, 0x8f, 0x3a, 0x93, 0x93,
-	0xf5, 0x1b, 0xad, 0x26, 0x72, 0x4a, 0xef, 0x92, 0x76, 0xd5, 0x32, 0x6e, 0xe9, 0xca, 0xad, 0x8b,
-	0x78, 0xd3, 0x75, 0xe1, 0x08, 0xbb, 0x16, 0xf9, 0x02, 0x82, 0x39, 0x0f, 0xdc, 0x7f, 0x61, 0xee,
-	0xec, 0x48, 0x30, 0xa1, 0x36, 0x1b, 0xcc, 0xfe, 0x4a, 0x48, 0x8f, 0xc3, 0xd5, 0x48, 0x4e, 0x15,
-	0x17, 0xcb, 0xf1, 0xea, 0xd6, 0x4b, 0x58, 0xeb, 0xd7, 0xd6, 0xbf, 0xfa, 0xd5, 0xae, 0x4e, 0x61,
-	0x15, 0x1d, 0x22, 0x70, 0x29, 0xc3, 0xaa, 0x90, 0x26, 0xb6, 0x56, 0x40, 0xd5, 0x27, 0xa8, 0xe3,
-	0xa7, 0x88, 0xff, 0x04, 0x61, 0xed, 0x5d, 0xc3, 0xed, 0x04, 0x1b, 0xd8, 0x6e, 0x77, 0x49, 0x7b,
-	0x02, 0x80, 0xbf, 0x98, 0x36, 0x77, 0x4b, 0x37, 0x6d, 0x14, 0x2c, 0x7d, 0xeb, 0xe3, 0x27, 0xa5,
-	0xcb, 0x2b, 0x01, 0x7b, 0x4e, 0x0e, 0xfc, 0x38, 0xde, 0x64, 0xb2, 0x89, 0x2d, 0xa9, 0xc5, 0xc6,
-	0x2e, 0x50, 0x4f, 0x1b, 0xfb, 0xce, 0x98, 0x77, 0xd7, 0xa0, 0xac, 0xfb, 0x1a, 0xb9, 0xb1, 0x31,
-	0xd7, 0xd9, 0x22, 0x87, 0xa0, 0x5c, 0x93, 0x38, 0xfa, 0xac, 0x0e, 0xbb, 0x3e, 0xfd, 0x0d, 0x76,
-	0x3e, 0xc0, 0x18, 0x1d, 0xeb, 0xe7, 0x01, 0xfb, 0x82, 0x74, 0x3e, 0x28, 0x3b, 0x7c, 0xe9, 0xc8,
-	0xcc, 0x84, 0x15, 0xf1, 0x69, 0x72, 0x6b, 0xf6, 0xbd, 0x0b, 0xe0, 0x2a, 0x8c, 0x68, 0x1c, 0xba,
-	0xee, 0xdd, 0x93, 0x76, 0xa6, 0x17, 0x36, 0xb4, 0x71, 0xf8, 0x50, 0xdc, 0x90, 0xb2, 0x77, 0x78,
-	0x25, 0xc2, 0x10, 0x2d, 0x4f, 0xa4, 0x8f, 0x6d, 0x22, 0x73, 0xc0, 0x4f, 0x5d, 0x12, 0xbe, 0x82,
-	0x01, 0x7f, 0xee, 0xe1, 0x7a, 0xfd, 0xe4, 0xd7, 0xc7, 0x53, 0x69, 0x67, 0x8b, 0x8b, 0xa3, 0x54,
-	0xcf, 0x5f, 0x0c, 0x87, 0xa9, 0x7a, 0x91, 0xce, 0x84, 0x54, 0xc3, 0xe1, 0x0b, 0x7c, 0xec, 0x2f,
-	0xb6, 0xf1, 0xbf, 0x3c, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x15, 0xd2, 0xd3, 0x68, 0x0b,
-	0x00, 0x00,
+	// 1457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6e, 0x1b, 0xb7,
+	0x13, 0xc7, 0x6a, 0x2d, 0xdb, 0xa2, 0x65, 0x27, 0xd9, 0x38, 0xc1, 0xc2, 0xff, 0x8f, 0x3a, 0x2c,
+	0x92, 0xba, 0x68, 0xe0, 0x04, 0x4e, 0x0f, 0x45, 0x81, 0x02, 0x71, 0x3e, 0x1c, 0x07, 0x70, 0x12,
+	0x83, 0x52, 0x91, 0xa2, 0x97, 0x82, 0xda, 0xa5, 0x25, 0xc2, 0xab, 0xe5, 0x9a, 0x4b, 0x59, 0xd2,
+	0xad, 0x8f, 0xd1, 0x07, 0xe8, 0xb1, 0xc7, 0x3e, 0x43, 0xef, 0x7d, 0xa3, 0x62, 0xc8, 0xe1, 0x7e,
+	0xb8, 0x76, 0x8b, 0xa2, 0x37, 0xfe, 0x66, 0x87, 0xf3, 0xf1, 0x9b, 0xe1, 0x90, 0x4b, 0xfa, 0x73,
+	0x9e, 0x65, 0xc2, 0xec, 0x17, 0x5a, 0x19, 0x15, 0x75, 0xcd, 0xb2, 0x10, 0xe5, 0xce, 0x1d, 0xa3,
+	0x79, 0x5e, 0xf2, 0xc4, 0x48, 0x95, 0xbb, 0x2f, 0x3b, 0x9b, 0x3c, 0x49, 0xd4, 0x2c, 0x47, 0x45,
+	0xfa, 0x6b, 0x87, 0x6c, 0x7d, 0xb4, 0x3b, 0x87, 0x8b, 0x57, 0xc2, 0x70, 0x99, 0x45, 0x94, 0x74,
+	0xcc, 0x22, 0x0e, 0x76, 0x83, 0xbd, 0x8d, 0x83, 0x68, 0xdf, 0x1a, 0xda, 0x1f, 0xd6, 0x76, 0x58,
+	0xc7, 0x2c, 0xa2, 0xc7, 0x64, 0x4d, 0x8b, 0x44, 0xc8, 0xc2, 0xc4, 0x9d, 0x96, 0x22, 0x73, 0xd2,
+	0x57, 0xdc, 0x70, 0xe6, 0x55, 0xa2, 0xfb, 0x64, 0x75, 0x22, 0xe4, 0x78, 0x62, 0xe2, 0x70, 0x37,
+	0xd8, 0x0b, 0x19, 0xa2, 0x68, 0x9b, 0x74, 0x65, 0x9e, 0x8a, 0x45, 0xbc, 0x62, 0xc5, 0x0e, 0x44,
+	0xff, 0x25, 0xbd, 0x51, 0xa6, 0x92, 0x73, 0x23, 0xa7, 0x22, 0xee, 0xda, 0x2f, 0xb5, 0x00, 0x6c,
+	0xf1, 0x29, 0x24, 0x10, 0xaf, 0x3a, 0x5b, 0x0e, 0x45, 0x3b, 0x64, 0xfd, 0x4c, 0xab, 0x29, 0x4f,
+	0x53, 0x1d, 0xaf, 0xed, 0x06, 0x7b, 0x3d, 0x56, 0x61, 0xd8, 0x63, 0x16, 0x13, 0x5e, 0x4e, 0xe2,
+	0xf5, 0xdd, 0x60, 0xaf, 0xcf, 0x10, 0x45, 0xff, 0x27, 0xc4, 0xe5, 0xf4, 0x9e, 0x4f, 0x45, 0xdc,
+	0xb3, 0xbb, 0x1a, 0x92, 0x28, 0x26, 0x6b, 0x05, 0x5f, 0x66, 0x8a, 0xa7, 0x31, 0xb1, 0x1b, 0x3d,
+	0xa4, 0x47, 0xe4, 0x56, 0x9b, 0xb5, 0x32, 0x7a, 0x46, 0x7a, 0xc6, 0x83, 0x38, 0xd8, 0x0d, 0xf7,
+	0x36, 0x0e, 0xee, 0x21, 0x29, 0x6d, 0x55, 0x56, 0xeb, 0xd1, 0x5f, 0x02, 0x12, 0xb9, 0xaf, 0x87,
+	0xae, 0x2c, 0x03, 0xa3, 0xb4, 0x73, 0xac, 0xe5, 0xe5, 0xb9, 0x58, 0xda, 0x3a, 0xf4, 0x98, 0x87,
+	0x40, 0x59, 0xc6, 0x47, 0x22, 0xb3, 0xb4, 0xf7, 0x98, 0x03, 0x51, 0x44, 0x56, 0x6c, 0xe2, 0xa1,
+	0x15, 0xda, 0x35, 0xd0, 0x08, 0x84, 0x0d, 0x0c, 0x9f, 0x16, 0x96, 0xe0, 0x1e, 0xab, 0x05, 0xf0,
+	0x75, 0xce, 0x4d, 0x32, 0xf9, 0x90, 0x67, 0x4b, 0x4b, 0xf2, 0x3a, 0xab, 0x05, 0x40, 0x58, 0x29,
+	0xc7, 0xb9, 0xd0, 0x96, 0xe4, 0x1e, 0x43, 0x44, 0x9f, 0x93, 0xbe, 0x8b, 0xf6, 0x74, 0x7e, 0x0c,
+	0x04, 0xde, 0x27, 0xab, 0x85, 0x5d, 0xd9, 0x30, 0xfb, 0x0c, 0x11, 0xc4, 0xaf, 0x79, 0x9e, 0x96,
+	0x46, 0x63, 0x9c, 0x1e, 0xd2, 0x9f, 0x02, 0x6f, 0x62, 0x60, 0xb8, 0x99, 0x95, 0x11, 0x25, 0x7d,
+	0x59, 0x3a, 0xc9, 0x89, 0x4a, 0xce, 0xad, 0xa1, 0x75, 0xd6, 0x92, 0x39, 0x9d, 0xc3, 0x99, 0x51,
+	0xef, 0x64, 0x2e, 0xf3, 0x71, 0xdc, 0xf1, 0x3a, 0xb5, 0x0c, 0x12, 0x92, 0xe5, 0x31, 0x2f, 0x07,
+	0x42, 0xa4, 0x96, 0x87, 0x75, 0x56, 0x0b, 0x9c, 0x85, 0xa1, 0x4c, 0xce, 0xd1, 0xcb, 0x8a, 0xb7,
+	0x50, 0xcb, 0xe8, 0x73, 0xb2, 0xd5, 0x2a, 0x45, 0x19, 0xed, 0x93, 0x35, 0x77, 0xaa, 0x7c, 0x41,
+	0xb7, 0x5b, 0x05, 0x45, 0x3d, 0xe6, 0x95, 0xa8, 0x20, 0x9b, 0xad, 0x2f, 0xd1, 0x2e, 0x09, 0x79,
+	0x92, 0xe0, 0x59, 0xda, 0xc2, 0xcd, 0x7e, 0x1b, 0x7c, 0xba, 0xa1, 0x9e, 0xad, 0xea, 0x84, 0x57,
+	0xaa, 0x43, 0x27, 0x9e, 0xc2, 0x6f, 0x73, 0x4b, 0x0f, 0x54, 0x81, 0x97, 0xe5, 0x3c, 0xc5, 0x66,
+	0x41, 0x04, 0x55, 0x80, 0x82, 0xab, 0x99, 0x3b, 0xa4, 0x21, 0xf3, 0x30, 0x7a, 0x44, 0xb6, 0x5c,
+	0xcc, 0x1f, 0xb4, 0x23, 0x00, 0x9d, 0x5c, 0x91, 0xd2, 0x07, 0x64, 0xe3, 0x8d, 0xc8, 0x81, 0xc1,
+	0x13, 0x9e, 0x8f, 0xa1, 0xcd, 0x32, 0x9e, 0x8f, 0xad, 0x9b, 0x2e, 0xb3, 0x6b, 0xfa, 0x10, 0x54,
+	0x0c, 0xa8, 0xbc, 0x58, 0x9e, 0xce, 0x6f, 0x8a, 0x85, 0x7e, 0x4d, 0xfa, 0x03, 0x7e, 0x29, 0x2a,
+	0xbd, 0x88, 0xac, 0x94, 0x42, 0x78, 0x2d, 0xbb, 0x6e, 0xec, 0xed, 0xb4, 0xf6, 0x7e, 0x42, 0x7a,
+	0x4c, 0x14, 0xd9, 0xd2, 0x56, 0xf2, 0x9a, 0x8d, 0xf4, 0x98, 0x44, 0x4c, 0x5c, 0x60, 0x5b, 0x09,
+	0x73, 0x5a, 0xa5, 0xaf, 0xb2, 0x14, 0x80, 0x3f, 0x44, 0x08, 0xe1, 0x4b, 0x2e, 0xe6, 0xf6, 0x0b,
+	0xb6, 0x27, 0x42, 0xfa, 0x90, 0x6c, 0x32, 0x71, 0xf1, 0x5e, 0xcc, 0x7d, 0x05, 0xab, 0xfa, 0x04,
+	0x8d, 0xfa, 0xa0, 0xda, 0x1b, 0x61, 0xfe, 0x5a, 0xed, 0x8c, 0xc4, 0x55, 0x5c, 0x8d, 0x09, 0x7a,
+	0x22, 0x4b, 0x3b, 0x13, 0x61, 0x3e, 0x0d, 0x17, 0xfe, 0xe8, 0x38, 0x04, 0x96, 0xac, 0x49, 0x1b,
+	0x59, 0x97, 0x39, 0x00, 0x0d, 0x91, 0x4a, 0x2d, 0xec, 0x76, 0x5b, 0xab, 0x2e, 0xab, 0x05, 0xf4,
+	0x98, 0xdc, 0xaf, 0xfc, 0xbc, 0x9d, 0x16, 0x4a, 0x9b, 0x53, 0x1c, 0x17, 0xff, 0x70, 0x90, 0xd0,
+	0x57, 0x24, 0xbe, 0x62, 0xe9, 0x63, 0x35, 0x14, 0xfc, 0x90, 0x09, 0x1a, 0x43, 0xe6, 0x7a, 0x2b,
+	0xe7, 0xe4, 0xee, 0x15, 0x2b, 0xdf, 0x15, 0xb3, 0x11, 0x18, 0x58, 0x14, 0xb3, 0x91, 0x37, 0x00,
+	0xeb, 0x1b, 0xfa, 0x7f, 0x9b, 0x74, 0x4b, 0xc3, 0xb5, 0xc1, 0x54, 0x1d, 0xa8, 0xa9, 0x59, 0x69,
+	0x50, 0x43, 0x7f, 0x0e, 0x1a, 0xd9, 0x0f, 0x44, 0x9e, 0x0e, 0xd5, 0x61, 0x9a, 0x6a, 0x51, 0x96,
+	0xe0, 0x10, 0x58, 0xf5, 0x0e, 0x61, 0x1d, 0x6d, 0x91, 0x8e, 0x51, 0xe8, 0xad, 0x63, 0x54, 0xe3,
+	0x3e, 0x09, 0x5b, 0xf7, 0x49, 0x44, 0x56, 0x72, 0x65, 0x04, 0x4e, 0x4e, 0xbb, 0x06, 0x36, 0x65,
+	0x39, 0x54, 0xe7, 0x22, 0xc7, 0x91, 0xe9, 0x61, 0xb4, 0x4b, 0x36, 0x0c, 0x2c, 0x06, 0xcb, 0xe9,
+	0x48, 0x65, 0x38, 0x35, 0x9b, 0x22, 0xfa, 0x39, 0xb9, 0xd5, 0xec, 0xd1, 0x23, 0xd1, 0xbc, 0xca,
+	0x82, 0xa6, 0x6b, 0xfa, 0x0d, 0xb9, 0xd3, 0x54, 0x3d, 0x69, 0x8d, 0xf8, 0xbf, 0x67, 0xff, 0x33,
+	0x72, 0xaf, 0xda, 0xfe, 0x4e, 0xe8, 0xb1, 0x78, 0xc1, 0x33, 0x9e, 0x27, 0x02, 0x53, 0x0f, 0x7c,
+	0xea, 0xf4, 0xf7, 0xc0, 0x3a, 0xb2, 0x19, 0x9c, 0x6a, 0xf1, 0x52, 0x0b, 0x6e, 0x44, 0xf4, 0x80,
+	0xf4, 0x13, 0x58, 0x29, 0xfd, 0x43, 0xc3, 0xe1, 0x06, 0xca, 0x80, 0x5a, 0xcb, 0x0d, 0xdc, 0x98,
+	0x1d, 0xe4, 0x86, 0xbb, 0x7b, 0xb9, 0x74, 0xc9, 0x87, 0x78, 0x65, 0x58, 0x64, 0x27, 0x6f, 0x6e,
+	0xb4, 0x4a, 0x67, 0xae, 0x79, 0x1d, 0x9f, 0x2d, 0x59, 0xf4, 0x3f, 0x42, 0xd4, 0x3c, 0x17, 0xe8,
+	0xb0, 0x6b, 0x35, 0x7a, 0x56, 0x72, 0x88, 0x69, 0x1a, 0x65, 0x78, 0x86, 0x37, 0xbe, 0x03, 0x20,
+	0x2d, 0xb4, 0x4c, 0x84, 0xbd, 0xed, 0x43, 0xe6, 0x00, 0xd5, 0x64, 0xdb, 0xa7, 0x74, 0x24, 0x73,
+	0x59, 0x4e, 0x30, 0xab, 0x4f, 0xc9, 0xe6, 0x99, 0xc5, 0xa2, 0x95, 0x56, 0xdf, 0x0b, 0x0f, 0xf1,
+	0x9d, 0x80, 0x39, 0x74, 0x5a, 0x39, 0xb4, 0xe3, 0x0b, 0xaf, 0xc4, 0x47, 0x8b, 0xda, 0x27, 0x13,
+	0x97, 0xea, 0xbc, 0xc1, 0xa4, 0xb6, 0xb8, 0xcd, 0x24, 0xca, 0xfe, 0x8d, 0x47, 0x61, 0x9b, 0xe9,
+	0x9d, 0x4a, 0xe5, 0xd9, 0xf2, 0xa5, 0xca, 0xcf, 0xe4, 0x38, 0xba, 0x4d, 0xc2, 0xfa, 0x94, 0xc3,
+	0x12, 0xca, 0xad, 0x0a, 0xdf, 0xe9, 0xaa, 0x00, 0xc2, 0x2e, 0x79, 0x36, 0x13, 0x68, 0xce, 0x01,
+	0x78, 0x37, 0x4d, 0xc1, 0x8e, 0x14, 0x1a, 0x6b, 0x53, 0x61, 0xfa, 0x5b, 0x40, 0xfa, 0x4c, 0x5c,
+	0x0c, 0xe4, 0x38, 0x67, 0x7c, 0x3e, 0x5c, 0x5c, 0xdb, 0x84, 0x8d, 0x11, 0xd3, 0xf9, 0xd3, 0x88,
+	0x31, 0x8b, 0x63, 0xb1, 0xf0, 0x0e, 0x2d, 0x80, 0x94, 0xc5, 0xa2, 0x90, 0xda, 0x1f, 0x2d, 0x44,
+	0xf5, 0x63, 0xb0, 0xeb, 0x4e, 0xb7, 0x05, 0xae, 0xf6, 0x70, 0xe0, 0xd6, 0xd0, 0x06, 0x00, 0x48,
+	0xf6, 0x4c, 0x08, 0xfb, 0x9a, 0x0b, 0x19, 0x2c, 0x61, 0x40, 0xe6, 0x62, 0xee, 0x8e, 0xbe, 0x7d,
+	0xac, 0xf5, 0x58, 0x2d, 0xa0, 0x8f, 0xc8, 0x96, 0xbb, 0x41, 0xaa, 0x4c, 0xaa, 0xd8, 0x82, 0x46,
+	0x6c, 0x74, 0x64, 0xf5, 0x94, 0x36, 0xaf, 0xb5, 0x7e, 0x7d, 0x29, 0x72, 0x03, 0x4f, 0x44, 0x18,
+	0x1b, 0x53, 0x95, 0xce, 0x32, 0x81, 0xca, 0x0d, 0x09, 0xd0, 0x67, 0x14, 0x7e, 0x75, 0xe9, 0x57,
+	0x18, 0x7c, 0x08, 0xad, 0x95, 0xaf, 0x9f, 0x03, 0xf4, 0x3f, 0xa4, 0xfb, 0x36, 0x37, 0xcf, 0x0e,
+	0x80, 0xcc, 0x94, 0x1b, 0xee, 0x6f, 0x53, 0x58, 0xd3, 0xaf, 0x20, 0x80, 0x0b, 0xbc, 0x55, 0xec,
+	0x3d, 0x01, 0x57, 0xb5, 0x34, 0x13, 0x35, 0x33, 0x78, 0x8c, 0xf1, 0x85, 0x74, 0x45, 0x4a, 0x5f,
+	0xdb, 0x96, 0xc0, 0xb9, 0x5f, 0x1e, 0x49, 0x17, 0xdb, 0x99, 0xcc, 0x84, 0x7d, 0xdc, 0x06, 0xf8,
+	0x24, 0x46, 0x7c, 0xe3, 0x5d, 0x9b, 0xdb, 0xab, 0xd4, 0x35, 0xf0, 0x29, 0xd7, 0x46, 0xf2, 0xec,
+	0x26, 0xb6, 0xa0, 0xf2, 0xee, 0x5d, 0x08, 0xd7, 0x68, 0x08, 0x95, 0x47, 0xd8, 0xa8, 0x71, 0xd8,
+	0xaa, 0x31, 0xd6, 0x6d, 0xa5, 0xaa, 0x1b, 0x1d, 0x92, 0xdb, 0xd8, 0x61, 0xb5, 0xb7, 0x26, 0x31,
+	0x3d, 0x47, 0x4c, 0xd5, 0x79, 0x9d, 0xeb, 0x3b, 0x2f, 0x6c, 0x75, 0x1e, 0xfd, 0xc2, 0x5e, 0x40,
+	0x2f, 0xd5, 0x74, 0x24, 0xf3, 0x76, 0x1a, 0x60, 0xcc, 0xbd, 0xe6, 0x7a, 0xcc, 0x01, 0xfa, 0x91,
+	0xdc, 0x45, 0x95, 0x81, 0x0d, 0x1f, 0x1f, 0xa6, 0x55, 0x3f, 0x06, 0xcd, 0x7e, 0xbc, 0x2e, 0x0e,
+	0xff, 0x5a, 0xf6, 0xef, 0x4e, 0x44, 0xf4, 0xc7, 0x00, 0x06, 0x43, 0x91, 0x2d, 0xab, 0x08, 0xd0,
+	0xb4, 0xfd, 0x1f, 0xa9, 0x9e, 0xcd, 0x3d, 0x86, 0x28, 0xfa, 0xb2, 0x4d, 0xe8, 0xc6, 0xc1, 0x0e,
+	0x3e, 0x19, 0xaf, 0x89, 0xaf, 0x26, 0x7b, 0x87, 0xac, 0x27, 0x6a, 0x5a, 0x64, 0xc2, 0x08, 0x0c,
+	0xa0, 0xc2, 0x2f, 0xf6, 0xbf, 0x7f, 0x3c, 0x96, 0x66, 0x32, 0x1b, 0xed, 0x27, 0x6a, 0xfa, 0xc4,
+	0xcc, 0xb4, 0xcc, 0xc7, 0xc9, 0x84, 0xcb, 0xfc, 0xe0, 0xe9, 0xc1, 0xd3, 0x26, 0x7e, 0x62, 0x1d,
+	0x8d, 0x56, 0xed, 0x5f, 0xe1, 0xb3, 0x3f, 0x06, 0x00, 0xc6, 0x72, 0x3b, 0xd2, 0x4e, 0x0e, 0x00,
+	0x00,
 }
@@ -85,6 +85,7 @@ func Run(RPCAddr, ParaName, name string) {
 		commands.CoinsCmd(),
 		commands.ExecCmd(),
 		commands.MempoolCmd(),
+		commands.QueueCmd(),
 		commands.NetCmd(),
 		commands.SeedCmd(),
 		commands.StatCmd(),
@@ -20,6 +20,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 
 	"github.com/turingchain2020/turingchain/common/crypto"
 
@@ -43,6 +44,7 @@ import (
 	"github.com/turingchain2020/turingchain/queue"
 	"github.com/turingchain2020/turingchain/rpc"
 	"github.com/turingchain2020/turingchain/store"
+	"github.com/turingchain2020/turingchain/trace"
 	"github.com/turingchain2020/turingchain/types"
 	"github.com/turingchain2020/turingchain/wallet"
 	"google.golang.org/grpc/grpclog"
@@ -61,6 +63,7 @@ var (
 	exportTitle = flag.String("export", "", "export block title name")
 	fileDir     = flag.String("filedir", "", "import/export block file dir,defalut current path")
 	startHeight = flag.Int64("startheight", 0, "export block start height")
+	remote      = flag.String("remote", "", "run modules(wallet,rpc) in this process, connect to node queue by queue.remote.nodeAddr")
 )
 
 //RunTuringchain : run Turingchain
@@ -156,6 +159,10 @@ func RunTuringchain(name, defCfg string) {
 	version.SetStoreDBVersion(cfg.Store.StoreDBVersion)
 	version.SetAppVersion(cfg.Version)
 	log.Info(cfg.Title + "-app:" + version.GetAppVersion() + " turingchain:" + version.GetVersion() + " localdb:" + version.GetLocalDBVersion() + " statedb:" + version.GetStoreDBVersion())
+	if *remote != "" {
+		runRemote(turingchainCfg, strings.Split(*remote, ","))
+		return
+	}
 	log.Info("loading queue")
 	q := queue.New("channel")
 	q.SetConfig(turingchainCfg)
@@ -175,21 +182,37 @@ func RunTuringchain(name, defCfg string) {
 	chain.SetQueueClient(q.Client())
 
 	log.Info("loading store module")
+	//轻节点本地不保存状态, 查询时从全节点获取状态证明
+	if cfg.BlockChain.LightMode {
+		cfg.Store.Name = "light"
+	}
 	s := store.New(turingchainCfg)
 	s.SetQueueClient(q.Client())
 
 	chain.Upgrade()
 
 	log.Info("loading consensus module")
-	cs := consensus.New(turingchainCfg)
+	var cs queue.Module
+	if cfg.BlockChain.LightMode {
+		//轻节点不参与共识
+		cs = &util.MockModule{Key: "consensus"}
+	} else {
+		cs = consensus.New(turingchainCfg)
+	}
 	cs.SetQueueClient(q.Client())
 
 	//jsonrpc, grpc, channel 三种模式
-	rpcapi := rpc.New(turingchainCfg)
+	var rpcapi queue.Module = &remoteModule{}
+	if !isRemoteModule(cfg, "rpc") {
+		rpcapi = rpc.New(turingchainCfg)
+	}
 	rpcapi.SetQueueClient(q.Client())
 
 	log.Info("loading wallet module")
-	walletm := wallet.New(turingchainCfg)
+	var walletm queue.Module = &remoteModule{}
+	if !isRemoteModule(cfg, "wallet") {
+		walletm = wallet.New(turingchainCfg)
+	}
 	walletm.SetQueueClient(q.Client())
 
 	chain.Rollbackblock()
@@ -211,11 +234,19 @@ func RunTuringchain(name, defCfg string) {
 
 	health := util.NewHealthCheckServer(q.Client())
 	health.Start(cfg.Health)
+	remoteServer := startRemoteServer(q, cfg)
 	metrics.StartMetrics(turingchainCfg)
+	if err := trace.Start(cfg.Trace); err != nil {
+		panic(err)
+	}
 	defer func() {
 		//close all module,clean some resource
 		log.Info("begin close health module")
 		health.Close()
+		if remoteServer != nil {
+			log.Info("begin close remote queue server")
+			remoteServer.Close()
+		}
 		log.Info("begin close blockchain module")
 		chain.Close()
 		log.Info("begin close mempool module")
@@ -234,11 +265,106 @@ func RunTuringchain(name, defCfg string) {
 		walletm.Close()
 		log.Info("begin close queue module")
 		q.Close()
+		trace.Stop()
 
 	}()
 	q.Start()
 }
 
+//remoteModule 在其他进程中运行的模块, 本地不加载
+type remoteModule struct{}
+
+func (m *remoteModule) SetQueueClient(client queue.Client) {}
+
+func (m *remoteModule) Wait() {}
+
+func (m *remoteModule) Close() {}
+
+func remoteConfig(cfg *types.Config) *types.QueueRemote {
+	if cfg.Queue == nil {
+		return nil
+	}
+	return cfg.Queue.Remote
+}
+
+//isRemoteModule 模块配置在其他进程中运行
+func isRemoteModule(cfg *types.Config, name string) bool {
+	remoteCfg := remoteConfig(cfg)
+	if remoteCfg == nil {
+		return false
+	}
+	for _, m := range remoteCfg.Modules {
+		if m == name {
+			log.Info("module runs in remote process", "module", name)
+			return true
+		}
+	}
+	return false
+}
+
+//startRemoteServer 开启远程模块接入服务
+func startRemoteServer(q queue.Queue, cfg *types.Config) *queue.RemoteServer {
+	remoteCfg := remoteConfig(cfg)
+	if remoteCfg == nil || remoteCfg.ListenAddr == "" {
+		return nil
+	}
+	server, err := queue.NewRemoteServer(q, remoteCfg)
+	if err != nil {
+		panic(err)
+	}
+	if err := server.Start(); err != nil {
+		panic(err)
+	}
+	return server
+}
+
+//runRemote 在单独的进程中运行wallet, rpc 等模块, 通过grpc 接入节点的消息队列
+func runRemote(turingchainCfg *types.TuringchainConfig, names []string) {
+	cfg := turingchainCfg.GetModuleConfig()
+	remoteCfg := remoteConfig(cfg)
+	if remoteCfg == nil || remoteCfg.NodeAddr == "" {
+		panic("queue.remote.nodeAddr is not configured")
+	}
+	for i := range names {
+		names[i] = strings.TrimSpace(names[i])
+	}
+	log.Info("loading remote queue", "node", remoteCfg.NodeAddr, "modules", names)
+	q, err := queue.NewRemote(remoteCfg, names)
+	if err != nil {
+		panic(err)
+	}
+	q.SetConfig(turingchainCfg)
+	var modules []queue.Module
+	for _, name := range names {
+		var m queue.Module
+		switch name {
+		case "wallet":
+			log.Info("loading wallet module")
+			m = wallet.New(turingchainCfg)
+		case "rpc":
+			log.Info("loading rpc module")
+			m = rpc.New(turingchainCfg)
+		default:
+			panic(fmt.Sprintf("module %s can not run in remote process", name))
+		}
+		m.SetQueueClient(q.Client())
+		modules = append(modules, m)
+	}
+	metrics.StartMetrics(turingchainCfg)
+	if err := trace.Start(cfg.Trace); err != nil {
+		panic(err)
+	}
+	defer func() {
+		for i := len(modules) - 1; i >= 0; i-- {
+			modules[i].Close()
+		}
+		log.Info("begin close remote queue")
+		q.Close()
+		trace.Stop()
+	}()
+	q.Start()
+}
+
 func createFile(filename string) (*os.File, error) {
 	f, err := os.OpenFile(filename, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
 	if err != nil {
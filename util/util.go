@@ -371,6 +371,8 @@ func PreExecBlock(client queue.Client, prevStateRoot []byte, block *types.Block,
 	beg = types.Now()
 	kvset := make([]*types.KeyValue, 0, len(receipts.GetReceipts()))
 	rdata := make([]*types.ReceiptData, 0, len(receipts.GetReceipts())) //save to db receipt log
+	//区块开始时的状态修改不属于任何交易
+	kvset = append(kvset, receipts.GetBlockBegin().GetKV()...)
 	//删除无效的交易
 	var deltxs []*types.Transaction
 	index := 0
@@ -467,6 +469,7 @@ func ExecBlockUpgrade(client queue.Client, prevStateRoot []byte, block *types.Bl
 	ulog.Debug("ExecBlockUpgrade", "ExecTx", types.Since(beg))
 	beg = types.Now()
 	var kvset []*types.KeyValue
+	kvset = append(kvset, receipts.GetBlockBegin().GetKV()...)
 	for i := 0; i < len(receipts.Receipts); i++ {
 		receipt := receipts.Receipts[i]
 		kvset = append(kvset, receipt.KV...)
@@ -33,6 +33,9 @@ const (
 	TypeYcc                uint32 = 0x80003334
 )
 
+// ErrXpubKeyType 只有secp256k1 的公钥可以导出和导入xpub
+var ErrXpubKeyType = errors.New("ErrXpubKeyType")
+
 // CoinName 币种名称
 var CoinName = map[uint32]string{
 	TypeEther:        "ETH",
@@ -99,6 +102,34 @@ func (w *HDWallet) NewKeyPair(index uint32) (priv, pub []byte, err error) {
 	return
 }
 
+// ExtendedPubKey 导出NewKeyPair 使用的账户路径 m/44'/coin/0' 的扩展公钥(xpub), 只支持secp256k1
+func (w *HDWallet) ExtendedPubKey() (string, error) {
+	if w.KeyType != types.SECP256K1 {
+		return "", ErrXpubKeyType
+	}
+	key, err := bip44.NewAccountKeyFromMasterKey(w.MasterKey, w.CoinType, bip32.FirstHardenedChild)
+	if err != nil {
+		return "", err
+	}
+	return key.PublicKey().String(), nil
+}
+
+// PubKeyFromXpub 从ExtendedPubKey 导出的xpub 生成第index 个地址的公钥, 和NewKeyPair(index) 的公钥相同
+func PubKeyFromXpub(xpub string, index uint32) ([]byte, error) {
+	key, err := bip32.B58Deserialize(xpub)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsPrivate {
+		return nil, ErrXpubKeyType
+	}
+	child, err := bip44.NewKeyFromAccountKey(key, 0, index)
+	if err != nil {
+		return nil, err
+	}
+	return child.Key, nil
+}
+
 // NewAddress 新建地址
 func (w *HDWallet) NewAddress(index uint32) (string, error) {
 	if cointype, ok := CoinName[w.CoinType]; ok {
@@ -99,3 +99,28 @@ func TestSm2PrivPub(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, tpub, pub)
 }
+
+func TestExtendedPubKey(t *testing.T) {
+	wallet, err := NewWalletFromMnemonic(TypeYcc, types.SECP256K1, mnem)
+	assert.Nil(t, err)
+	xpub, err := wallet.ExtendedPubKey()
+	assert.Nil(t, err)
+	assert.Equal(t, "xpub", xpub[:4])
+	for _, index := range []uint32{0, 1, 100} {
+		_, pub, err := wallet.NewKeyPair(index)
+		assert.Nil(t, err)
+		xpubPub, err := PubKeyFromXpub(xpub, index)
+		assert.Nil(t, err)
+		assert.Equal(t, pub, xpubPub)
+	}
+	pub, err := PubKeyFromXpub(xpub, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, secp256k1Pub, hex.EncodeToString(pub))
+
+	_, err = PubKeyFromXpub(xpub[:len(xpub)-1]+"1", 0)
+	assert.NotNil(t, err)
+	wallet, err = NewWalletFromMnemonic(TypeYcc, types.ED25519, mnem)
+	assert.Nil(t, err)
+	_, err = wallet.ExtendedPubKey()
+	assert.Equal(t, ErrXpubKeyType, err)
+}
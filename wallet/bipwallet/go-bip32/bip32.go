@@ -204,6 +204,51 @@ func (key *Key) String() string {
 	return string(base58Encode(key.Serialize()))
 }
 
+// Deserialize 从78 字节加上4 字节校验和的数据恢复Key, Serialize 的逆操作
+func Deserialize(data []byte) (*Key, error) {
+	if len(data) != 82 {
+		return nil, errors.New("Serialized keys should be exactly 82 bytes")
+	}
+	if !bytes.Equal(checksum(data[:78]), data[78:]) {
+		return nil, errors.New("Checksum doesn't match")
+	}
+	key := &Key{
+		Version:     data[0:4],
+		Depth:       data[4],
+		FingerPrint: data[5:9],
+		ChildNumber: data[9:13],
+		ChainCode:   data[13:45],
+	}
+	switch {
+	case bytes.Equal(key.Version, PrivateWalletVersion):
+		if data[45] != 0x0 {
+			return nil, errors.New("Invalid private key")
+		}
+		key.IsPrivate = true
+		key.Key = data[46:78]
+		if err := validatePrivateKey(key.Key); err != nil {
+			return nil, err
+		}
+	case bytes.Equal(key.Version, PublicWalletVersion):
+		key.Key = data[45:78]
+		if err := validateChildPublicKey(key.Key); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("Unknown key version")
+	}
+	return key, nil
+}
+
+// B58Deserialize 从base58 编码的字符串恢复Key
+func B58Deserialize(data string) (*Key, error) {
+	b, err := bitcoinBase58Encoding.DecodeStringN(data, 82)
+	if err != nil {
+		return nil, err
+	}
+	return Deserialize(b)
+}
+
 // NewSeed Cryptographically secure seed
 func NewSeed() ([]byte, error) {
 	// Well that easy, just make go read 256 random bytes into a slice
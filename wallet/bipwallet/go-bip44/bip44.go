@@ -143,22 +143,31 @@ func NewKeyFromMnemonic(mnemonic string, coin, account, chain, address uint32) (
 
 // NewKeyFromMasterKey 新建Key
 func NewKeyFromMasterKey(masterKey *bip32.Key, coin, account, chain, address uint32) (*bip32.Key, error) {
-	child, err := masterKey.NewChildKey(Purpose)
+	child, err := NewAccountKeyFromMasterKey(masterKey, coin, account)
 	if err != nil {
 		return nil, err
 	}
+	return NewKeyFromAccountKey(child, chain, address)
+}
 
-	child, err = child.NewChildKey(coin)
+// NewAccountKeyFromMasterKey 生成 m/44'/coin/account 路径的账户Key, 账户Key 的公钥版本就是导出的xpub
+func NewAccountKeyFromMasterKey(masterKey *bip32.Key, coin, account uint32) (*bip32.Key, error) {
+	child, err := masterKey.NewChildKey(Purpose)
 	if err != nil {
 		return nil, err
 	}
 
-	child, err = child.NewChildKey(account)
+	child, err = child.NewChildKey(coin)
 	if err != nil {
 		return nil, err
 	}
 
-	child, err = child.NewChildKey(chain)
+	return child.NewChildKey(account)
+}
+
+// NewKeyFromAccountKey 从账户Key 生成 chain/address 路径的Key, 账户Key 是公钥时chain 和address 不能是hardened
+func NewKeyFromAccountKey(accountKey *bip32.Key, chain, address uint32) (*bip32.Key, error) {
+	child, err := accountKey.NewChildKey(chain)
 	if err != nil {
 		return nil, err
 	}
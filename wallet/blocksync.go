@@ -0,0 +1,152 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"sync/atomic"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+//钱包在其他进程中运行时, 和节点断开期间blockchain 发送的区块消息可能被丢弃:
+//1. 记录处理过的最后一个区块, 每次连接上节点之后从blockchain 补上错过的区块
+//2. 区块已经不在主链上时, 先按照记录的hash 取回区块做回滚
+//3. 收到的区块和记录的区块不连续时, 先补上中间的区块
+
+func (wallet *Wallet) isRemoteSync() bool {
+	return atomic.LoadInt32(&wallet.remoteSync) == 1
+}
+
+//getSyncBlock 处理过的最后一个区块, 只有高度和hash
+func (wallet *Wallet) getSyncBlock() *types.Header {
+	value, err := wallet.walletStore.Get(CalcWalletSyncBlockKey())
+	if err != nil || value == nil {
+		return nil
+	}
+	var header types.Header
+	if err := types.Decode(value, &header); err != nil {
+		walletlog.Error("getSyncBlock", "decode err", err)
+		return nil
+	}
+	return &header
+}
+
+func (wallet *Wallet) setSyncBlock(height int64, hash []byte) {
+	header := &types.Header{Height: height, Hash: hash}
+	err := wallet.walletStore.GetDB().SetSync(CalcWalletSyncBlockKey(), types.Encode(header))
+	if err != nil {
+		walletlog.Error("setSyncBlock", "height", height, "err", err)
+	}
+}
+
+//syncBlocks 从blockchain 补上没有处理的区块
+func (wallet *Wallet) syncBlocks() error {
+	header, err := wallet.api.GetLastHeader()
+	if err != nil {
+		return err
+	}
+	last := wallet.getSyncBlock()
+	if last == nil {
+		//第一次运行, 之前的交易通过导入账户时的扫描获取
+		wallet.setSyncBlock(header.Height, header.Hash)
+		return nil
+	}
+	//回滚已经不在主链上的区块
+	for last.Height >= 0 {
+		if last.Height <= header.Height {
+			reply, err := wallet.api.GetBlockHash(&types.ReqInt{Height: last.Height})
+			if err != nil {
+				return err
+			}
+			if bytes.Equal(reply.Hash, last.Hash) {
+				break
+			}
+		}
+		blocks, err := wallet.api.GetBlockByHashes(&types.ReqHashes{Hashes: [][]byte{last.Hash}})
+		if err != nil {
+			return err
+		}
+		if len(blocks.Items) == 0 || blocks.Items[0].GetBlock() == nil {
+			walletlog.Error("syncBlocks block not found", "height", last.Height, "hash", common.ToHex(last.Hash))
+			return types.ErrBlockNotFound
+		}
+		wallet.delBlock(blocks.Items[0])
+		last = wallet.getSyncBlock()
+	}
+	for start := last.Height + 1; start <= header.Height; start += types.MaxBlockCountPerTime {
+		end := start + types.MaxBlockCountPerTime - 1
+		if end > header.Height {
+			end = header.Height
+		}
+		blocks, err := wallet.api.GetBlocks(&types.ReqBlocks{Start: start, End: end, IsDetail: true})
+		if err != nil {
+			return err
+		}
+		for _, block := range blocks.Items {
+			wallet.addBlock(block)
+		}
+	}
+	if last.Height < header.Height {
+		walletlog.Info("syncBlocks", "from", last.Height+1, "to", header.Height)
+	}
+	return nil
+}
+
+//syncAddBlock 重连时已经补上的区块跳过, 和记录的区块不连续时先补上中间的区块
+func (wallet *Wallet) syncAddBlock(block *types.BlockDetail) error {
+	cfg := wallet.client.GetConfig()
+	last := wallet.getSyncBlock()
+	if last != nil && block.Block.Height <= last.Height {
+		return nil
+	}
+	if last == nil || block.Block.Height != last.Height+1 || !bytes.Equal(block.Block.ParentHash, last.Hash) {
+		if err := wallet.syncBlocks(); err != nil {
+			return err
+		}
+		last = wallet.getSyncBlock()
+		if block.Block.Height <= last.Height {
+			return nil
+		}
+		if !bytes.Equal(block.Block.ParentHash, last.Hash) {
+			walletlog.Error("syncAddBlock parent not match", "height", block.Block.Height, "hash", common.ToHex(block.Block.Hash(cfg)))
+			return types.ErrParentHash
+		}
+	}
+	wallet.addBlock(block)
+	return nil
+}
+
+//syncDelBlock 只回滚记录的最后一个区块, 其他的是重连之前的消息
+func (wallet *Wallet) syncDelBlock(block *types.BlockDetail) {
+	last := wallet.getSyncBlock()
+	if last == nil || !bytes.Equal(block.Block.Hash(wallet.client.GetConfig()), last.Hash) {
+		return
+	}
+	wallet.delBlock(block)
+}
+
+func (wallet *Wallet) addBlock(block *types.BlockDetail) {
+	err := wallet.updateLastHeader(block, 1)
+	if err != nil {
+		walletlog.Error("addBlock updateLastHeader", "height", block.Block.Height, "err", err)
+	}
+	wallet.ProcWalletAddBlock(block)
+	if wallet.isRemoteSync() {
+		wallet.setSyncBlock(block.Block.Height, block.Block.Hash(wallet.client.GetConfig()))
+	}
+}
+
+func (wallet *Wallet) delBlock(block *types.BlockDetail) {
+	err := wallet.updateLastHeader(block, -1)
+	if err != nil {
+		walletlog.Error("delBlock updateLastHeader", "height", block.Block.Height, "err", err)
+	}
+	wallet.ProcWalletDelBlock(block)
+	if wallet.isRemoteSync() {
+		wallet.setSyncBlock(block.Block.Height-1, block.Block.ParentHash)
+	}
+}
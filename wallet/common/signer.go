@@ -0,0 +1,112 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package common
+
+import (
+	"github.com/turingchain2020/turingchain/common/crypto"
+	log "github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+var (
+	signerlog      = log.New("module", "wallet.signer")
+	signerCreators = make(map[string]func() Signer)
+)
+
+// Signer 外部签名器接口, 私钥保存在钱包数据库之外(硬件安全模块, 独立的签名进程等), 钱包只通过签名器获得签名
+type Signer interface {
+	// Init 初始化签名器, sub 为[wallet.sub.签名器名称] 中的配置
+	Init(sub []byte) error
+	// SignType 签名器中私钥的签名类型, 需要和钱包的签名类型一致
+	SignType() int
+	// Addrs 签名器管理的所有地址
+	Addrs() []string
+	// PubKey 地址对应的公钥
+	PubKey(addr string) (crypto.PubKey, error)
+	// Sign 使用地址对应的私钥签名, 私钥不会离开签名器
+	Sign(addr string, msg []byte) (crypto.Signature, error)
+	Close()
+}
+
+// RegisterSigner 注册外部签名器
+func RegisterSigner(name string, create func() Signer) {
+	if _, existed := signerCreators[name]; existed {
+		panic("RegisterSigner dup")
+	}
+	signerCreators[name] = create
+}
+
+// NewSigner 创建并初始化指定名称的签名器
+func NewSigner(name string, sub []byte) (Signer, error) {
+	create, ok := signerCreators[name]
+	if !ok {
+		return nil, types.ErrSignerNotExist
+	}
+	signer := create()
+	err := signer.Init(sub)
+	if err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
+
+// NewSignerPrivKey 把签名器中的地址包装成crypto.PrivKey, 钱包和业务插件可以像本地私钥一样使用, 但是私钥不能导出
+func NewSignerPrivKey(signer Signer, addr string) (crypto.PrivKey, error) {
+	pub, err := signer.PubKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &signerPrivKey{signer: signer, addr: addr, pub: pub}, nil
+}
+
+type signerPrivKey struct {
+	signer Signer
+	addr   string
+	pub    crypto.PubKey
+}
+
+// Bytes 私钥保存在签名器中, 返回空
+func (key *signerPrivKey) Bytes() []byte {
+	return nil
+}
+
+// Sign crypto.PrivKey 的签名接口不能返回错误, 签名器出错时返回空签名, 交易的签名检查会失败
+func (key *signerPrivKey) Sign(msg []byte) crypto.Signature {
+	sig, err := key.signer.Sign(key.addr, msg)
+	if err != nil {
+		signerlog.Error("Sign", "addr", key.addr, "err", err)
+		return emptySignature{}
+	}
+	return sig
+}
+
+func (key *signerPrivKey) PubKey() crypto.PubKey {
+	return key.pub
+}
+
+func (key *signerPrivKey) Equals(other crypto.PrivKey) bool {
+	if otherKey, ok := other.(*signerPrivKey); ok {
+		return otherKey.signer == key.signer && otherKey.addr == key.addr
+	}
+	return false
+}
+
+type emptySignature struct{}
+
+func (sig emptySignature) Bytes() []byte {
+	return nil
+}
+
+func (sig emptySignature) IsZero() bool {
+	return true
+}
+
+func (sig emptySignature) String() string {
+	return ""
+}
+
+func (sig emptySignature) Equals(other crypto.Signature) bool {
+	return other.IsZero()
+}
@@ -5,10 +5,16 @@
 package wallet
 
 const (
-	keyWalletPassKey = "WalletPassKey"
+	keyWalletPassKey   = "WalletPassKey"
+	keyWalletSyncBlock = "WalletSyncBlock"
 )
 
 // CalcWalletPassKey 获取钱包密码的数据库字段Key值
 func CalcWalletPassKey() []byte {
 	return []byte(keyWalletPassKey)
 }
+
+// CalcWalletSyncBlockKey 钱包处理过的最后一个区块的数据库字段Key值
+func CalcWalletSyncBlockKey() []byte {
+	return []byte(keyWalletSyncBlock)
+}
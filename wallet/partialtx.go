@@ -0,0 +1,187 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"time"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/common/crypto"
+	"github.com/turingchain2020/turingchain/types"
+)
+
+// ProcCreatePartialTx 创建部分签名交易, fee 和expire 在创建时确定, 之后各个签名方只添加签名
+func (wallet *Wallet) ProcCreatePartialTx(req *types.ReqCreatePartialTx) (string, error) {
+	if req == nil || len(req.GetTxHex()) == 0 {
+		return "", types.ErrInvalidParam
+	}
+	txByteData, err := common.FromHex(req.GetTxHex())
+	if err != nil {
+		return "", err
+	}
+	var tx types.Transaction
+	err = types.Decode(txByteData, &tx)
+	if err != nil {
+		return "", err
+	}
+	expire, err := types.ParseExpire(req.GetExpire())
+	if err != nil {
+		return "", err
+	}
+	types.AssertConfig(wallet.client)
+	cfg := wallet.client.GetConfig()
+	group, err := tx.GetTxGroup()
+	if err != nil {
+		return "", err
+	}
+	if group == nil {
+		if req.Fee != 0 {
+			tx.Fee = req.Fee
+		} else {
+			proper, err := wallet.api.GetProperFee(nil)
+			if err != nil {
+				return "", err
+			}
+			tx.Fee, err = tx.GetRealFee(proper.ProperFee)
+			if err != nil {
+				return "", err
+			}
+		}
+		tx.SetExpire(cfg, time.Duration(expire))
+	} else {
+		//交易组的手续费由第一笔交易支付, 修改之后需要重构交易组
+		if req.Fee != 0 {
+			group.Txs[0].Fee = req.Fee
+		}
+		group.SetExpire(cfg, 0, time.Duration(expire))
+		group.RebuiltGroup()
+		tx = *group.Tx()
+	}
+	ptx, err := types.NewPartiallySignedTx(&tx, req.Signers)
+	if err != nil {
+		return "", err
+	}
+	return ptx.Hex(), nil
+}
+
+// ProcSignPartialTx 对部分签名交易中签名地址匹配的交易签名
+// 指定privkey 时使用私钥签名, 指定addr 时使用钱包中该地址签名, 都不指定时使用钱包中所有匹配的地址签名
+func (wallet *Wallet) ProcSignPartialTx(req *types.ReqSignPartialTx) (string, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil {
+		return "", types.ErrInvalidParam
+	}
+	ptx, err := types.DecodePartiallySignedTx(req.GetData())
+	if err != nil {
+		return "", err
+	}
+	var keys []crypto.PrivKey
+	if req.GetPrivkey() != "" {
+		keyByte, err := common.FromHex(req.GetPrivkey())
+		if err != nil {
+			return "", err
+		}
+		if len(keyByte) == 0 {
+			return "", types.ErrPrivateKeyLen
+		}
+		cr, err := crypto.New(types.GetSignName("", wallet.SignType))
+		if err != nil {
+			return "", err
+		}
+		key, err := cr.PrivKeyFromBytes(keyByte)
+		if err != nil {
+			return "", err
+		}
+		keys = append(keys, key)
+	} else {
+		ok, err := wallet.checkWalletStatus()
+		if !ok {
+			return "", err
+		}
+		addrs := []string{req.GetAddr()}
+		if req.GetAddr() == "" {
+			addrs = nil
+			for _, signer := range ptx.Signers {
+				if signer.Signature == nil && wallet.AddrInWallet(signer.Addr) {
+					addrs = append(addrs, signer.Addr)
+				}
+			}
+		}
+		for _, addr := range addrs {
+			key, err := wallet.getPrivKeyByAddr(addr)
+			//只读地址不能签名, 跳过
+			if err == types.ErrWatchOnlyAccount && req.GetAddr() == "" {
+				continue
+			}
+			if err != nil {
+				return "", err
+			}
+			keys = append(keys, key)
+		}
+	}
+	count := 0
+	for _, key := range keys {
+		count += ptx.Sign(int32(wallet.SignType), key)
+	}
+	if count == 0 {
+		return "", types.ErrNoMatchedSigner
+	}
+	return ptx.Hex(), nil
+}
+
+// ProcCombinePartialTx 合并多个签名方返回的部分签名交易
+func (wallet *Wallet) ProcCombinePartialTx(req *types.ReqCombinePartialTx) (string, error) {
+	if req == nil || len(req.Datas) == 0 {
+		return "", types.ErrInvalidParam
+	}
+	ptx, err := types.DecodePartiallySignedTx(req.Datas[0])
+	if err != nil {
+		return "", err
+	}
+	for _, data := range req.Datas[1:] {
+		other, err := types.DecodePartiallySignedTx(data)
+		if err != nil {
+			return "", err
+		}
+		err = ptx.Combine(other)
+		if err != nil {
+			return "", err
+		}
+	}
+	return ptx.Hex(), nil
+}
+
+// ProcFinalizePartialTx 签名收集完成之后生成可以发送的交易
+func (wallet *Wallet) ProcFinalizePartialTx(req *types.ReqString) (string, error) {
+	ptx, err := types.DecodePartiallySignedTx(req.GetData())
+	if err != nil {
+		return "", err
+	}
+	tx, err := ptx.Finalize()
+	if err != nil {
+		return "", err
+	}
+	return common.ToHex(types.Encode(tx)), nil
+}
+
+// ProcPartialTxStatus 查看部分签名交易各个签名方的签名情况
+func (wallet *Wallet) ProcPartialTxStatus(req *types.ReqString) (*types.ReplyPartialTxStatus, error) {
+	ptx, err := types.DecodePartiallySignedTx(req.GetData())
+	if err != nil {
+		return nil, err
+	}
+	reply := &types.ReplyPartialTxStatus{TxHash: common.ToHex(ptx.Hash())}
+	for i, signer := range ptx.Signers {
+		reply.Signers = append(reply.Signers, &types.PartialSignerStatus{
+			Index:  int32(i),
+			Addr:   signer.Addr,
+			Signed: signer.Signature != nil,
+		})
+	}
+	reply.Complete = len(ptx.Missing()) == 0
+	return reply, nil
+}
@@ -61,6 +61,10 @@ func (wallet *Wallet) getAllPrivKeys() ([]crypto.PrivKey, error) {
 	}
 	var privs []crypto.PrivKey
 	for _, acc := range accounts {
+		//只读地址没有私钥
+		if acc.GetWatchOnly() {
+			continue
+		}
 		priv, err := wallet.getPrivKeyByAddr(acc.Addr)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,54 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"github.com/turingchain2020/turingchain/common/crypto"
+	"github.com/turingchain2020/turingchain/types"
+	wcom "github.com/turingchain2020/turingchain/wallet/common"
+	// 注册内置的软件签名器
+	_ "github.com/turingchain2020/turingchain/wallet/softhsm"
+)
+
+//initSigner 加载配置的外部签名器, 签名器中的地址作为钱包账户保存, 数据库中不保存私钥
+func (wallet *Wallet) initSigner(sub []byte) {
+	name := wallet.cfg.Signer
+	signer, err := wcom.NewSigner(name, sub)
+	if err != nil {
+		panic("wallet init signer " + name + " err: " + err.Error())
+	}
+	if signer.SignType() != wallet.SignType {
+		panic("wallet signer " + name + " sign type not match wallet signType")
+	}
+	wallet.signer = signer
+	for _, addr := range signer.Addrs() {
+		err = wallet.saveSignerAccount(name, addr)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (wallet *Wallet) saveSignerAccount(name, addr string) error {
+	acc, err := wallet.walletStore.GetAccountByAddr(addr)
+	if acc != nil && err == nil {
+		if acc.Signer != name {
+			//钱包中已经有这个地址, 保留原来的账户
+			walletlog.Error("saveSignerAccount addr is exist in wallet", "addr", addr, "signer", acc.Signer)
+		}
+		return nil
+	}
+	store := &types.WalletAccountStore{Label: name + "-" + addr, Addr: addr, Signer: name}
+	return wallet.walletStore.SetWalletAccount(false, addr, store)
+}
+
+//getSignerPrivKey 外部签名器中的账户返回包装的私钥, 签名在签名器中完成
+func (wallet *Wallet) getSignerPrivKey(acc *types.WalletAccountStore) (crypto.PrivKey, error) {
+	if wallet.signer == nil || acc.Signer != wallet.cfg.Signer {
+		walletlog.Error("getSignerPrivKey signer not loaded", "addr", acc.Addr, "signer", acc.Signer)
+		return nil, types.ErrSignerNotExist
+	}
+	return wcom.NewSignerPrivKey(wallet.signer, acc.Addr)
+}
@@ -0,0 +1,276 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package softhsm 基于文件的软件签名模块, 用于代替硬件安全模块
+// 私钥使用pin 加密保存在独立的目录中, 不会写入钱包数据库, 钱包只通过签名器接口获得签名
+package softhsm
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/turingchain2020/turingchain/common"
+	"github.com/turingchain2020/turingchain/common/address"
+	"github.com/turingchain2020/turingchain/common/crypto"
+	log "github.com/turingchain2020/turingchain/common/log/log15"
+	"github.com/turingchain2020/turingchain/types"
+	wcom "github.com/turingchain2020/turingchain/wallet/common"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Name 签名器名称, 配置在[wallet.sub.softhsm]
+const Name = "softhsm"
+
+const keyFileSuffix = ".key"
+
+//scrypt 从pin 派生加密私钥的密钥, 每个私钥文件使用独立的随机salt
+const (
+	scryptN  = 1 << 15
+	scryptR  = 8
+	scryptP  = 1
+	saltSize = 32
+)
+
+var hsmlog = log.New("module", "wallet.softhsm")
+
+func init() {
+	wcom.RegisterSigner(Name, func() wcom.Signer {
+		return &softHSM{}
+	})
+}
+
+type subConfig struct {
+	// 私钥文件目录
+	KeyDir string `json:"keyDir"`
+	// 解密私钥文件的口令, 为空时从环境变量PinEnv 中读取
+	Pin    string `json:"pin"`
+	PinEnv string `json:"pinEnv"`
+	// 签名类型, 默认secp256k1
+	SignType string `json:"signType"`
+}
+
+//keyFile 私钥文件, 每个地址一个文件
+type keyFile struct {
+	Addr     string `json:"addr"`
+	SignType string `json:"signType"`
+	Salt     string `json:"salt"`
+	Nonce    string `json:"nonce"`
+	Cipher   string `json:"cipher"`
+}
+
+type softHSM struct {
+	mtx      sync.Mutex
+	signType int
+	keys     map[string]crypto.PrivKey
+}
+
+func (hsm *softHSM) Init(sub []byte) error {
+	var cfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &cfg)
+	}
+	if cfg.KeyDir == "" {
+		return types.ErrInvalidParam
+	}
+	pin := cfg.Pin
+	if pin == "" && cfg.PinEnv != "" {
+		pin = os.Getenv(cfg.PinEnv)
+	}
+	hsm.signType = signTypeOf(cfg.SignType)
+	keys, err := loadKeys(cfg.KeyDir, pin, hsm.signType)
+	if err != nil {
+		return err
+	}
+	hsm.keys = keys
+	hsmlog.Info("Init", "keyDir", cfg.KeyDir, "keys", len(keys))
+	return nil
+}
+
+func (hsm *softHSM) SignType() int {
+	return hsm.signType
+}
+
+func (hsm *softHSM) Addrs() []string {
+	hsm.mtx.Lock()
+	defer hsm.mtx.Unlock()
+	var addrs []string
+	for addr := range hsm.keys {
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+func (hsm *softHSM) PubKey(addr string) (crypto.PubKey, error) {
+	hsm.mtx.Lock()
+	defer hsm.mtx.Unlock()
+	key, ok := hsm.keys[addr]
+	if !ok {
+		return nil, types.ErrAccountNotExist
+	}
+	return key.PubKey(), nil
+}
+
+func (hsm *softHSM) Sign(addr string, msg []byte) (crypto.Signature, error) {
+	hsm.mtx.Lock()
+	defer hsm.mtx.Unlock()
+	key, ok := hsm.keys[addr]
+	if !ok {
+		return nil, types.ErrAccountNotExist
+	}
+	return key.Sign(msg), nil
+}
+
+func (hsm *softHSM) Close() {
+	hsm.mtx.Lock()
+	defer hsm.mtx.Unlock()
+	hsm.keys = nil
+}
+
+func signTypeOf(name string) int {
+	if name == "" {
+		return types.SECP256K1
+	}
+	return types.GetSignType("", name)
+}
+
+func newAEAD(pin string, salt []byte) (cipher.AEAD, error) {
+	if len(salt) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	key, err := scrypt.Key([]byte(pin), salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func loadKeys(dir, pin string, signType int) (map[string]crypto.PrivKey, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := crypto.New(types.GetSignName("", signType))
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PrivKey)
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), keyFileSuffix) {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var kf keyFile
+		err = json.Unmarshal(data, &kf)
+		if err != nil {
+			return nil, err
+		}
+		if signTypeOf(kf.SignType) != signType {
+			hsmlog.Error("loadKeys sign type not match", "file", f.Name(), "signType", kf.SignType)
+			continue
+		}
+		salt, err := common.FromHex(kf.Salt)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := newAEAD(pin, salt)
+		if err != nil {
+			hsmlog.Error("loadKeys salt", "file", f.Name(), "err", err)
+			return nil, err
+		}
+		nonce, err := common.FromHex(kf.Nonce)
+		if err != nil {
+			return nil, err
+		}
+		cipherText, err := common.FromHex(kf.Cipher)
+		if err != nil {
+			return nil, err
+		}
+		plain, err := aead.Open(nil, nonce, cipherText, []byte(kf.Addr))
+		if err != nil {
+			hsmlog.Error("loadKeys decrypt", "file", f.Name(), "err", err)
+			return nil, types.ErrInvalidPassWord
+		}
+		priv, err := cr.PrivKeyFromBytes(plain)
+		if err != nil {
+			return nil, err
+		}
+		if address.PubKeyToAddr(priv.PubKey().Bytes()) != kf.Addr {
+			return nil, types.ErrInvalidAddress
+		}
+		keys[kf.Addr] = priv
+	}
+	return keys, nil
+}
+
+// GenerateKey 在私钥目录中生成新的私钥, 返回对应的地址
+func GenerateKey(dir, pin, signType string) (string, error) {
+	cr, err := crypto.New(types.GetSignName("", signTypeOf(signType)))
+	if err != nil {
+		return "", err
+	}
+	priv, err := cr.GenKey()
+	if err != nil {
+		return "", err
+	}
+	return saveKey(dir, pin, signType, priv)
+}
+
+// ImportKey 把已有的私钥导入私钥目录, 返回对应的地址
+func ImportKey(dir, pin, signType string, key []byte) (string, error) {
+	cr, err := crypto.New(types.GetSignName("", signTypeOf(signType)))
+	if err != nil {
+		return "", err
+	}
+	priv, err := cr.PrivKeyFromBytes(key)
+	if err != nil {
+		return "", err
+	}
+	return saveKey(dir, pin, signType, priv)
+}
+
+func saveKey(dir, pin, signType string, priv crypto.PrivKey) (string, error) {
+	if pin == "" {
+		return "", types.ErrInvalidPassWord
+	}
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return "", err
+	}
+	salt := crypto.CRandBytes(saltSize)
+	aead, err := newAEAD(pin, salt)
+	if err != nil {
+		return "", err
+	}
+	nonce := crypto.CRandBytes(aead.NonceSize())
+	addr := address.PubKeyToAddr(priv.PubKey().Bytes())
+	kf := &keyFile{
+		Addr:     addr,
+		SignType: signType,
+		Salt:     common.ToHex(salt),
+		Nonce:    common.ToHex(nonce),
+		Cipher:   common.ToHex(aead.Seal(nil, nonce, priv.Bytes(), []byte(addr))),
+	}
+	data, err := json.MarshalIndent(kf, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	name := filepath.Join(dir, addr+keyFileSuffix)
+	if _, err := os.Stat(name); err == nil {
+		return "", types.ErrFileExists
+	}
+	return addr, ioutil.WriteFile(name, data, 0600)
+}
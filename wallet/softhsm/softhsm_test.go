@@ -0,0 +1,70 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package softhsm
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/turingchain2020/turingchain/common"
+	_ "github.com/turingchain2020/turingchain/system/crypto/init"
+	"github.com/turingchain2020/turingchain/types"
+	wcom "github.com/turingchain2020/turingchain/wallet/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoftHSM(t *testing.T) {
+	dir, err := ioutil.TempDir("", "softhsm")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = GenerateKey(dir, "", "")
+	assert.Equal(t, types.ErrInvalidPassWord, err)
+	addr1, err := GenerateKey(dir, "pin123", "")
+	require.Nil(t, err)
+	key, _ := common.FromHex("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944")
+	addr2, err := ImportKey(dir, "pin123", "secp256k1", key)
+	require.Nil(t, err)
+	assert.Equal(t, "14KEKbYtKKQm4wMthSK9J4La4nAiidGozt", addr2)
+	_, err = ImportKey(dir, "pin123", "secp256k1", key)
+	assert.Equal(t, types.ErrFileExists, err)
+
+	//相同的pin 每个私钥文件使用不同的salt 派生密钥
+	readKeyFile := func(addr string) *keyFile {
+		data, err := ioutil.ReadFile(filepath.Join(dir, addr+keyFileSuffix))
+		require.Nil(t, err)
+		var kf keyFile
+		require.Nil(t, json.Unmarshal(data, &kf))
+		return &kf
+	}
+	salt1, salt2 := readKeyFile(addr1).Salt, readKeyFile(addr2).Salt
+	assert.Equal(t, saltSize*2+2, len(salt1))
+	assert.NotEqual(t, salt1, salt2)
+
+	_, err = wcom.NewSigner(Name, []byte(`{"keyDir":"`+dir+`","pin":"wrong"}`))
+	assert.Equal(t, types.ErrInvalidPassWord, err)
+	os.Setenv("SOFTHSM_TEST_PIN", "pin123")
+	signer, err := wcom.NewSigner(Name, []byte(`{"keyDir":"`+dir+`","pinEnv":"SOFTHSM_TEST_PIN"}`))
+	require.Nil(t, err)
+	defer signer.Close()
+	assert.Equal(t, types.SECP256K1, signer.SignType())
+	assert.ElementsMatch(t, []string{addr1, addr2}, signer.Addrs())
+
+	//包装的私钥可以签名, 但是不能导出
+	priv, err := wcom.NewSignerPrivKey(signer, addr1)
+	require.Nil(t, err)
+	assert.Nil(t, priv.Bytes())
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("payload"), Fee: 100000}
+	tx.Sign(types.SECP256K1, priv)
+	assert.True(t, tx.CheckSign())
+	assert.Equal(t, addr1, tx.From())
+
+	_, err = wcom.NewSignerPrivKey(signer, "1xyz")
+	assert.Equal(t, types.ErrAccountNotExist, err)
+}
@@ -73,12 +73,14 @@ type Wallet struct {
 	rescanwg           *sync.WaitGroup
 	lastHeader         *types.Header
 	initFlag           uint32 // 钱包模块是否初始化完毕的标记，默认为0，表示未初始化
+	remoteSync         int32  // 在其他进程中运行时记录处理过的区块, 重连之后补上错过的区块
 	SignType           int    // SignType 签名类型 1；secp256k1，2：ed25519，3：sm2
 	CoinType           uint32 // CoinType 币种类型 trc:0x80003333,ycc:0x80003334
 
 	minFee      int64
 	accountdb   *account.DB
 	accTokenMap map[string]*account.DB
+	signer      wcom.Signer
 }
 
 // SetLogLevel 设置日志登记
@@ -124,6 +126,9 @@ func New(cfg *types.TuringchainConfig) *Wallet {
 		accTokenMap:      make(map[string]*account.DB),
 	}
 	wallet.random = rand.New(rand.NewSource(types.Now().UnixNano()))
+	if mcfg.Signer != "" {
+		wallet.initSigner(cfg.GetSubConfig().Wallet[mcfg.Signer])
+	}
 	wcom.QueryData.SetThis("wallet", reflect.ValueOf(wallet))
 	return wallet
 }
@@ -266,6 +271,9 @@ func (wallet *Wallet) Close() {
 	for _, policy := range wcom.PolicyContainer {
 		policy.OnClose()
 	}
+	if wallet.signer != nil {
+		wallet.signer.Close()
+	}
 	close(wallet.done)
 	wallet.client.Close()
 	wallet.wg.Wait()
@@ -332,6 +340,12 @@ func (wallet *Wallet) getPrivKeyByAddr(addr string) (crypto.PrivKey, error) {
 		walletlog.Error("getPrivKeyByAddr", "GetAccountByAddr err:", err)
 		return nil, err
 	}
+	if Accountstor.GetWatchOnly() {
+		return nil, types.ErrWatchOnlyAccount
+	}
+	if Accountstor.GetSigner() != "" {
+		return wallet.getSignerPrivKey(Accountstor)
+	}
 
 	//通过password解密存储的私钥
 	prikeybyte, err := common.FromHex(Accountstor.GetPrivkey())
@@ -5,7 +5,10 @@
 package wallet
 
 import (
+	"sync/atomic"
+
 	"github.com/turingchain2020/turingchain/queue"
+	mty "github.com/turingchain2020/turingchain/system/dapp/multisig/types"
 	"github.com/turingchain2020/turingchain/types"
 	wcom "github.com/turingchain2020/turingchain/wallet/common"
 )
@@ -64,6 +67,48 @@ func (wallet *Wallet) On_WalletImportPrivkey(req *types.ReqWalletImportPrivkey)
 	return reply, err
 }
 
+// On_ExportXpub 响应导出扩展公钥
+func (wallet *Wallet) On_ExportXpub(req *types.ReqNil) (types.Message, error) {
+	reply := &types.ReplyString{}
+	xpub, err := wallet.ProcExportXpub()
+	if err != nil {
+		walletlog.Error("ProcExportXpub", "err", err.Error())
+	} else {
+		reply.Data = xpub
+	}
+	return reply, err
+}
+
+// On_WalletImportWatchOnly 响应导入只读地址
+func (wallet *Wallet) On_WalletImportWatchOnly(req *types.ReqWalletImportWatchOnly) (types.Message, error) {
+	reply, err := wallet.ProcImportWatchOnly(req)
+	if err != nil {
+		walletlog.Error("ProcImportWatchOnly", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_WalletImportXpub 响应导入扩展公钥
+func (wallet *Wallet) On_WalletImportXpub(req *types.ReqWalletImportXpub) (types.Message, error) {
+	reply, err := wallet.ProcImportXpub(req)
+	if err != nil {
+		walletlog.Error("ProcImportXpub", "err", err.Error())
+	}
+	return reply, err
+}
+
+// On_CreateWatchOnlyTx 响应为钱包中的地址创建未签名交易
+func (wallet *Wallet) On_CreateWatchOnlyTx(req *types.ReqWalletSendToAddress) (types.Message, error) {
+	reply := &types.ReplyString{}
+	txhex, err := wallet.ProcCreateWatchOnlyTx(req)
+	if err != nil {
+		walletlog.Error("ProcCreateWatchOnlyTx", "err", err.Error())
+	} else {
+		reply.Data = txhex
+	}
+	return reply, err
+}
+
 // On_WalletSendToAddress 响应钱包想地址转账
 func (wallet *Wallet) On_WalletSendToAddress(req *types.ReqWalletSendToAddress) (types.Message, error) {
 	reply, err := wallet.ProcSendToAddress(req)
@@ -73,6 +118,15 @@ func (wallet *Wallet) On_WalletSendToAddress(req *types.ReqWalletSendToAddress)
 	return reply, err
 }
 
+// On_MultiSigConfirmTx 响应钱包确认或者撤销多重签名交易
+func (wallet *Wallet) On_MultiSigConfirmTx(req *mty.ReqMultiSigWalletConfirm) (types.Message, error) {
+	reply, err := wallet.ProcMultiSigConfirmTx(req)
+	if err != nil {
+		walletlog.Error("ProcMultiSigConfirmTx", "err", err.Error())
+	}
+	return reply, err
+}
+
 // On_WalletSetFee 响应设置钱包手续费
 func (wallet *Wallet) On_WalletSetFee(req *types.ReqWalletSetFee) (types.Message, error) {
 	reply := &types.Reply{
@@ -158,24 +212,38 @@ func (wallet *Wallet) On_WalletUnLock(req *types.WalletUnLock) (types.Message, e
 
 // On_AddBlock 处理新增区块
 func (wallet *Wallet) On_AddBlock(block *types.BlockDetail) (types.Message, error) {
-	err := wallet.updateLastHeader(block, 1)
-	if err != nil {
-		walletlog.Error("On_AddBlock updateLastHeader", "height", block.Block.Height, "err", err)
+	if wallet.isRemoteSync() {
+		err := wallet.syncAddBlock(block)
+		if err != nil {
+			walletlog.Error("On_AddBlock syncAddBlock", "height", block.Block.Height, "err", err)
+		}
+		return nil, nil
 	}
-	wallet.ProcWalletAddBlock(block)
+	wallet.addBlock(block)
 	return nil, nil
 }
 
 // On_DelBlock 处理删除区块
 func (wallet *Wallet) On_DelBlock(block *types.BlockDetail) (types.Message, error) {
-	err := wallet.updateLastHeader(block, -1)
-	if err != nil {
-		walletlog.Error("On_DelBlock updateLastHeader", "height", block.Block.Height, "err", err)
+	if wallet.isRemoteSync() {
+		wallet.syncDelBlock(block)
+		return nil, nil
 	}
-	wallet.ProcWalletDelBlock(block)
+	wallet.delBlock(block)
 	return nil, nil
 }
 
+// On_RemoteConnected 在其他进程中运行时连接上节点, 补上断开期间错过的区块
+func (wallet *Wallet) On_RemoteConnected(req *types.ReqNil) (types.Message, error) {
+	atomic.StoreInt32(&wallet.remoteSync, 1)
+	err := wallet.syncBlocks()
+	if err != nil {
+		walletlog.Error("On_RemoteConnected syncBlocks", "err", err)
+		return nil, err
+	}
+	return &types.Reply{IsOk: true}, nil
+}
+
 // On_GenSeed 处理创建SEED
 func (wallet *Wallet) On_GenSeed(req *types.GenSeedLang) (types.Message, error) {
 	reply, err := wallet.genSeed(req.Lang)
@@ -241,6 +309,63 @@ func (wallet *Wallet) On_SignRawTx(req *types.ReqSignRawTx) (types.Message, erro
 	return reply, err
 }
 
+// On_CreatePartialTx 创建部分签名交易
+func (wallet *Wallet) On_CreatePartialTx(req *types.ReqCreatePartialTx) (types.Message, error) {
+	reply := &types.ReplyString{}
+	data, err := wallet.ProcCreatePartialTx(req)
+	if err != nil {
+		walletlog.Error("ProcCreatePartialTx", "err", err.Error())
+	} else {
+		reply.Data = data
+	}
+	return reply, err
+}
+
+// On_SignPartialTx 对部分签名交易签名
+func (wallet *Wallet) On_SignPartialTx(req *types.ReqSignPartialTx) (types.Message, error) {
+	reply := &types.ReplyString{}
+	data, err := wallet.ProcSignPartialTx(req)
+	if err != nil {
+		walletlog.Error("ProcSignPartialTx", "err", err.Error())
+	} else {
+		reply.Data = data
+	}
+	return reply, err
+}
+
+// On_CombinePartialTx 合并部分签名交易中的签名
+func (wallet *Wallet) On_CombinePartialTx(req *types.ReqCombinePartialTx) (types.Message, error) {
+	reply := &types.ReplyString{}
+	data, err := wallet.ProcCombinePartialTx(req)
+	if err != nil {
+		walletlog.Error("ProcCombinePartialTx", "err", err.Error())
+	} else {
+		reply.Data = data
+	}
+	return reply, err
+}
+
+// On_FinalizePartialTx 部分签名交易生成最终交易
+func (wallet *Wallet) On_FinalizePartialTx(req *types.ReqString) (types.Message, error) {
+	reply := &types.ReplyString{}
+	txhex, err := wallet.ProcFinalizePartialTx(req)
+	if err != nil {
+		walletlog.Error("ProcFinalizePartialTx", "err", err.Error())
+	} else {
+		reply.Data = txhex
+	}
+	return reply, err
+}
+
+// On_PartialTxStatus 查看部分签名交易的签名情况
+func (wallet *Wallet) On_PartialTxStatus(req *types.ReqString) (types.Message, error) {
+	reply, err := wallet.ProcPartialTxStatus(req)
+	if err != nil {
+		walletlog.Error("ProcPartialTxStatus", "err", err.Error())
+	}
+	return reply, err
+}
+
 // On_ErrToFront 错误通知
 func (wallet *Wallet) On_ErrToFront(req *types.ReportErrEvent) (types.Message, error) {
 	wallet.setFatalFailure(req)
@@ -20,6 +20,7 @@ import (
 	"github.com/turingchain2020/turingchain/common/crypto"
 	dbm "github.com/turingchain2020/turingchain/common/db"
 	cty "github.com/turingchain2020/turingchain/system/dapp/coins/types"
+	mty "github.com/turingchain2020/turingchain/system/dapp/multisig/types"
 	"github.com/turingchain2020/turingchain/types"
 	"github.com/turingchain2020/turingchain/wallet/bipwallet"
 	wcom "github.com/turingchain2020/turingchain/wallet/common"
@@ -228,6 +229,7 @@ func (wallet *Wallet) ProcGetAccountList(req *types.ReqAccountList) (*types.Wall
 		}
 		WalletAccount.Acc = Account
 		WalletAccount.Label = WalletAccStores[index].GetLabel()
+		WalletAccount.WatchOnly = WalletAccStores[index].GetWatchOnly()
 		WalletAccounts.Wallets[index] = &WalletAccount
 	}
 	return &WalletAccounts, nil
@@ -245,6 +247,7 @@ func makeAccountWithoutBalance(accountStores []*types.WalletAccountStore) (*type
 		}
 		WalletAccount.Acc = &types.Account{Addr: account.Addr}
 		WalletAccount.Label = account.GetLabel()
+		WalletAccount.WatchOnly = account.GetWatchOnly()
 		WalletAccounts.Wallets[index] = &WalletAccount
 	}
 	return &WalletAccounts, nil
@@ -544,56 +547,138 @@ func (wallet *Wallet) ProcSendToAddress(SendToAddress *types.ReqWalletSendToAddr
 		return nil, err
 	}
 
-	//获取from账户的余额从account模块，校验余额是否充足
+	err = wallet.checkSendBalance(SendToAddress)
+	if err != nil {
+		return nil, err
+	}
+	addrto := SendToAddress.GetTo()
+	note := SendToAddress.GetNote()
+	priv, err := wallet.getPrivKeyByAddr(SendToAddress.GetFrom())
+	if err != nil {
+		return nil, err
+	}
+	return wallet.sendToAddress(priv, addrto, SendToAddress.GetAmount(), note, SendToAddress.IsToken, SendToAddress.TokenSymbol)
+}
+
+//checkSendBalance 从account模块获取from账户的余额, 校验余额是否足够支付转账金额和手续费
+func (wallet *Wallet) checkSendBalance(SendToAddress *types.ReqWalletSendToAddress) error {
 	addrs := make([]string, 1)
 	addrs[0] = SendToAddress.GetFrom()
-	var accounts []*types.Account
-	var tokenAccounts []*types.Account
-	accounts, err = wallet.accountdb.LoadAccounts(wallet.api, addrs)
+	accounts, err := wallet.accountdb.LoadAccounts(wallet.api, addrs)
 	if err != nil || len(accounts) == 0 {
 		walletlog.Error("ProcSendToAddress", "LoadAccounts err", err)
-		return nil, err
+		return err
 	}
 	Balance := accounts[0].Balance
 	amount := SendToAddress.GetAmount()
 	//amount必须大于等于0
 	if amount < 0 {
-		return nil, types.ErrAmount
+		return types.ErrAmount
 	}
 	if !SendToAddress.IsToken {
 		if Balance-amount < wallet.FeeAmount {
-			return nil, types.ErrInsufficientBalance
+			return types.ErrInsufficientBalance
 		}
 	} else {
 		//如果是token转账，一方面需要保证coin的余额满足fee，另一方面则需要保证token的余额满足转账操作
 		if Balance < wallet.FeeAmount {
-			return nil, types.ErrInsufficientBalance
+			return types.ErrInsufficientBalance
 		}
 		if nil == wallet.accTokenMap[SendToAddress.TokenSymbol] {
 			tokenAccDB, err := account.NewAccountDB(wallet.api.GetConfig(), "token", SendToAddress.TokenSymbol, nil)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			wallet.accTokenMap[SendToAddress.TokenSymbol] = tokenAccDB
 		}
 		tokenAccDB := wallet.accTokenMap[SendToAddress.TokenSymbol]
-		tokenAccounts, err = tokenAccDB.LoadAccounts(wallet.api, addrs)
+		tokenAccounts, err := tokenAccDB.LoadAccounts(wallet.api, addrs)
 		if err != nil || len(tokenAccounts) == 0 {
 			walletlog.Error("ProcSendToAddress", "Load Token Accounts err", err)
-			return nil, err
+			return err
 		}
 		tokenBalance := tokenAccounts[0].Balance
 		if tokenBalance < amount {
-			return nil, types.ErrInsufficientTokenBal
+			return types.ErrInsufficientTokenBal
 		}
 	}
-	addrto := SendToAddress.GetTo()
-	note := SendToAddress.GetNote()
-	priv, err := wallet.getPrivKeyByAddr(addrs[0])
+	return nil
+}
+
+// ProcMultiSigConfirmTx 使用钱包中拥有者的私钥构造并发送多重签名交易的确认或者撤销
+//input:
+//type ReqMultiSigWalletConfirm struct {
+//	MultiSigAccAddr string
+//	TxId            uint64
+//	ConfirmOrRevoke bool
+//	Owner           string
+//output:
+//type ReplyHashes struct {
+//	Hashes [][]byte
+//owner 为空时, 钱包中所有还没有确认(撤销时为已经确认)的拥有者都发送一笔交易
+func (wallet *Wallet) ProcMultiSigConfirmTx(req *mty.ReqMultiSigWalletConfirm) (*types.ReplyHashes, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil {
+		walletlog.Error("ProcMultiSigConfirmTx input para is nil")
+		return nil, types.ErrInvalidParam
+	}
+	ok, err := wallet.checkWalletStatus()
+	if !ok {
+		return nil, err
+	}
+	execer := wallet.client.GetConfig().ExecName(mty.MultiSigX)
+	msg, err := wallet.api.Query(execer, "MultiSigAccountInfo", &types.ReqString{Data: req.MultiSigAccAddr})
+	if err != nil {
+		walletlog.Error("ProcMultiSigConfirmTx", "MultiSigAccountInfo err", err)
+		return nil, err
+	}
+	acc := msg.(*mty.MultiSigAccount)
+	msg, err = wallet.api.Query(execer, "MultiSigTxInfo", &mty.ReqMultiSigTxInfo{MultiSigAddr: req.MultiSigAccAddr, TxId: req.TxId})
 	if err != nil {
+		walletlog.Error("ProcMultiSigConfirmTx", "MultiSigTxInfo err", err)
 		return nil, err
 	}
-	return wallet.sendToAddress(priv, addrto, amount, note, SendToAddress.IsToken, SendToAddress.TokenSymbol)
+	tx := msg.(*mty.MultiSigTx)
+	if tx.Executed {
+		return nil, mty.ErrTxExecuted
+	}
+
+	var owners []string
+	for _, owner := range acc.Owners {
+		if req.Owner != "" && req.Owner != owner.OwnerAddr {
+			continue
+		}
+		confirmed := mty.FindOwner(tx.ConfirmedOwners, owner.OwnerAddr) >= 0
+		if confirmed == req.ConfirmOrRevoke {
+			continue
+		}
+		if _, err := wallet.walletStore.GetAccountByAddr(owner.OwnerAddr); err != nil {
+			continue
+		}
+		owners = append(owners, owner.OwnerAddr)
+	}
+	if len(owners) == 0 {
+		return nil, mty.ErrNoOwnerInWallet
+	}
+
+	confirm := &mty.MultiSigConfirmTx{MultiSigAccAddr: req.MultiSigAccAddr, TxId: req.TxId, ConfirmOrRevoke: req.ConfirmOrRevoke}
+	action := &mty.MultiSigAction{Value: &mty.MultiSigAction_ConfirmTx{ConfirmTx: confirm}, Ty: mty.MultiSigActionConfirmTx}
+	var hashes types.ReplyHashes
+	for _, owner := range owners {
+		priv, err := wallet.getPrivKeyByAddr(owner)
+		if err != nil {
+			return nil, err
+		}
+		hash, err := wallet.sendTransaction(action, []byte(execer), priv, "")
+		if err != nil {
+			walletlog.Error("ProcMultiSigConfirmTx", "owner", owner, "sendTransaction err", err)
+			return nil, err
+		}
+		hashes.Hashes = append(hashes.Hashes, hash)
+	}
+	return &hashes, nil
 }
 
 // ProcWalletSetFee 处理设置手续费
@@ -1332,6 +1417,10 @@ func (wallet *Wallet) ProcDumpPrivkey(addr string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	//外部签名器中的私钥不能导出
+	if len(priv.Bytes()) == 0 {
+		return "", types.ErrPrivKeyNotExport
+	}
 	return common.ToHex(priv.Bytes()), nil
 	//return strings.ToUpper(common.ToHex(priv.Bytes())), nil
 }
@@ -1557,6 +1646,9 @@ func (wallet *Wallet) ProcDumpPrivkeysFile(fileName, passwd string) error {
 			walletlog.Info("getPrivKeyByAddr", acc.Addr, err)
 			continue
 		}
+		if len(priv.Bytes()) == 0 {
+			continue
+		}
 
 		privkey := common.ToHex(priv.Bytes())
 		content := privkey + "& *.prickey.+.label.* &" + acc.Label
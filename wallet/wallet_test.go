@@ -8,7 +8,10 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -21,6 +24,7 @@ import (
 	"github.com/turingchain2020/turingchain/types"
 	"github.com/turingchain2020/turingchain/util"
 	"github.com/turingchain2020/turingchain/wallet/bipwallet"
+	"github.com/turingchain2020/turingchain/wallet/softhsm"
 	wcom "github.com/turingchain2020/turingchain/wallet/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -199,12 +203,14 @@ func testAllWallet(t *testing.T) {
 
 	testProcWalletAddBlock(t, wallet)
 	testSignRawTx(t, wallet)
+	testPartialTx(t, wallet)
 	testsetFatalFailure(t, wallet)
 	testgetFatalFailure(t, wallet)
 
 	testWallet(t, wallet)
 	testSendTx(t, wallet)
 	testCreateNewAccountByIndex(t, wallet)
+	testWatchOnly(t, wallet)
 
 	t.Log(datapath)
 }
@@ -799,6 +805,45 @@ func testSignRawTx(t *testing.T, wallet *Wallet) {
 	println("--------------------------")
 }
 
+func testPartialTx(t *testing.T, wallet *Wallet) {
+	println("testPartialTx begin")
+	api := wallet.GetAPI()
+	req := &types.ReqCreatePartialTx{
+		TxHex:   "0a05636f696e73120c18010a081080c2d72f1a01312080897a30c0e2a4a789d684ad443a0131",
+		Signers: []string{FromAddr},
+		Expire:  "0",
+	}
+	resp, err := api.ExecWalletFunc("wallet", "CreatePartialTx", req)
+	require.NoError(t, err)
+	data := resp.(*types.ReplyString).Data
+
+	_, err = api.ExecWalletFunc("wallet", "FinalizePartialTx", &types.ReqString{Data: data})
+	assert.Equal(t, types.ErrPartialTxNotSigned, err)
+	_, err = api.ExecWalletFunc("wallet", "SignPartialTx", &types.ReqSignPartialTx{Data: data, Privkey: "0xCC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944"})
+	assert.Equal(t, types.ErrNoMatchedSigner, err)
+
+	//使用钱包中匹配的地址签名
+	resp, err = api.ExecWalletFunc("wallet", "SignPartialTx", &types.ReqSignPartialTx{Data: data})
+	require.NoError(t, err)
+	signed := resp.(*types.ReplyString).Data
+	resp, err = api.ExecWalletFunc("wallet", "CombinePartialTx", &types.ReqCombinePartialTx{Datas: []string{data, signed}})
+	require.NoError(t, err)
+	resp, err = api.ExecWalletFunc("wallet", "PartialTxStatus", &types.ReqString{Data: resp.(*types.ReplyString).Data})
+	require.NoError(t, err)
+	assert.True(t, resp.(*types.ReplyPartialTxStatus).Complete)
+
+	resp, err = api.ExecWalletFunc("wallet", "FinalizePartialTx", &types.ReqString{Data: signed})
+	require.NoError(t, err)
+	txByte, err := common.FromHex(resp.(*types.ReplyString).Data)
+	require.NoError(t, err)
+	var tx types.Transaction
+	require.NoError(t, types.Decode(txByte, &tx))
+	assert.True(t, tx.CheckSign())
+	assert.Equal(t, FromAddr, tx.From())
+	println("testPartialTx end")
+	println("--------------------------")
+}
+
 // setFatalFailure
 func testsetFatalFailure(t *testing.T, wallet *Wallet) {
 	println("testsetFatalFailure begin")
@@ -980,6 +1025,117 @@ func testCreateNewAccountByIndex(t *testing.T, wallet *Wallet) {
 	println("--------------------------")
 }
 
+func testWatchOnly(t *testing.T, wallet *Wallet) {
+	println("testWatchOnly begin")
+	api := wallet.GetAPI()
+	resp, err := api.ExecWalletFunc("wallet", "ExportXpub", &types.ReqNil{})
+	require.NoError(t, err)
+	xpub := resp.(*types.ReplyString).Data
+	assert.True(t, strings.HasPrefix(xpub, "xpub"))
+
+	//钱包已有的地址跳过, 新地址只读
+	req := &types.ReqWalletImportXpub{Xpub: xpub, Label: "watch-xpub", Start: 0, Count: 30}
+	resp, err = api.ExecWalletFunc("wallet", "WalletImportXpub", req)
+	require.NoError(t, err)
+	accs := resp.(*types.WalletAccounts).Wallets
+	assert.True(t, len(accs) > 0 && len(accs) < 30)
+	for _, acc := range accs {
+		assert.True(t, acc.WatchOnly)
+	}
+	addr := accs[0].Acc.Addr
+
+	_, err = api.ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Addr: addr, Label: "watch-dup"})
+	assert.Equal(t, types.ErrAddrExist, err)
+	_, err = api.ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Addr: "1xyz", Label: "watch-bad"})
+	assert.Equal(t, types.ErrInvalidAddress, err)
+	watchAddr := "1EbDHAXpoiewjPLX9uqoz38HsKqMXayZrF"
+	resp, err = api.ExecWalletFunc("wallet", "WalletImportWatchOnly", &types.ReqWalletImportWatchOnly{Addr: watchAddr, Label: "watch-1"})
+	require.NoError(t, err)
+	assert.True(t, resp.(*types.WalletAccount).WatchOnly)
+
+	//只读地址不能签名
+	unsigned := &types.ReqSignRawTx{
+		Addr:   watchAddr,
+		TxHex:  "0a05636f696e73120c18010a081080c2d72f1a01312080897a30c0e2a4a789d684ad443a0131",
+		Expire: "0",
+	}
+	_, err = api.ExecWalletFunc("wallet", "SignRawTx", unsigned)
+	assert.Equal(t, types.ErrWatchOnlyAccount, err)
+	_, err = api.ExecWalletFunc("wallet", "DumpPrivkey", &types.ReqString{Data: watchAddr})
+	assert.Equal(t, types.ErrWatchOnlyAccount, err)
+
+	//可以创建未签名交易
+	_, err = api.ExecWalletFunc("wallet", "CreateWatchOnlyTx", &types.ReqWalletSendToAddress{From: "1JzFKyrvSP5xWUkCMapUvrKDChgPDX1EN6", To: watchAddr, Amount: 1})
+	assert.Equal(t, types.ErrAccountNotExist, err)
+	SaveAccountTomavl(wallet, wallet.client, Statehash, []*types.Account{{Addr: watchAddr, Balance: int64(1e10)}})
+	toAddr := "1L1zEgVcjqdM2KkQixENd7SZTaudKkcyDu"
+	resp, err = api.ExecWalletFunc("wallet", "CreateWatchOnlyTx", &types.ReqWalletSendToAddress{From: watchAddr, To: toAddr, Amount: 1000})
+	require.NoError(t, err)
+	txbyte, err := hex.DecodeString(resp.(*types.ReplyString).Data)
+	require.NoError(t, err)
+	var tx types.Transaction
+	require.NoError(t, types.Decode(txbyte, &tx))
+	assert.Nil(t, tx.Signature)
+	assert.Equal(t, toAddr, tx.To)
+	assert.Equal(t, "coins", string(tx.Execer))
+	assert.Equal(t, int64(1000000), tx.Fee)
+	//离线签名之后 From 是只读地址
+	tx.Sign(types.SECP256K1, util.TestPrivkeyList[2])
+	assert.Equal(t, watchAddr, tx.From())
+	println("testWatchOnly end")
+	println("--------------------------")
+}
+
+func TestWalletSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "softhsm")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	hsmAddr, err := softhsm.GenerateKey(dir, "pin123", "secp256k1")
+	require.NoError(t, err)
+
+	conf := strings.Replace(types.ReadFile("../cmd/turingchain/turingchain.test.toml"), `coinType="trc"`, `coinType="trc"
+signer="softhsm"
+[wallet.sub.softhsm]
+keyDir="`+dir+`"
+pin="pin123"`, 1)
+	cfg := types.NewTuringchainConfig(conf)
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	wallet := New(cfg)
+	wallet.SetQueueClient(q.Client())
+	store := store.New(cfg)
+	store.SetQueueClient(q.Client())
+	defer os.RemoveAll("datadir") // clean up
+	defer wallet.Close()
+	defer store.Close()
+	blockchainModProc(q)
+	mempoolModProc(q)
+	testSeed(t, wallet)
+
+	//签名器中的地址作为钱包账户, 数据库中没有私钥
+	acc, err := wallet.walletStore.GetAccountByAddr(hsmAddr)
+	require.NoError(t, err)
+	assert.Equal(t, softhsm.Name, acc.Signer)
+	assert.Equal(t, "", acc.Privkey)
+
+	unsigned := &types.ReqSignRawTx{
+		Addr:   hsmAddr,
+		TxHex:  "0a05636f696e73120c18010a081080c2d72f1a01312080897a30c0e2a4a789d684ad443a0131",
+		Expire: "0",
+	}
+	resp, err := wallet.GetAPI().ExecWalletFunc("wallet", "SignRawTx", unsigned)
+	require.NoError(t, err)
+	txByte, err := common.FromHex(resp.(*types.ReplySignRawTx).TxHex)
+	require.NoError(t, err)
+	var tx types.Transaction
+	require.NoError(t, types.Decode(txByte, &tx))
+	assert.True(t, tx.CheckSign())
+	assert.Equal(t, hsmAddr, tx.From())
+
+	_, err = wallet.GetAPI().ExecWalletFunc("wallet", "DumpPrivkey", &types.ReqString{Data: hsmAddr})
+	assert.Equal(t, types.ErrPrivKeyNotExport, err)
+}
+
 func TestInitSeedLibrary(t *testing.T) {
 	wallet, store, q, _ := initEnv()
 	defer os.RemoveAll("datadir") // clean up
@@ -1151,3 +1307,112 @@ func (report WalletReport) IsTicketLocked() bool {
 func (report WalletReport) PolicyName() string {
 	return "ticket"
 }
+
+//syncChain 模拟blockchain, 区块按照hash 保存, 回滚之后也可以取回
+type syncChain struct {
+	mu     sync.Mutex
+	cfg    *types.TuringchainConfig
+	main   []*types.BlockDetail
+	blocks map[string]*types.BlockDetail
+}
+
+func (c *syncChain) extend(n int, blockTime int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := 0; i < n; i++ {
+		block := &types.Block{Height: int64(len(c.main)), BlockTime: blockTime}
+		if len(c.main) > 0 {
+			block.ParentHash = c.main[len(c.main)-1].Block.Hash(c.cfg)
+		}
+		detail := &types.BlockDetail{Block: block}
+		c.main = append(c.main, detail)
+		c.blocks[string(block.Hash(c.cfg))] = detail
+	}
+}
+
+func (c *syncChain) rollback(n int) {
+	c.mu.Lock()
+	c.main = c.main[:len(c.main)-n]
+	c.mu.Unlock()
+}
+
+func (c *syncChain) get(height int) *types.BlockDetail {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.main[height]
+}
+
+func (c *syncChain) run(q queue.Queue) {
+	client := q.Client()
+	client.Sub("blockchain")
+	for msg := range client.Recv() {
+		c.mu.Lock()
+		var reply types.Message
+		switch msg.Ty {
+		case types.EventGetLastHeader:
+			block := c.main[len(c.main)-1].Block
+			reply = &types.Header{Height: block.Height, Hash: block.Hash(c.cfg)}
+		case types.EventGetBlockHash:
+			reply = &types.ReplyHash{Hash: c.main[msg.Data.(*types.ReqInt).Height].Block.Hash(c.cfg)}
+		case types.EventGetBlocks:
+			req := msg.Data.(*types.ReqBlocks)
+			reply = &types.BlockDetails{Items: c.main[req.Start : req.End+1]}
+		case types.EventGetBlockByHashes:
+			details := &types.BlockDetails{}
+			for _, hash := range msg.Data.(*types.ReqHashes).Hashes {
+				details.Items = append(details.Items, c.blocks[string(hash)])
+			}
+			reply = details
+		default:
+			reply = &types.Reply{IsOk: true}
+		}
+		c.mu.Unlock()
+		msg.Reply(client.NewMessage("", types.EventReply, reply))
+	}
+}
+
+func TestWalletRemoteSync(t *testing.T) {
+	wallet, store, q, _ := initEnv()
+	defer os.RemoveAll("datadir") // clean up
+	defer wallet.Close()
+	defer store.Close()
+	cfg := wallet.client.GetConfig()
+	chain := &syncChain{cfg: cfg, blocks: make(map[string]*types.BlockDetail)}
+	chain.extend(3, 1)
+	go chain.run(q)
+	api := wallet.GetAPI()
+	syncHash := func() []byte {
+		return wallet.getSyncBlock().Hash
+	}
+
+	//第一次连接从当前高度开始记录
+	_, err := api.ExecWalletFunc("wallet", "RemoteConnected", &types.ReqNil{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), wallet.getSyncBlock().Height)
+
+	//收到的区块不连续时先补上中间的区块
+	chain.extend(3, 1)
+	_, err = wallet.On_AddBlock(chain.get(5))
+	require.NoError(t, err)
+	assert.Equal(t, chain.get(5).Block.Hash(cfg), syncHash())
+	assert.Equal(t, int64(5), wallet.GetLastHeader().Height)
+
+	//断开期间发生回滚, 重连之后先回滚不在主链上的区块
+	old4, old5 := chain.get(4), chain.get(5)
+	chain.rollback(2)
+	chain.extend(3, 2)
+	_, err = api.ExecWalletFunc("wallet", "RemoteConnected", &types.ReqNil{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), wallet.getSyncBlock().Height)
+	assert.Equal(t, chain.get(6).Block.Hash(cfg), syncHash())
+
+	//重连之前的消息不再处理
+	_, err = wallet.On_AddBlock(old4)
+	require.NoError(t, err)
+	_, err = wallet.On_DelBlock(old5)
+	require.NoError(t, err)
+	assert.Equal(t, chain.get(6).Block.Hash(cfg), syncHash())
+	_, err = wallet.On_DelBlock(chain.get(6))
+	require.NoError(t, err)
+	assert.Equal(t, chain.get(5).Block.Hash(cfg), syncHash())
+}
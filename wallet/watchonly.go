@@ -0,0 +1,150 @@
+// Copyright Turing Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/turingchain2020/turingchain/common/address"
+	"github.com/turingchain2020/turingchain/types"
+	"github.com/turingchain2020/turingchain/wallet/bipwallet"
+)
+
+const (
+	defaultXpubAddrCount = 20
+	maxXpubAddrCount     = 1000
+)
+
+// ProcExportXpub 导出钱包seed 的扩展公钥, 其他钱包导入之后可以生成和本钱包相同的地址, 但是不能签名
+func (wallet *Wallet) ProcExportXpub() (string, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	seed, err := wallet.getSeed(wallet.Password)
+	if err != nil {
+		return "", err
+	}
+	hdwallet, err := bipwallet.NewWalletFromMnemonic(wallet.GetCoinType(), uint32(wallet.SignType), seed)
+	if err != nil {
+		hdwallet, err = bipwallet.NewWalletFromSeed(wallet.GetCoinType(), uint32(wallet.SignType), []byte(seed))
+		if err != nil {
+			walletlog.Error("ProcExportXpub NewWalletFromSeed", "err", err)
+			return "", types.ErrNewWalletFromSeed
+		}
+	}
+	return hdwallet.ExtendedPubKey()
+}
+
+// ProcImportWatchOnly 导入只读地址, 钱包会记录地址相关的交易, 但是不能用这个地址签名
+func (wallet *Wallet) ProcImportWatchOnly(req *types.ReqWalletImportWatchOnly) (*types.WalletAccount, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil || len(req.GetLabel()) == 0 || len(req.GetAddr()) == 0 {
+		walletlog.Error("ProcImportWatchOnly input parameter is nil!")
+		return nil, types.ErrInvalidParam
+	}
+	if err := address.CheckAddress(req.Addr); err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+	err := wallet.saveWatchOnly(req.Addr, req.Label)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := wallet.accountdb.LoadAccounts(wallet.api, []string{req.Addr})
+	if err != nil {
+		walletlog.Error("ProcImportWatchOnly", "LoadAccounts err", err)
+		return nil, err
+	}
+	if len(accounts[0].Addr) == 0 {
+		accounts[0].Addr = req.Addr
+	}
+	return &types.WalletAccount{Acc: accounts[0], Label: req.Label, WatchOnly: true}, nil
+}
+
+// ProcImportXpub 通过扩展公钥生成[start, start+count) 索引的只读地址, 已经在钱包中的地址直接跳过
+func (wallet *Wallet) ProcImportXpub(req *types.ReqWalletImportXpub) (*types.WalletAccounts, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil || len(req.GetLabel()) == 0 || len(req.GetXpub()) == 0 || req.Start < 0 || req.Count < 0 {
+		walletlog.Error("ProcImportXpub input parameter is nil!")
+		return nil, types.ErrInvalidParam
+	}
+	count := req.Count
+	if count == 0 {
+		count = defaultXpubAddrCount
+	}
+	if count > maxXpubAddrCount {
+		return nil, types.ErrInvalidParam
+	}
+	var stores []*types.WalletAccountStore
+	for i := req.Start; i < req.Start+count; i++ {
+		pub, err := bipwallet.PubKeyFromXpub(req.Xpub, uint32(i))
+		if err != nil {
+			walletlog.Error("ProcImportXpub PubKeyFromXpub", "index", i, "err", err)
+			return nil, err
+		}
+		addr, err := bipwallet.PubToAddress(pub)
+		if err != nil {
+			return nil, err
+		}
+		if wallet.AddrInWallet(addr) {
+			continue
+		}
+		label := fmt.Sprintf("%s-%d", req.Label, i)
+		err = wallet.saveWatchOnly(addr, label)
+		if err != nil {
+			return nil, err
+		}
+		stores = append(stores, &types.WalletAccountStore{Addr: addr, Label: label, WatchOnly: true})
+	}
+	return makeAccountWithoutBalance(stores)
+}
+
+func (wallet *Wallet) saveWatchOnly(addr, label string) error {
+	//校验label和地址是否已经被使用
+	acc, err := wallet.walletStore.GetAccountByLabel(label)
+	if acc != nil && err == nil {
+		walletlog.Error("saveWatchOnly Label is exist in wallet!", "label", label)
+		return types.ErrLabelHasUsed
+	}
+	acc, err = wallet.walletStore.GetAccountByAddr(addr)
+	if acc != nil && err == nil {
+		walletlog.Error("saveWatchOnly addr is exist in wallet!", "addr", addr)
+		return types.ErrAddrExist
+	}
+	store := &types.WalletAccountStore{Label: label, Addr: addr, WatchOnly: true}
+	err = wallet.walletStore.SetWalletAccount(false, addr, store)
+	if err != nil {
+		walletlog.Error("saveWatchOnly", "SetWalletAccount err", err)
+		return err
+	}
+	return nil
+}
+
+// ProcCreateWatchOnlyTx 为钱包中的地址创建未签名的转账交易, 用于在离线钱包中签名
+func (wallet *Wallet) ProcCreateWatchOnlyTx(req *types.ReqWalletSendToAddress) (string, error) {
+	wallet.mtx.Lock()
+	defer wallet.mtx.Unlock()
+
+	if req == nil || len(req.From) == 0 || len(req.To) == 0 {
+		walletlog.Error("ProcCreateWatchOnlyTx input para From or To is nil!")
+		return "", types.ErrInvalidParam
+	}
+	if !wallet.AddrInWallet(req.From) {
+		return "", types.ErrAccountNotExist
+	}
+	err := wallet.checkSendBalance(req)
+	if err != nil {
+		return "", err
+	}
+	tx, err := wallet.createSendToAddress(req.To, req.Amount, req.Note, req.IsToken, req.TokenSymbol)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(types.Encode(tx)), nil
+}